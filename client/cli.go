@@ -0,0 +1,134 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const usage = `usage:
+  mongoplayground run  -config <file> -query <file> [-mode bson|mgodatagen] [-version <version>] [-url <url>]
+  mongoplayground save -config <file> -query <file> [-mode bson|mgodatagen] [-version <version>] [-parent <id>]
+                       [-title <title>] [-description <file>] [-tags <tag,tag>] [-url <url>]
+  mongoplayground load [-url <url>] <id>
+`
+
+// IsCommand returns true if name is a subcommand of the cli
+func IsCommand(name string) bool {
+	return name == "run" || name == "save" || name == "load"
+}
+
+// RunCommand runs a subcommand of the cli, like:
+//
+//	mongoplayground run -config config.json -query query.js
+//
+// args doesn't include the name of the program. The result of
+// the command is written to out
+func RunCommand(ctx context.Context, args []string, out io.Writer) error {
+
+	if len(args) == 0 || !IsCommand(args[0]) {
+		return errors.New(usage)
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	baseURL := flags.String("url", DefaultURL, "url of the mongoplayground server")
+	mode := flags.String("mode", ModeBSON, "mode of the playground, bson or mgodatagen")
+	configFile := flags.String("config", "", "file containing the configuration")
+	queryFile := flags.String("query", "", "file containing the query")
+	version := flags.String("version", "", "MongoDB version to run the playground against")
+	parent := flags.String("parent", "", "id of the playground this one is forked from")
+	title := flags.String("title", "", "title of the playground")
+	descriptionFile := flags.String("description", "", "file containing the markdown description of the playground")
+	tags := flags.String("tags", "", "comma separated list of tags")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%v\n%s", err, usage)
+	}
+
+	c := New(*baseURL)
+
+	if args[0] == "load" {
+		if flags.NArg() != 1 {
+			return errors.New(usage)
+		}
+		p, err := c.Load(ctx, flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(p)
+	}
+
+	if *configFile == "" || *queryFile == "" {
+		return errors.New(usage)
+	}
+	config, err := os.ReadFile(*configFile)
+	if err != nil {
+		return err
+	}
+	query, err := os.ReadFile(*queryFile)
+	if err != nil {
+		return err
+	}
+
+	// files usually end with a new line, which would change
+	// the id of the saved playground
+	p := Playground{
+		Mode:    *mode,
+		Config:  string(bytes.TrimSpace(config)),
+		Query:   string(bytes.TrimSpace(query)),
+		Version: *version,
+		Parent:  *parent,
+		Title:   *title,
+	}
+	if *tags != "" {
+		p.Tags = strings.Split(*tags, ",")
+	}
+	if *descriptionFile != "" {
+		description, err := os.ReadFile(*descriptionFile)
+		if err != nil {
+			return err
+		}
+		p.Description = string(description)
+	}
+
+	if args[0] == "run" {
+		res, err := c.Run(ctx, p)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, res)
+		return err
+	}
+
+	id, err := c.Save(ctx, p)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, c.URL(id))
+	return err
+}
@@ -0,0 +1,190 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package client runs, saves and loads playgrounds using the
+// json api of a mongoplayground server:
+//
+//	c := client.New(client.DefaultURL)
+//	res, err := c.Run(ctx, client.Playground{
+//		Mode:   client.ModeBSON,
+//		Config: `[{"_id":1,"k":1}]`,
+//		Query:  `db.collection.find()`,
+//	})
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultURL is the url of the public mongoplayground server
+const DefaultURL = "https://mongoplayground.net"
+
+// modes of a playground
+const (
+	ModeBSON       = "bson"
+	ModeMgodatagen = "mgodatagen"
+)
+
+// Playground is the content of a playground
+type Playground struct {
+	// either ModeBSON or ModeMgodatagen
+	Mode   string `json:"mode"`
+	Config string `json:"config"`
+	Query  string `json:"query"`
+	// MongoDB version to run the playground against, like "6.0".
+	// If empty, the default version of the server is used
+	Version string `json:"version,omitempty"`
+	// id of the playground this one was forked from, only
+	// used by Save
+	Parent string `json:"parent,omitempty"`
+	// optional title, markdown description and tags of the playground
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// Error is an error returned by the server
+type Error struct {
+	// HTTP status code of the response
+	StatusCode int
+	// kind of error, like "query", "config" or "runtime"
+	Kind    string
+	Message string
+	// offset in bytes of a syntax error in the query, or -1
+	Position int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s error: %s", e.Kind, e.Message)
+}
+
+// Client sends requests to a mongoplayground server
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// New returns a client for the server at baseURL, like DefaultURL
+func New(baseURL string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+	}
+}
+
+// WithHTTPClient sets the http client used to send requests
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+	return c
+}
+
+// Run runs a playground and returns its result, formatted like in
+// the playground, for example:
+//
+//	[{"_id":1,"k":1}]
+func (c *Client) Run(ctx context.Context, p Playground) (string, error) {
+
+	var result string
+	err := c.do(ctx, http.MethodPost, "/api/v1/run", p, &result)
+	return result, err
+}
+
+// Save saves a playground and returns its id
+func (c *Client) Save(ctx context.Context, p Playground) (string, error) {
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	err := c.do(ctx, http.MethodPost, "/api/v1/save", p, &result)
+	return result.ID, err
+}
+
+// Load returns the content of a saved playground
+func (c *Client) Load(ctx context.Context, id string) (*Playground, error) {
+
+	var p Playground
+	err := c.do(ctx, http.MethodGet, "/api/v1/p/"+url.PathEscape(id), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// URL returns the url to share a saved playground
+func (c *Client) URL(id string) string {
+	return c.baseURL + "/p/" + id
+}
+
+type response struct {
+	OK     bool            `json:"ok"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Kind     string `json:"kind"`
+		Message  string `json:"message"`
+		Position *int   `json:"position"`
+	} `json:"error"`
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body any, result any) error {
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("invalid response from server (status %d): %v", resp.StatusCode, err)
+	}
+
+	if !r.OK {
+		e := &Error{
+			StatusCode: resp.StatusCode,
+			Position:   -1,
+		}
+		if r.Error != nil {
+			e.Kind = r.Error.Kind
+			e.Message = r.Error.Message
+			if r.Error.Position != nil {
+				e.Position = *r.Error.Position
+			}
+		}
+		return e
+	}
+	return json.Unmarshal(r.Result, result)
+}
@@ -0,0 +1,288 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/feliixx/mongoextjson"
+)
+
+const (
+	apiRunEndpoint  = "/api/v1/run"
+	apiSaveEndpoint = "/api/v1/save"
+	apiPageEndpoint = "/api/v1/p/"
+)
+
+// body of a request to the json api, like:
+//
+//	{"mode":"bson","config":"[{_id:1}]","query":"db.collection.find()"}
+type apiRequest struct {
+	Mode    string `json:"mode"`
+	Config  string `json:"config"`
+	Query   string `json:"query"`
+	Version string `json:"version,omitempty"`
+	// output mode, only for /api/v1/run
+	Output string `json:"output,omitempty"`
+	// id of the playground this one was forked from, only
+	// for /api/v1/save
+	Parent string `json:"parent,omitempty"`
+	// optional metadata of the playground, only for /api/v1/save
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type apiResponse struct {
+	OK     bool      `json:"ok"`
+	Result any       `json:"result,omitempty"`
+	Error  *apiError `json:"error,omitempty"`
+}
+
+type apiError struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	// offset in bytes of a syntax error in the query
+	Position *int `json:"position,omitempty"`
+}
+
+// a saved playground, as returned by /api/v1/p/{id}
+type apiPage struct {
+	ID      string `json:"id"`
+	Mode    string `json:"mode"`
+	Config  string `json:"config"`
+	Query   string `json:"query"`
+	Version string `json:"version,omitempty"`
+	// description is in markdown
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// run a playground and return its result. The result is the output
+// of /run converted to canonical extended JSON, so types like
+// ObjectId("...") are kept:
+//
+//	{"ok":true,"result":[{"_id":{"$oid":"5a934e000102030405000000"}}]}
+//
+// or in case of error:
+//
+//	{"ok":false,"error":{"kind":"query","message":"fail to parse content of query: invalid character ']'","position":21}}
+func (s *storage) apiRunHandler(w http.ResponseWriter, r *http.Request) {
+
+	p, req, ok := readAPIRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := s.run(r.Context(), p, req.Output)
+	if errors.Is(err, errTooManyDBCreations) {
+		writeTooManyRequests(w, r, dbCreationRetryAfter, throttledByDBCreation, err)
+		return
+	}
+	if errors.Is(err, errServerBusy) {
+		writeServerBusy(w, r, err)
+		return
+	}
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+
+	result, err := canonicalResult(res)
+	if err != nil {
+		writeAPIError(w, newRunError(errKindInternal, "", fmt.Errorf("fail to convert result: %w", err)))
+		return
+	}
+	writeAPIResult(w, result)
+}
+
+// convert the output of run(), written in shell mode, to canonical
+// extended JSON
+func canonicalResult(res []byte) (json.RawMessage, error) {
+
+	if bytes.Equal(res, []byte(noDocFound)) {
+		return json.RawMessage("[]"), nil
+	}
+
+	var v any
+	if err := mongoextjson.Unmarshal(res, &v); err != nil {
+		return nil, err
+	}
+	return mongoextjson.MarshalCanonical(v)
+}
+
+// save a playground and return its id:
+//
+//	{"ok":true,"result":{"id":"nJhd-dhf3Ea"}}
+func (s *storage) apiSaveHandler(w http.ResponseWriter, r *http.Request) {
+
+	p, req, ok := readAPIRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := s.backend(p.Version); err != nil {
+		writeAPIError(w, newRunError(errKindInvalidRequest, "", err))
+		return
+	}
+
+	id, err := s.save(p, []byte(req.Parent))
+	if err != nil {
+		writeAPIError(w, newRunError(errKindInternal, "", fmt.Errorf("fail to save playground: %w", err)))
+		return
+	}
+	writeAPIResult(w, map[string]string{"id": string(id)})
+}
+
+// return the content of a saved playground
+func (s *storage) apiPageHandler(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, apiPageEndpoint)
+
+	p, err := s.loadPage([]byte(id))
+	if err != nil {
+		log.Printf("fail to load page with id %s : %v", id, err)
+		writeAPIError(w, newRunError(errKindNotFound, "", errors.New(errNoMatchingPlayground)))
+		return
+	}
+
+	writeAPIResult(w, newAPIPage(id, p))
+}
+
+func newAPIPage(id string, p *page) apiPage {
+
+	mode := "bson"
+	if p.Mode == mgodatagenMode {
+		mode = mgodatagenLabel
+	}
+	var tags []string
+	for _, tag := range p.Tags {
+		tags = append(tags, string(tag))
+	}
+	return apiPage{
+		ID:          id,
+		Mode:        mode,
+		Config:      string(p.Config),
+		Query:       string(p.Query),
+		Version:     string(p.Version),
+		Title:       string(p.Title),
+		Description: string(p.Description),
+		Tags:        tags,
+	}
+}
+
+// decode the body of a POST request and return the page and the
+// request. If the request is invalid, an error is written
+// to w and false is returned
+func readAPIRequest(w http.ResponseWriter, r *http.Request) (*page, *apiRequest, bool) {
+
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return nil, nil, false
+	}
+
+	// config and query are escaped in the body, so allow
+	// some extra room
+	r.Body = http.MaxBytesReader(w, r.Body, 2*maxByteSize)
+
+	var req apiRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, newRunError(errKindInvalidRequest, "", fmt.Errorf("invalid json body: %v", err)))
+		return nil, nil, false
+	}
+
+	p, err := newPage(req.Mode, req.Config, req.Query, req.Version)
+	if err == nil {
+		err = p.setMetadata(req.Title, req.Description, req.Tags)
+	}
+	if err != nil {
+		writeAPIError(w, newRunError(errKindInvalidRequest, "", err))
+		return nil, nil, false
+	}
+	return p, &req, true
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, allowed string) {
+	w.Header().Set("Allow", allowed)
+	writeAPIResponse(w, http.StatusMethodNotAllowed, apiResponse{
+		Error: &apiError{
+			Kind:    errKindInvalidRequest,
+			Message: fmt.Sprintf("method not allowed, expected %s", allowed),
+		},
+	})
+}
+
+func writeAPIResult(w http.ResponseWriter, result any) {
+	writeAPIResponse(w, http.StatusOK, apiResponse{
+		OK:     true,
+		Result: result,
+	})
+}
+
+func writeAPIError(w http.ResponseWriter, err error) {
+
+	kind := errorKind(err)
+	apiErr := &apiError{
+		Kind:    kind,
+		Message: errorMessage(err),
+	}
+	if position := errorPosition(err); position >= 0 {
+		apiErr.Position = &position
+	}
+
+	status := http.StatusUnprocessableEntity
+	switch kind {
+	case errKindInvalidRequest:
+		status = http.StatusBadRequest
+		if apiErr.Message == errPlaygroundToBig {
+			status = http.StatusRequestEntityTooLarge
+		}
+	case errKindNotFound:
+		status = http.StatusNotFound
+	case errKindInternal:
+		status = http.StatusInternalServerError
+	case errKindRateLimited:
+		status = http.StatusTooManyRequests
+	case errKindServerBusy:
+		status = http.StatusServiceUnavailable
+	}
+
+	writeAPIResponse(w, status, apiResponse{Error: apiErr})
+}
+
+func writeAPIResponse(w http.ResponseWriter, status int, response apiResponse) {
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("fail to write api response: %v", err)
+	}
+}
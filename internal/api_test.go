@@ -0,0 +1,197 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIRun(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	apiTests := []struct {
+		name   string
+		method string
+		body   string
+		status int
+		result string
+	}{
+		{
+			name:   "valid query",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":1,\"k\":1},{\"_id\":2,\"k\":2}]","query":"db.collection.find({\"k\":2})"}`,
+			status: http.StatusOK,
+			result: `{"ok":true,"result":[{"_id":2,"k":2}]}`,
+		},
+		{
+			name:   "no document found",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":1,\"k\":1}]","query":"db.collection.find({\"k\":2})"}`,
+			status: http.StatusOK,
+			result: `{"ok":true,"result":[]}`,
+		},
+		{
+			name:   "syntax error in query",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":1}]","query":"db.collection.find({\"_id\":})"}`,
+			status: http.StatusUnprocessableEntity,
+			result: `{"ok":false,"error":{"kind":"query","message":"fail to parse content of query: invalid character '}' looking for beginning of value","position":26}}`,
+		},
+		{
+			name:   "syntax error in second statement",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":1}]","query":"db.collection.find()\ndb.collection.find({k:})"}`,
+			status: http.StatusUnprocessableEntity,
+			result: `{"ok":false,"error":{"kind":"query","message":"statement 2: fail to parse content of query: invalid character '}' looking for beginning of value","position":43}}`,
+		},
+		{
+			name:   "unknown collection",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":3}]","query":"db.other.find()"}`,
+			status: http.StatusUnprocessableEntity,
+			result: `{"ok":false,"error":{"kind":"query","message":"collection \"other\" doesn't exist"}}`,
+		},
+		{
+			name:   "invalid config",
+			method: http.MethodPost,
+			body:   `{"mode":"mgodatagen","config":"[{}]","query":"db.collection.find()"}`,
+			status: http.StatusUnprocessableEntity,
+			result: `{"ok":false,"error":{"kind":"config","message":"error in configuration file: \n\t'collection' and 'database' fields can't be empty"}}`,
+		},
+		{
+			name:   "query failing at runtime",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[{\"_id\":4}]","query":"db.collection.distinct(1)"}`,
+			status: http.StatusUnprocessableEntity,
+			result: `{"ok":false,"error":{"kind":"runtime","message":"distinct() requires a field name as first argument, for example: distinct(\"field\")"}}`,
+		},
+		{
+			name:   "invalid output",
+			method: http.MethodPost,
+			body:   `{"mode":"bson","config":"[]","query":"db.collection.find()","output":"unknown"}`,
+			status: http.StatusBadRequest,
+			result: `{"ok":false,"error":{"kind":"invalid_request","message":"output must be empty, 'stages' or 'diff'"}}`,
+		},
+		{
+			name:   "invalid json",
+			method: http.MethodPost,
+			body:   `{"mode":`,
+			status: http.StatusBadRequest,
+			result: `{"ok":false,"error":{"kind":"invalid_request","message":"invalid json body: unexpected EOF"}}`,
+		},
+		{
+			name:   "invalid method",
+			method: http.MethodGet,
+			status: http.StatusMethodNotAllowed,
+			result: `{"ok":false,"error":{"kind":"invalid_request","message":"method not allowed, expected POST"}}`,
+		},
+	}
+
+	for _, tt := range apiTests {
+
+		resp := apiRequestRecorder(tt.method, apiRunEndpoint, tt.body)
+
+		if want, got := tt.status, resp.Code; want != got {
+			t.Errorf("%s: expected status %d but got %d", tt.name, want, got)
+		}
+		if want, got := tt.result, strings.TrimSpace(resp.Body.String()); want != got {
+			t.Errorf("%s: expected\n '%s'\n but got\n '%s'", tt.name, want, got)
+		}
+	}
+}
+
+func TestAPISaveAndLoad(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	resp := apiRequestRecorder(http.MethodPost, apiSaveEndpoint, `{"mode":"bson","config":"[{}]","query":"db.collection.find()"}`)
+	if want, got := `{"ok":true,"result":{"id":"4cOeA7NGLru"}}`, strings.TrimSpace(resp.Body.String()); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	resp = apiRequestRecorder(http.MethodGet, apiPageEndpoint+"4cOeA7NGLru", "")
+	if want, got := http.StatusOK, resp.Code; want != got {
+		t.Errorf("expected status %d but got %d", want, got)
+	}
+	if want, got := `{"ok":true,"result":{"id":"4cOeA7NGLru","mode":"bson","config":"[{}]","query":"db.collection.find()"}}`, strings.TrimSpace(resp.Body.String()); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	resp = apiRequestRecorder(http.MethodGet, apiPageEndpoint+"aaaaaaaaaaa", "")
+	if want, got := http.StatusNotFound, resp.Code; want != got {
+		t.Errorf("expected status %d but got %d", want, got)
+	}
+	if want, got := `{"ok":false,"error":{"kind":"not_found","message":"this playground doesn't exist"}}`, strings.TrimSpace(resp.Body.String()); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	resp = apiRequestRecorder(http.MethodPost, apiSaveEndpoint, `{"mode":"bson","config":"`+strings.Repeat("a", maxByteSize)+`","query":""}`)
+	if want, got := http.StatusRequestEntityTooLarge, resp.Code; want != got {
+		t.Errorf("expected status %d but got %d", want, got)
+	}
+
+	testStorageContent(t, 0, 0, 1)
+}
+
+func apiRequestRecorder(method, url, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	testServer.Handler.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestCanonicalResult(t *testing.T) {
+
+	t.Parallel()
+
+	resultTests := []struct {
+		name   string
+		output string
+		result string
+	}{
+		{
+			name:   "extended json types",
+			output: `[{"_id":ObjectId("5a934e000102030405000000"),"n":NumberLong(3),"d":ISODate("2020-01-01T00:00:00Z")}]`,
+			result: `[{"_id":{"$oid":"5a934e000102030405000000"},"d":{"$date":"2020-01-01T00:00:00Z"},"n":{"$numberLong":"3"}}]`,
+		},
+		{
+			name:   "no document found",
+			output: noDocFound,
+			result: `[]`,
+		},
+		{
+			name:   "count",
+			output: `2`,
+			result: `2`,
+		},
+	}
+
+	for _, tt := range resultTests {
+		res, err := canonicalResult([]byte(tt.output))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+		if want, got := tt.result, strings.TrimSpace(string(res)); want != got {
+			t.Errorf("%s: expected\n%s\nbut got\n%s", tt.name, want, got)
+		}
+	}
+}
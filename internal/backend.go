@@ -0,0 +1,233 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// backend is a MongoDB server playgrounds can be run against.
+// Each backend has its own cache of databases
+type backend struct {
+	// version used to select the backend, like "6.0"
+	version      string
+	mongoSession *mongo.Client
+	// full version of the server, like "6.0.5"
+	mongoVersion []byte
+
+	activeDB *cache
+	// shared by all backends to limit concurrent database creations
+	rateLimiter *rateLimiter
+}
+
+func newBackend(version, mongoUri string, cacheMaxSize int64) (*backend, error) {
+
+	session, err := createMongodbSession(mongoUri)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoVersion := getMongoVersion(session)
+	if version == "" {
+		version = majorMinor(mongoVersion)
+	}
+
+	return &backend{
+		version:      version,
+		mongoSession: session,
+		mongoVersion: mongoVersion,
+		activeDB:     newCache(cacheMaxSize),
+	}, nil
+}
+
+// create a backend for the default uri, and one for each uri
+// of mongoUris, keyed by version. The databases cached by each
+// backend are limited to cacheMaxSize bytes
+func newBackends(mongoUri string, mongoUris map[string]string, cacheMaxSize int64) (*backend, map[string]*backend, error) {
+
+	defaultBackend, err := newBackend("", mongoUri, cacheMaxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backends := map[string]*backend{
+		defaultBackend.version: defaultBackend,
+	}
+	for version, uri := range mongoUris {
+		if _, exists := backends[version]; exists {
+			return nil, nil, fmt.Errorf("several mongodb uri for version %s", version)
+		}
+		b, err := newBackend(version, uri, cacheMaxSize)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to create backend for version %s: %v", version, err)
+		}
+		backends[version] = b
+	}
+	return defaultBackend, backends, nil
+}
+
+// return the backend matching the requested version. If no
+// version is specified, the default backend is used
+func (s *storage) backend(version []byte) (*backend, error) {
+
+	if len(version) == 0 {
+		return s.defaultBackend, nil
+	}
+	b, ok := s.backends[string(version)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported MongoDB version '%s', available versions are: %s", version, strings.Join(s.versions(), ", "))
+	}
+	return b, nil
+}
+
+// sorted list of available versions
+func (s *storage) versions() []string {
+
+	versions := make([]string, 0, len(s.backends))
+	for version := range s.backends {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// delete all database having a name with 32 char
+func (b *backend) deleteExistingDB() error {
+
+	dbNames, err := b.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range dbNames {
+		if len(name) == 32 {
+			log.Printf("Deleting db '%s'", name)
+			err = b.mongoSession.Database(name).Drop(context.Background())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return b.markers().Drop(context.Background())
+}
+
+func (b *backend) removeUnusedDB(now time.Time) {
+
+	expired := make([]string, 0)
+
+	b.activeDB.Lock()
+	for name, info := range b.activeDB.list {
+
+		// the database is being created by another goroutine
+		if !info.ready {
+			continue
+		}
+
+		// if database creation failed, always remove it from the cache
+		// as soon as possible, to prevent temporary error due to MongoDB
+		// from being kept for too long.
+		if info.err != nil {
+			delete(b.activeDB.list, name)
+			continue
+		}
+
+		if now.Sub(time.Unix(info.lastUsed, 0)) > maxUnusedDuration {
+			b.activeDB.removeLocked(name)
+			expired = append(expired, name)
+		}
+	}
+	b.activeDB.Unlock()
+
+	b.dropDatabases(expired)
+	dbCacheSize.WithLabelValues(b.version).Set(float64(b.activeDB.size()))
+}
+
+// drop databases removed from the cache. The cache is not locked
+// while dropping, so it can still be used by other goroutines
+func (b *backend) dropDatabases(names []string) {
+	for _, name := range names {
+		err := b.mongoSession.Database(name).Drop(context.Background())
+		if err != nil {
+			log.Printf("fail to drop database %v: %v", name, err)
+		}
+		b.activeDB.dropped(name)
+	}
+	if err := deleteMarkers(context.Background(), b.markers(), names); err != nil {
+		log.Printf("fail to delete markers of dropped databases: %v", err)
+	}
+}
+
+// if the databases in cache exceed the size limit of the cache, evict the
+// least recently used ones, except keep, and drop them in background
+func (b *backend) evictDatabases(keep string) {
+
+	evicted := b.activeDB.evict(keep)
+	dbCacheSize.WithLabelValues(b.version).Set(float64(b.activeDB.size()))
+	if len(evicted) == 0 {
+		return
+	}
+	dbEvictions.Add(float64(len(evicted)))
+	activeDatabasesCounter.Sub(float64(len(evicted)))
+
+	go b.dropDatabases(evicted)
+}
+
+// return the number of documents and the size of the documents
+// of a database, as returned by dbStats
+func databaseSize(ctx context.Context, db *mongo.Database) (docCount, dataSize int64, err error) {
+
+	var stats struct {
+		// depending on the server version, these
+		// fields may be stored as int or double
+		Objects  float64 `bson:"objects"`
+		DataSize float64 `bson:"dataSize"`
+	}
+	err = db.RunCommand(ctx, bson.D{{Key: "dbStats", Value: 1}}).Decode(&stats)
+	return int64(stats.Objects), int64(stats.DataSize), err
+}
+
+// update the server version, in case the cluster has automatically
+// been upgraded. Returns true if the version changed
+func (b *backend) refreshMongoVersion() bool {
+
+	currentMongoVersion := getMongoVersion(b.mongoSession)
+	if bytes.Equal(currentMongoVersion, b.mongoVersion) {
+		return false
+	}
+	b.mongoVersion = currentMongoVersion
+	return true
+}
+
+// return the major and minor version of a
+// full version, for example 6.0.5 -> 6.0
+func majorMinor(version []byte) string {
+
+	parts := strings.SplitN(string(version), ".", 3)
+	if len(parts) < 2 {
+		return string(version)
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -24,21 +24,20 @@ import (
 	"log"
 	"os"
 
-	"github.com/dgraph-io/badger/v2"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/option"
 )
 
-func localBackup(storage *badger.DB, fileName string) error {
+func localBackup(store PageStore, fileName string) error {
 	f, err := os.Create(fileName)
 	if err != nil {
 		return fmt.Errorf("fail to create file %s: %v", fileName, err)
 	}
 	defer f.Close()
 
-	_, err = storage.Backup(f, 1)
+	err = store.Backup(f)
 	if err != nil {
 		return fmt.Errorf("backup failed: %v", err)
 	}
@@ -0,0 +1,118 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"io"
+
+	"github.com/dgraph-io/badger/v2"
+)
+
+// a PageStore backed by a badger database
+type badgerStore struct {
+	db *badger.DB
+}
+
+func newBadgerStore(dir string) (*badgerStore, error) {
+	db, err := badger.Open(badger.DefaultOptions(dir))
+	if err != nil {
+		return nil, err
+	}
+	return &badgerStore{db: db}, nil
+}
+
+func (s *badgerStore) Get(key []byte) (val []byte, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		val, err = item.ValueCopy(nil)
+		return err
+	})
+	return val, convertBadgerError(err)
+}
+
+func (s *badgerStore) Put(entries ...Entry) error {
+	return convertBadgerError(s.db.Update(func(txn *badger.Txn) error {
+		for _, e := range entries {
+			if err := txn.Set(e.Key, e.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *badgerStore) Has(key []byte) (bool, error) {
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	err = convertBadgerError(err)
+	if err == errKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *badgerStore) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	return convertBadgerError(s.db.View(func(txn *badger.Txn) error {
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			err := item.Value(func(val []byte) error {
+				return fn(item.Key(), val)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *badgerStore) Delete(prefix []byte) error {
+	if s.db.IsClosed() {
+		return errStoreClosed
+	}
+	return s.db.DropPrefix(prefix)
+}
+
+func (s *badgerStore) Backup(w io.Writer) error {
+	_, err := s.db.Backup(w, 1)
+	return convertBadgerError(err)
+}
+
+func (s *badgerStore) Close() error {
+	return s.db.Close()
+}
+
+func convertBadgerError(err error) error {
+	switch err {
+	case badger.ErrKeyNotFound:
+		return errKeyNotFound
+	case badger.ErrDBClosed:
+		return errStoreClosed
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// all entries are stored in a single bucket
+var boltBucket = []byte("pages")
+
+// a PageStore backed by a bolt database, stored in a single file
+type boltStore struct {
+	db *bolt.DB
+}
+
+func newBoltStore(path string) (*boltStore, error) {
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+func (s *boltStore) Get(key []byte) (val []byte, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		k, v := tx.Bucket(boltBucket).Cursor().Seek(key)
+		if !bytes.Equal(k, key) {
+			return errKeyNotFound
+		}
+		// v is only valid during the transaction
+		val = append([]byte{}, v...)
+		return nil
+	})
+	return val, convertBoltError(err)
+}
+
+func (s *boltStore) Put(entries ...Entry) error {
+	return convertBoltError(s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(boltBucket)
+		for _, e := range entries {
+			if err := b.Put(e.Key, e.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *boltStore) Has(key []byte) (found bool, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(boltBucket).Cursor().Seek(key)
+		found = bytes.Equal(k, key)
+		return nil
+	})
+	return found, convertBoltError(err)
+}
+
+func (s *boltStore) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	return convertBoltError(s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(boltBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *boltStore) Delete(prefix []byte) error {
+	return convertBoltError(s.db.Update(func(tx *bolt.Tx) error {
+
+		b := tx.Bucket(boltBucket)
+		// deleting keys while moving a cursor may skip
+		// some keys, so copy them first
+		keys := make([][]byte, 0)
+		c := b.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			keys = append(keys, append([]byte{}, k...))
+		}
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (s *boltStore) Backup(w io.Writer) error {
+	return convertBoltError(s.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	}))
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+func convertBoltError(err error) error {
+	if err == bolt.ErrDatabaseNotOpen {
+		return errStoreClosed
+	}
+	return err
+}
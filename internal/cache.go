@@ -16,13 +16,36 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"sort"
 	"sync"
+	"time"
 )
 
+// max time a request waits for a database created by
+// another request
+var dbCreationTimeout = 20 * time.Second
+
+var errDBCreationTimeout = errors.New("timeout while waiting for the database to be created")
+
 type cache struct {
 	sync.Mutex
 	list map[string]dbMetaInfo
+	// databases removed from list and being dropped. The channel
+	// is closed once the database is dropped
+	dropping map[string]chan struct{}
+	// max total data size of the databases in bytes. When exceeded, the least
+	// recently used databases are evicted. 0 means no limit
+	maxSize int64
+}
+
+func newCache(maxSize int64) *cache {
+	return &cache{
+		list:     map[string]dbMetaInfo{},
+		dropping: map[string]chan struct{}{},
+		maxSize:  maxSize,
+	}
 }
 
 type dbMetaInfo struct {
@@ -35,6 +58,16 @@ type dbMetaInfo struct {
 	ready bool
 	// any error that occured while creating the db
 	err error
+	// closed once the database is ready, or once its creation failed
+	// unexpectedly and the entry was removed from the cache
+	done chan struct{}
+	// number of documents in the database
+	docCount int64
+	// size of the documents of the database in bytes, as
+	// returned by dbStats
+	dataSize int64
+	// last usage stored in the marker of the database, see writeMarker()
+	markedLastUsed int64
 }
 
 func (d *dbMetaInfo) hasCollection(collectionName string) bool {
@@ -45,3 +78,204 @@ func (d *dbMetaInfo) hasCollection(collectionName string) bool {
 	}
 	return false
 }
+
+// return the database name from the cache. If the database isn't in the
+// cache yet, it is created with create(). If another goroutine is already
+// creating it, wait until it's ready, ctx is done or dbCreationTimeout
+// expires. cached is true if the database was already in the cache
+func (c *cache) getOrCreate(ctx context.Context, name string, create func() (sort.StringSlice, error)) (info dbMetaInfo, cached bool, err error) {
+
+	timeout := time.NewTimer(dbCreationTimeout)
+	defer timeout.Stop()
+
+	for {
+		c.Lock()
+		var exists bool
+		info, exists = c.list[name]
+		if dropped, isDropping := c.dropping[name]; !exists && isDropping {
+			c.Unlock()
+			// the database can't be created again before
+			// the previous one is fully dropped
+			select {
+			case <-dropped:
+				continue
+			case <-ctx.Done():
+				return info, cached, ctx.Err()
+			case <-timeout.C:
+				return info, cached, errDBCreationTimeout
+			}
+		}
+		if !exists {
+			// add an entry in cache immediately to make sure that only
+			// one goroutine will create the database
+			info = dbMetaInfo{
+				done: make(chan struct{}),
+			}
+		}
+		info.lastUsed = time.Now().Unix()
+		c.list[name] = info
+		c.Unlock()
+
+		if !exists {
+			info, err = c.create(name, info, create)
+			return info, cached, err
+		}
+		cached = true
+
+		if info.ready {
+			return info, cached, nil
+		}
+
+		select {
+		case <-info.done:
+			// the entry is either ready, or has been removed
+			// because its creation failed, so check it again
+		case <-ctx.Done():
+			return info, cached, ctx.Err()
+		case <-timeout.C:
+			return info, cached, errDBCreationTimeout
+		}
+	}
+}
+
+// create the database and mark its entry as ready. If create() panics
+// or returns a transient error, the entry is removed from the cache so
+// it can be created again by another goroutine. Transient errors are
+// returned instead of being cached
+func (c *cache) create(name string, info dbMetaInfo, create func() (sort.StringSlice, error)) (dbMetaInfo, error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			c.remove(name, info)
+			panic(r)
+		}
+	}()
+
+	info.collections, info.err = create()
+	if isTransientError(info.err) {
+		err := info.err
+		c.remove(name, info)
+		return dbMetaInfo{}, err
+	}
+	// at this point, the db has either been created on the server, or
+	// the creation failed with an error. In both cases, it is now ready
+	// to use by other goroutines
+	info.ready = true
+
+	c.Lock()
+	// waiting goroutines may have updated the last usage
+	if current, ok := c.list[name]; ok && current.lastUsed > info.lastUsed {
+		info.lastUsed = current.lastUsed
+	}
+	c.list[name] = info
+	c.Unlock()
+
+	close(info.done)
+	return info, nil
+}
+
+// remove the entry of a database whose creation failed, and
+// wake up the goroutines waiting for it
+func (c *cache) remove(name string, info dbMetaInfo) {
+	c.Lock()
+	if current, ok := c.list[name]; ok && current.done == info.done {
+		delete(c.list, name)
+	}
+	c.Unlock()
+	close(info.done)
+}
+
+// errors that don't depend on the configuration of the database,
+// so the creation may succeed on the next attempt
+func isTransientError(err error) bool {
+	return errors.Is(err, errTooManyDBCreations) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// set the size of a database once it's created
+func (c *cache) setSize(name string, docCount, dataSize int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if info, ok := c.list[name]; ok {
+		info.docCount, info.dataSize = docCount, dataSize
+		c.list[name] = info
+	}
+}
+
+// total data size of the databases in cache
+func (c *cache) size() int64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.sizeLocked()
+}
+
+func (c *cache) sizeLocked() (size int64) {
+	for _, info := range c.list {
+		size += info.dataSize
+	}
+	return size
+}
+
+// if the total size of the databases exceeds maxSize, remove the least
+// recently used databases from the cache until the total size fits in
+// maxSize. keep is never evicted. The evicted databases are marked as
+// being dropped, and must be passed to dropped() once dropped
+func (c *cache) evict(keep string) []string {
+	c.Lock()
+	defer c.Unlock()
+
+	size := c.sizeLocked()
+	if c.maxSize <= 0 || size <= c.maxSize {
+		return nil
+	}
+
+	candidates := make([]string, 0, len(c.list))
+	for name, info := range c.list {
+		// databases being created have no size yet
+		if name != keep && info.ready && info.err == nil {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := c.list[candidates[i]], c.list[candidates[j]]
+		if a.lastUsed != b.lastUsed {
+			return a.lastUsed < b.lastUsed
+		}
+		return candidates[i] < candidates[j]
+	})
+
+	evicted := make([]string, 0)
+	for _, name := range candidates {
+		if size <= c.maxSize {
+			break
+		}
+		size -= c.list[name].dataSize
+		c.removeLocked(name)
+		evicted = append(evicted, name)
+	}
+	return evicted
+}
+
+// remove a database from the cache, and mark it as being dropped
+// if it was created on the server
+func (c *cache) removeLocked(name string) {
+	info := c.list[name]
+	delete(c.list, name)
+	if info.err == nil {
+		c.dropping[name] = make(chan struct{})
+	}
+}
+
+// mark a database as dropped, so it can be created again
+func (c *cache) dropped(name string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if ch, ok := c.dropping[name]; ok {
+		close(ch)
+		delete(c.dropping, name)
+	}
+}
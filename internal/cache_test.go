@@ -0,0 +1,271 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheConcurrentCreation(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(0)
+
+	var created int32
+	create := func() (sort.StringSlice, error) {
+		atomic.AddInt32(&created, 1)
+		time.Sleep(20 * time.Millisecond)
+		return sort.StringSlice{"collection"}, nil
+	}
+
+	nbGoroutines := 50
+	var wg sync.WaitGroup
+	var cachedCount int32
+	errs := make(chan error, nbGoroutines)
+
+	for i := 0; i < nbGoroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info, cached, err := c.getOrCreate(context.Background(), "db", create)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if cached {
+				atomic.AddInt32(&cachedCount, 1)
+			}
+			if !info.ready || !reflect.DeepEqual(sort.StringSlice{"collection"}, info.collections) {
+				errs <- errors.New("database should be ready with its collections")
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if want, got := int32(1), atomic.LoadInt32(&created); want != got {
+		t.Errorf("database should be created %d time, but was created %d times", want, got)
+	}
+	if want, got := int32(nbGoroutines-1), atomic.LoadInt32(&cachedCount); want != got {
+		t.Errorf("expected %d cache hits but got %d", want, got)
+	}
+}
+
+func TestCacheCreationError(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(0)
+	configErr := errors.New("invalid config")
+
+	for i := 0; i < 2; i++ {
+		info, _, err := c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+			return nil, configErr
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// errors in configuration are cached like a created database
+		if !info.ready || info.err != configErr {
+			t.Errorf("expected a ready entry with error %v but got %+v", configErr, info)
+		}
+	}
+}
+
+func TestCacheCreationPanic(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(0)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	panicked := make(chan any)
+
+	go func() {
+		defer func() {
+			panicked <- recover()
+		}()
+		c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+			close(started)
+			<-release
+			panic("creation failed")
+		})
+	}()
+	<-started
+
+	result := make(chan dbMetaInfo)
+	go func() {
+		info, _, err := c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+			return sort.StringSlice{"collection"}, nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		result <- info
+	}()
+
+	// let the second goroutine wait for the first one
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if r := <-panicked; r == nil {
+		t.Error("the panic of the creating goroutine should not be swallowed")
+	}
+
+	select {
+	case info := <-result:
+		if !info.ready || !info.hasCollection("collection") {
+			t.Errorf("waiting goroutine should have created the database, but got %+v", info)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiting goroutine should not block once the creating goroutine failed")
+	}
+}
+
+func TestCacheWaitCancelled(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(0)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	defer close(release)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, cached, err := c.getOrCreate(ctx, "db", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error %v but got %v", context.DeadlineExceeded, err)
+	}
+	if !cached {
+		t.Error("database should be in cache")
+	}
+}
+
+func TestCacheWaitTimeout(t *testing.T) {
+
+	defer func(timeout time.Duration) { dbCreationTimeout = timeout }(dbCreationTimeout)
+	dbCreationTimeout = 10 * time.Millisecond
+
+	c := newCache(0)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	defer close(release)
+	<-started
+
+	if _, _, err := c.getOrCreate(context.Background(), "db", nil); err != errDBCreationTimeout {
+		t.Errorf("expected error %v but got %v", errDBCreationTimeout, err)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(100)
+
+	now := time.Now().Unix()
+	c.list = map[string]dbMetaInfo{
+		"old":     {ready: true, lastUsed: now - 30, dataSize: 40},
+		"recent":  {ready: true, lastUsed: now - 10, dataSize: 40},
+		"invalid": {ready: true, lastUsed: now - 60, err: errors.New("invalid config")},
+		"pending": {ready: false, lastUsed: now - 60},
+		"new":     {ready: true, lastUsed: now - 100, dataSize: 40},
+	}
+
+	evicted := c.evict("new")
+	if want := []string{"old"}; !reflect.DeepEqual(want, evicted) {
+		t.Errorf("expected evicted databases %v but got %v", want, evicted)
+	}
+	if want, got := int64(80), c.size(); want != got {
+		t.Errorf("expected cache size %d but got %d", want, got)
+	}
+	if _, ok := c.dropping["old"]; !ok {
+		t.Error("evicted database should be marked as being dropped")
+	}
+
+	if evicted := c.evict("new"); len(evicted) != 0 {
+		t.Errorf("cache fits in max size, nothing should be evicted but got %v", evicted)
+	}
+
+	c.maxSize = 0
+	c.setSize("recent", 10, 1000)
+	if evicted := c.evict("new"); len(evicted) != 0 {
+		t.Errorf("cache has no max size, nothing should be evicted but got %v", evicted)
+	}
+}
+
+func TestCacheWaitDropping(t *testing.T) {
+
+	t.Parallel()
+
+	c := newCache(0)
+	c.list["db"] = dbMetaInfo{ready: true}
+
+	c.Lock()
+	c.removeLocked("db")
+	c.Unlock()
+
+	result := make(chan error)
+	go func() {
+		_, _, err := c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+			return sort.StringSlice{"collection"}, nil
+		})
+		result <- err
+	}()
+
+	select {
+	case <-result:
+		t.Fatal("database should not be created again before being dropped")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	c.dropped("db")
+
+	if err := <-result; err != nil {
+		t.Error(err)
+	}
+	if _, ok := c.list["db"]; !ok {
+		t.Error("database should be created again once dropped")
+	}
+}
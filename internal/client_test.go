@@ -0,0 +1,153 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/feliixx/mongoplayground/client"
+)
+
+func TestClient(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	server := httptest.NewServer(testServer.Handler)
+	defer server.Close()
+
+	c := client.New(server.URL)
+	ctx := context.Background()
+
+	p := client.Playground{
+		Mode:        client.ModeBSON,
+		Config:      `[{"_id":1,"k":1},{"_id":2,"k":2}]`,
+		Query:       `db.collection.find({"k":1})`,
+		Title:       "find by key",
+		Description: "find documents with **k** equal to 1",
+		Tags:        []string{"find", "key"},
+	}
+
+	res, err := c.Run(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := `[{"_id":1,"k":1}]`, res; want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	id, err := c.Save(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := server.URL+"/p/"+id, c.URL(id); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	loaded, err := c.Load(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := p, *loaded; !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %+v but got %+v", want, got)
+	}
+
+	_, err = c.Run(ctx, client.Playground{Mode: client.ModeBSON, Config: "[]", Query: "db.collection.find({k:})"})
+	var clientErr *client.Error
+	if !errors.As(err, &clientErr) {
+		t.Fatalf("expected a client.Error but got %v", err)
+	}
+	if want, got := "query", clientErr.Kind; want != got {
+		t.Errorf("expected error kind %s but got %s", want, got)
+	}
+	if want, got := 22, clientErr.Position; want != got {
+		t.Errorf("expected error at position %d but got %d", want, got)
+	}
+
+	_, err = c.Load(ctx, "unknownURL1")
+	if !errors.As(err, &clientErr) || clientErr.Kind != "not_found" {
+		t.Errorf("expected a not_found error but got %v", err)
+	}
+
+	testStorageContent(t, 1, 1, 1)
+}
+
+func TestClientCommand(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	server := httptest.NewServer(testServer.Handler)
+	defer server.Close()
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	queryFile := filepath.Join(dir, "query.js")
+	os.WriteFile(configFile, []byte("[{\"_id\":1}]\n"), 0644)
+	os.WriteFile(queryFile, []byte("db.collection.find()\n"), 0644)
+
+	commandTests := []struct {
+		name   string
+		args   []string
+		output string
+		err    string
+	}{
+		{
+			name:   "run",
+			args:   []string{"run", "-url", server.URL, "-config", configFile, "-query", queryFile},
+			output: "[{\"_id\":1}]\n",
+		},
+		{
+			name:   "save",
+			args:   []string{"save", "-url", server.URL, "-config", configFile, "-query", queryFile},
+			output: server.URL + "/p/vXu3jyvsaZT\n",
+		},
+		{
+			name:   "load",
+			args:   []string{"load", "-url", server.URL, "vXu3jyvsaZT"},
+			output: "{\n  \"mode\": \"bson\",\n  \"config\": \"[{\\\"_id\\\":1}]\",\n  \"query\": \"db.collection.find()\"\n}\n",
+		},
+		{
+			name: "missing query",
+			args: []string{"run", "-url", server.URL, "-config", configFile},
+			err:  "usage:",
+		},
+	}
+
+	for _, tt := range commandTests {
+
+		var out bytes.Buffer
+		err := client.RunCommand(context.Background(), tt.args, &out)
+		if tt.err != "" {
+			if err == nil || !bytes.HasPrefix([]byte(err.Error()), []byte(tt.err)) {
+				t.Errorf("%s: expected error starting with %s but got %v", tt.name, tt.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if want, got := tt.output, out.String(); want != got {
+			t.Errorf("%s: expected\n%s\nbut got\n%s", tt.name, want, got)
+		}
+	}
+}
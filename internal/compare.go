@@ -0,0 +1,244 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"regexp"
+	"sync"
+
+	"github.com/feliixx/mongoextjson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// name of the databases created by the playground, see
+// page.dbHash() and uniqueDBHash()
+var dbNameRegex = regexp.MustCompile(`update_[0-9a-f]{25}|[0-9a-f]{32}`)
+
+// result of a playground on a single backend
+type versionResult struct {
+	Version      string `json:"version"`
+	MongoVersion string `json:"mongoVersion"`
+	// output of the query, as returned by /run
+	Result rawJSON `json:"result,omitempty"`
+	Error  string  `json:"error,omitempty"`
+
+	// output used to check if two versions agree
+	normalized []byte
+}
+
+type compareResponse struct {
+	Results []versionResult `json:"results"`
+	// true if all versions returned the same output
+	Identical bool `json:"identical"`
+	// versions grouped by identical output
+	Groups [][]string `json:"groups"`
+	// difference between the output of each group and
+	// the output of the first group
+	Diffs []groupDiff `json:"diffs,omitempty"`
+}
+
+// difference between the normalized output of a group and the normalized
+// output of the first group. If both outputs are lists of documents with
+// an _id, documents are compared one by one like in a write diff.
+// Otherwise, for example when one of the versions returned an error,
+// both outputs are returned as is
+type groupDiff struct {
+	Versions []string       `json:"versions"`
+	Diff     []documentDiff `json:"diff,omitempty"`
+	Output   *fieldChange   `json:"output,omitempty"`
+}
+
+// rawJSON is written as is by mongoextjson, like a json.RawMessage
+type rawJSON []byte
+
+func (r rawJSON) MarshalJSON() ([]byte, error) {
+	return r, nil
+}
+
+// run a playground on every backend and return the result of each
+// version. The result looks like:
+//
+//	{"results":[{"version":"5.0","mongoVersion":"5.0.14","result":[{_id:1}]},{"version":"6.0","mongoVersion":"6.0.5","error":"query failed: ..."}],"identical":false,"groups":[["5.0"],["6.0"]],"diffs":[{"versions":["6.0"],"output":{"before":"[{\"_id\":1}]","after":"query failed: ..."}}]}
+//
+// extra backends are configured in config.json, see mongo.versions. Uris
+// can't be sent in the request, as the server would then open connections
+// to any host on behalf of users
+func (s *storage) compareHandler(w http.ResponseWriter, r *http.Request) {
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	p, err := newPage(
+		r.FormValue("mode"),
+		r.FormValue("config"),
+		r.FormValue("query"),
+		"",
+	)
+	if err != nil {
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	versions := r.Form["versions"]
+	if len(versions) == 0 {
+		versions = s.versions()
+	}
+	for _, version := range versions {
+		if _, err := s.backend([]byte(version)); err != nil {
+			w.Write([]byte(err.Error()))
+			return
+		}
+	}
+
+	res, err := mongoextjson.Marshal(s.compare(r.Context(), p, versions))
+	if err != nil {
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write(res)
+}
+
+// run the page on each version in parallel
+func (s *storage) compare(ctx context.Context, p *page, versions []string) compareResponse {
+
+	results := make([]versionResult, len(versions))
+
+	var wg sync.WaitGroup
+	for i, version := range versions {
+
+		wg.Add(1)
+		go func(i int, version string) {
+			defer wg.Done()
+
+			b := s.backends[version]
+			// each version gets its own copy of the query and of the
+			// config, so nothing is shared between goroutines
+			versionPage := *p
+			versionPage.Version = []byte(version)
+			versionPage.Query = append([]byte(nil), p.Query...)
+			versionPage.Config = append([]byte(nil), p.Config...)
+
+			res := versionResult{
+				Version:      version,
+				MongoVersion: string(b.mongoVersion),
+			}
+
+			out, err := s.run(ctx, &versionPage, defaultOutput)
+			if err != nil {
+				res.Error = err.Error()
+				res.normalized = normalizeOutput([]byte(res.Error))
+			} else {
+				if bytes.Equal(out, []byte(noDocFound)) {
+					out = []byte("[]")
+				}
+				res.Result = out
+				res.normalized = normalizeOutput(out)
+			}
+			results[i] = res
+		}(i, version)
+	}
+	wg.Wait()
+
+	groups := groupVersions(results)
+	return compareResponse{
+		Results:   results,
+		Identical: len(groups) <= 1,
+		Groups:    groups,
+		Diffs:     diffGroups(results, groups),
+	}
+}
+
+// the name of the database a query is run on is random
+// for write queries, and may appear in error messages
+func normalizeOutput(out []byte) []byte {
+	return dbNameRegex.ReplaceAll(out, []byte("db"))
+}
+
+// group versions returning the same output, in the
+// order of their first appearance
+func groupVersions(results []versionResult) [][]string {
+
+	groups := [][]string{}
+	outputs := [][]byte{}
+
+	for _, res := range results {
+
+		found := false
+		for i, out := range outputs {
+			if bytes.Equal(out, res.normalized) {
+				groups[i] = append(groups[i], res.Version)
+				found = true
+				break
+			}
+		}
+		if !found {
+			outputs = append(outputs, res.normalized)
+			groups = append(groups, []string{res.Version})
+		}
+	}
+	return groups
+}
+
+// compare the output of each group with the output of the first group
+func diffGroups(results []versionResult, groups [][]string) []groupDiff {
+
+	if len(groups) <= 1 {
+		return nil
+	}
+
+	outputs := make(map[string][]byte, len(results))
+	for _, res := range results {
+		outputs[res.Version] = res.normalized
+	}
+
+	first := outputs[groups[0][0]]
+	firstDocs, firstOk := decodeDocuments(first)
+
+	diffs := make([]groupDiff, 0, len(groups)-1)
+	for _, group := range groups[1:] {
+
+		d := groupDiff{Versions: group}
+
+		out := outputs[group[0]]
+		docs, ok := decodeDocuments(out)
+		if firstOk && ok {
+			d.Diff = diffDocuments(firstDocs, docs)
+		} else {
+			d.Output = &fieldChange{Before: string(first), After: string(out)}
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// decode an output as a list of documents. Documents are matched
+// by _id, so this fails if one of them doesn't have an _id
+func decodeDocuments(out []byte) ([]bson.M, bool) {
+
+	docs := []bson.M{}
+	if err := mongoextjson.Unmarshal(out, &docs); err != nil {
+		return nil, false
+	}
+	for _, doc := range docs {
+		if _, ok := doc["_id"]; !ok {
+			return nil, false
+		}
+	}
+	return docs, true
+}
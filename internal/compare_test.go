@@ -0,0 +1,122 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/feliixx/mongoextjson"
+)
+
+func TestCompare(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	b := testStorage.defaultBackend
+
+	compareTests := []struct {
+		name   string
+		params url.Values
+		result string
+	}{
+		{
+			name:   "find",
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2}]`}, "query": {`db.collection.find({"_id":2})`}},
+			result: fmt.Sprintf(`{"results":[{"version":"%s","mongoVersion":"%s","result":[{"_id":2}]}],"identical":true,"groups":[["%s"]]}`, b.version, b.mongoVersion, b.version),
+		},
+		{
+			name:   "no document found",
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2}]`}, "query": {`db.collection.find({"_id":3})`}},
+			result: fmt.Sprintf(`{"results":[{"version":"%s","mongoVersion":"%s","result":[]}],"identical":true,"groups":[["%s"]]}`, b.version, b.mongoVersion, b.version),
+		},
+		{
+			name:   "query error",
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2}]`}, "query": {`db.other.find()`}},
+			result: fmt.Sprintf(`{"results":[{"version":"%s","mongoVersion":"%s","error":"collection \"other\" doesn't exist"}],"identical":true,"groups":[["%s"]]}`, b.version, b.mongoVersion, b.version),
+		},
+		{
+			name:   "unknown version",
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1}]`}, "query": {templateQuery}, "versions": {"1.0"}},
+			result: fmt.Sprintf("unsupported MongoDB version '1.0', available versions are: %s", b.version),
+		},
+	}
+
+	for _, tt := range compareTests {
+		if want, got := tt.result, httpBody(t, compareEndpoint, http.MethodPost, tt.params); want != got {
+			t.Errorf("%s: expected\n '%s'\n but got\n '%s'", tt.name, want, got)
+		}
+	}
+
+	// the same page is run concurrently for each requested version, so
+	// run it twice on the default backend. The explain query must be
+	// parsed the same way by both goroutines
+	params := url.Values{
+		"mode":     {"bson"},
+		"config":   {`[{"_id":1},{"_id":2}]`},
+		"query":    {`db.collection.explain().find({"_id":2})`},
+		"versions": {b.version, b.version},
+	}
+	body := httpBody(t, compareEndpoint, http.MethodPost, params)
+	if !strings.Contains(body, `"identical":true`) || strings.Contains(body, `"error"`) {
+		t.Errorf("explain: expected identical results without error, but got\n '%s'", body)
+	}
+}
+
+func TestGroupVersions(t *testing.T) {
+
+	t.Parallel()
+
+	results := []versionResult{
+		{Version: "5.0", normalized: normalizeOutput([]byte(`fail to run insertOne: E11000 duplicate key error collection: 0123456789abcdef0123456789abcdef.collection`))},
+		{Version: "6.0", normalized: normalizeOutput([]byte(`fail to run insertOne: E11000 duplicate key error collection: fedcba9876543210fedcba9876543210.collection`))},
+		{Version: "7.0", normalized: normalizeOutput([]byte(`[{"_id":1}]`))},
+	}
+
+	if want, got := `[[5.0 6.0] [7.0]]`, fmt.Sprint(groupVersions(results)); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+}
+
+func TestDiffGroups(t *testing.T) {
+
+	t.Parallel()
+
+	results := []versionResult{
+		{Version: "5.0", normalized: normalizeOutput([]byte(`[{"_id":1,"a":1},{"_id":2}]`))},
+		{Version: "6.0", normalized: normalizeOutput([]byte(`[{"_id":1,"a":2},{"_id":3}]`))},
+		{Version: "7.0", normalized: normalizeOutput([]byte(`fail to run insertOne: E11000 duplicate key error collection: 0123456789abcdef0123456789abcdef.collection`))},
+	}
+
+	diffs, err := mongoextjson.Marshal(diffGroups(results, groupVersions(results)))
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := `[{"versions":["6.0"],"diff":[{"_id":1,"status":"changed","changedFields":{"a":{"before":1,"after":2}}},{"_id":3,"status":"added","document":{"_id":3}},{"_id":2,"status":"removed","document":{"_id":2}}]},` +
+		`{"versions":["7.0"],"output":{"before":"[{\"_id\":1,\"a\":1},{\"_id\":2}]","after":"fail to run insertOne: E11000 duplicate key error collection: db.collection"}}]`
+	if got := string(diffs); want != got {
+		t.Errorf("expected\n%s\nbut got\n%s", want, got)
+	}
+
+	if diffs := diffGroups(results[:1], groupVersions(results[:1])); diffs != nil {
+		t.Errorf("expected no diff for a single group, but got %v", diffs)
+	}
+}
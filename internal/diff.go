@@ -0,0 +1,196 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/feliixx/mongoextjson"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	docAdded   = "added"
+	docRemoved = "removed"
+	docChanged = "changed"
+)
+
+// writeDiff is the output of a write query in diff mode
+type writeDiff struct {
+	// set if the collection is too big to be read entirely, in which
+	// case only the first documents, sorted by _id, are compared
+	Truncated string         `json:"truncated,omitempty"`
+	Result    *writeResult   `json:"result"`
+	Diff      []documentDiff `json:"diff"`
+	Documents []bson.M       `json:"documents"`
+}
+
+// changes made to a single document, identified by its _id. Only top
+// level fields are compared, so a change in a nested document is
+// reported as a change of the whole top level field
+type documentDiff struct {
+	ID     any    `json:"_id"`
+	Status string `json:"status"`
+	// full document, only for added and removed documents
+	Document      bson.M                 `json:"document,omitempty"`
+	AddedFields   bson.M                 `json:"addedFields,omitempty"`
+	RemovedFields bson.M                 `json:"removedFields,omitempty"`
+	ChangedFields map[string]fieldChange `json:"changedFields,omitempty"`
+}
+
+type fieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// run a write query and return the summary of the write, the changes
+// made to each document and the new content of the collection. The
+// result looks like:
+//
+//	{"result":{"insertedCount":0,"matchedCount":1,"modifiedCount":1,"deletedCount":0,"upsertedCount":0},"diff":[{"_id":1,"status":"changed","changedFields":{"k":{"before":1,"after":2}}}],"documents":[{"_id":1,"k":2}]}
+func runWriteDiff(ctx context.Context, collection *mongo.Collection, q *parsedQuery, limits *ResultLimits) ([]byte, error) {
+
+	before, beforeTruncated, err := snapshot(ctx, collection, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := runWrite(ctx, collection, q.method, q.stages)
+	if err != nil {
+		return nil, err
+	}
+
+	after, afterTruncated, err := snapshot(ctx, collection, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	d := writeDiff{
+		Result:    res,
+		Diff:      diffDocuments(before, after),
+		Documents: after,
+	}
+	if beforeTruncated || afterTruncated {
+		d.Truncated = fmt.Sprintf("collection truncated at %d documents, only those documents are compared", len(after))
+	}
+	return mongoextjson.Marshal(d)
+}
+
+// read the documents of the collection, sorted by _id so that both
+// snapshots cover the same documents when the result limits are reached
+func snapshot(ctx context.Context, collection *mongo.Collection, limits *ResultLimits) ([]bson.M, bool, error) {
+
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, false, fmt.Errorf("fail to read collection %s: %v", collection.Name(), err)
+	}
+
+	docs, truncated, err := limits.readCursor(ctx, cursor)
+	if err != nil {
+		return nil, false, fmt.Errorf("fail to read collection %s: %v", collection.Name(), err)
+	}
+
+	snap := make([]bson.M, 0, len(docs))
+	for _, doc := range docs {
+		snap = append(snap, doc.(bson.M))
+	}
+	return snap, truncated, nil
+}
+
+// compare two snapshots of a collection. Documents are matched by _id.
+// Changed and added documents are listed first, in the order of the new
+// snapshot, followed by removed documents
+func diffDocuments(before, after []bson.M) []documentDiff {
+
+	beforeByID := make(map[string]bson.M, len(before))
+	for _, doc := range before {
+		beforeByID[idKey(doc["_id"])] = doc
+	}
+
+	diffs := []documentDiff{}
+	seen := make(map[string]bool, len(after))
+
+	for _, doc := range after {
+
+		key := idKey(doc["_id"])
+		seen[key] = true
+
+		old, ok := beforeByID[key]
+		if !ok {
+			diffs = append(diffs, documentDiff{ID: doc["_id"], Status: docAdded, Document: doc})
+			continue
+		}
+		if d, changed := diffFields(old, doc); changed {
+			diffs = append(diffs, d)
+		}
+	}
+
+	for _, doc := range before {
+		if !seen[idKey(doc["_id"])] {
+			diffs = append(diffs, documentDiff{ID: doc["_id"], Status: docRemoved, Document: doc})
+		}
+	}
+	return diffs
+}
+
+func diffFields(before, after bson.M) (documentDiff, bool) {
+
+	d := documentDiff{
+		ID:     after["_id"],
+		Status: docChanged,
+	}
+
+	for k, v := range after {
+		old, ok := before[k]
+		if !ok {
+			if d.AddedFields == nil {
+				d.AddedFields = bson.M{}
+			}
+			d.AddedFields[k] = v
+			continue
+		}
+		if !reflect.DeepEqual(old, v) {
+			if d.ChangedFields == nil {
+				d.ChangedFields = map[string]fieldChange{}
+			}
+			d.ChangedFields[k] = fieldChange{Before: old, After: v}
+		}
+	}
+
+	for k, v := range before {
+		if _, ok := after[k]; !ok {
+			if d.RemovedFields == nil {
+				d.RemovedFields = bson.M{}
+			}
+			d.RemovedFields[k] = v
+		}
+	}
+
+	changed := d.AddedFields != nil || d.RemovedFields != nil || d.ChangedFields != nil
+	return d, changed
+}
+
+// _id can be of any type except array, so use its
+// extended json representation as a key
+func idKey(id any) string {
+	b, _ := mongoextjson.Marshal(id)
+	return string(b)
+}
@@ -0,0 +1,142 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// default size of the iframe returned by /oembed
+	defaultEmbedWidth  = 800
+	defaultEmbedHeight = 450
+)
+
+var embedTemplate = template.Must(template.ParseFS(assets, "web/src/embed.html"))
+
+type embedPage struct {
+	*page
+	ID string
+}
+
+func (p embedPage) ModeLabel() string {
+	if p.Mode == mgodatagenMode {
+		return mgodatagenLabel
+	}
+	return "bson"
+}
+
+// view a saved playground in a read-only page that can be
+// embedded in an iframe
+func (s *storage) embedHandler(w http.ResponseWriter, r *http.Request) {
+
+	id := []byte(strings.TrimPrefix(r.URL.Path, embedEndpoint))
+
+	page, err := s.loadPage(id)
+	if err != nil {
+		log.Printf("fail to load page with id %s : %v", id, err)
+		serveNoMatchingPlayground(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Encoding", gzipEncoding)
+
+	writer := gzip.NewWriter(w)
+	embedTemplate.Execute(writer, embedPage{page: page, ID: string(id)})
+	writer.Close()
+}
+
+// see https://oembed.com/#section2.3
+type oembedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	Title        string `json:"title"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// return the oEmbed description of a playground url, like
+//
+//	/oembed?url=https://mongoplayground.net/p/nJhd-dhf3Ea
+//
+// only json format is supported
+func (s *storage) oembedHandler(w http.ResponseWriter, r *http.Request) {
+
+	if format := r.FormValue("format"); format != "" && format != "json" {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	u, err := url.Parse(r.FormValue("url"))
+	if err != nil || u.Host != r.Host || !strings.HasPrefix(u.Path, viewEndpoint) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	id := extractPageIDFromURL(u.Path)
+	if _, err := s.loadPage(id); err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	width := embedSize(r.FormValue("maxwidth"), defaultEmbedWidth)
+	height := embedSize(r.FormValue("maxheight"), defaultEmbedHeight)
+
+	base := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	src := fmt.Sprintf("%s%s%s", base, embedEndpoint, id)
+
+	res := oembedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		ProviderName: "Mongo playground",
+		ProviderURL:  base,
+		Title:        "Mongo playground",
+		HTML:         fmt.Sprintf(`<iframe src="%s" width="%d" height="%d" frameborder="0"></iframe>`, html.EscapeString(src), width, height),
+		Width:        width,
+		Height:       height,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	// keep the html of the iframe readable
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(res)
+}
+
+// the size of the iframe can't be greater than the
+// max size requested by the consumer
+func embedSize(max string, defaultSize int) int {
+	n, err := strconv.Atoi(max)
+	if err != nil || n <= 0 || n > defaultSize {
+		return defaultSize
+	}
+	return n
+}
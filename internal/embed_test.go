@@ -0,0 +1,119 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestEmbed(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {`[{"_id": 1}]`}, "query": {templateQuery}}
+	httpBody(t, saveEndpoint, http.MethodPost, params)
+
+	checkServerResponse(t, "/embed/DEz-pkpheLX", http.StatusOK, "text/html; charset=utf-8", gzipEncoding)
+	checkServerResponse(t, "/embed/unknownURL", http.StatusNotFound, "", "")
+
+	// only the embed route sets frame-ancestors, other routes keep
+	// the default policy of the browser
+	frameTests := []struct {
+		url            string
+		frameAncestors string
+	}{
+		{url: "/embed/DEz-pkpheLX", frameAncestors: "frame-ancestors *"},
+		{url: "/p/DEz-pkpheLX", frameAncestors: ""},
+		{url: homeEndpoint, frameAncestors: ""},
+	}
+	for _, tt := range frameTests {
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+		testServer.Handler.ServeHTTP(resp, req)
+
+		csp := resp.Header().Get("Content-Security-Policy")
+		got := ""
+		if i := strings.Index(csp, "frame-ancestors"); i >= 0 {
+			got = csp[i:]
+		}
+		if tt.frameAncestors != got {
+			t.Errorf("%s: expected %q in CSP but got %s", tt.url, tt.frameAncestors, csp)
+		}
+	}
+}
+
+func TestOembed(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {`[{"_id": 1}]`}, "query": {templateQuery}}
+	httpBody(t, saveEndpoint, http.MethodPost, params)
+
+	oembedTests := []struct {
+		name         string
+		query        string
+		responseCode int
+		body         string
+	}{
+		{
+			name:         "valid url",
+			query:        "url=" + url.QueryEscape("https://mongoplayground.net/p/DEz-pkpheLX"),
+			responseCode: http.StatusOK,
+			body:         `{"version":"1.0","type":"rich","provider_name":"Mongo playground","provider_url":"https://mongoplayground.net","title":"Mongo playground","html":"<iframe src=\"https://mongoplayground.net/embed/DEz-pkpheLX\" width=\"800\" height=\"450\" frameborder=\"0\"></iframe>","width":800,"height":450}`,
+		},
+		{
+			name:         "max size",
+			query:        "maxwidth=400&maxheight=1000&url=" + url.QueryEscape("https://mongoplayground.net/p/DEz-pkpheLX"),
+			responseCode: http.StatusOK,
+			body:         `{"version":"1.0","type":"rich","provider_name":"Mongo playground","provider_url":"https://mongoplayground.net","title":"Mongo playground","html":"<iframe src=\"https://mongoplayground.net/embed/DEz-pkpheLX\" width=\"400\" height=\"450\" frameborder=\"0\"></iframe>","width":400,"height":450}`,
+		},
+		{
+			name:         "unknown playground",
+			query:        "url=" + url.QueryEscape("https://mongoplayground.net/p/unknownURL1"),
+			responseCode: http.StatusNotFound,
+		},
+		{
+			name:         "other host",
+			query:        "url=" + url.QueryEscape("https://example.com/p/DEz-pkpheLX"),
+			responseCode: http.StatusNotFound,
+		},
+		{
+			name:         "xml format",
+			query:        "format=xml&url=" + url.QueryEscape("https://mongoplayground.net/p/DEz-pkpheLX"),
+			responseCode: http.StatusNotImplemented,
+		},
+	}
+
+	for _, tt := range oembedTests {
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "https://mongoplayground.net"+oembedEndpoint+"?"+tt.query, nil)
+		testServer.Handler.ServeHTTP(resp, req)
+
+		if want, got := tt.responseCode, resp.Code; want != got {
+			t.Errorf("%s: expected response code %d but got %d", tt.name, want, got)
+		}
+		if want, got := tt.body, strings.TrimSpace(resp.Body.String()); want != got {
+			t.Errorf("%s: expected\n%s\nbut got\n%s", tt.name, want, got)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// an encoded page starts with pageMagic followed by the format
+	// of the page. A page in legacy format starts with an uint32 lower
+	// than maxByteSize, so its third byte is always lower than 0x06
+	// and it can't start with pageMagic
+	pageMagic = "\xffpg"
+
+	// fixed layout, see decodeLegacy()
+	legacyPageFormat byte = 1
+	// list of tagged fields, see encode()
+	taggedPageFormat byte = 2
+
+	currentPageFormat = taggedPageFormat
+
+	// set on the mode byte of a legacy page if the page
+	// requests a specific MongoDB version, see decodeLegacy()
+	versionFlag byte = 1 << 7
+	// set on the mode byte of a legacy page if the page has
+	// a title, a description or tags, see decodeLegacy()
+	metadataFlag byte = 1 << 6
+)
+
+// tags of the fields of a page, see encode(). Do not change
+// existing values, only add new ones
+const (
+	modeField byte = iota + 1
+	configField
+	queryField
+	versionField
+	titleField
+	descriptionField
+	tagField
+)
+
+// encode a page into a byte slice:
+//
+// pageMagic | format | field 1 | field 2 ...
+//
+// each field is made of a tag, the length of its value as an
+// uvarint, and its value:
+//
+// tag | length | value
+//
+// empty optional fields are omitted, and tagField is repeated for each
+// tag of the page. Unknown fields are skipped by decode(), so new fields
+// can be added without changing the format
+func (p *page) encode() []byte {
+
+	size := len(pageMagic) + 1 + len(p.Config) + len(p.Query) + len(p.Version) + len(p.Title) + len(p.Description)
+	for _, tag := range p.Tags {
+		size += len(tag)
+	}
+	// each field adds a tag and a length, which
+	// usually fit in a few bytes
+	size += 4 * (7 + len(p.Tags))
+
+	v := make([]byte, 0, size)
+	v = append(v, pageMagic...)
+	v = append(v, currentPageFormat)
+
+	v = appendField(v, modeField, []byte{p.Mode})
+	v = appendField(v, configField, p.Config)
+	v = appendField(v, queryField, p.Query)
+
+	optionalFields := []struct {
+		tag   byte
+		value []byte
+	}{
+		{tag: versionField, value: p.Version},
+		{tag: titleField, value: p.Title},
+		{tag: descriptionField, value: p.Description},
+	}
+	for _, f := range optionalFields {
+		if len(f.value) > 0 {
+			v = appendField(v, f.tag, f.value)
+		}
+	}
+	for _, tag := range p.Tags {
+		v = appendField(v, tagField, tag)
+	}
+	return v
+}
+
+func appendField(v []byte, tag byte, value []byte) []byte {
+	v = append(v, tag)
+	return appendString(v, value)
+}
+
+func appendString(v, s []byte) []byte {
+	v = appendUvarint(v, uint64(len(s)))
+	return append(v, s...)
+}
+
+func appendUvarint(v []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	return append(v, buf[:n]...)
+}
+
+// returns the format of an encoded page
+func pageFormat(v []byte) byte {
+	if len(v) > len(pageMagic) && bytes.HasPrefix(v, []byte(pageMagic)) {
+		return v[len(pageMagic)]
+	}
+	return legacyPageFormat
+}
+
+// decode a slice of byte into the p page. The fields of p reference
+// v, so v must not be modified afterwards
+func (p *page) decode(v []byte) error {
+
+	p.Config, p.Query, p.Version = nil, nil, nil
+	p.Title, p.Description, p.Tags = nil, nil, nil
+
+	switch format := pageFormat(v); format {
+	case legacyPageFormat:
+		return p.decodeLegacy(v)
+	case taggedPageFormat:
+		return p.decodeTagged(v[len(pageMagic)+1:])
+	default:
+		return fmt.Errorf("unsupported page format %d", format)
+	}
+}
+
+func (p *page) decodeTagged(v []byte) error {
+
+	hasMode := false
+	for len(v) > 0 {
+
+		tag := v[0]
+		value, remaining, ok := readString(v[1:])
+		if !ok {
+			return fmt.Errorf("invalid length for field %d", tag)
+		}
+		v = remaining
+
+		switch tag {
+		case modeField:
+			if len(value) != 1 {
+				return fmt.Errorf("invalid mode length %d", len(value))
+			}
+			p.Mode = value[0]
+			hasMode = true
+		case configField:
+			p.Config = value
+		case queryField:
+			p.Query = value
+		case versionField:
+			p.Version = value
+		case titleField:
+			p.Title = value
+		case descriptionField:
+			p.Description = value
+		case tagField:
+			p.Tags = append(p.Tags, value)
+		}
+	}
+
+	if !hasMode {
+		return errors.New("missing mode field")
+	}
+	return nil
+}
+
+// decode a page saved before taggedPageFormat was introduced. The
+// layout of such a page is:
+//
+// v[0:4] -> an int32 to store the position of the last byte of the configuration
+// v[4] -> the mode (mgodatagen / bson) to use for building the database
+// v[5:endConfig] -> the configuration
+// v[endConfig:] -> the query
+//
+// if the page requests a specific MongoDB version, versionFlag is set on
+// the mode, and the version is stored after the query:
+//
+// v[endConfig:endQuery] -> the query
+// v[endQuery:len(v)-1] -> the version
+// v[len(v)-1] -> the length of the version
+//
+// if the page has a title, a description or tags, metadataFlag is set on
+// the mode, and the metadata are stored at the very end of the page:
+//
+// v[len(v)-4-n:len(v)-4] -> the metadata, see metadataHashInput()
+// v[len(v)-4:] -> an uint32 n, the length of the metadata
+func (p *page) decodeLegacy(v []byte) error {
+
+	if len(v) < 5 {
+		return fmt.Errorf("page too short: %d bytes", len(v))
+	}
+	endConfig := int(binary.LittleEndian.Uint32(v[0:4]))
+	flags := v[4]
+	p.Mode = flags &^ (versionFlag | metadataFlag)
+
+	if flags&metadataFlag != 0 {
+		if len(v) < endConfig+4 {
+			return errors.New("page too short for metadata")
+		}
+		endMetadata := len(v) - 4
+		startMetadata := endMetadata - int(binary.LittleEndian.Uint32(v[endMetadata:]))
+		if startMetadata < endConfig {
+			return errors.New("invalid metadata length")
+		}
+		if err := p.decodeLegacyMetadata(v[startMetadata:endMetadata]); err != nil {
+			return err
+		}
+		v = v[:startMetadata]
+	}
+
+	if endConfig < 5 || endConfig > len(v) {
+		return fmt.Errorf("invalid end of configuration %d for a page of %d bytes", endConfig, len(v))
+	}
+	p.Config = v[5:endConfig]
+	p.Query = v[endConfig:]
+
+	if flags&versionFlag != 0 {
+		if len(v) == endConfig {
+			return errors.New("page too short for version")
+		}
+		endQuery := len(v) - 1 - int(v[len(v)-1])
+		if endQuery < endConfig {
+			return errors.New("invalid version length")
+		}
+		p.Version = v[endQuery : len(v)-1]
+		p.Query = v[endConfig:endQuery]
+	}
+	return nil
+}
+
+// decode the metadata of a legacy page, see metadataHashInput()
+func (p *page) decodeLegacyMetadata(v []byte) error {
+
+	var ok bool
+	if p.Title, v, ok = readString(v); !ok {
+		return errors.New("invalid title length")
+	}
+	if p.Description, v, ok = readString(v); !ok {
+		return errors.New("invalid description length")
+	}
+	nbTags, n := binary.Uvarint(v)
+	if n <= 0 || nbTags > maxTagNb {
+		return errors.New("invalid number of tags")
+	}
+	v = v[n:]
+
+	for i := uint64(0); i < nbTags; i++ {
+		var tag []byte
+		if tag, v, ok = readString(v); !ok {
+			return errors.New("invalid tag length")
+		}
+		p.Tags = append(p.Tags, tag)
+	}
+	return nil
+}
+
+func readString(v []byte) (s []byte, remaining []byte, ok bool) {
+	length, n := binary.Uvarint(v)
+	if n <= 0 || length > uint64(len(v)-n) {
+		return nil, v, false
+	}
+	end := n + int(length)
+	return v[n:end], v[end:], true
+}
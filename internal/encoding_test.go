@@ -0,0 +1,277 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// pages encoded in legacy format, see decodeLegacy()
+var legacyPageTests = []struct {
+	name string
+	page *page
+}{
+	{
+		name: "bson",
+		page: &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte(templateQuery)},
+	},
+	{
+		name: "mgodatagen",
+		page: &page{Mode: mgodatagenMode, Config: []byte(templateConfigOld), Query: []byte(templateQuery)},
+	},
+	{
+		name: "empty config and query",
+		page: &page{Mode: bsonMode, Config: []byte{}, Query: []byte{}},
+	},
+	{
+		name: "with version",
+		page: &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte(templateQuery), Version: []byte("6.0")},
+	},
+	{
+		name: "with metadata",
+		page: &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte(templateQuery), Title: []byte("title"), Description: []byte("*desc*"), Tags: [][]byte{[]byte("a"), []byte("b")}},
+	},
+	{
+		name: "with version and metadata",
+		page: &page{Mode: mgodatagenMode, Config: []byte(templateConfigOld), Query: []byte(templateQuery), Version: []byte("5.0"), Title: []byte("title")},
+	},
+}
+
+func TestDecodeLegacyPage(t *testing.T) {
+
+	t.Parallel()
+
+	// a page saved before versions and metadata were added
+	v := []byte{16, 0, 0, 0, bsonMode}
+	v = append(v, `[{"_id":1}]`...)
+	v = append(v, templateQuery...)
+
+	p := &page{}
+	if err := p.decode(v); err != nil {
+		t.Fatal(err)
+	}
+	want := &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte(templateQuery)}
+	if !reflect.DeepEqual(want, p) {
+		t.Errorf("expected %+v but got %+v", want, p)
+	}
+
+	for _, tt := range legacyPageTests {
+
+		decoded := &page{}
+		if err := decoded.decode(encodeLegacy(tt.page)); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !samePage(tt.page, decoded) {
+			t.Errorf("%s: expected %+v but got %+v", tt.name, tt.page, decoded)
+		}
+		if !bytes.Equal(tt.page.ID(), decoded.ID()) {
+			t.Errorf("%s: ID of a legacy page should not change", tt.name)
+		}
+	}
+}
+
+func TestEncodeDecodeTaggedPage(t *testing.T) {
+
+	t.Parallel()
+
+	for _, tt := range legacyPageTests {
+
+		v := tt.page.encode()
+		if want, got := currentPageFormat, pageFormat(v); want != got {
+			t.Errorf("%s: expected format %d but got %d", tt.name, want, got)
+		}
+
+		decoded := &page{}
+		if err := decoded.decode(v); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !samePage(tt.page, decoded) {
+			t.Errorf("%s: expected %+v but got %+v", tt.name, tt.page, decoded)
+		}
+	}
+
+	// fields added in a later version are skipped
+	v := (&page{Mode: bsonMode, Config: []byte("[]"), Query: []byte(templateQuery)}).encode()
+	v = appendField(v, 100, []byte("unknown"))
+
+	decoded := &page{}
+	if err := decoded.decode(v); err != nil {
+		t.Error(err)
+	}
+	if want, got := templateQuery, string(decoded.Query); want != got {
+		t.Errorf("expected query %s but got %s", want, got)
+	}
+}
+
+func TestDecodeInvalidPage(t *testing.T) {
+
+	t.Parallel()
+
+	invalidTests := []struct {
+		name  string
+		value []byte
+	}{
+		{
+			name:  "empty",
+			value: []byte{},
+		},
+		{
+			name:  "legacy too short",
+			value: []byte{5, 0, 0},
+		},
+		{
+			name:  "legacy config out of range",
+			value: []byte{200, 0, 0, 0, bsonMode, '[', ']'},
+		},
+		{
+			name:  "legacy version out of range",
+			value: []byte{7, 0, 0, 0, bsonMode | versionFlag, '[', ']', 200},
+		},
+		{
+			name:  "legacy metadata out of range",
+			value: []byte{7, 0, 0, 0, bsonMode | metadataFlag, '[', ']', 200, 0, 0, 0},
+		},
+		{
+			name:  "unknown format",
+			value: append([]byte(pageMagic), 100),
+		},
+		{
+			name:  "missing mode",
+			value: appendField(append([]byte(pageMagic), taggedPageFormat), queryField, []byte(templateQuery)),
+		},
+		{
+			name:  "truncated field",
+			value: append(append([]byte(pageMagic), taggedPageFormat, modeField, 1, bsonMode, queryField, 100), "db."...),
+		},
+	}
+
+	for _, tt := range invalidTests {
+		if err := (&page{}).decode(tt.value); err == nil {
+			t.Errorf("%s: expected an error but got none", tt.name)
+		}
+	}
+
+	// truncated pages should never make decode panic
+	for _, tt := range legacyPageTests {
+		for _, v := range [][]byte{encodeLegacy(tt.page), tt.page.encode()} {
+			for i := range v {
+				(&page{}).decode(v[:i])
+			}
+		}
+	}
+}
+
+func TestMigratePages(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	entries := make([]Entry, 0)
+	for _, tt := range legacyPageTests {
+		entries = append(entries, Entry{Key: tt.page.ID(), Value: encodeLegacy(tt.page)})
+	}
+	p := &page{Mode: bsonMode, Config: []byte("[]"), Query: []byte("db.collection.count()")}
+	entries = append(entries,
+		// already migrated
+		Entry{Key: p.ID(), Value: p.encode()},
+		// can't be decoded
+		Entry{Key: []byte("invalidPage"), Value: []byte{1, 2, 3}},
+		// not a page
+		Entry{Key: previewKey(p.ID()), Value: []byte{1, 2, 3}},
+	)
+	if err := testStorage.pageStore.Put(entries...); err != nil {
+		t.Fatal(err)
+	}
+
+	migrated, err := migratePages(testStorage.pageStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := len(legacyPageTests), migrated; want != got {
+		t.Errorf("expected %d migrated pages but got %d", want, got)
+	}
+
+	for _, tt := range legacyPageTests {
+
+		val, _ := testStorage.pageStore.Get(tt.page.ID())
+		if want, got := currentPageFormat, pageFormat(val); want != got {
+			t.Errorf("%s: expected format %d but got %d", tt.name, want, got)
+		}
+
+		loaded, err := testStorage.loadPage(tt.page.ID())
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !samePage(tt.page, loaded) {
+			t.Errorf("%s: expected %+v but got %+v", tt.name, tt.page, loaded)
+		}
+	}
+
+	migrated, err = migratePages(testStorage.pageStore)
+	if err != nil || migrated != 0 {
+		t.Errorf("pages should be migrated only once, but got %d, %v", migrated, err)
+	}
+}
+
+// compare the encoded fields of two pages
+func samePage(a, b *page) bool {
+	return a.Mode == b.Mode &&
+		bytes.Equal(a.Config, b.Config) &&
+		bytes.Equal(a.Query, b.Query) &&
+		bytes.Equal(a.Version, b.Version) &&
+		bytes.Equal(a.Title, b.Title) &&
+		bytes.Equal(a.Description, b.Description) &&
+		reflect.DeepEqual(a.Tags, b.Tags)
+}
+
+// encode a page in legacy format, see decodeLegacy()
+func encodeLegacy(p *page) []byte {
+
+	size := 5 + len(p.Config) + len(p.Query)
+	if len(p.Version) > 0 {
+		size += len(p.Version) + 1
+	}
+	v := make([]byte, size)
+
+	endConfig := len(p.Config) + 5
+	binary.LittleEndian.PutUint32(v[0:4], uint32(endConfig))
+
+	v[4] = p.Mode
+	copy(v[5:endConfig], p.Config)
+	copy(v[endConfig:], p.Query)
+
+	if len(p.Version) > 0 {
+		v[4] |= versionFlag
+		endQuery := endConfig + len(p.Query)
+		copy(v[endQuery:], p.Version)
+		v[len(v)-1] = byte(len(p.Version))
+	}
+
+	if p.hasMetadata() {
+		v[4] |= metadataFlag
+		metadata := p.metadataHashInput()
+		v = append(v, metadata...)
+		v = append(v, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(v[len(v)-4:], uint32(len(metadata)))
+	}
+	return v
+}
@@ -0,0 +1,139 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/feliixx/mongoextjson"
+)
+
+// kinds of errors that can occur while running a playground
+const (
+	// the request itself is invalid: unknown output mode or version,
+	// playground too big...
+	errKindInvalidRequest = "invalid_request"
+	// the configuration can't be used to create a database
+	errKindConfig = "config"
+	// the query can't be parsed
+	errKindQuery = "query"
+	// the query is valid but failed when run by MongoDB
+	errKindRuntime = "runtime"
+	// the playground doesn't exist
+	errKindNotFound = "not_found"
+	// something went wrong on our side
+	errKindInternal = "internal"
+	// too many requests, the client should retry later
+	errKindRateLimited = "rate_limited"
+	// too many playgrounds are already running
+	errKindServerBusy = "server_busy"
+)
+
+// runError is an error with a kind, so the cause of an error can
+// be known without parsing its message
+type runError struct {
+	kind string
+	// message as shown to users, like "error in query:\n  invalid query"
+	msg string
+	// underlying error
+	err error
+}
+
+func newRunError(kind, prefix string, err error) *runError {
+	return &runError{
+		kind: kind,
+		msg:  prefix + err.Error(),
+		err:  err,
+	}
+}
+
+func (e *runError) Error() string {
+	return e.msg
+}
+
+func (e *runError) Unwrap() error {
+	return e.err
+}
+
+// return the kind of an error. Errors without kind come from
+// MongoDB when running the query
+func errorKind(err error) string {
+
+	var runErr *runError
+	if errors.As(err, &runErr) {
+		return runErr.kind
+	}
+	return errKindRuntime
+}
+
+// return the underlying message of an error, without the
+// prefix added for users of the web page
+func errorMessage(err error) string {
+
+	var runErr *runError
+	if errors.As(err, &runErr) {
+		return runErr.err.Error()
+	}
+	return err.Error()
+}
+
+// positionError is a syntax error at a known position in a query
+type positionError struct {
+	err error
+	// offset in bytes from the start of the query
+	position int
+}
+
+func (e *positionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *positionError) Unwrap() error {
+	return e.err
+}
+
+// if err is a syntax error in args, return an error with the position
+// of the syntax error in the query. The offset of a syntax error counts
+// the invalid byte, and args are wrapped in '[' and ']' before being
+// parsed, see unmarshalStages()
+func withPosition(err error, query, args []byte) error {
+
+	var syntaxErr *mongoextjson.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return err
+	}
+	start := bytes.Index(query, args)
+	if start < 0 {
+		return err
+	}
+	position := start + int(syntaxErr.Offset) - 2
+	if position < start {
+		position = start
+	}
+	return &positionError{err: err, position: position}
+}
+
+// return the position of the error in the query, or -1 if unknown
+func errorPosition(err error) int {
+
+	var posErr *positionError
+	if errors.As(err, &posErr) {
+		return posErr.position
+	}
+	return -1
+}
@@ -0,0 +1,128 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// suffixes of a page url to get the raw content of a playground,
+// for example /p/nJhd-dhf3Ea/config
+const (
+	jsonSuffix   = ".json"
+	scriptSuffix = ".js"
+	configSuffix = "/config"
+	querySuffix  = "/query"
+)
+
+// serve the content of a saved playground in a machine-readable format.
+// Returns false if the suffix doesn't match any format
+func serveExport(w http.ResponseWriter, id []byte, p *page, suffix string) bool {
+
+	var (
+		content     []byte
+		contentType string
+	)
+
+	switch suffix {
+	case jsonSuffix:
+		content, _ = json.Marshal(newAPIPage(string(id), p))
+		contentType = "application/json; charset=utf-8"
+	case scriptSuffix:
+		content = mongoshScript(id, p)
+		// the script is written by users, so it must never be loaded as a
+		// script from our own origin, as it would be allowed by the CSP
+		contentType = "text/plain; charset=utf-8"
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.js"`, id))
+	case configSuffix:
+		content = p.Config
+		contentType = "text/plain; charset=utf-8"
+	case querySuffix:
+		content = p.Query
+		contentType = "text/plain; charset=utf-8"
+	default:
+		return false
+	}
+
+	writeImmutable(w, id, contentType, content)
+	return true
+}
+
+// the ID of a page is a hash of its content, so the
+// content of a page never changes
+func writeImmutable(w http.ResponseWriter, id []byte, contentType string, content []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", strconv.Quote(string(id)))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Write(content)
+}
+
+// convert a page to a script that can be run with mongosh, like:
+//
+//	// playground nJhd-dhf3Ea
+//	const collections = {"collection": [{_id:1,k:1}]};
+//	for (const [name, docs] of Object.entries(collections)) {
+//	  if (docs.length > 0) db.getCollection(name).insertMany(docs);
+//	}
+//
+//	db.collection.find()
+//
+// configurations in mgodatagen mode can't be converted, so they are
+// added as a comment
+func mongoshScript(id []byte, p *page) []byte {
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(p.Config)+len(p.Query)+256))
+	fmt.Fprintf(buf, "// playground %s\n", id)
+
+	switch {
+	case p.Mode == mgodatagenMode:
+		buf.WriteString("// mgodatagen configurations can't be converted to a script, see https://github.com/feliixx/mgodatagen\n/*\n")
+		buf.Write(bytes.ReplaceAll(p.Config, []byte("*/"), []byte("* /")))
+		buf.WriteString("\n*/\n")
+
+	case detailBsonMode(p.Config) == bsonMultipleCollection:
+		// config looks like db={"c1":[...],"c2":[...]}
+		buf.WriteString("const collections = ")
+		buf.Write(p.Config[3:])
+		buf.WriteString(";\n")
+		writeInsertLoop(buf)
+
+	default:
+		buf.WriteString("const collections = {\"collection\": ")
+		buf.Write(p.Config)
+		buf.WriteString("};\n")
+		writeInsertLoop(buf)
+	}
+
+	buf.WriteByte('\n')
+	buf.Write(p.Query)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// documents without _id get a random ObjectId, unlike in
+// the playground where they are seeded
+func writeInsertLoop(buf *bytes.Buffer) {
+	buf.WriteString("for (const [name, docs] of Object.entries(collections)) {\n")
+	buf.WriteString("  if (docs.length > 0) db.getCollection(name).insertMany(docs);\n")
+	buf.WriteString("}\n")
+}
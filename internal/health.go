@@ -32,6 +32,10 @@ const (
 	statusDegrade = "DEGRADE"
 	// service is unavailable
 	statusDown = "DOWN"
+
+	// key read to check that the page store is available. It is
+	// never written, so the read is expected to return errKeyNotFound
+	healthCheckKey = "health_check"
 )
 
 type serviceInfo struct {
@@ -58,39 +62,53 @@ func (s *storage) healthCheck() []byte {
 		Status: statusUp,
 	}
 
-	badger := serviceInfo{
-		Name:   "badger",
+	store := serviceInfo{
+		Name:   pageStoreKind(s.pageStore),
 		Status: "UP",
 	}
 
-	if s.kvStore.IsClosed() {
-		badger.Status = statusDown
-		badger.Cause = "database is closed"
+	// any read fails once the store is closed
+	if _, err := s.pageStore.Get([]byte(healthCheckKey)); err != nil && err != errKeyNotFound {
+		store.Status = statusDown
+		store.Cause = strconv.Quote(err.Error())
 		response.Status = statusDegrade
 	}
 
-	mongodb := serviceInfo{
-		Name:    "mongodb",
-		Version: string(s.mongoVersion),
-		Status:  statusUp,
+	response.Services = []serviceInfo{store}
+
+	// default backend first, then the other ones sorted by version
+	backends := []*backend{s.defaultBackend}
+	for _, version := range s.versions() {
+		if b := s.backends[version]; b != s.defaultBackend {
+			backends = append(backends, b)
+		}
 	}
 
-	err := s.mongoSession.Ping(context.Background(), nil)
-	if err != nil {
-		mongodb.Status = statusDown
-		mongodb.Cause = strconv.Quote(err.Error())
-		response.Status = statusDegrade
+	for _, b := range backends {
+
+		mongodb := serviceInfo{
+			Name:    "mongodb",
+			Version: string(b.mongoVersion),
+			Status:  statusUp,
+		}
+		if b != s.defaultBackend {
+			mongodb.Name = "mongodb-" + b.version
+		}
+
+		err := b.mongoSession.Ping(context.Background(), nil)
+		if err != nil {
+			mongodb.Status = statusDown
+			mongodb.Cause = strconv.Quote(err.Error())
+			response.Status = statusDegrade
+		}
+		response.Services = append(response.Services, mongodb)
 	}
 
 	if s.backupServiceStatus.Status != statusUp {
 		response.Status = statusDegrade
 	}
 
-	response.Services = []serviceInfo{
-		badger,
-		mongodb,
-		s.backupServiceStatus,
-	}
+	response.Services = append(response.Services, s.backupServiceStatus)
 
 	if moduleInfo, ok := debug.ReadBuildInfo(); ok {
 		for _, s := range moduleInfo.Settings {
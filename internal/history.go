@@ -0,0 +1,165 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+)
+
+const (
+	historySuffix = "/history"
+
+	// a page and its parent are linked with two keys:
+	//
+	//	<id>/parent -> <parent id>
+	//	<parent id>/fork/<id> -> empty
+	//
+	// so the forks of a page can be listed with a prefix scan
+	parentSuffix = "/parent"
+	forkInfix    = "/fork/"
+
+	// max number of ancestors listed in the history of a page
+	maxHistoryDepth = 100
+	// max length of the summary of a page in its history
+	historySummaryMaxChars = 80
+)
+
+var historyTemplate = template.Must(template.ParseFS(assets, "web/src/history.html"))
+
+// a saved page in the history of a playground
+type historyEntry struct {
+	ID      string
+	Summary string
+}
+
+type pageHistory struct {
+	Page historyEntry
+	// oldest ancestor first, the direct parent of the page being the
+	// last one
+	Ancestors []historyEntry
+	// pages forked from this page
+	Forks []historyEntry
+}
+
+// returns the entries linking the page with the given id to
+// its parent, if the parent is a valid saved page
+func (s *storage) parentEntries(id, parent []byte) ([]Entry, error) {
+
+	if len(parent) != pageIDLength || bytes.Equal(id, parent) {
+		return nil, nil
+	}
+	if found, err := s.pageStore.Has(parent); !found || err != nil {
+		return nil, err
+	}
+
+	return []Entry{
+		{Key: pageSubKey(id, parentSuffix), Value: parent},
+		{Key: pageSubKey(parent, forkInfix+string(id))},
+	}, nil
+}
+
+// list the ancestors and the forks of a page
+func (s *storage) history(id []byte, p *page) (*pageHistory, error) {
+
+	h := &pageHistory{
+		Page:      newHistoryEntry(id, p),
+		Ancestors: make([]historyEntry, 0),
+		Forks:     make([]historyEntry, 0),
+	}
+
+	current := id
+	for i := 0; i < maxHistoryDepth; i++ {
+
+		parent, err := s.pageStore.Get(pageSubKey(current, parentSuffix))
+		if err == errKeyNotFound {
+			break
+		}
+		if err != nil {
+			return h, err
+		}
+		entry, err := s.loadHistoryEntry(parent)
+		if err != nil {
+			return h, err
+		}
+		h.Ancestors = append([]historyEntry{entry}, h.Ancestors...)
+		current = parent
+	}
+
+	prefix := pageSubKey(id, forkInfix)
+	forks, err := listKeys(s.pageStore, prefix, nil)
+	if err != nil {
+		return h, err
+	}
+	for _, fork := range forks {
+		entry, err := s.loadHistoryEntry(bytes.TrimPrefix(fork, prefix))
+		if err != nil {
+			return h, err
+		}
+		h.Forks = append(h.Forks, entry)
+	}
+	return h, nil
+}
+
+func (s *storage) loadHistoryEntry(id []byte) (historyEntry, error) {
+	val, err := s.pageStore.Get(id)
+	if err != nil {
+		return historyEntry{}, err
+	}
+	p := &page{}
+	if err := p.decode(val); err != nil {
+		return historyEntry{}, fmt.Errorf("fail to decode page %s: %v", id, err)
+	}
+	return newHistoryEntry(id, p), nil
+}
+
+// the summary of an entry is the title of the page, or the
+// first line of its query if the page has no title
+func newHistoryEntry(id []byte, p *page) historyEntry {
+	summary := string(p.Title)
+	if lines := firstLines(p.Query, 1); summary == "" && len(lines) > 0 {
+		summary = lines[0]
+	}
+	summary = truncate(summary, historySummaryMaxChars)
+	return historyEntry{
+		ID:      string(id),
+		Summary: summary,
+	}
+}
+
+// list the ancestors and the known forks of a page, to follow
+// how a playground evolved
+func (s *storage) serveHistory(w http.ResponseWriter, id []byte, p *page) {
+
+	h, err := s.history(id, p)
+	if err != nil {
+		log.Printf("fail to load history of page %s: %v", id, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Encoding", gzipEncoding)
+
+	writer := gzip.NewWriter(w)
+	historyTemplate.Execute(writer, h)
+	writer.Close()
+}
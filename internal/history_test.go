@@ -0,0 +1,149 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	save := func(query, parent string) string {
+		params := url.Values{"mode": {"bson"}, "config": {templateConfig}, "query": {query}, "parent": {parent}}
+		return strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, params), "p/")
+	}
+
+	root := save("db.collection.find()", "")
+	child := save("db.collection.find({key:1})", root)
+	grandChild := save("db.collection.find({key:2})", child)
+	sibling := save("db.collection.count()", root)
+	// unknown parents are ignored
+	orphan := save("db.collection.find({key:3})", "unknownURL0")
+	// the parent of a page can't change once saved
+	save("db.collection.find({key:1})", sibling)
+
+	testStorageContent(t, 0, 0, 5)
+
+	forks := []historyEntry{
+		{ID: child, Summary: "db.collection.find({key:1})"},
+		{ID: sibling, Summary: "db.collection.count()"},
+	}
+	sort.Slice(forks, func(i, j int) bool { return forks[i].ID < forks[j].ID })
+
+	historyTests := []struct {
+		name string
+		id   string
+		want *pageHistory
+	}{
+		{
+			name: "root",
+			id:   root,
+			want: &pageHistory{
+				Page:      historyEntry{ID: root, Summary: "db.collection.find()"},
+				Ancestors: []historyEntry{},
+				Forks:     forks,
+			},
+		},
+		{
+			name: "grand child",
+			id:   grandChild,
+			want: &pageHistory{
+				Page: historyEntry{ID: grandChild, Summary: "db.collection.find({key:2})"},
+				Ancestors: []historyEntry{
+					{ID: root, Summary: "db.collection.find()"},
+					{ID: child, Summary: "db.collection.find({key:1})"},
+				},
+				Forks: []historyEntry{},
+			},
+		},
+		{
+			name: "orphan",
+			id:   orphan,
+			want: &pageHistory{
+				Page:      historyEntry{ID: orphan, Summary: "db.collection.find({key:3})"},
+				Ancestors: []historyEntry{},
+				Forks:     []historyEntry{},
+			},
+		},
+	}
+
+	for _, tt := range historyTests {
+
+		p, err := testStorage.loadPage([]byte(tt.id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := testStorage.history([]byte(tt.id), p)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%s: expected\n%+v\nbut got\n%+v", tt.name, tt.want, got)
+		}
+	}
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, viewEndpoint+child+historySuffix, nil)
+	testServer.Handler.ServeHTTP(resp, req)
+
+	if want, got := http.StatusOK, resp.Code; want != got {
+		t.Fatalf("expected response code %d but got %d", want, got)
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(reader)
+	for _, want := range []string{`<a href="/p/` + root + `">`, `<a href="/p/` + grandChild + `">`} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected history to contain %s but got\n%s", want, body)
+		}
+	}
+
+	checkServerResponse(t, "/p/unknownURL"+historySuffix, http.StatusNotFound, "", "")
+}
+
+func TestAPISaveWithParent(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	parent := strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, templateParams), "p/")
+
+	resp := apiRequestRecorder(http.MethodPost, apiSaveEndpoint, `{"mode":"bson","config":"[{\"_id\":1}]","query":"db.collection.find()","parent":"`+parent+`"}`)
+	if want, got := http.StatusOK, resp.Code; want != got {
+		t.Fatalf("expected response code %d but got %d: %s", want, got, resp.Body)
+	}
+
+	p, _ := testStorage.loadPage([]byte(parent))
+	h, err := testStorage.history([]byte(parent), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := 1, len(h.Forks); want != got {
+		t.Errorf("expected %d fork but got %d", want, got)
+	}
+}
@@ -44,7 +44,7 @@ func (s *storage) homeHandler(w http.ResponseWriter, r *http.Request) {
 		Mode:         bsonMode,
 		Config:       []byte(templateConfig),
 		Query:        []byte(templateQuery),
-		MongoVersion: s.mongoVersion,
+		MongoVersion: s.defaultBackend.mongoVersion,
 	}
 
 	serveHomeTemplate(w, page)
@@ -0,0 +1,154 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// a PageStore keeping everything in memory, mostly useful for
+// tests. Nothing is persisted once the store is closed
+type memoryStore struct {
+	sync.RWMutex
+	entries map[string][]byte
+	closed  bool
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		entries: map[string][]byte{},
+	}
+}
+
+func (s *memoryStore) Get(key []byte) ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return nil, errStoreClosed
+	}
+	val, ok := s.entries[string(key)]
+	if !ok {
+		return nil, errKeyNotFound
+	}
+	return append([]byte{}, val...), nil
+}
+
+func (s *memoryStore) Put(entries ...Entry) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return errStoreClosed
+	}
+	for _, e := range entries {
+		s.entries[string(e.Key)] = append([]byte{}, e.Value...)
+	}
+	return nil
+}
+
+func (s *memoryStore) Has(key []byte) (bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return false, errStoreClosed
+	}
+	_, ok := s.entries[string(key)]
+	return ok, nil
+}
+
+// fn is called on a snapshot of the matching entries, so
+// the store isn't locked while iterating
+func (s *memoryStore) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+
+	entries, err := s.snapshot(string(prefix))
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := fn(e.Key, e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) snapshot(prefix string) ([]Entry, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return nil, errStoreClosed
+	}
+	entries := make([]Entry, 0)
+	for key, val := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			entries = append(entries, Entry{Key: []byte(key), Value: val})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Key) < string(entries[j].Key)
+	})
+	return entries, nil
+}
+
+func (s *memoryStore) Delete(prefix []byte) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return errStoreClosed
+	}
+	for key := range s.entries {
+		if strings.HasPrefix(key, string(prefix)) {
+			delete(s.entries, key)
+		}
+	}
+	return nil
+}
+
+// the backup is the list of all entries, each one written as
+// len(key) | key | len(value) | value, lengths being uvarints
+func (s *memoryStore) Backup(w io.Writer) error {
+
+	entries, err := s.snapshot("")
+	if err != nil {
+		return err
+	}
+	var buf []byte
+	for _, e := range entries {
+		buf = appendString(buf[:0], e.Key)
+		buf = appendString(buf, e.Value)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.closed = true
+	s.entries = nil
+	return nil
+}
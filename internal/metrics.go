@@ -17,7 +17,6 @@
 package internal
 
 import (
-	"github.com/dgraph-io/badger/v2"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -30,6 +29,19 @@ var (
 		},
 		[]string{"handler"},
 	)
+	runQueueLength = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "run_queue_length",
+			Help: "Number of playgrounds waiting for a free worker",
+		},
+	)
+	runQueueWait = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "run_queue_wait_seconds",
+			Help:    "Histogram of time spent by playgrounds waiting for a free worker",
+			Buckets: []float64{0.001, 0.01, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+	)
 	activeDatabasesCounter = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Name: "active_db_count",
@@ -53,7 +65,7 @@ var (
 	badgerBackupSize = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Name: "badger_backup_size_bytes",
-			Help: "Size of last badger backup in bytes",
+			Help: "Size of last page store backup in bytes",
 		},
 	)
 	dbCacheHit = prometheus.NewCounter(
@@ -62,35 +74,62 @@ var (
 			Help: "Number of run queries where the database already exists",
 		},
 	)
+	dbCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "db_cache_size_bytes",
+			Help: "Total data size of the cached databases of a MongoDB backend",
+		},
+		[]string{"version"},
+	)
+	dbCacheMaxSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "db_cache_max_size_bytes",
+			Help: "Max data size of the cached databases of a MongoDB backend, 0 if unlimited",
+		},
+	)
+	dbEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "db_cache_evictions",
+			Help: "Number of databases dropped to keep the cache under its max size",
+		},
+	)
+	throttledRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "throttled_requests",
+			Help: "Number of requests rejected with a 429 status",
+		},
+		[]string{"handler", "reason"},
+	)
 )
 
-func initPrometheusCounter(storage *badger.DB) {
+func initPrometheusCounter(store PageStore) {
 	prometheus.MustRegister(requestDurations)
+	prometheus.MustRegister(runQueueLength)
+	prometheus.MustRegister(runQueueWait)
 	prometheus.MustRegister(activeDatabasesCounter)
 	prometheus.MustRegister(savedPlaygroundSize)
 	prometheus.MustRegister(cleanupDuration)
 	prometheus.MustRegister(badgerBackupSize)
 	prometheus.MustRegister(dbCacheHit)
+	prometheus.MustRegister(dbCacheSize)
+	prometheus.MustRegister(dbCacheMaxSize)
+	prometheus.MustRegister(dbEvictions)
+	prometheus.MustRegister(throttledRequests)
 
-	computeSavedPlaygroundStats(storage)
+	computeSavedPlaygroundStats(store)
 }
 
-func computeSavedPlaygroundStats(storage *badger.DB) {
-
-	storage.View(func(txn *badger.Txn) error {
+func computeSavedPlaygroundStats(store PageStore) {
 
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-
-		for it.Rewind(); it.Valid(); it.Next() {
-			item := it.Item()
-			item.Value(func(val []byte) error {
-				p := &page{}
-				p.decode(val)
-				savedPlaygroundSize.WithLabelValues(p.label()).Observe(float64(len(val)))
-				return nil
-			})
+	store.Iterate(nil, func(key, val []byte) error {
+		if !isPageKey(key) {
+			return nil
+		}
+		p := &page{}
+		if err := p.decode(val); err != nil {
+			return nil
 		}
+		savedPlaygroundSize.WithLabelValues(p.label()).Observe(float64(len(val)))
 		return nil
 	})
 }
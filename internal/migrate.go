@@ -0,0 +1,60 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"log"
+)
+
+// rewrite all pages saved in an older format with the current format,
+// see encode(). Pages that can't be decoded are logged and left
+// untouched. Returns the number of migrated pages
+func migratePages(store PageStore) (int, error) {
+
+	// only the first bytes of a page are needed to get its format,
+	// so list the keys to migrate first, and load pages one by one
+	keys := make([][]byte, 0)
+	err := store.Iterate(nil, func(key, value []byte) error {
+		if isPageKey(key) && pageFormat(value) != currentPageFormat {
+			keys = append(keys, append([]byte{}, key...))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	batch := newWriteBatch(store)
+	migrated := 0
+	for _, key := range keys {
+
+		val, err := store.Get(key)
+		if err != nil {
+			return migrated, err
+		}
+		p := &page{}
+		if err := p.decode(val); err != nil {
+			log.Printf("fail to decode page %s, skipping migration: %v", key, err)
+			continue
+		}
+		if err := batch.put(Entry{Key: key, Value: p.encode()}); err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, batch.flush()
+}
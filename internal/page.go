@@ -24,6 +24,10 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -45,34 +49,116 @@ const (
 	maxByteSize = 350 * 1000
 	// length of the id of a page. Do not change this value
 	pageIDLength = 11
+	// max length of the version of a page, like "6.0"
+	maxVersionLength = 16
+	// max length of the optional title, description and tags of a page
+	maxTitleLength       = 200
+	maxDescriptionLength = 10000
+	maxTagNb             = 10
+	maxTagLength         = 32
 )
 
 type page struct {
 	Mode byte
 	// configuration used to generate the sample database
 	Config []byte
-	// query to run against the collection / database
+	// query to run against the collection / database. It can
+	// be a script made of several statements, see splitStatements()
 	Query []byte
-	// mongodb version
+	// version of the MongoDB backend to run the playground against,
+	// like "6.0". If empty, the default backend is used
+	Version []byte
+	// optional title of the page
+	Title []byte
+	// optional description of the page, in markdown
+	Description []byte
+	// optional tags of the page, lowercase and sorted, see setMetadata()
+	Tags [][]byte
+	// full version of the MongoDB server, only used for display
 	MongoVersion []byte
+	// Open Graph metadata of the page, only used for display
+	Meta *pageMeta
 }
 
-func newPage(modeName, config, query string) (*page, error) {
+func newPage(modeName, config, query, version string) (*page, error) {
 
 	if (len(config) + len(query)) > maxByteSize {
 		return nil, errors.New(errPlaygroundToBig)
 	}
+	if len(version) > maxVersionLength {
+		return nil, fmt.Errorf("invalid MongoDB version '%s'", version)
+	}
 	mode := bsonMode
 	if modeName == mgodatagenLabel {
 		mode = mgodatagenMode
 	}
 	return &page{
-		Mode:   mode,
-		Config: []byte(config),
-		Query:  []byte(query),
+		Mode:    mode,
+		Config:  []byte(config),
+		Query:   []byte(query),
+		Version: []byte(version),
 	}, nil
 }
 
+// set the optional title, description and tags of the page. Tags
+// are trimmed and lowercased, and duplicated tags are removed
+func (p *page) setMetadata(title, description string, tags []string) error {
+
+	title = strings.TrimSpace(title)
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return fmt.Errorf("title can't be longer than %d chars", maxTitleLength)
+	}
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return fmt.Errorf("description can't be longer than %d chars", maxDescriptionLength)
+	}
+
+	uniqueTags := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if !isValidTag(tag) {
+			return fmt.Errorf("invalid tag '%s': a tag can only contain letters, digits, '-', '_' and '.', and can't be longer than %d chars", tag, maxTagLength)
+		}
+		uniqueTags[tag] = true
+	}
+	if len(uniqueTags) > maxTagNb {
+		return fmt.Errorf("a playground can't have more than %d tags", maxTagNb)
+	}
+
+	sortedTags := make([]string, 0, len(uniqueTags))
+	for tag := range uniqueTags {
+		sortedTags = append(sortedTags, tag)
+	}
+	sort.Strings(sortedTags)
+
+	p.Title = []byte(title)
+	p.Description = []byte(description)
+	p.Tags = nil
+	for _, tag := range sortedTags {
+		p.Tags = append(p.Tags, []byte(tag))
+	}
+	return nil
+}
+
+func isValidTag(tag string) bool {
+	if len(tag) > maxTagLength {
+		return false
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *page) hasMetadata() bool {
+	return len(p.Title) > 0 || len(p.Description) > 0 || len(p.Tags) > 0
+}
+
 // get the ID of the page. The ID is a hash of the 
 // content of the page, so calling ID() multiple times on the 
 // same page will always return the same result.
@@ -81,6 +167,13 @@ func (p *page) ID() []byte {
 	e.Write([]byte{p.Mode})
 	e.Write(p.Query)
 	e.Write(p.Config)
+	// keep the ID of pages without version or metadata unchanged
+	if len(p.Version) > 0 {
+		e.Write(p.Version)
+	}
+	if p.hasMetadata() {
+		e.Write(p.metadataHashInput())
+	}
 	sum := e.Sum(nil)
 	b := make([]byte, base64.URLEncoding.EncodedLen(len(sum)))
 	base64.URLEncoding.Encode(b, sum)
@@ -94,30 +187,35 @@ func (p *page) dbHash() string {
 	return fmt.Sprintf("%x", md5.Sum(append(p.Config, p.Mode)))
 }
 
-// encode a page into a byte slice
+// returns the title, the description and the tags of the page as a
+// single slice, each string being prefixed by its length as an uvarint:
+//
+// title | description | number of tags | tag 1 | tag 2 ...
 //
-// v[0:4] -> an int32 to store the position of the last byte of the configuration
-// v[4] -> the mode (mgodatagen / bson) to use for building the database
-// v[5:endConfig] -> the configuration
-// v[endConfig:] -> the query
-func (p *page) encode() []byte {
-	v := make([]byte, 5+len(p.Config)+len(p.Query))
-
-	endConfig := len(p.Config) + 5
-	binary.LittleEndian.PutUint32(v[0:4], uint32(endConfig))
-
-	v[4] = p.Mode
-	copy(v[5:endConfig], p.Config)
-	copy(v[endConfig:], p.Query)
+// it's used to compute the ID of a page, so do not change it
+func (p *page) metadataHashInput() []byte {
+
+	v := make([]byte, 0, len(p.Title)+len(p.Description)+2*binary.MaxVarintLen64)
+	v = appendString(v, p.Title)
+	v = appendString(v, p.Description)
+	v = appendUvarint(v, uint64(len(p.Tags)))
+	for _, tag := range p.Tags {
+		v = appendString(v, tag)
+	}
 	return v
 }
 
-// decode a slice of byte into the p page
-func (p *page) decode(v []byte) {
-	endConfig := binary.LittleEndian.Uint32(v[0:4])
-	p.Mode = v[4]
-	p.Config = v[5:endConfig]
-	p.Query = v[endConfig:]
+// returns the key of some data stored in badger next to the page
+// with the given id, like 'nJhd-dhf3Ea/preview.png'
+func pageSubKey(id []byte, suffix string) []byte {
+	key := make([]byte, 0, len(id)+len(suffix))
+	return append(append(key, id...), suffix...)
+}
+
+// returns true if the key is the key of a page, and not the key
+// of some data stored next to a page, see pageSubKey()
+func isPageKey(key []byte) bool {
+	return len(key) == pageIDLength
 }
 
 // returns a label for the page for prometheus metrics
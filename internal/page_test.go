@@ -0,0 +1,172 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodePage(t *testing.T) {
+
+	t.Parallel()
+
+	pageTests := []struct {
+		name        string
+		mode        string
+		version     string
+		title       string
+		description string
+		tags        []string
+	}{
+		{
+			name: "bson without version",
+			mode: "bson",
+		},
+		{
+			name: "mgodatagen without version",
+			mode: mgodatagenLabel,
+		},
+		{
+			name:    "bson with version",
+			mode:    "bson",
+			version: "6.0",
+		},
+		{
+			name:    "mgodatagen with version",
+			mode:    mgodatagenLabel,
+			version: "5.0",
+		},
+		{
+			name:        "bson with metadata",
+			mode:        "bson",
+			title:       "a title",
+			description: "some *markdown*",
+			tags:        []string{"b", "a"},
+		},
+		{
+			name:    "bson with version and metadata",
+			mode:    "bson",
+			version: "6.0",
+			title:   "a title",
+		},
+		{
+			name: "bson with tags only",
+			mode: "bson",
+			tags: []string{"lookup"},
+		},
+	}
+
+	for _, tt := range pageTests {
+
+		p, err := newPage(tt.mode, `[{"_id":1}]`, templateQuery, tt.version)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if err := p.setMetadata(tt.title, tt.description, tt.tags); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		decoded := &page{}
+		if err := decoded.decode(p.encode()); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if p.Mode != decoded.Mode ||
+			!bytes.Equal(p.Config, decoded.Config) ||
+			!bytes.Equal(p.Query, decoded.Query) ||
+			!bytes.Equal(p.Version, decoded.Version) ||
+			!bytes.Equal(p.Title, decoded.Title) ||
+			!bytes.Equal(p.Description, decoded.Description) ||
+			!reflect.DeepEqual(p.Tags, decoded.Tags) {
+			t.Errorf("%s: expected %+v but got %+v", tt.name, p, decoded)
+		}
+	}
+}
+
+func TestPageIDWithVersion(t *testing.T) {
+
+	t.Parallel()
+
+	p, _ := newPage("bson", `[{}]`, templateQuery, "")
+	// ID of pages without version must not change
+	if want, got := "4cOeA7NGLru", string(p.ID()); want != got {
+		t.Errorf("expected ID %s but got %s", want, got)
+	}
+
+	withVersion, _ := newPage("bson", `[{}]`, templateQuery, "6.0")
+	if bytes.Equal(p.ID(), withVersion.ID()) {
+		t.Errorf("pages with different versions should have different IDs")
+	}
+}
+
+func TestDecodePageWithoutMetadata(t *testing.T) {
+
+	t.Parallel()
+
+	// page encoded before title, description and tags were added
+	v := []byte{0, 0, 0, 0, bsonMode}
+	v = append(v, `[{"_id":1}]`...)
+	binary.LittleEndian.PutUint32(v[0:4], uint32(len(v)))
+	v = append(v, templateQuery...)
+
+	p := &page{}
+	if err := p.decode(v); err != nil {
+		t.Fatal(err)
+	}
+
+	if want, got := templateQuery, string(p.Query); want != got {
+		t.Errorf("expected query %s but got %s", want, got)
+	}
+	if p.Title != nil || p.Description != nil || p.Tags != nil {
+		t.Errorf("expected no metadata but got %+v", p)
+	}
+}
+
+func TestSetMetadata(t *testing.T) {
+
+	t.Parallel()
+
+	p, _ := newPage("bson", `[{}]`, templateQuery, "")
+	id := p.ID()
+
+	if err := p.setMetadata(" title ", "", []string{" Lookup", "lookup", "", "$group"}); err == nil {
+		t.Error("tags with '$' should be rejected")
+	}
+	if err := p.setMetadata(" title ", "", []string{" Lookup", "lookup", "", "agg"}); err != nil {
+		t.Error(err)
+	}
+	if want, got := "title", string(p.Title); want != got {
+		t.Errorf("expected title %s but got %s", want, got)
+	}
+	if want, got := [][]byte{[]byte("agg"), []byte("lookup")}, p.Tags; !reflect.DeepEqual(want, got) {
+		t.Errorf("expected tags %s but got %s", want, got)
+	}
+	if bytes.Equal(id, p.ID()) {
+		t.Error("pages with different metadata should have different IDs")
+	}
+
+	tooManyTags := make([]string, maxTagNb+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = string(rune('a' + i))
+	}
+	if err := p.setMetadata("", "", tooManyTags); err == nil {
+		t.Errorf("more than %d tags should be rejected", maxTagNb)
+	}
+}
@@ -0,0 +1,146 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	BadgerStore = "badger"
+	BoltStore   = "bolt"
+	MemoryStore = "memory"
+
+	// a writeBatch is flushed once it holds this many entries
+	// or this many bytes, so a single Put() never grows too big
+	maxBatchEntries = 1000
+	maxBatchBytes   = 4 << 20
+)
+
+var (
+	errKeyNotFound = errors.New("key not found")
+	errStoreClosed = errors.New("page store is closed")
+)
+
+// PageStore is a key/value store where saved playgrounds are persisted,
+// along with the data attached to them like previews, history or the
+// search index
+type PageStore interface {
+	// Get returns a copy of the value of key, or errKeyNotFound
+	// if the key doesn't exist
+	Get(key []byte) ([]byte, error)
+	// Put stores all the entries in a single atomic write
+	Put(entries ...Entry) error
+	// Has returns whether key exists
+	Has(key []byte) (bool, error)
+	// Iterate calls fn for each key starting with prefix, in
+	// ascending key order. key and value are only valid during
+	// the call, and fn must not call the store
+	Iterate(prefix []byte, fn func(key, value []byte) error) error
+	// Delete removes all keys starting with prefix
+	Delete(prefix []byte) error
+	// Backup writes a full backup of the store to w
+	Backup(w io.Writer) error
+	Close() error
+}
+
+type Entry struct {
+	Key   []byte
+	Value []byte
+}
+
+// OpenPageStore opens a store of the given kind, one of BadgerStore,
+// BoltStore or MemoryStore. path is the directory of a badger store,
+// or the file of a bolt store, and is ignored for a memory store
+func OpenPageStore(kind, path string) (PageStore, error) {
+	switch kind {
+	case BadgerStore, "":
+		if path == "" {
+			path = "storage"
+		}
+		return newBadgerStore(path)
+	case BoltStore:
+		if path == "" {
+			path = "storage.db"
+		}
+		return newBoltStore(path)
+	case MemoryStore:
+		return newMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown page store '%s', expected one of %s, %s, %s", kind, BadgerStore, BoltStore, MemoryStore)
+	}
+}
+
+// returns the kind of a store, like BadgerStore
+func pageStoreKind(store PageStore) string {
+	switch store.(type) {
+	case *badgerStore:
+		return BadgerStore
+	case *boltStore:
+		return BoltStore
+	case *memoryStore:
+		return MemoryStore
+	}
+	return "unknown"
+}
+
+// list the keys starting with prefix. As keys are small, this allows
+// to update a large number of entries without writing to the store
+// while iterating over it
+func listKeys(store PageStore, prefix []byte, filter func(key []byte) bool) ([][]byte, error) {
+	keys := make([][]byte, 0)
+	err := store.Iterate(prefix, func(key, _ []byte) error {
+		if filter == nil || filter(key) {
+			keys = append(keys, append([]byte(nil), key...))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// writeBatch groups many entries in a few calls to Put()
+type writeBatch struct {
+	store   PageStore
+	entries []Entry
+	size    int
+}
+
+func newWriteBatch(store PageStore) *writeBatch {
+	return &writeBatch{store: store}
+}
+
+func (b *writeBatch) put(entries ...Entry) error {
+	for _, e := range entries {
+		b.entries = append(b.entries, e)
+		b.size += len(e.Key) + len(e.Value)
+	}
+	if len(b.entries) >= maxBatchEntries || b.size >= maxBatchBytes {
+		return b.flush()
+	}
+	return nil
+}
+
+func (b *writeBatch) flush() error {
+	if len(b.entries) == 0 {
+		return nil
+	}
+	err := b.store.Put(b.entries...)
+	b.entries, b.size = nil, 0
+	return err
+}
@@ -0,0 +1,115 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPageStore(t *testing.T) {
+
+	t.Parallel()
+
+	for _, kind := range []string{BadgerStore, BoltStore, MemoryStore} {
+
+		path := filepath.Join(t.TempDir(), "store")
+		store, err := OpenPageStore(kind, path)
+		if err != nil {
+			t.Fatalf("%s: %v", kind, err)
+		}
+		if want, got := kind, pageStoreKind(store); want != got {
+			t.Errorf("expected kind %s but got %s", want, got)
+		}
+		testPageStore(t, kind, store)
+	}
+
+	if _, err := OpenPageStore("unknown", ""); err == nil {
+		t.Error("expected an error for an unknown page store")
+	}
+}
+
+func testPageStore(t *testing.T, kind string, store PageStore) {
+
+	if _, err := store.Get([]byte("a")); err != errKeyNotFound {
+		t.Errorf("%s: expected %v but got %v", kind, errKeyNotFound, err)
+	}
+
+	err := store.Put(
+		Entry{Key: []byte("a/2"), Value: []byte("2")},
+		Entry{Key: []byte("a/1"), Value: []byte("1")},
+		Entry{Key: []byte("b"), Value: nil},
+	)
+	if err != nil {
+		t.Fatalf("%s: %v", kind, err)
+	}
+
+	if val, err := store.Get([]byte("a/1")); err != nil || string(val) != "1" {
+		t.Errorf("%s: expected value 1 but got %s, %v", kind, val, err)
+	}
+	for key, want := range map[string]bool{"b": true, "a": false, "c": false} {
+		if got, err := store.Has([]byte(key)); err != nil || want != got {
+			t.Errorf("%s: expected Has(%s) to be %v but got %v, %v", kind, key, want, got, err)
+		}
+	}
+
+	var iterated []string
+	err = store.Iterate([]byte("a/"), func(key, value []byte) error {
+		iterated = append(iterated, fmt.Sprintf("%s=%s", key, value))
+		return nil
+	})
+	if want := []string{"a/1=1", "a/2=2"}; err != nil || !reflect.DeepEqual(want, iterated) {
+		t.Errorf("%s: expected %v but got %v, %v", kind, want, iterated, err)
+	}
+
+	batch := newWriteBatch(store)
+	for i := 0; i < maxBatchEntries+10; i++ {
+		if err := batch.put(Entry{Key: []byte(fmt.Sprintf("c/%04d", i)), Value: []byte("v")}); err != nil {
+			t.Fatalf("%s: %v", kind, err)
+		}
+	}
+	if err := batch.flush(); err != nil {
+		t.Fatalf("%s: %v", kind, err)
+	}
+	keys, err := listKeys(store, []byte("c/"), nil)
+	if want, got := maxBatchEntries+10, len(keys); err != nil || want != got {
+		t.Errorf("%s: expected %d keys but got %d, %v", kind, want, got, err)
+	}
+
+	if err := store.Delete([]byte("c/")); err != nil {
+		t.Fatalf("%s: %v", kind, err)
+	}
+	keys, _ = listKeys(store, nil, nil)
+	if want, got := 3, len(keys); want != got {
+		t.Errorf("%s: expected %d keys after delete but got %d", kind, want, got)
+	}
+
+	var backup bytes.Buffer
+	if err := store.Backup(&backup); err != nil || backup.Len() == 0 {
+		t.Errorf("%s: expected a backup but got %d bytes, %v", kind, backup.Len(), err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatalf("%s: %v", kind, err)
+	}
+	if _, err := store.Has([]byte("b")); err != errStoreClosed {
+		t.Errorf("%s: expected %v but got %v", kind, errStoreClosed, err)
+	}
+}
@@ -0,0 +1,391 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/feliixx/mgodatagen/datagen"
+	"github.com/feliixx/mongoextjson"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	previewSuffix = "/preview.png"
+
+	// size recommended for Open Graph images
+	previewWidth  = 1200
+	previewHeight = 630
+	previewMargin = 48
+
+	previewFontSize   = 22
+	previewLineHeight = 30
+	// max number of lines of the query displayed in the
+	// preview and in the description of a page
+	previewMaxLines     = 15
+	descriptionMaxLines = 3
+	descriptionMaxChars = 200
+)
+
+var (
+	previewBackground = color.RGBA{0x1e, 0x1e, 0x1e, 0xff}
+
+	// colors of the tokens of a query, close to the ones
+	// used by the editor in dark mode
+	tokenColors = map[tokenKind]color.Color{
+		plainToken:    color.RGBA{0xd4, 0xd4, 0xd4, 0xff},
+		stringToken:   color.RGBA{0xce, 0x91, 0x78, 0xff},
+		numberToken:   color.RGBA{0xb5, 0xce, 0xa8, 0xff},
+		operatorToken: color.RGBA{0x56, 0x9c, 0xd6, 0xff},
+		keyToken:      color.RGBA{0x9c, 0xdc, 0xfe, 0xff},
+		functionToken: color.RGBA{0xdc, 0xdc, 0xaa, 0xff},
+	}
+
+	// a font.Face can't be used concurrently
+	previewFace      = mustLoadPreviewFace()
+	previewFaceMutex sync.Mutex
+)
+
+func mustLoadPreviewFace() font.Face {
+	f, err := opentype.Parse(gomono.TTF)
+	if err != nil {
+		panic(err)
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    previewFontSize,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return face
+}
+
+// metadata of a page used to build Open Graph and Twitter
+// cards when a playground is shared
+type pageMeta struct {
+	Title       string
+	Description string
+	URL         string
+	Image       string
+}
+
+func newPageMeta(r *http.Request, id []byte, p *page) *pageMeta {
+
+	scheme := "https"
+	if r.TLS == nil {
+		scheme = "http"
+	}
+	url := fmt.Sprintf("%s://%s%s%s", scheme, r.Host, viewEndpoint, id)
+
+	title := "Mongo playground"
+	if len(p.Title) > 0 {
+		title = string(p.Title)
+	} else if method := queryMethod(p.Query); method != "" {
+		title = fmt.Sprintf("%s: %s", title, method)
+	}
+
+	description := embedPage{page: p}.ModeLabel() + " playground"
+	if names := collectionNames(p); len(names) > 0 {
+		description += " on " + strings.Join(names, ", ")
+	}
+	// prefer the description of the page to its query
+	text := p.Query
+	if len(p.Description) > 0 {
+		text = p.Description
+	}
+	if lines := firstLines(text, descriptionMaxLines); len(lines) > 0 {
+		description += "\n" + truncate(strings.Join(lines, "\n"), descriptionMaxChars)
+	}
+
+	return &pageMeta{
+		Title:       title,
+		Description: description,
+		URL:         url,
+		Image:       url + previewSuffix,
+	}
+}
+
+// returns the method used in the query, like 'aggregate() on collection', or
+// an empty string if the query is invalid. For scripts, only the
+// number of statements is returned
+func queryMethod(query []byte) string {
+
+	statements := splitStatements(query)
+	if len(statements) > 1 {
+		return fmt.Sprintf("script of %d statements", len(statements))
+	}
+
+	// the query is the one of the page, and is displayed in
+	// the editor afterwards, so parse a copy of it
+	q, err := parseQuery(append([]byte(nil), query...))
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s() on %s", q.method, q.collectionName)
+}
+
+// returns the name of the collections created from the configuration
+// of a page, in alphabetical order
+func collectionNames(p *page) []string {
+
+	names := make([]string, 0)
+
+	if p.Mode == mgodatagenMode {
+		collections, err := datagen.ParseConfig(p.Config, true)
+		if err != nil {
+			return nil
+		}
+		for _, c := range collections {
+			names = append(names, c.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	switch detailBsonMode(p.Config) {
+	case bsonSingleCollection:
+		names = append(names, "collection")
+	case bsonMultipleCollection:
+		collections := map[string]any{}
+		if err := mongoextjson.Unmarshal(p.Config[3:], &collections); err != nil {
+			return nil
+		}
+		for name := range collections {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	return names
+}
+
+func firstLines(b []byte, max int) []string {
+
+	lines := make([]string, 0, max)
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			continue
+		}
+		if len(lines) == max {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max-1]) + "…"
+}
+
+// serve a png image of the query of a page. As pages never change,
+// the image is rendered only once and stored next to the page
+func (s *storage) servePreview(w http.ResponseWriter, id []byte, p *page) {
+
+	key := previewKey(id)
+
+	content, err := s.pageStore.Get(key)
+	if err != nil {
+
+		content, err = renderPreview(p)
+		if err != nil {
+			log.Printf("fail to render preview of page %s: %v", id, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = s.pageStore.Put(Entry{Key: key, Value: content})
+		if err != nil {
+			log.Printf("fail to save preview of page %s: %v", id, err)
+		}
+	}
+
+	writeImmutable(w, id, "image/png", content)
+}
+
+// key of the preview of a page in the page store, like 'nJhd-dhf3Ea/preview.png'
+func previewKey(id []byte) []byte {
+	return pageSubKey(id, previewSuffix)
+}
+
+// render the query of a page as a syntax highlighted png image
+func renderPreview(p *page) ([]byte, error) {
+
+	img := image.NewRGBA(image.Rect(0, 0, previewWidth, previewHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(previewBackground), image.Point{}, draw.Src)
+
+	previewFaceMutex.Lock()
+	defer previewFaceMutex.Unlock()
+
+	d := &font.Drawer{
+		Dst:  img,
+		Face: previewFace,
+	}
+
+	advance, _ := previewFace.GlyphAdvance('m')
+	columns := (previewWidth - 2*previewMargin) / advance.Ceil()
+
+	header := "Mongo playground - " + embedPage{page: p}.ModeLabel()
+	if names := collectionNames(p); len(names) > 0 {
+		header += " on " + strings.Join(names, ", ")
+	}
+	d.Src = image.NewUniform(tokenColors[stringToken])
+	d.Dot = fixed.P(previewMargin, previewMargin+previewLineHeight)
+	d.DrawString(truncate(header, columns))
+
+	lines := wrapLines(string(p.Query), columns)
+	if len(lines) > previewMaxLines {
+		lines = append(lines[:previewMaxLines-1], "…")
+	}
+
+	for i, line := range lines {
+		d.Dot = fixed.P(previewMargin, previewMargin+(i+3)*previewLineHeight)
+		for _, t := range tokenize(line) {
+			d.Src = image.NewUniform(tokenColors[t.kind])
+			d.DrawString(t.text)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	return buf.Bytes(), err
+}
+
+// split the text in lines of at most columns chars
+func wrapLines(text string, columns int) []string {
+
+	lines := make([]string, 0)
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\t", "  "), "\n") {
+		line = strings.TrimRight(line, " \r")
+		runes := []rune(line)
+		for len(runes) > columns {
+			lines = append(lines, string(runes[:columns]))
+			runes = runes[columns:]
+		}
+		lines = append(lines, string(runes))
+	}
+	return lines
+}
+
+type tokenKind int
+
+const (
+	plainToken tokenKind = iota
+	stringToken
+	numberToken
+	operatorToken
+	keyToken
+	functionToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// split a line of a query in tokens for syntax highlighting. This
+// is not a real lexer, as a line may start or end in the middle
+// of a string, but it's good enough for a preview
+func tokenize(line string) []token {
+
+	tokens := make([]token, 0)
+
+	for i := 0; i < len(line); {
+
+		c := line[i]
+		start := i
+
+		switch {
+		case c == '"' || c == '\'':
+			i++
+			for i < len(line) && line[i] != c {
+				if line[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			// a trailing backslash skips past the end of the line
+			if i > len(line) {
+				i = len(line)
+			}
+			if i < len(line) {
+				i++
+			}
+			kind := stringToken
+			if strings.HasPrefix(strings.TrimLeft(line[i:], " "), ":") {
+				kind = keyToken
+			}
+			tokens = append(tokens, token{kind: kind, text: line[start:i]})
+
+		case c >= '0' && c <= '9':
+			for i < len(line) && (isDigit(line[i]) || line[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: line[start:i]})
+
+		case c == '$' || isIdentifierChar(c):
+			i++
+			for i < len(line) && isIdentifierChar(line[i]) {
+				i++
+			}
+			kind := plainToken
+			next := strings.TrimLeft(line[i:], " ")
+			switch {
+			case c == '$':
+				kind = operatorToken
+			case strings.HasPrefix(next, "("):
+				kind = functionToken
+			case strings.HasPrefix(next, ":"):
+				kind = keyToken
+			}
+			tokens = append(tokens, token{kind: kind, text: line[start:i]})
+
+		default:
+			_, size := utf8.DecodeRuneInString(line[i:])
+			i += size
+			tokens = append(tokens, token{kind: plainToken, text: line[start:i]})
+		}
+	}
+	return tokens
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
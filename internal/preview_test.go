@@ -0,0 +1,224 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPreview(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {`[{"_id": 1}]`}, "query": {`db.collection.aggregate([{"$match":{"_id":1}}])`}}
+	id := strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, params), "p/")
+
+	var first []byte
+	for i := 0; i < 2; i++ {
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, viewEndpoint+id+previewSuffix, nil)
+		testServer.Handler.ServeHTTP(resp, req)
+
+		if want, got := http.StatusOK, resp.Code; want != got {
+			t.Fatalf("expected response code %d but got %d", want, got)
+		}
+		if want, got := "image/png", resp.Header().Get("Content-Type"); want != got {
+			t.Errorf("expected Content-Type %s but got %s", want, got)
+		}
+
+		img, err := png.Decode(bytes.NewReader(resp.Body.Bytes()))
+		if err != nil {
+			t.Fatalf("invalid png: %v", err)
+		}
+		if want, got := previewWidth, img.Bounds().Dx(); want != got {
+			t.Errorf("expected image width %d but got %d", want, got)
+		}
+
+		if first == nil {
+			first = resp.Body.Bytes()
+		} else if !bytes.Equal(first, resp.Body.Bytes()) {
+			t.Error("cached preview should be identical to the rendered one")
+		}
+	}
+
+	if found, _ := testStorage.pageStore.Has(previewKey([]byte(id))); !found {
+		t.Error("preview should be stored in the page store")
+	}
+
+	testStorageContent(t, 0, 0, 1)
+
+	checkServerResponse(t, "/p/unknownURL"+previewSuffix, http.StatusNotFound, "", "")
+}
+
+func TestViewMeta(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {`db={"a":[],"b":[]}`}, "query": {"db.a.find()"}}
+	id := strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, params), "p/")
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, viewEndpoint+id, nil)
+	testServer.Handler.ServeHTTP(resp, req)
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(reader)
+
+	for _, want := range []string{
+		`<meta property="og:title" content="Mongo playground: find() on a">`,
+		`<meta property="og:description" content="bson playground on a, b`,
+		`<meta property="og:image" content="http://` + req.Host + viewEndpoint + id + previewSuffix + `">`,
+		`<meta name="twitter:card" content="summary_large_image">`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected page to contain\n%s\nbut got\n%s", want, body)
+		}
+	}
+}
+
+func TestPageMeta(t *testing.T) {
+
+	t.Parallel()
+
+	metaTests := []struct {
+		name        string
+		page        *page
+		title       string
+		description string
+	}{
+		{
+			name:        "single collection",
+			page:        &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte("db.collection.aggregate([\n  {$match:{_id:1}}\n])")},
+			title:       "Mongo playground: aggregate() on collection",
+			description: "bson playground on collection\ndb.collection.aggregate([\n  {$match:{_id:1}}\n])",
+		},
+		{
+			name:        "mgodatagen",
+			page:        &page{Mode: mgodatagenMode, Config: []byte(`[{"collection":"coll","count":1,"content":{}}]`), Query: []byte("db.coll.count()")},
+			title:       "Mongo playground: count() on coll",
+			description: "mgodatagen playground on coll\ndb.coll.count()",
+		},
+		{
+			name:        "script",
+			page:        &page{Mode: bsonMode, Config: []byte(`db={"a":[],"b":[]}`), Query: []byte("db.a.insertOne({k:1});\ndb.a.find()")},
+			title:       "Mongo playground: script of 2 statements",
+			description: "bson playground on a, b\ndb.a.insertOne({k:1});\ndb.a.find()",
+		},
+		{
+			name:        "explain",
+			page:        &page{Mode: bsonMode, Config: []byte(`[{"_id":1}]`), Query: []byte("db.collection.explain().find({_id:1})")},
+			title:       "Mongo playground: find() on collection",
+			description: "bson playground on collection\ndb.collection.explain().find({_id:1})",
+		},
+		{
+			name:        "invalid query and config",
+			page:        &page{Mode: bsonMode, Config: []byte(`db={`), Query: []byte("db.collection.find(\n{\n\n  k:\n  1\n)")},
+			title:       "Mongo playground",
+			description: "bson playground\ndb.collection.find(\n{\n  k:",
+		},
+	}
+
+	for _, tt := range metaTests {
+
+		query, config := string(tt.page.Query), string(tt.page.Config)
+
+		req, _ := http.NewRequest(http.MethodGet, "/p/aaaaaaaaaaa", nil)
+		req.Host = "localhost"
+		meta := newPageMeta(req, []byte("aaaaaaaaaaa"), tt.page)
+
+		// the page is displayed in the editor after its meta are built
+		if query != string(tt.page.Query) || config != string(tt.page.Config) {
+			t.Errorf("%s: page should not be modified, but got query %q and config %q", tt.name, tt.page.Query, tt.page.Config)
+		}
+
+		if want, got := tt.title, meta.Title; want != got {
+			t.Errorf("%s: expected title %q but got %q", tt.name, want, got)
+		}
+		if want, got := tt.description, meta.Description; want != got {
+			t.Errorf("%s: expected description %q but got %q", tt.name, want, got)
+		}
+		if want, got := "http://localhost/p/aaaaaaaaaaa/preview.png", meta.Image; want != got {
+			t.Errorf("%s: expected image %s but got %s", tt.name, want, got)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+
+	t.Parallel()
+
+	want := []token{
+		{kind: plainToken, text: "db"},
+		{kind: plainToken, text: "."},
+		{kind: plainToken, text: "collection"},
+		{kind: plainToken, text: "."},
+		{kind: functionToken, text: "find"},
+		{kind: plainToken, text: "("},
+		{kind: plainToken, text: "{"},
+		{kind: keyToken, text: "k"},
+		{kind: plainToken, text: ":"},
+		{kind: plainToken, text: "{"},
+		{kind: operatorToken, text: "$gt"},
+		{kind: plainToken, text: ":"},
+		{kind: numberToken, text: "1.5"},
+		{kind: plainToken, text: "}"},
+		{kind: plainToken, text: ","},
+		{kind: keyToken, text: `"n"`},
+		{kind: plainToken, text: ":"},
+		{kind: stringToken, text: `"a\"b"`},
+		{kind: plainToken, text: "}"},
+		{kind: plainToken, text: ")"},
+	}
+	got := tokenize(`db.collection.find({k:{$gt:1.5},"n":"a\"b"})`)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v but got %v", want, got)
+	}
+
+	// unterminated strings should not panic
+	unterminatedTests := []struct {
+		line string
+		want []token
+	}{
+		{
+			line: `{"k`,
+			want: []token{{kind: plainToken, text: "{"}, {kind: stringToken, text: `"k`}},
+		},
+		{
+			line: `{"a":"x\`,
+			want: []token{{kind: plainToken, text: "{"}, {kind: keyToken, text: `"a"`}, {kind: plainToken, text: ":"}, {kind: stringToken, text: `"x\`}},
+		},
+	}
+	for _, tt := range unterminatedTests {
+		if got := tokenize(tt.line); !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%s: expected %v but got %v", tt.line, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,510 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/feliixx/mongoextjson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// methods that can be chained after find(). Options are
+// added to the find command in this order
+var cursorMethods = []string{"sort", "skip", "limit", "collation", "hint", "min", "max"}
+
+// a query sent by the user, once parsed
+type parsedQuery struct {
+	collectionName string
+	method         string
+	stages         []any
+	// extra options of the command, set by the cursor methods
+	// chained after find(), like sort() or limit(), or by the
+	// options of aggregate()
+	cmdOpts     bson.D
+	explainMode string
+}
+
+// options of aggregate() forwarded to the server, in this order
+var aggregateOptions = []string{"allowDiskUse", "collation", "comment", "hint", "let", "maxTimeMS"}
+
+// a method call in a query, for example sort({k:-1})
+type call struct {
+	name string
+	args []byte
+}
+
+// find, aggregate, count, countDocuments, estimatedDocumentCount and distinct
+// queries are supported, with or without explain(), as well as write queries
+// listed in writeMethods. Cursor methods can be chained after find(), see
+// cursorMethods
+//
+// for example, those queries are valid:
+//
+//	db.collection.find({k:1})
+//	db.collection.aggregate([{$project:{_id:0}}])
+//	db.collection.aggregate([{$project:{_id:0}}],{allowDiskUse:true})
+//	db.collection.update({k:1},{$set:{n:1}},{upsert:true})
+//	db.collection.countDocuments({k:1},{limit:10})
+//	db.collection.distinct("k",{n:{$gt:1}})
+//	db.collection.insertMany([{k:1},{k:2}])
+//	db.collection.find({k:1}).sort({k:-1}).skip(1).limit(2)
+//	db.collection.find({k:1}).count()
+//	db.collection.find({k:1}).explain()
+//	db.collection.explain("executionStats").find({k:1}).sort({k:1})
+//
+// input is filtered from front-end side, but this should
+// not panic on pathological/malformatted input
+func parseQuery(query []byte) (*parsedQuery, error) {
+
+	original := query
+	query, explainMode := stripExplain(query)
+
+	collectionName, calls, err := splitCalls(query)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &parsedQuery{
+		collectionName: collectionName,
+		method:         calls[0].name,
+		explainMode:    explainMode,
+	}
+
+	q.stages, err = unmarshalStages(calls[0].args)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse content of query: %w", withPosition(err, original, calls[0].args))
+	}
+
+	if q.method == aggregateMethod {
+		err = q.splitAggregateOptions()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(calls) > 1 {
+		if q.method != findMethod {
+			return nil, fmt.Errorf("%s() can't be chained after %s()", calls[1].name, q.method)
+		}
+		err = q.applyCursorMethods(calls[1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// split a script into statements. Statements are separated by ';' or
+// by a new line, unless the next line starts with a '.', like in
+//
+//	db.collection.find()
+//	  .sort({k:1})
+//
+// a statement also ends when a new one starts right after a closing
+// parenthesis, like in
+//
+//	db.collection.insertOne({k:1})db.collection.find()
+//
+// separators inside strings, documents, arrays or calls are ignored
+func splitStatements(script []byte) [][]byte {
+
+	statements := [][]byte{}
+	depth, start := 0, 0
+	var quote byte
+
+	for i := 0; i < len(script); i++ {
+
+		if quote != 0 {
+			switch script[i] {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch script[i] {
+		case '"', '\'':
+			quote = script[i]
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 && bytes.HasPrefix(bytes.TrimSpace(script[i+1:]), []byte("db.")) {
+				statements = appendStatement(statements, script[start:i+1])
+				start = i + 1
+			}
+		case ';', '\n':
+			if depth != 0 {
+				continue
+			}
+			if script[i] == '\n' && bytes.HasPrefix(bytes.TrimSpace(script[i+1:]), []byte{'.'}) {
+				continue
+			}
+			statements = appendStatement(statements, script[start:i])
+			start = i + 1
+		}
+	}
+	return appendStatement(statements, script[start:])
+}
+
+func appendStatement(statements [][]byte, statement []byte) [][]byte {
+	statement = bytes.TrimSpace(statement)
+	if len(statement) == 0 {
+		return statements
+	}
+	return append(statements, statement)
+}
+
+// split a query like
+//
+//	db.collection.find({k:1}).sort({k:-1}).limit(2)
+//
+// into the collection name and the list of method calls. Parenthesis
+// in strings or in nested calls like ISODate("...") are skipped
+func splitCalls(query []byte) (collectionName string, calls []call, err error) {
+
+	query = bytes.TrimSpace(query)
+	query = bytes.TrimRight(query, "; \t\r\n")
+
+	if !bytes.HasPrefix(query, []byte("db.")) {
+		return "", nil, errors.New(errInvalidQuery)
+	}
+	query = query[3:]
+
+	dot := bytes.IndexByte(query, '.')
+	if dot <= 0 {
+		return "", nil, errors.New(errInvalidQuery)
+	}
+	collectionName = string(query[:dot])
+	query = query[dot:]
+
+	for {
+		query = bytes.TrimSpace(query)
+		if len(query) == 0 {
+			break
+		}
+		if query[0] != '.' {
+			return "", nil, errors.New(errInvalidQuery)
+		}
+		query = query[1:]
+
+		start := bytes.IndexByte(query, '(')
+		if start == -1 {
+			return "", nil, errors.New(errInvalidQuery)
+		}
+		name := bytes.TrimSpace(query[:start])
+		if !isIdentifier(name) {
+			return "", nil, errors.New(errInvalidQuery)
+		}
+
+		end := closingParenthesis(query, start)
+		if end == -1 {
+			return "", nil, errors.New(errInvalidQuery)
+		}
+
+		calls = append(calls, call{
+			name: string(name),
+			args: bytes.TrimSpace(query[start+1 : end]),
+		})
+		query = query[end+1:]
+	}
+
+	if len(calls) == 0 {
+		return "", nil, errors.New(errInvalidQuery)
+	}
+	return collectionName, calls, nil
+}
+
+// return the position of the parenthesis closing the one at
+// position start, or -1 if there is none
+func closingParenthesis(b []byte, start int) int {
+
+	depth := 0
+	var quote byte
+
+	for i := start; i < len(b); i++ {
+
+		if quote != 0 {
+			switch b[i] {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch b[i] {
+		case '"', '\'':
+			quote = b[i]
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isIdentifier(name []byte) bool {
+
+	if len(name) == 0 {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$') {
+			return false
+		}
+	}
+	return true
+}
+
+// transform the cursor methods chained after find() into options
+// of the find command. If a method is called several times, the
+// last call wins, like in the mongo shell.
+//
+// count() turns the query into a count query. Like in the mongo shell,
+// skip() and limit() are ignored unless count(true) is used
+func (q *parsedQuery) applyCursorMethods(calls []call) error {
+
+	opts := map[string]any{}
+	count, applySkipLimit := false, false
+
+	for _, c := range calls {
+
+		args, err := unmarshalStages(c.args)
+		if err != nil {
+			return fmt.Errorf("fail to parse content of %s(): %v", c.name, err)
+		}
+
+		switch c.name {
+		case "projection":
+			if len(c.args) == 0 {
+				return fmt.Errorf("%s() requires a document", c.name)
+			}
+			for len(q.stages) < 2 {
+				q.stages = append(q.stages, bson.M{})
+			}
+			q.stages[1] = args[0]
+
+		case "sort", "collation", "min", "max", "hint":
+			if len(c.args) == 0 {
+				return fmt.Errorf("%s() requires an argument", c.name)
+			}
+			opts[c.name] = args[0]
+
+		case "skip", "limit":
+			n, ok := toInt64(args[0])
+			if !ok {
+				return fmt.Errorf("%s() requires an integer", c.name)
+			}
+			opts[c.name] = n
+
+		case countMethod:
+			count = true
+			if len(c.args) > 0 {
+				applySkipLimit, _ = args[0].(bool)
+			}
+
+		default:
+			return fmt.Errorf("unsupported method %s() after find(), supported methods are: sort, skip, limit, collation, hint, min, max, projection, count", c.name)
+		}
+	}
+
+	if count {
+		// reuse the options of count(): db.collection.count(filter, opts)
+		countOpts := map[string]any{}
+		for _, key := range []string{"hint", "collation"} {
+			if v, ok := opts[key]; ok {
+				countOpts[key] = v
+			}
+		}
+		if applySkipLimit {
+			for _, key := range []string{"skip", "limit"} {
+				if v, ok := opts[key]; ok {
+					countOpts[key] = v
+				}
+			}
+		}
+		q.method = countMethod
+		q.stages = []any{stageAt(q.stages, 0), countOpts}
+		return nil
+	}
+
+	for _, name := range cursorMethods {
+		v, ok := opts[name]
+		if !ok {
+			continue
+		}
+		// a negative limit means that the server has to return
+		// a single batch of at most n documents
+		if n, isInt := v.(int64); name == "limit" && isInt && n < 0 {
+			q.cmdOpts = append(q.cmdOpts,
+				bson.E{Key: "limit", Value: -n},
+				bson.E{Key: "singleBatch", Value: true},
+			)
+			continue
+		}
+		q.cmdOpts = append(q.cmdOpts, bson.E{Key: name, Value: v})
+	}
+	return nil
+}
+
+// aggregate() can be called with a pipeline and an optional document
+// of options, like in mongosh:
+//
+//	db.collection.aggregate([{$match:{k:1}}], {allowDiskUse: true})
+//
+// or with a list of stages, like in the legacy mongo shell:
+//
+//	db.collection.aggregate({$match:{k:1}}, {$project:{_id:0}})
+func (q *parsedQuery) splitAggregateOptions() error {
+
+	pipeline, ok := stageAt(q.stages, 0).([]any)
+	if !ok {
+		return nil
+	}
+	if len(q.stages) > 2 {
+		return fmt.Errorf("%s() expects a pipeline and an optional document of options, but got %d arguments", aggregateMethod, len(q.stages))
+	}
+
+	if len(q.stages) == 2 {
+		opts, ok := q.stages[1].(map[string]any)
+		if !ok {
+			return fmt.Errorf("options of %s() must be a document", aggregateMethod)
+		}
+		cmdOpts, err := parseAggregateOpts(opts)
+		if err != nil {
+			return err
+		}
+		q.cmdOpts = cmdOpts
+	}
+	q.stages = pipeline
+	return nil
+}
+
+// maxTimeMS can't be greater than maxQueryTime
+func parseAggregateOpts(opts map[string]any) (bson.D, error) {
+
+	for key := range opts {
+		if !contains(aggregateOptions, key) {
+			return nil, fmt.Errorf("unsupported option '%s' in %s(), supported options are: %s", key, aggregateMethod, strings.Join(aggregateOptions, ", "))
+		}
+	}
+
+	parsed := bson.D{}
+	for _, key := range aggregateOptions {
+		value, ok := opts[key]
+		if !ok {
+			continue
+		}
+		switch key {
+		case "allowDiskUse":
+			if _, isBool := value.(bool); !isBool {
+				return nil, fmt.Errorf("option '%s' in %s() must be a boolean", key, aggregateMethod)
+			}
+		case "maxTimeMS":
+			n, isInt := toInt64(value)
+			if !isInt || n <= 0 {
+				return nil, fmt.Errorf("option '%s' in %s() must be a positive integer", key, aggregateMethod)
+			}
+			if n > maxQueryTime.Milliseconds() {
+				n = maxQueryTime.Milliseconds()
+			}
+			value = n
+		}
+		parsed = append(parsed, bson.E{Key: key, Value: value})
+	}
+	return parsed, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func stripExplain(query []byte) (strippedQuery []byte, explainMode string) {
+
+	startExplain := bytes.Index(query, []byte(".explain("))
+	if startExplain == -1 {
+		return query, ""
+	}
+
+	endExplain := bytes.Index(query[startExplain:], []byte(")"))
+	if endExplain == -1 {
+		// explain( is not closed, just ignore it
+		return query[:startExplain], ""
+	}
+	endExplain += startExplain
+
+	explainMode = string(bytes.TrimSpace(query[startExplain+9 : endExplain]))
+	if len(explainMode) < 2 {
+		explainMode = "queryPlanner"
+	} else {
+		// remove the enclosing double quote (")
+		explainMode = explainMode[1 : len(explainMode)-1]
+	}
+
+	// the query may be parsed several times, for example to run it on
+	// each version in compare(), so it must not be modified in place
+	stripped := make([]byte, 0, len(query)-(endExplain+1-startExplain))
+	stripped = append(stripped, query[:startExplain]...)
+	stripped = append(stripped, query[endExplain+1:]...)
+
+	return stripped, explainMode
+}
+
+// most of the time, each stage is a bson.M document.
+//
+// however, since mongodb 4.2, the second stage of an update()
+// can be an slice of bson.M
+//
+//	cf https://docs.mongodb.com/manual/tutorial/update-documents-with-aggregation-pipeline/
+//
+// the first stage of aggregate(), insertMany() and bulkWrite()
+// can also be a slice of bson.M
+func unmarshalStages(queryBytes []byte) (stages []any, err error) {
+
+	if len(queryBytes) == 0 {
+		return []any{bson.M{}, bson.M{}}, nil
+	}
+
+	// because projections are allowed, transform
+	// {}, {"_id": 0} into [{}, {"_id": 0}] so we
+	// can parse it as a []bson.M
+	b := make([]byte, 0, len(queryBytes)+2)
+	b = append(b, '[')
+	b = append(b, queryBytes...)
+	b = append(b, ']')
+
+	err = mongoextjson.Unmarshal(b, &stages)
+
+	return stages, err
+}
@@ -0,0 +1,285 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+
+	t.Parallel()
+
+	parseTests := []struct {
+		name        string
+		query       string
+		collection  string
+		method      string
+		cmdOpts     string
+		explainMode string
+		err         string
+	}{
+		{
+			name:       "basic find",
+			query:      `db.collection.find({"k":1})`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[]",
+		},
+		{
+			name:       "find with trailing semicolon",
+			query:      `db.collection.find();`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[]",
+		},
+		{
+			name:       "find with cursor methods",
+			query:      `db.collection.find().limit(2).sort({"k":-1}).skip(1)`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[{sort map[k:-1]} {skip 1} {limit 2}]",
+		},
+		{
+			name:       "last call wins",
+			query:      `db.collection.find().limit(2).limit(3)`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[{limit 3}]",
+		},
+		{
+			name:       "negative limit",
+			query:      `db.collection.find().limit(-2)`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[{limit 2} {singleBatch true}]",
+		},
+		{
+			name:       "hint with index name",
+			query:      `db.collection.find().hint("k_1")`,
+			collection: "collection",
+			method:     findMethod,
+			cmdOpts:    "[{hint k_1}]",
+		},
+		{
+			name:       "count after find",
+			query:      `db.collection.find({"k":1}).sort({"k":1}).count()`,
+			collection: "collection",
+			method:     countMethod,
+			cmdOpts:    "[]",
+		},
+		{
+			name:        "explain with cursor methods",
+			query:       `db.collection.explain("executionStats").find({"k":{"$in":[1,2,3]}}).sort({"k":1})`,
+			collection:  "collection",
+			method:      findMethod,
+			cmdOpts:     "[{sort map[k:1]}]",
+			explainMode: "executionStats",
+		},
+		{
+			name:        "explain at the end",
+			query:       `db.collection.find().limit(1).explain()`,
+			collection:  "collection",
+			method:      findMethod,
+			cmdOpts:     "[{limit 1}]",
+			explainMode: "queryPlanner",
+		},
+		{
+			name:       "aggregate with options",
+			query:      `db.collection.aggregate([{"$match":{}}], {"allowDiskUse": true, "maxTimeMS": 500})`,
+			collection: "collection",
+			method:     aggregateMethod,
+			cmdOpts:    "[{allowDiskUse true} {maxTimeMS 500}]",
+		},
+		{
+			name:       "aggregate with maxTimeMS too high",
+			query:      `db.collection.aggregate([], {"maxTimeMS": 1000000})`,
+			collection: "collection",
+			method:     aggregateMethod,
+			cmdOpts:    fmt.Sprintf("[{maxTimeMS %d}]", maxQueryTime.Milliseconds()),
+		},
+		{
+			name:       "aggregate with list of stages",
+			query:      `db.collection.aggregate({"$match":{}}, {"$project":{"_id":0}})`,
+			collection: "collection",
+			method:     aggregateMethod,
+			cmdOpts:    "[]",
+		},
+		{
+			name:  "aggregate with unsupported option",
+			query: `db.collection.aggregate([], {"explain": true})`,
+			err:   "unsupported option 'explain' in aggregate(), supported options are: allowDiskUse, collation, comment, hint, let, maxTimeMS",
+		},
+		{
+			name:  "aggregate with invalid allowDiskUse",
+			query: `db.collection.aggregate([], {"allowDiskUse": 1})`,
+			err:   "option 'allowDiskUse' in aggregate() must be a boolean",
+		},
+		{
+			name:  "aggregate with too many arguments",
+			query: `db.collection.aggregate([], {}, {})`,
+			err:   "aggregate() expects a pipeline and an optional document of options, but got 3 arguments",
+		},
+		{
+			name:  "invalid limit",
+			query: `db.collection.find().limit("2")`,
+			err:   "limit() requires an integer",
+		},
+		{
+			name:  "unclosed parenthesis",
+			query: `db.collection.find({"k":1}.limit(1)`,
+			err:   errInvalidQuery,
+		},
+		{
+			name:  "missing db",
+			query: `collection.find()`,
+			err:   errInvalidQuery,
+		},
+		{
+			name:  "garbage after query",
+			query: `db.collection.find() garbage`,
+			err:   errInvalidQuery,
+		},
+	}
+
+	for _, tt := range parseTests {
+
+		query := []byte(tt.query)
+		q, err := parseQuery(query)
+		if want, got := tt.query, string(query); want != got {
+			t.Errorf("%s: query should not be modified by parsing, but became %s", tt.name, got)
+		}
+		// the same query may be parsed several times, like in compare()
+		if _, secondErr := parseQuery(query); fmt.Sprint(err) != fmt.Sprint(secondErr) {
+			t.Errorf("%s: parsing the query twice should give the same error, got '%v' then '%v'", tt.name, err, secondErr)
+		}
+		if err != nil {
+			if want, got := tt.err, err.Error(); want != got {
+				t.Errorf("%s: expected error '%s' but got '%s'", tt.name, want, got)
+			}
+			continue
+		}
+		if tt.err != "" {
+			t.Errorf("%s: expected error '%s' but got none", tt.name, tt.err)
+			continue
+		}
+
+		if want, got := tt.collection, q.collectionName; want != got {
+			t.Errorf("%s: expected collection %s but got %s", tt.name, want, got)
+		}
+		if want, got := tt.method, q.method; want != got {
+			t.Errorf("%s: expected method %s but got %s", tt.name, want, got)
+		}
+		if want, got := tt.cmdOpts, fmt.Sprint(q.cmdOpts); want != got {
+			t.Errorf("%s: expected cursor options %s but got %s", tt.name, want, got)
+		}
+		if want, got := tt.explainMode, q.explainMode; want != got {
+			t.Errorf("%s: expected explain mode %s but got %s", tt.name, want, got)
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+
+	t.Parallel()
+
+	splitTests := []struct {
+		name       string
+		script     string
+		statements []string
+	}{
+		{
+			name:       "single statement",
+			script:     `db.collection.find()`,
+			statements: []string{`db.collection.find()`},
+		},
+		{
+			name:       "trailing semicolon",
+			script:     "db.collection.find();\n",
+			statements: []string{`db.collection.find()`},
+		},
+		{
+			name:       "semicolon and new lines",
+			script:     "db.a.insertOne({k:1}); db.a.find()\n\ndb.b.find()",
+			statements: []string{`db.a.insertOne({k:1})`, `db.a.find()`, `db.b.find()`},
+		},
+		{
+			name:       "statement on several lines",
+			script:     "db.a.find({\n  k: 1\n})\n  .sort({k: 1})\ndb.a.count()",
+			statements: []string{"db.a.find({\n  k: 1\n})\n  .sort({k: 1})", `db.a.count()`},
+		},
+		{
+			name:       "separators in strings",
+			script:     `db.a.find({k: "a;b\ndb.c"});db.a.find({k: 'c)db.'})`,
+			statements: []string{`db.a.find({k: "a;b\ndb.c"})`, `db.a.find({k: 'c)db.'})`},
+		},
+		{
+			name:       "compacted statements",
+			script:     `db.a.insertOne({k:1})db.a.find()`,
+			statements: []string{`db.a.insertOne({k:1})`, `db.a.find()`},
+		},
+	}
+
+	for _, tt := range splitTests {
+
+		statements := splitStatements([]byte(tt.script))
+
+		got := make([]string, len(statements))
+		for i, s := range statements {
+			got[i] = string(s)
+		}
+		if want, got := fmt.Sprintf("%q", tt.statements), fmt.Sprintf("%q", got); want != got {
+			t.Errorf("%s: expected %s but got %s", tt.name, want, got)
+		}
+	}
+}
+
+func TestParseQueryErrorPosition(t *testing.T) {
+
+	t.Parallel()
+
+	positionTests := []struct {
+		name     string
+		query    string
+		position int
+	}{
+		{
+			name:     "find",
+			query:    `db.collection.find({"k":})`,
+			position: 24,
+		},
+		{
+			name:     "explain before find",
+			query:    `db.collection.explain().find({"k":})`,
+			position: 34,
+		},
+		{
+			name:     "explain after find",
+			query:    `db.collection.find({"k":}).explain()`,
+			position: 24,
+		},
+	}
+
+	for _, tt := range positionTests {
+
+		_, err := parseQuery([]byte(tt.query))
+		if want, got := tt.position, errorPosition(err); want != got {
+			t.Errorf("%s: expected error at position %d but got %d (%v)", tt.name, want, got, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// default max time a playground waits for a free worker
+	defaultQueueWaitTimeout = 10 * time.Second
+	// Retry-After sent when the server is busy
+	serverBusyRetryAfter = 5 * time.Second
+
+	throttledByServerBusy = "server_busy"
+)
+
+var errServerBusy = errors.New("server busy, too many playgrounds are running. Please retry in a few seconds")
+
+// QueueLimits caps the number of playgrounds run at the same
+// time, and the number of playgrounds waiting to be run
+type QueueLimits struct {
+	// max number of playgrounds run at the same time
+	workers int
+	// max number of playgrounds waiting for a free worker
+	depth int
+	// max time a playground waits for a free worker
+	waitTimeout time.Duration
+}
+
+// NewQueueLimits returns the limits of the queue in front of MongoDB. A
+// number of workers lower or equal to 0 disables the queue. A depth lower
+// than 0 is set to 0, and a waitTimeout lower or equal to 0 is replaced by
+// its default value
+func NewQueueLimits(workers, depth int, waitTimeout time.Duration) *QueueLimits {
+
+	if workers < 0 {
+		workers = 0
+	}
+	if depth < 0 {
+		depth = 0
+	}
+	if waitTimeout <= 0 {
+		waitTimeout = defaultQueueWaitTimeout
+	}
+	return &QueueLimits{
+		workers:     workers,
+		depth:       depth,
+		waitTimeout: waitTimeout,
+	}
+}
+
+// runQueue admits playgrounds to run, so slow queries don't pile up on
+// MongoDB until the write timeout of the server kills them
+type runQueue struct {
+	waitTimeout time.Duration
+	// one slot per running playground, nil if the queue is disabled
+	workers chan struct{}
+	// one slot per running or waiting playground
+	admitted chan struct{}
+}
+
+func newRunQueue(limits *QueueLimits) *runQueue {
+
+	q := &runQueue{
+		waitTimeout: limits.waitTimeout,
+	}
+	if limits.workers > 0 {
+		q.workers = make(chan struct{}, limits.workers)
+		q.admitted = make(chan struct{}, limits.workers+limits.depth)
+	}
+	return q
+}
+
+// wait for a free worker. errServerBusy is returned if the queue is
+// full, or if no worker is freed within waitTimeout. Once the playground
+// is run, release() must be called
+func (q *runQueue) enter(ctx context.Context) (release func(), err error) {
+
+	if q.workers == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case q.admitted <- struct{}{}:
+	default:
+		return nil, errServerBusy
+	}
+
+	runQueueLength.Inc()
+	start := time.Now()
+	defer func() {
+		runQueueLength.Dec()
+		runQueueWait.Observe(time.Since(start).Seconds())
+	}()
+
+	timeout := time.NewTimer(q.waitTimeout)
+	defer timeout.Stop()
+
+	select {
+	case q.workers <- struct{}{}:
+		return func() {
+			<-q.workers
+			<-q.admitted
+		}, nil
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timeout.C:
+		err = errServerBusy
+	}
+	<-q.admitted
+	return nil, err
+}
+
+// write a 503 response with a Retry-After header. Requests to the
+// json API get a json error, other requests a plain text one
+func writeServerBusy(w http.ResponseWriter, r *http.Request, err error) {
+
+	throttledRequests.WithLabelValues(r.URL.Path, throttledByServerBusy).Inc()
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(serverBusyRetryAfter.Seconds())))
+
+	if strings.HasPrefix(r.URL.Path, "/api/") {
+		writeAPIError(w, newRunError(errKindServerBusy, "", err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,126 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunQueue(t *testing.T) {
+
+	t.Parallel()
+
+	q := newRunQueue(NewQueueLimits(1, 1, time.Second))
+
+	release, err := q.enter(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	admitted := make(chan error)
+	go func() {
+		release, err := q.enter(context.Background())
+		if err == nil {
+			release()
+		}
+		admitted <- err
+	}()
+
+	// wait for the second playground to be queued
+	for len(q.admitted) != 2 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := q.enter(context.Background()); !errors.Is(err, errServerBusy) {
+		t.Errorf("expected error %v when the queue is full but got %v", errServerBusy, err)
+	}
+
+	release()
+	if err := <-admitted; err != nil {
+		t.Errorf("queued playground should be run once a worker is free, but got %v", err)
+	}
+	if want, got := 0, len(q.admitted); want != got {
+		t.Errorf("expected %d admitted playground but got %d", want, got)
+	}
+}
+
+func TestRunQueueWaitTimeout(t *testing.T) {
+
+	t.Parallel()
+
+	q := newRunQueue(NewQueueLimits(1, 5, 10*time.Millisecond))
+
+	release, err := q.enter(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	if _, err := q.enter(context.Background()); !errors.Is(err, errServerBusy) {
+		t.Errorf("expected error %v but got %v", errServerBusy, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := q.enter(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error %v but got %v", context.Canceled, err)
+	}
+
+	if want, got := 1, len(q.admitted); want != got {
+		t.Errorf("playgrounds that timed out should leave the queue, expected %d admitted but got %d", want, got)
+	}
+}
+
+func TestRunQueueDisabled(t *testing.T) {
+
+	t.Parallel()
+
+	q := newRunQueue(NewQueueLimits(0, 0, 0))
+	for i := 0; i < 100; i++ {
+		if _, err := q.enter(context.Background()); err != nil {
+			t.Fatalf("playgrounds should not be queued without workers limit, but got %v", err)
+		}
+	}
+}
+
+func TestWriteServerBusy(t *testing.T) {
+
+	t.Parallel()
+
+	err := newRunError(errKindServerBusy, "", errServerBusy)
+
+	for _, url := range []string{runEndpoint, apiRunEndpoint} {
+
+		resp := httptest.NewRecorder()
+		writeServerBusy(resp, httptest.NewRequest(http.MethodPost, url, nil), err)
+
+		if want, got := http.StatusServiceUnavailable, resp.Code; want != got {
+			t.Errorf("%s: expected response code %d but got %d", url, want, got)
+		}
+		if want, got := "5", resp.Header().Get("Retry-After"); want != got {
+			t.Errorf("%s: expected Retry-After %s but got %s", url, want, got)
+		}
+		if !strings.Contains(resp.Body.String(), errServerBusy.Error()) {
+			t.Errorf("%s: expected body to contain %q but got %s", url, errServerBusy, resp.Body.String())
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// how long a request waits for another database creation
+// to finish before being rejected
+var dbCreationWait = 5 * time.Second
+
+const (
+	// Retry-After sent when too many databases are being created
+	dbCreationRetryAfter = 1 * time.Second
+	// default max number of clients whose bucket is kept in memory
+	defaultMaxClients = 100000
+
+	// reasons of a throttled request, used as metric label
+	throttledByClient     = "client"
+	throttledByDBCreation = "db_creation"
+)
+
+var (
+	errTooManyRequests    = errors.New("too many requests, please retry later")
+	errTooManyDBCreations = errors.New("too many databases are being created, please retry later")
+)
+
+// RateLimits caps how often a single client can run or save playgrounds,
+// and how many databases can be created at the same time
+type RateLimits struct {
+	// tokens added to the bucket of a client each second
+	requestsPerSecond float64
+	// max number of tokens in the bucket of a client
+	burst int
+	// max number of databases created at the same time, on all backends
+	maxDBCreations int
+	// max number of clients whose bucket is kept in memory
+	maxClients int
+	// whether the ip of the client is read from the CF-Connecting-IP
+	// header. Only enable it if the server is behind Cloudflare, or
+	// anyone can choose its ip by setting the header
+	trustCloudflare bool
+}
+
+// NewRateLimits returns the limits to apply on incoming requests. A
+// requestsPerSecond or maxDBCreations lower or equal to 0 disables the
+// corresponding limit. A burst lower than 1 is set to 1, and a maxClients
+// lower or equal to 0 is replaced by its default value
+func NewRateLimits(requestsPerSecond float64, burst, maxDBCreations, maxClients int, trustCloudflare bool) *RateLimits {
+
+	if requestsPerSecond < 0 {
+		requestsPerSecond = 0
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	if maxDBCreations < 0 {
+		maxDBCreations = 0
+	}
+	if maxClients <= 0 {
+		maxClients = defaultMaxClients
+	}
+	return &RateLimits{
+		requestsPerSecond: requestsPerSecond,
+		burst:             burst,
+		maxDBCreations:    maxDBCreations,
+		maxClients:        maxClients,
+		trustCloudflare:   trustCloudflare,
+	}
+}
+
+type rateLimiter struct {
+	sync.Mutex
+	limits *RateLimits
+	// token bucket of each client, keyed by ip
+	buckets map[string]tokenBucket
+	// one slot per database being created, nil if unlimited
+	dbCreations chan struct{}
+}
+
+type tokenBucket struct {
+	tokens float64
+	// last time tokens were added to the bucket
+	updated time.Time
+}
+
+func newRateLimiter(limits *RateLimits) *rateLimiter {
+
+	l := &rateLimiter{
+		limits:  limits,
+		buckets: map[string]tokenBucket{},
+	}
+	if limits.maxDBCreations > 0 {
+		l.dbCreations = make(chan struct{}, limits.maxDBCreations)
+	}
+	return l
+}
+
+// take a token from the bucket of client. If the bucket is empty,
+// return false and how long the client has to wait for a new token
+func (l *rateLimiter) allow(client string, now time.Time) (bool, time.Duration) {
+
+	if l.limits.requestsPerSecond == 0 {
+		return true, 0
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	b, ok := l.buckets[client]
+	if !ok {
+		// the buckets are only removed by cleanup(), so evict a random one
+		// to keep the memory bounded if many clients show up in between
+		if len(l.buckets) >= l.limits.maxClients {
+			for evicted := range l.buckets {
+				delete(l.buckets, evicted)
+				break
+			}
+		}
+		b = tokenBucket{tokens: float64(l.limits.burst)}
+	} else {
+		b.tokens = math.Min(float64(l.limits.burst), b.tokens+now.Sub(b.updated).Seconds()*l.limits.requestsPerSecond)
+	}
+	b.updated = now
+
+	if b.tokens < 1 {
+		l.buckets[client] = b
+		return false, time.Duration((1 - b.tokens) / l.limits.requestsPerSecond * float64(time.Second))
+	}
+	b.tokens--
+	l.buckets[client] = b
+	return true, 0
+}
+
+// remove the buckets that are full again, as they are
+// the same as the bucket of a new client
+func (l *rateLimiter) cleanup(now time.Time) {
+
+	if l.limits.requestsPerSecond == 0 {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*l.limits.requestsPerSecond >= float64(l.limits.burst) {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// create the database once a creation slot is free. If no slot is freed
+// within dbCreationWait, errTooManyDBCreations is returned
+func (l *rateLimiter) createDB(ctx context.Context, db *mongo.Database, mode byte, config []byte) (sort.StringSlice, error) {
+
+	if l == nil || l.dbCreations == nil {
+		return createDB(db, mode, config)
+	}
+
+	timeout := time.NewTimer(dbCreationWait)
+	defer timeout.Stop()
+
+	select {
+	case l.dbCreations <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout.C:
+		return nil, errTooManyDBCreations
+	}
+	defer func() { <-l.dbCreations }()
+
+	return createDB(db, mode, config)
+}
+
+// return the ip of the client. If the server sits behind Cloudflare,
+// the ip is read from CF-Connecting-IP if set
+func (l *rateLimiter) clientIP(r *http.Request) string {
+
+	if l.limits.trustCloudflare {
+		if ip := r.Header.Get("CF-Connecting-IP"); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Middleware rejecting requests of clients that have no token left
+// with a 429 status
+func (s *storage) rateLimit(handler http.HandlerFunc) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		if ok, retryAfter := s.rateLimiter.allow(s.rateLimiter.clientIP(r), time.Now()); !ok {
+			writeTooManyRequests(w, r, retryAfter, throttledByClient, errTooManyRequests)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// write a 429 response with a Retry-After header, rounded
+// up to the next second. Requests to the json API get a json
+// error, other requests a plain text one
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration, reason string, err error) {
+
+	throttledRequests.WithLabelValues(r.URL.Path, reason).Inc()
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+
+	if strings.HasPrefix(r.URL.Path, "/api/") {
+		writeAPIError(w, newRunError(errKindRateLimited, "", err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusTooManyRequests)
+	w.Write([]byte(err.Error()))
+}
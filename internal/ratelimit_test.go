@@ -0,0 +1,213 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+
+	t.Parallel()
+
+	l := newRateLimiter(NewRateLimits(2, 3, 0, 0, false))
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.allow("1.1.1.1", now); !ok {
+			t.Fatalf("request %d should be allowed by the burst", i+1)
+		}
+	}
+	ok, retryAfter := l.allow("1.1.1.1", now)
+	if ok {
+		t.Fatal("request should be throttled once the bucket is empty")
+	}
+	if want := 500 * time.Millisecond; want != retryAfter {
+		t.Errorf("expected retry after %v but got %v", want, retryAfter)
+	}
+
+	if ok, _ := l.allow("2.2.2.2", now); !ok {
+		t.Error("each client should have its own bucket")
+	}
+
+	if ok, _ := l.allow("1.1.1.1", now.Add(500*time.Millisecond)); !ok {
+		t.Error("a token should be added after 500ms")
+	}
+
+	l.cleanup(now.Add(time.Second))
+	if want, got := 1, len(l.buckets); want != got {
+		t.Errorf("expected %d bucket after cleanup but got %d", want, got)
+	}
+	l.cleanup(now.Add(10 * time.Second))
+	if want, got := 0, len(l.buckets); want != got {
+		t.Errorf("expected %d bucket after cleanup but got %d", want, got)
+	}
+
+	bounded := newRateLimiter(NewRateLimits(2, 3, 0, 2, false))
+	for _, ip := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+		bounded.allow(ip, now)
+	}
+	if want, got := 2, len(bounded.buckets); want != got {
+		t.Errorf("expected at most %d buckets but got %d", want, got)
+	}
+
+	unlimited := newRateLimiter(NewRateLimits(0, 0, 0, 0, false))
+	for i := 0; i < 100; i++ {
+		if ok, _ := unlimited.allow("1.1.1.1", now); !ok {
+			t.Fatal("requests should not be throttled without limit")
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodPost, runEndpoint, nil)
+	r.RemoteAddr = "10.0.0.1:4567"
+	r.Header.Set("CF-Connecting-IP", "203.0.113.7")
+
+	untrusted := newRateLimiter(NewRateLimits(1, 1, 0, 0, false))
+	if want, got := "10.0.0.1", untrusted.clientIP(r); want != got {
+		t.Errorf("CF-Connecting-IP should be ignored, expected ip %s but got %s", want, got)
+	}
+
+	trusted := newRateLimiter(NewRateLimits(1, 1, 0, 0, true))
+	if want, got := "203.0.113.7", trusted.clientIP(r); want != got {
+		t.Errorf("expected ip %s but got %s", want, got)
+	}
+
+	r.Header.Del("CF-Connecting-IP")
+	if want, got := "10.0.0.1", trusted.clientIP(r); want != got {
+		t.Errorf("expected ip %s but got %s", want, got)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+
+	t.Parallel()
+
+	s := &storage{rateLimiter: newRateLimiter(NewRateLimits(0.5, 1, 0, 0, true))}
+	handler := s.rateLimit(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	testCases := []struct {
+		name        string
+		url         string
+		ip          string
+		code        int
+		contentType string
+		body        string
+	}{
+		{
+			name:        "first request",
+			url:         runEndpoint,
+			ip:          "203.0.113.8",
+			code:        http.StatusOK,
+			contentType: "",
+			body:        "ok",
+		},
+		{
+			name:        "throttled request",
+			url:         runEndpoint,
+			ip:          "203.0.113.8",
+			code:        http.StatusTooManyRequests,
+			contentType: "text/plain; charset=utf-8",
+			body:        errTooManyRequests.Error(),
+		},
+		{
+			name:        "throttled api request",
+			url:         apiRunEndpoint,
+			ip:          "203.0.113.8",
+			code:        http.StatusTooManyRequests,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"ok":false,"error":{"kind":"rate_limited","message":"too many requests, please retry later"}}`,
+		},
+		{
+			name:        "other client",
+			url:         saveEndpoint,
+			ip:          "203.0.113.9",
+			code:        http.StatusOK,
+			contentType: "",
+			body:        "ok",
+		},
+	}
+
+	for _, tt := range testCases {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			r := httptest.NewRequest(http.MethodPost, tt.url, nil)
+			r.Header.Set("CF-Connecting-IP", tt.ip)
+			resp := httptest.NewRecorder()
+			handler(resp, r)
+
+			if tt.code != resp.Code {
+				t.Errorf("expected response code %d but got %d", tt.code, resp.Code)
+			}
+			if tt.contentType != "" && tt.contentType != resp.Header().Get("Content-Type") {
+				t.Errorf("expected Content-Type %s but got %s", tt.contentType, resp.Header().Get("Content-Type"))
+			}
+			if want, got := tt.body, strings.TrimSpace(resp.Body.String()); want != got {
+				t.Errorf("expected body\n%s\nbut got\n%s", want, got)
+			}
+			if tt.code == http.StatusTooManyRequests && resp.Header().Get("Retry-After") != "2" {
+				t.Errorf("expected Retry-After 2 but got %s", resp.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestRateLimiterDBCreation(t *testing.T) {
+
+	defer func(wait time.Duration) { dbCreationWait = wait }(dbCreationWait)
+	dbCreationWait = 10 * time.Millisecond
+
+	l := newRateLimiter(NewRateLimits(0, 0, 1, 0, false))
+	// a database is already being created
+	l.dbCreations <- struct{}{}
+
+	if _, err := l.createDB(context.Background(), nil, mgodatagenMode, nil); !errors.Is(err, errTooManyDBCreations) {
+		t.Errorf("expected error %v but got %v", errTooManyDBCreations, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.createDB(ctx, nil, mgodatagenMode, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error %v but got %v", context.Canceled, err)
+	}
+
+	// throttled creations must not be cached
+	c := newCache(0)
+	_, _, err := c.getOrCreate(context.Background(), "db", func() (sort.StringSlice, error) {
+		return l.createDB(context.Background(), nil, mgodatagenMode, nil)
+	})
+	if !errors.Is(err, errTooManyDBCreations) {
+		t.Errorf("expected error %v but got %v", errTooManyDBCreations, err)
+	}
+	if _, ok := c.list["db"]; ok {
+		t.Error("throttled database should be removed from the cache")
+	}
+}
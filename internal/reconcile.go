@@ -0,0 +1,251 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// the last usage of each cached database is stored in a single
+	// collection, outside of the databases of the users:
+	//
+	//	playground_meta.cache: {"_id": <database name>, "lastUsed": <unix time>}
+	//
+	// so the cache can be rebuilt from MongoDB after a restart, see
+	// reconcileDB(). Databases created for write queries have no marker,
+	// as they are dropped right after the query
+	metaDBName       = "playground_meta"
+	markerCollection = "cache"
+
+	// prefix of the databases created for write queries, see uniqueDBHash()
+	updateDBPrefix = "update_"
+)
+
+// collection holding the markers of the cached databases
+func (b *backend) markers() *mongo.Collection {
+	return b.mongoSession.Database(metaDBName).Collection(markerCollection)
+}
+
+// store the last usage of a cached database in its marker
+func writeMarker(ctx context.Context, markers *mongo.Collection, name string, lastUsed int64) error {
+	_, err := markers.UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: name}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "lastUsed", Value: lastUsed}}}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// remove the markers of dropped databases
+func deleteMarkers(ctx context.Context, markers *mongo.Collection, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := markers.DeleteMany(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: names}}}})
+	return err
+}
+
+// read the last usage stored in the marker of each database
+func readMarkers(ctx context.Context, markers *mongo.Collection) (map[string]bson.RawValue, error) {
+
+	cursor, err := markers.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	lastUsed := map[string]bson.RawValue{}
+	for cursor.Next(ctx) {
+		name, ok := cursor.Current.Lookup("_id").StringValueOK()
+		if !ok {
+			continue
+		}
+		lastUsed[name] = cursor.Current.Lookup("lastUsed")
+	}
+	return lastUsed, cursor.Err()
+}
+
+// read the collections and the size of a database from MongoDB
+func readDBMetaInfo(ctx context.Context, db *mongo.Database, lastUsed, markedLastUsed int64) (dbMetaInfo, error) {
+
+	collections, err := db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return dbMetaInfo{}, err
+	}
+	if len(collections) == 0 {
+		return dbMetaInfo{}, errors.New("database has no collection")
+	}
+	sort.Strings(collections)
+
+	docCount, dataSize, err := databaseSize(ctx, db)
+	if err != nil {
+		return dbMetaInfo{}, err
+	}
+
+	return dbMetaInfo{
+		collections:    collections,
+		lastUsed:       lastUsed,
+		markedLastUsed: markedLastUsed,
+		ready:          true,
+		docCount:       docCount,
+		dataSize:       dataSize,
+	}, nil
+}
+
+// add the databases already present on the server to the cache, so they
+// can be reused and removed once unused. Databases without marker are
+// considered as used now, so they are removed by removeUnusedDB() if they
+// are not used again. Databases left by write queries, with an invalid
+// marker, without collection or unused for more than maxUnusedDuration
+// are dropped. Returns the number
+// of restored and dropped databases
+func (b *backend) reconcileDB(now time.Time) (restored, dropped int, err error) {
+
+	ctx := context.Background()
+
+	// read the markers first, so the markers of databases created
+	// after the databases are listed are not considered as stale
+	markers, err := readMarkers(ctx, b.markers())
+	if err != nil {
+		return 0, 0, fmt.Errorf("fail to read markers: %v", err)
+	}
+	names, err := b.mongoSession.ListDatabaseNames(ctx, bson.D{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	existing := map[string]bool{}
+	unused := make([]string, 0)
+
+	for _, name := range names {
+
+		if len(name) != 32 {
+			continue
+		}
+		existing[name] = true
+
+		var info dbMetaInfo
+		var err error
+
+		lastUsed, markedLastUsed := now.Unix(), int64(0)
+		marker, hasMarker := markers[name]
+		switch {
+		case hasMarker:
+			var ok bool
+			if markedLastUsed, ok = marker.AsInt64OK(); !ok {
+				err = errors.New("invalid marker")
+			}
+			lastUsed = markedLastUsed
+		case strings.HasPrefix(name, updateDBPrefix):
+			// left by a write query interrupted by the restart
+			err = errors.New("database was created for a write query")
+		}
+		if err == nil && now.Sub(time.Unix(lastUsed, 0)) > maxUnusedDuration {
+			err = errors.New("database is unused")
+		}
+		if err == nil {
+			info, err = readDBMetaInfo(ctx, b.mongoSession.Database(name), lastUsed, markedLastUsed)
+		}
+		if err != nil {
+			log.Printf("dropping database %s: %v", name, err)
+			unused = append(unused, name)
+			continue
+		}
+
+		b.activeDB.Lock()
+		// the database may have been used since the server started
+		if _, exists := b.activeDB.list[name]; !exists {
+			b.activeDB.list[name] = info
+			restored++
+		}
+		b.activeDB.Unlock()
+	}
+
+	// databases used since the server started are not dropped. The
+	// others can't be created again until they are fully dropped
+	b.activeDB.Lock()
+	expired := make([]string, 0, len(unused))
+	for _, name := range unused {
+		if _, exists := b.activeDB.list[name]; exists {
+			continue
+		}
+		if _, isDropping := b.activeDB.dropping[name]; isDropping {
+			continue
+		}
+		b.activeDB.dropping[name] = make(chan struct{})
+		expired = append(expired, name)
+	}
+	b.activeDB.Unlock()
+	b.dropDatabases(expired)
+
+	// markers of databases that don't exist anymore
+	stale := make([]string, 0)
+	b.activeDB.Lock()
+	for name := range markers {
+		if _, exists := b.activeDB.list[name]; !exists && !existing[name] {
+			stale = append(stale, name)
+		}
+	}
+	b.activeDB.Unlock()
+	if err := deleteMarkers(ctx, b.markers(), stale); err != nil {
+		log.Printf("fail to delete stale markers: %v", err)
+	}
+
+	b.evictDatabases("")
+	return restored, len(expired), nil
+}
+
+// store the last usage of the databases used since their marker
+// was last written
+func (b *backend) writeMarkers() {
+
+	used := map[string]int64{}
+
+	b.activeDB.Lock()
+	for name, info := range b.activeDB.list {
+		if info.ready && info.err == nil && info.lastUsed > info.markedLastUsed {
+			used[name] = info.lastUsed
+		}
+	}
+	b.activeDB.Unlock()
+
+	for name, lastUsed := range used {
+
+		if err := writeMarker(context.Background(), b.markers(), name, lastUsed); err != nil {
+			log.Printf("fail to write marker of database %s: %v", name, err)
+			continue
+		}
+
+		b.activeDB.Lock()
+		if info, ok := b.activeDB.list[name]; ok && info.markedLastUsed < lastUsed {
+			info.markedLastUsed = lastUsed
+			b.activeDB.list[name] = info
+		}
+		b.activeDB.Unlock()
+	}
+}
@@ -0,0 +1,159 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// default max number of documents returned by a query
+	defaultMaxResultDocs = 10000
+	// default max size in bytes of the documents returned by a query
+	defaultMaxResultBytes = 8 * 1024 * 1024
+)
+
+// ResultLimits caps the size of the result of a single query
+type ResultLimits struct {
+	maxDocs  int
+	maxBytes int
+}
+
+// NewResultLimits returns the limits to apply on query results. A
+// limit lower or equal to 0 is replaced by its default value
+func NewResultLimits(maxDocs, maxBytes int) *ResultLimits {
+
+	if maxDocs <= 0 {
+		maxDocs = defaultMaxResultDocs
+	}
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxResultBytes
+	}
+	return &ResultLimits{
+		maxDocs:  maxDocs,
+		maxBytes: maxBytes,
+	}
+}
+
+// returned instead of the documents when the result of a query
+// is too big, like
+//
+//	{"truncated":"results truncated at 2 documents","total":3,"documents":[{_id:1},{_id:2}]}
+type truncatedResult struct {
+	Message string `json:"truncated"`
+	// number of documents returned by the query without limits
+	Total     int64  `json:"total"`
+	Documents bson.A `json:"documents"`
+}
+
+func newTruncatedResult(docs bson.A, total int64) truncatedResult {
+	return truncatedResult{
+		Message:   fmt.Sprintf("results truncated at %d documents", len(docs)),
+		Total:     total,
+		Documents: docs,
+	}
+}
+
+// read the documents of a cursor, fetching new batches with getMore if
+// needed. The cursor is closed as soon as a limit is reached, so the
+// remaining documents are never fetched. truncated is true if the
+// cursor had more documents than the returned ones
+func (l *ResultLimits) readCursor(ctx context.Context, cursor *mongo.Cursor) (docs bson.A, truncated bool, err error) {
+
+	defer cursor.Close(ctx)
+
+	docs = bson.A{}
+	size := 0
+
+	for cursor.Next(ctx) {
+
+		size += len(cursor.Current)
+		if len(docs) >= l.maxDocs || size > l.maxBytes {
+			return docs, true, nil
+		}
+
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, false, err
+		}
+		docs = append(docs, doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, false, err
+	}
+	return docs, false, nil
+}
+
+// count the documents returned by a find or an aggregate command, so the
+// total is known even if the result is truncated. The count is run with
+// the same maxTimeMS as cmd
+func countResults(ctx context.Context, db *mongo.Database, cmd bson.D) (int64, error) {
+
+	method := cmd[0].Key
+
+	var countCmd bson.D
+	switch method {
+	case findMethod:
+		countCmd = bson.D{{Key: countMethod, Value: cmd[0].Value}}
+		for _, e := range cmd[1:] {
+			switch e.Key {
+			case "filter":
+				countCmd = append(countCmd, bson.E{Key: "query", Value: e.Value})
+			case "skip", "limit", "hint", "collation", "maxTimeMS":
+				countCmd = append(countCmd, e)
+			}
+		}
+	case aggregateMethod:
+		countCmd = bson.D{{Key: aggregateMethod, Value: cmd[0].Value}}
+		for _, e := range cmd[1:] {
+			if pipeline, ok := e.Value.([]any); ok && e.Key == "pipeline" {
+				e.Value = append(append([]any(nil), pipeline...), bson.M{"$count": "n"})
+			}
+			countCmd = append(countCmd, e)
+		}
+	default:
+		return 0, fmt.Errorf("can't count the documents returned by %s()", method)
+	}
+
+	// result doc looks like
+	//
+	//	{"n":3,"ok":1} for count
+	//	{"cursor":{"firstBatch":[{"n":3}],"id":NumberLong(0),"ns":"dbName.collection"},"ok":1} for aggregate
+	var res struct {
+		N      int64 `bson:"n"`
+		Cursor struct {
+			FirstBatch []struct {
+				N int64 `bson:"n"`
+			} `bson:"firstBatch"`
+		} `bson:"cursor"`
+	}
+	if err := db.RunCommand(ctx, countCmd).Decode(&res); err != nil {
+		return 0, err
+	}
+	if method == aggregateMethod {
+		// firstBatch is empty if the pipeline returns no document
+		if len(res.Cursor.FirstBatch) == 0 {
+			return 0, nil
+		}
+		return res.Cursor.FirstBatch[0].N, nil
+	}
+	return res.N, nil
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"crypto/rand"
+	"log"
 	"net/http"
 	"sort"
 	"time"
@@ -63,13 +64,38 @@ db = {
 		{_id: 1, v: 1}
 	]
 }`
-	errInvalidQuery    = "query must match db.coll.find(...) or db.coll.aggregate(...) or db.coll.update()"
+	errInvalidQuery    = "query must match db.coll.method(...), where method is one of: find, aggregate, count, countDocuments, estimatedDocumentCount, distinct, update, updateOne, updateMany, replaceOne, insertOne, insertMany, deleteOne, deleteMany, bulkWrite, findOneAndUpdate, findOneAndReplace, findOneAndDelete"
 	errPlaygroundToBig = "playground is too big"
+	errInvalidOutput   = "output must be empty, 'stages' or 'diff'"
 	noDocFound         = "no document found"
-
-	findMethod      = "find"
-	aggregateMethod = "aggregate"
-	updateMethod    = "update"
+	// max number of statements in a single query
+	maxStatementNb = 20
+
+	// output modes of a run. By default, only the result of the query is returned
+	defaultOutput = ""
+	// return the result of each stage of an aggregation pipeline, see runStages()
+	stagesOutput = "stages"
+	// return the changes made by a write query, see runWriteDiff()
+	diffOutput = "diff"
+
+	findMethod                   = "find"
+	aggregateMethod              = "aggregate"
+	updateMethod                 = "update"
+	countMethod                  = "count"
+	countDocumentsMethod         = "countDocuments"
+	estimatedDocumentCountMethod = "estimatedDocumentCount"
+	distinctMethod               = "distinct"
+	updateOneMethod              = "updateOne"
+	updateManyMethod             = "updateMany"
+	replaceOneMethod             = "replaceOne"
+	insertOneMethod              = "insertOne"
+	insertManyMethod             = "insertMany"
+	deleteOneMethod              = "deleteOne"
+	deleteManyMethod             = "deleteMany"
+	bulkWriteMethod              = "bulkWrite"
+	findOneAndUpdateMethod       = "findOneAndUpdate"
+	findOneAndReplaceMethod      = "findOneAndReplace"
+	findOneAndDeleteMethod       = "findOneAndDelete"
 )
 
 // run a query and return the results as plain text.
@@ -84,13 +110,22 @@ func (s *storage) runHandler(w http.ResponseWriter, r *http.Request) {
 		r.FormValue("mode"),
 		r.FormValue("config"),
 		r.FormValue("query"),
+		r.FormValue("version"),
 	)
 	if err != nil {
 		w.Write([]byte(err.Error()))
 		return
 	}
 
-	res, err := s.run(r.Context(), p)
+	res, err := s.run(r.Context(), p, r.FormValue("output"))
+	if errors.Is(err, errTooManyDBCreations) {
+		writeTooManyRequests(w, r, dbCreationRetryAfter, throttledByDBCreation, err)
+		return
+	}
+	if errors.Is(err, errServerBusy) {
+		writeServerBusy(w, r, err)
+		return
+	}
 	if err != nil {
 		w.Write([]byte(err.Error()))
 		return
@@ -98,106 +133,196 @@ func (s *storage) runHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write(res)
 }
 
-func (s *storage) run(context context.Context, p *page) ([]byte, error) {
+func (s *storage) run(context context.Context, p *page, output string) ([]byte, error) {
 
-	collectionName, method, stages, explainMode, err := parseQuery(p.Query)
+	if output != defaultOutput && output != stagesOutput && output != diffOutput {
+		return nil, newRunError(errKindInvalidRequest, "", errors.New(errInvalidOutput))
+	}
+
+	b, err := s.backend(p.Version)
+	if err != nil {
+		return nil, newRunError(errKindInvalidRequest, "", err)
+	}
+
+	release, err := s.runQueue.enter(context)
+	if errors.Is(err, errServerBusy) {
+		return nil, newRunError(errKindServerBusy, "", err)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error in query:\n  %v", err)
+		return nil, err
+	}
+	defer release()
+
+	statements := splitStatements(p.Query)
+	if len(statements) > 1 {
+		if output != defaultOutput {
+			return nil, newRunError(errKindInvalidRequest, "", fmt.Errorf("output '%s' is not supported for queries with several statements", output))
+		}
+		return s.runScript(context, b, p, statements)
+	}
+
+	q, err := parseQuery(p.Query)
+	if err != nil {
+		return nil, newRunError(errKindQuery, "error in query:\n  ", err)
+	}
+
+	if output == stagesOutput && (q.method != aggregateMethod || q.explainMode != "") {
+		return nil, newRunError(errKindInvalidRequest, "", fmt.Errorf("output '%s' is only supported for aggregate() queries without explain()", output))
+	}
+	if output == diffOutput && (!writeMethods[q.method] || isFindOneAnd(q.method) || q.explainMode != "") {
+		return nil, newRunError(errKindInvalidRequest, "", fmt.Errorf("output '%s' is only supported for update, insert, delete, replace and bulkWrite queries without explain()", output))
 	}
 
-	// if this is an 'update' query, always create a unique database, 
-	// run the query and drop the database immediately afterwards. 
-	// 
-	// this is needed in order to avoid problems like: 
-	// - users running find() queries after an update() query has been run on a 
-	//   playground with the same config 
+	// if this is a write query (update, insert, delete...), always create a unique
+	// database, run the query and drop the database immediately afterwards.
+	//
+	// this is needed in order to avoid problems like:
+	// - users running find() queries after an update() query has been run on a
+	//   playground with the same config
 	// - multiple users running the same update() query with the same config
-	if method == updateMethod {
-		db := s.mongoSession.Database(uniqueDBHash())
-		_, err := createDB(db, p.Mode, p.Config)
+	if writeMethods[q.method] {
+		db := b.mongoSession.Database(uniqueDBHash())
+		_, err := b.rateLimiter.createDB(context, db, p.Mode, p.Config)
 		if err != nil {
-			return nil, err
+			return nil, createDBError(err)
 		}
 		defer db.Drop(context)
-		return runQuery(context, db.Collection(collectionName), method, stages, explainMode)
+
+		if output == diffOutput {
+			return runWriteDiff(context, db.Collection(q.collectionName), q, s.resultLimits)
+		}
+		return runQuery(context, db.Collection(q.collectionName), q, s.resultLimits)
 	}
 
-	// find() queries are always safe to cache, because they can't modify the database
+	// find() queries are always safe to cache, because they can't modify the database.
+	// Same goes for count(), countDocuments(), estimatedDocumentCount() and distinct()
 	// aggregate() queries are also safe to cache, because we remove any stage that could
 	// modify the database in runQuery()
-	db := s.mongoSession.Database(p.dbHash())
-	dbInfo := s.createCachedDB(db, p.Mode, p.Config)
+	db := b.mongoSession.Database(p.dbHash())
+	dbInfo, err := b.createCachedDB(context, db, p.Mode, p.Config)
+	if errors.Is(err, errTooManyDBCreations) {
+		return nil, newRunError(errKindRateLimited, "", err)
+	}
+	if err != nil {
+		return nil, newRunError(errKindInternal, "", fmt.Errorf("fail to create database: %w", err))
+	}
 	if dbInfo.err != nil {
-		return nil, fmt.Errorf("error in configuration:\n  %v", dbInfo.err)
+		return nil, newRunError(errKindConfig, "error in configuration:\n  ", dbInfo.err)
 	}
 
 	// mongodb returns an empty array ( [] ) if we try to run a query on a collection
 	// that doesn't exist. Check that the collection exist before running the query,
 	// to return a clear error message in that case
-	if !dbInfo.hasCollection(collectionName) {
-		return nil, fmt.Errorf(`collection "%s" doesn't exist`, collectionName)
+	if !dbInfo.hasCollection(q.collectionName) {
+		return nil, newRunError(errKindQuery, "", fmt.Errorf(`collection "%s" doesn't exist`, q.collectionName))
 	}
-	return runQuery(context, db.Collection(collectionName), method, stages, explainMode)
+
+	if output == stagesOutput {
+		return runStages(context, db.Collection(q.collectionName), q, s.resultLimits)
+	}
+	return runQuery(context, db.Collection(q.collectionName), q, s.resultLimits)
 }
 
-func (s *storage) createCachedDB(db *mongo.Database, mode byte, config []byte) dbMetaInfo {
+// run several statements in order against a unique database, like a write
+// query, and return the output of each statement. The result looks like:
+//
+//	[{"statement":"db.collection.insertOne({_id:2})","result":[{_id:1},{_id:2}]},{"statement":"db.collection.count()","result":2}]
+func (s *storage) runScript(context context.Context, b *backend, p *page, statements [][]byte) ([]byte, error) {
+
+	if len(statements) > maxStatementNb {
+		return nil, newRunError(errKindQuery, "error in query:\n  ", fmt.Errorf("max number of statements in a query is %d, but was %d", maxStatementNb, len(statements)))
+	}
 
-	// first, check if the db has already been created, or if there is
-	// another goroutine creating it
-	s.activeDB.Lock()
-	dbInfo, exists := s.activeDB.list[db.Name()]
-	if !exists {
-		// add an entry in cache immediately to make sure that only one goroutine
-		// will create the database
-		dbInfo = dbMetaInfo{
-			ready: false,
+	// parse all statements first, so nothing is run if
+	// one of them is invalid
+	queries := make([]*parsedQuery, len(statements))
+	for i, statement := range statements {
+		q, err := parseQuery(statement)
+		if err != nil {
+			// position of a syntax error is relative to the statement
+			var posErr *positionError
+			if offset := bytes.Index(p.Query, statement); offset >= 0 && errors.As(err, &posErr) {
+				posErr.position += offset
+			}
+			return nil, newRunError(errKindQuery, "error in query:\n  ", fmt.Errorf("statement %d: %w", i+1, err))
 		}
+		queries[i] = q
 	}
-	dbInfo.lastUsed = time.Now().Unix()
-	s.activeDB.list[db.Name()] = dbInfo
-	s.activeDB.Unlock()
 
-	if exists {
-		dbCacheHit.Inc()
+	db := b.mongoSession.Database(uniqueDBHash())
+	_, err := b.rateLimiter.createDB(context, db, p.Mode, p.Config)
+	if err != nil {
+		return nil, createDBError(err)
 	}
+	defer db.Drop(context)
+
+	buf := bytes.NewBuffer(make([]byte, 0, 512))
+	buf.WriteByte('[')
+	for i, q := range queries {
+
+		res, err := runQuery(context, db.Collection(q.collectionName), q, s.resultLimits)
+		if err != nil {
+			return nil, newRunError(errorKind(err), fmt.Sprintf("error in statement %d:\n  ", i+1), err)
+		}
+		if bytes.Equal(res, []byte(noDocFound)) {
+			res = []byte("[]")
+		}
 
-	if dbInfo.ready {
-		return dbInfo
+		label, _ := mongoextjson.Marshal(string(statements[i]))
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`{"statement":`)
+		buf.Write(label)
+		buf.WriteString(`,"result":`)
+		buf.Write(res)
+		buf.WriteByte('}')
 	}
+	buf.WriteByte(']')
 
-	// if the db was not in activeDB list, we need to create the database in MongoDB
-	if !exists {
+	return buf.Bytes(), nil
+}
 
-		dbInfo.collections, dbInfo.err = createDB(db, mode, config)
+func (b *backend) createCachedDB(ctx context.Context, db *mongo.Database, mode byte, config []byte) (dbMetaInfo, error) {
 
+	dbInfo, cached, err := b.activeDB.getOrCreate(ctx, db.Name(), func() (sort.StringSlice, error) {
+		collections, err := b.rateLimiter.createDB(ctx, db, mode, config)
 		// only increment the counter if it's the first time we create this db,
 		// to avoid counting db with update query multiple times
-		if dbInfo.err == nil {
+		if err == nil {
 			activeDatabasesCounter.Inc()
+			// without marker, the database would be dropped after a restart
+			if err := writeMarker(context.Background(), b.markers(), db.Name(), time.Now().Unix()); err != nil {
+				log.Printf("fail to write marker of database %s: %v", db.Name(), err)
+			}
 		}
-		// at this point, the db has either been created on the server, or
-		// the creation failed with an error. In both cases, it is now ready
-		// to use by other goroutine
-		dbInfo.ready = true
-
-		s.activeDB.Lock()
-		s.activeDB.list[db.Name()] = dbInfo
-		s.activeDB.Unlock()
-		return dbInfo
+		return collections, err
+	})
+	if cached {
+		dbCacheHit.Inc()
+		return dbInfo, err
 	}
 
-	// the db is being created by another goroutine, so wait for it to be
-	// ready to use
-wait:
-	time.Sleep(5 * time.Millisecond)
-	s.activeDB.Lock()
-	dbInfo = s.activeDB.list[db.Name()]
-	s.activeDB.Unlock()
+	if err == nil && dbInfo.err == nil {
+		docCount, dataSize, err := databaseSize(ctx, db)
+		if err != nil {
+			log.Printf("fail to get size of database %s: %v", db.Name(), err)
+		}
+		b.activeDB.setSize(db.Name(), docCount, dataSize)
+		dbInfo.docCount, dbInfo.dataSize = docCount, dataSize
+
+		b.evictDatabases(db.Name())
+	}
+	return dbInfo, err
+}
 
-	if dbInfo.ready {
-		return dbInfo
+// errors while creating a database are config errors, unless
+// the creation was throttled
+func createDBError(err error) error {
+	if errors.Is(err, errTooManyDBCreations) {
+		return newRunError(errKindRateLimited, "", err)
 	}
-	goto wait
+	return newRunError(errKindConfig, "", err)
 }
 
 func createDB(db *mongo.Database, mode byte, config []byte) (sort.StringSlice, error) {
@@ -393,154 +518,111 @@ func seededObjectID(n int32) primitive.ObjectID {
 	}
 }
 
-// find, aggregate and update queries are supported, with or without explain()
-// once the .explain() part is stripped, the query has to match the following
-// regex:
-//
-//	/^db\..(\w*)\.(find|aggregate|update)\([\s\S]*\)$/
-//
-// for example, those queries are valid:
-//
-//	db.collection.find({k:1})
-//	db.collection.aggregate([{$project:{_id:0}}])
-//	db.collection.update({k:1},{$set:{n:1}},{upsert:true})
-//	db.collection.find({k:1}).explain()
-//	db.collection.explain("executionStats").find({k:1})
-//
-// input is filtered from front-end side, but this should
-// not panic on pathological/malformatted input
-func parseQuery(query []byte) (collectionName, method string, stages []any, explainMode string, err error) {
-
-	query, explainMode = stripExplain(query)
+func runQuery(context context.Context, collection *mongo.Collection, q *parsedQuery, limits *ResultLimits) ([]byte, error) {
 
-	p := bytes.SplitN(query, []byte{'.'}, 3)
-	if len(p) != 3 {
-		return "", "", nil, "", errors.New(errInvalidQuery)
-	}
-
-	collectionName = string(p[1])
-
-	// last part of query contains the method and the stages, for example find({k:1})
-	queryBytes := p[2]
-	start, end := bytes.IndexByte(queryBytes, '('), bytes.LastIndexByte(queryBytes, ')')
-
-	if start == -1 || end == -1 || start > end {
-		return "", "", nil, "", errors.New(errInvalidQuery)
-	}
+	method, stages, explainMode := q.method, q.stages, q.explainMode
 
-	method = string(queryBytes[:start])
-
-	stages, err = unmarshalStages(queryBytes[start+1 : end])
-	if err != nil {
-		return "", "", nil, "", fmt.Errorf("fail to parse content of query: %v", err)
-	}
-
-	return collectionName, method, stages, explainMode, nil
-}
-
-func stripExplain(query []byte) (strippedQuery []byte, explainMode string) {
+	var cmd bson.D
 
-	startExplain := bytes.Index(query, []byte(".explain("))
-	if startExplain == -1 {
-		return query, ""
-	}
+	switch method {
+	case aggregateMethod:
 
-	endExplain := bytes.Index(query[startExplain:], []byte(")"))
-	if endExplain == -1 {
-		return query, ""
-	}
+		cmd = aggregateCommand(collection, sanitizeAggregationStages(stages), q.cmdOpts)
 
-	endExplain += startExplain
-	if endExplain+1 == len(query) {
-		query = query[:startExplain]
-	} else {
-		query = append(query[:startExplain], query[endExplain+1:]...)
-	}
+	case findMethod:
 
-	explainMode = string(query[startExplain+9 : endExplain])
-	if len(explainMode) < 2 {
-		explainMode = "queryPlanner"
-	} else {
-		// remove the enclosing double quote (")
-		explainMode = explainMode[1 : len(explainMode)-1]
-	}
+		cmd = bson.D{
+			{Key: findMethod, Value: collection.Name()},
+			{Key: "filter", Value: stageAt(stages, 0)},
+			{Key: "projection", Value: stageAt(stages, 1)},
+		}
+		// options from cursor methods like sort() or limit()
+		cmd = append(cmd, q.cmdOpts...)
 
-	return query, explainMode
-}
+	case findOneAndUpdateMethod, findOneAndReplaceMethod, findOneAndDeleteMethod:
 
-// most of the time, each stage is a bson.M document.
-//
-// however, since mongodb 4.2, the second stage of an update()
-// can be an slice of bson.M
-//
-//	cf https://docs.mongodb.com/manual/tutorial/update-documents-with-aggregation-pipeline/
-func unmarshalStages(queryBytes []byte) (stages []any, err error) {
+		if explainMode != "" {
+			return nil, fmt.Errorf("explain() is not supported with %s()", method)
+		}
+		// return the document returned by the server rather
+		// than the content of the collection
+		return runFindOneAnd(context, collection, method, stages)
 
-	if len(queryBytes) == 0 {
-		return []any{bson.M{}, bson.M{}}, nil
-	}
+	case updateMethod, updateOneMethod, updateManyMethod, replaceOneMethod,
+		insertOneMethod, insertManyMethod, deleteOneMethod, deleteManyMethod, bulkWriteMethod:
 
-	// because projections are allowed, transform
-	// {}, {"_id": 0} into [{}, {"_id": 0}] so we
-	// can parse it as a []bson.M
-	if queryBytes[0] != '[' {
-		b := make([]byte, 0, len(queryBytes)+2)
-		b = append(b, '[')
-		b = append(b, queryBytes...)
-		b = append(b, ']')
-		queryBytes = b
-	}
+		_, err := runWrite(context, collection, method, stages)
+		if err != nil {
+			return nil, err
+		}
 
-	err = mongoextjson.Unmarshal(queryBytes, &stages)
+		cmd = bson.D{
+			{Key: findMethod, Value: collection.Name()},
+			{Key: "filter", Value: bson.M{}},
+		}
 
-	return stages, err
-}
+	case countMethod:
 
-func runQuery(context context.Context, collection *mongo.Collection, method string, stages []any, explainMode string) ([]byte, error) {
+		opts, err := parseCountOpts(method, stageAt(stages, 1))
+		if err != nil {
+			return nil, err
+		}
 
-	var cmd bson.D
+		cmd = bson.D{
+			{Key: countMethod, Value: collection.Name()},
+			{Key: "query", Value: stageAt(stages, 0)},
+		}
+		cmd = append(cmd, opts...)
 
-	switch method {
-	case aggregateMethod:
+	case estimatedDocumentCountMethod:
 
 		cmd = bson.D{
-			{Key: aggregateMethod, Value: collection.Name()},
-			{Key: "pipeline", Value: sanitizeAggregationStages(stages)},
-			{Key: "cursor", Value: bson.M{"batchSize": 1000}},
+			{Key: countMethod, Value: collection.Name()},
 		}
 
-	case findMethod:
+	case countDocumentsMethod:
 
-		for len(stages) < 2 {
-			stages = append(stages, bson.M{})
+		opts, err := parseCountOpts(method, stageAt(stages, 1))
+		if err != nil {
+			return nil, err
 		}
 
-		cmd = bson.D{
-			{Key: findMethod, Value: collection.Name()},
-			{Key: "filter", Value: stages[0]},
-			{Key: "projection", Value: stages[1]},
+		// countDocuments() is not a server command, it's an aggregation
+		// built by the driver, so reproduce the same pipeline here:
+		//
+		//	[{$match: filter}, {$skip: n}, {$limit: n}, {$group: {_id: 1, n: {$sum: 1}}}]
+		pipeline := []any{bson.M{"$match": stageAt(stages, 0)}}
+		for _, opt := range opts {
+			switch opt.Key {
+			case "skip", "limit":
+				pipeline = append(pipeline, bson.M{"$" + opt.Key: opt.Value})
+			}
 		}
+		pipeline = append(pipeline, bson.M{"$group": bson.M{"_id": 1, "n": bson.M{"$sum": 1}}})
 
-	case updateMethod:
-
-		for len(stages) < 3 {
-			stages = append(stages, bson.M{})
+		cmd = bson.D{
+			{Key: aggregateMethod, Value: collection.Name()},
+			{Key: "pipeline", Value: pipeline},
+			{Key: "cursor", Value: bson.M{}},
 		}
-
-		var err error
-		multi, opts := parseUpdateOpts(stages[2])
-		if multi {
-			_, err = collection.UpdateMany(context, stages[0], stages[1], opts)
-		} else {
-			_, err = collection.UpdateOne(context, stages[0], stages[1], opts)
+		for _, opt := range opts {
+			switch opt.Key {
+			case "hint", "collation":
+				cmd = append(cmd, opt)
+			}
 		}
-		if err != nil {
-			return nil, fmt.Errorf("fail to run update: %v", err)
+
+	case distinctMethod:
+
+		key, ok := stageAt(stages, 0).(string)
+		if !ok {
+			return nil, errors.New("distinct() requires a field name as first argument, for example: distinct(\"field\")")
 		}
 
 		cmd = bson.D{
-			{Key: findMethod, Value: collection.Name()},
-			{Key: "filter", Value: bson.M{}},
+			{Key: distinctMethod, Value: collection.Name()},
+			{Key: "key", Value: key},
+			{Key: "query", Value: stageAt(stages, 1)},
 		}
 
 	default:
@@ -548,10 +630,13 @@ func runQuery(context context.Context, collection *mongo.Collection, method stri
 	}
 
 	// make sure that all types of queries have a timeout,
-	// even in explain mode
-	cmd = append(cmd,
-		bson.E{Key: "maxTimeMS", Value: maxQueryTime.Milliseconds()},
-	)
+	// even in explain mode. A lower timeout may have been
+	// set in the options of the query
+	if !hasKey(cmd, "maxTimeMS") {
+		cmd = append(cmd,
+			bson.E{Key: "maxTimeMS", Value: maxQueryTime.Milliseconds()},
+		)
+	}
 
 	if explainMode != "" {
 		cmd = bson.D{
@@ -560,6 +645,33 @@ func runQuery(context context.Context, collection *mongo.Collection, method stri
 		}
 	}
 
+	// find(), aggregate() and write queries return a cursor. Read all
+	// of its batches, as the first batch may not contain all documents
+	if explainMode == "" && returnsCursor(method) {
+
+		cursor, err := collection.Database().RunCommandCursor(context, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("query failed: %v", err)
+		}
+		docs, truncated, err := limits.readCursor(context, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("query failed: %v", err)
+		}
+		// a single document may exceed the limits, so
+		// check it before checking the number of docs
+		if truncated {
+			total, err := countResults(context, collection.Database(), cmd)
+			if err != nil {
+				return nil, fmt.Errorf("fail to count results: %v", err)
+			}
+			return mongoextjson.Marshal(newTruncatedResult(docs, total))
+		}
+		if len(docs) == 0 {
+			return []byte(noDocFound), nil
+		}
+		return mongoextjson.Marshal(docs)
+	}
+
 	res := collection.Database().RunCommand(context, cmd)
 	if res.Err() != nil {
 		return nil, fmt.Errorf("query failed: %v", res.Err())
@@ -578,29 +690,133 @@ func runQuery(context context.Context, collection *mongo.Collection, method stri
 
 		return mongoextjson.Marshal(cursorDoc)
 	}
-	// result doc looks like
-	//
-	// {"cursor":{"firstBatch":[{"_id":1},{"_id":2}],"id":NumberLong(0),"ns":"dbName.collection"},"ok":1}
-	docs := cursorDoc["cursor"].(bson.M)["firstBatch"].(bson.A)
-	if len(docs) == 0 {
-		return []byte(noDocFound), nil
+
+	switch method {
+	case countMethod, estimatedDocumentCountMethod:
+		// result doc looks like
+		//
+		// {"n":3,"ok":1}
+		return mongoextjson.Marshal(cursorDoc["n"])
+
+	case countDocumentsMethod:
+		// result doc looks like
+		//
+		// {"cursor":{"firstBatch":[{"_id":1,"n":3}],"id":NumberLong(0),"ns":"dbName.collection"},"ok":1}
+		//
+		// if no document matches the filter, firstBatch is empty
+		docs := cursorDoc["cursor"].(bson.M)["firstBatch"].(bson.A)
+		if len(docs) == 0 {
+			return []byte("0"), nil
+		}
+		return mongoextjson.Marshal(docs[0].(bson.M)["n"])
+
+	case distinctMethod:
+		// result doc looks like
+		//
+		// {"values":[1,2,3],"ok":1}
+		values, _ := cursorDoc["values"].(bson.A)
+		if len(values) == 0 {
+			return []byte(noDocFound), nil
+		}
+		return mongoextjson.Marshal(values)
+	}
+	return nil, fmt.Errorf("invalid method: '%s'", method)
+}
+
+// count(), countDocuments(), estimatedDocumentCount() and distinct()
+// return a single value instead of a cursor
+func returnsCursor(method string) bool {
+	switch method {
+	case countMethod, countDocumentsMethod, estimatedDocumentCountMethod, distinctMethod:
+		return false
 	}
-	return mongoextjson.Marshal(docs)
+	return true
 }
 
-func parseUpdateOpts(opts any) (bool, *options.UpdateOptions) {
+func aggregateCommand(collection *mongo.Collection, pipeline []any, opts bson.D) bson.D {
+
+	cmd := bson.D{
+		{Key: aggregateMethod, Value: collection.Name()},
+		{Key: "pipeline", Value: pipeline},
+		{Key: "cursor", Value: bson.M{"batchSize": 1000}},
+	}
+	// options of aggregate(), like allowDiskUse or collation
+	return append(cmd, opts...)
+}
+
+// parse the options of count() and countDocuments(). Only skip, limit,
+// hint and collation are supported. Options are returned in a fixed
+// order, so the generated command is always the same
+func parseCountOpts(method string, opts any) (bson.D, error) {
 
 	optsDoc, _ := opts.(map[string]any)
 
-	multi, _ := optsDoc["multi"].(bool)
-	upsert, _ := optsDoc["upsert"].(bool)
-	arrayFilters, _ := optsDoc["arrayFilters"].([]any)
+	for key := range optsDoc {
+		switch key {
+		case "skip", "limit", "hint", "collation":
+		default:
+			return nil, fmt.Errorf("unsupported option '%s' in %s(), supported options are: skip, limit, hint, collation", key, method)
+		}
+	}
+
+	parsed := bson.D{}
+	for _, key := range []string{"skip", "limit", "hint", "collation"} {
+		value, ok := optsDoc[key]
+		if !ok {
+			continue
+		}
+		if key == "skip" || key == "limit" {
+			n, ok := toInt64(value)
+			if !ok || n < 0 {
+				return nil, fmt.Errorf("option '%s' in %s() must be a positive integer", key, method)
+			}
+			// $limit: 0 is not valid in a pipeline, and count()
+			// treats limit: 0 as no limit
+			if n == 0 {
+				continue
+			}
+			value = n
+		}
+		parsed = append(parsed, bson.E{Key: key, Value: value})
+	}
+	return parsed, nil
+}
+
+func hasKey(doc bson.D, key string) bool {
+	for _, e := range doc {
+		if e.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// return the stage at index i, or an empty document if
+// there is no such stage
+func stageAt(stages []any, i int) any {
+	if i >= len(stages) {
+		return bson.M{}
+	}
+	return stages[i]
+}
 
-	return multi, options.Update().
-		SetUpsert(upsert).
-		SetArrayFilters(options.ArrayFilters{
-			Filters: arrayFilters,
-		})
+// numbers parsed by mongoextjson are float64, unless they're
+// explicitly typed like NumberInt(1) or NumberLong(1)
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case float64:
+		if n != float64(int64(n)) {
+			return 0, false
+		}
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	}
+	return 0, false
 }
 
 // remove any aggregation stages that might write to another db/collection,
@@ -626,10 +842,12 @@ func sanitizeAggregationStages(stages []any) []any {
 	return stages
 }
 
-// the string generated by this function has to be 32 chars long
+// the string generated by this function has to be 32 chars long. It
+// starts with updateDBPrefix, so these databases can be told apart
+// from the cached ones named after page.dbHash(), see reconcileDB()
 func uniqueDBHash() string {
 	data := [16]byte{}
 	rand.Read(data[0:8])
 	binary.BigEndian.PutUint64(data[8:16], uint64(time.Now().UnixNano()))
-	return fmt.Sprintf("%x", data)
+	return fmt.Sprintf("%s%x", updateDBPrefix, data)[:32]
 }
@@ -21,9 +21,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"regexp"
 	"strings"
 	"sync"
 	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type runTest struct {
@@ -773,6 +776,482 @@ var runTests = []runTest{
 		result:    `[{"_id":1}]`,
 		dbCreated: true,
 	},
+	{
+		name: `basic count`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":1},{"k":2},{"k":1}]`},
+			"query":  {`db.collection.count({"k":1})`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `count with limit`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":1},{"k":2},{"k":3},{"k":4}]`},
+			"query":  {`db.collection.count({}, {"limit": 2})`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `estimatedDocumentCount`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":5},{"k":6}]`},
+			"query":  {`db.collection.estimatedDocumentCount()`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `basic countDocuments`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":7},{"k":8},{"k":9}]`},
+			"query":  {`db.collection.countDocuments({"k":{"$gt":7}})`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `countDocuments no match`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":10}]`},
+			"query":  {`db.collection.countDocuments({"k":0})`},
+		},
+		result:    `0`,
+		dbCreated: true,
+	},
+	{
+		name: `countDocuments with skip`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":11},{"k":12},{"k":13}]`},
+			"query":  {`db.collection.countDocuments({}, {"skip": 1})`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `countDocuments with unsupported option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":14}]`},
+			"query":  {`db.collection.countDocuments({}, {"sort": {"k": 1}})`},
+		},
+		result:    `unsupported option 'sort' in countDocuments(), supported options are: skip, limit, hint, collation`,
+		dbCreated: true,
+	},
+	{
+		name: `explain count`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":15},{"k":16}]`},
+			"query":  {`db.collection.count({"k":15}).explain()`},
+		},
+		result:    `{"command":{"$db":"6dfc4f8fa5506874b7c4e20a6a0a2647","count":"collection","maxTimeMS":NumberLong(20000),"query":{"k":15}},`,
+		dbCreated: true,
+	},
+	{
+		name: `basic distinct`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":"a"},{"k":"b"},{"k":"a"}]`},
+			"query":  {`db.collection.distinct("k")`},
+		},
+		result:    `["a","b"]`,
+		dbCreated: true,
+	},
+	{
+		name: `distinct with filter`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":"c","n":1},{"k":"d","n":2}]`},
+			"query":  {`db.collection.distinct("k", {"n": {"$gt": 1}})`},
+		},
+		result:    `["d"]`,
+		dbCreated: true,
+	},
+	{
+		name: `distinct without field`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":"e"}]`},
+			"query":  {`db.collection.distinct()`},
+		},
+		result:    `distinct() requires a field name as first argument, for example: distinct("field")`,
+		dbCreated: true,
+	},
+	{
+		name: `insertOne`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.insertOne({"_id":2,"k":"new"})`},
+		},
+		result: `[{"_id":1},{"_id":2,"k":"new"}]`,
+	},
+	{
+		name: `insertOne without _id`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"k":1}]`},
+			"query":  {`db.collection.insertOne({"k":2})`},
+		},
+		result: `[{"_id":ObjectId("5a934e000102030405000000"),"k":1},{"_id":ObjectId("5a934e000102030405000001"),"k":2}]`,
+	},
+	{
+		name: `insertOne without _id after the highest seeded id`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":ObjectId("5a934e000102030405000004"),"k":1},{"_id":2,"k":1}]`},
+			"query":  {`db.collection.insertOne({"k":2})`},
+		},
+		result: `[{"_id":ObjectId("5a934e000102030405000004"),"k":1},{"_id":2,"k":1},{"_id":ObjectId("5a934e000102030405000005"),"k":2}]`,
+	},
+	{
+		name: `insertMany`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[]`},
+			"query":  {`db.collection.insertMany([{"_id":1},{"_id":2}])`},
+		},
+		result: `[{"_id":1},{"_id":2}]`,
+	},
+	{
+		name: `insertMany without array`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.insertMany({"_id":2})`},
+		},
+		result: `insertMany() requires an array of documents as first argument`,
+	},
+	{
+		name: `deleteOne`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":1}]`},
+			"query":  {`db.collection.deleteOne({"k":1})`},
+		},
+		result: `[{"_id":2,"k":1}]`,
+	},
+	{
+		name: `deleteMany`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":1},{"_id":3,"k":2}]`},
+			"query":  {`db.collection.deleteMany({"k":1})`},
+		},
+		result: `[{"_id":3,"k":2}]`,
+	},
+	{
+		name: `deleteMany all documents`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1},{"_id":2}]`},
+			"query":  {`db.collection.deleteMany({})`},
+		},
+		result: noDocFound,
+	},
+	{
+		name: `replaceOne`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.replaceOne({"_id":1},{"n":2})`},
+		},
+		result: `[{"_id":1,"n":2}]`,
+	},
+	{
+		name: `replaceOne with upsert`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.replaceOne({"_id":2},{"n":2},{"upsert":true})`},
+		},
+		result: `[{"_id":1,"k":1},{"_id":2,"n":2}]`,
+	},
+	{
+		name: `replaceOne with unsupported option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.replaceOne({"_id":1},{"n":2},{"multi":true})`},
+		},
+		result: `unsupported option 'multi' in replaceOne(), supported options are: upsert`,
+	},
+	{
+		name: `updateOne`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":3},{"_id":2,"k":3}]`},
+			"query":  {`db.collection.updateOne({"k":3},{"$set":{"k":0}})`},
+		},
+		result: `[{"_id":1,"k":0},{"_id":2,"k":3}]`,
+	},
+	{
+		name: `updateMany`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":3},{"_id":2,"k":3}]`},
+			"query":  {`db.collection.updateMany({"k":3},{"$set":{"k":0}})`},
+		},
+		result: `[{"_id":1,"k":0},{"_id":2,"k":0}]`,
+	},
+	{
+		name: `findOneAndUpdate`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.findOneAndUpdate({"_id":1},{"$inc":{"k":1}})`},
+		},
+		result: `{"_id":1,"k":1}`,
+	},
+	{
+		name: `findOneAndUpdate returnDocument after`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.findOneAndUpdate({"_id":1},{"$inc":{"k":1}},{"returnDocument":"after"})`},
+		},
+		result: `{"_id":1,"k":2}`,
+	},
+	{
+		name: `findOneAndUpdate with sort and projection`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":5}]`},
+			"query":  {`db.collection.findOneAndUpdate({},{"$set":{"max":true}},{"sort":{"k":-1},"projection":{"_id":0},"returnDocument":"after"})`},
+		},
+		result: `{"k":5,"max":true}`,
+	},
+	{
+		name: `findOneAndReplace`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.findOneAndReplace({"_id":1},{"n":1},{"returnNewDocument":true})`},
+		},
+		result: `{"_id":1,"n":1}`,
+	},
+	{
+		name: `findOneAndDelete`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1},{"_id":2}]`},
+			"query":  {`db.collection.findOneAndDelete({"_id":2})`},
+		},
+		result: `{"_id":2}`,
+	},
+	{
+		name: `findOneAndDelete no match`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1},{"_id":2}]`},
+			"query":  {`db.collection.findOneAndDelete({"_id":3})`},
+		},
+		result: noDocFound,
+	},
+	{
+		name: `findOneAndDelete with unsupported option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1},{"_id":2}]`},
+			"query":  {`db.collection.findOneAndDelete({"_id":2},{"returnDocument":"after"})`},
+		},
+		result: `unsupported option 'returnDocument' in findOneAndDelete(), supported options are: sort, projection`,
+	},
+	{
+		name: `findOneAndUpdate with unsupported option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1}]`},
+			"query":  {`db.collection.findOneAndUpdate({"_id":1},{"$inc":{"k":1}},{"new":true})`},
+		},
+		result: `unsupported option 'new' in findOneAndUpdate(), supported options are: upsert, returnDocument, returnNewDocument, sort, projection, arrayFilters`,
+	},
+	{
+		name: `bulkWrite`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":2}]`},
+			"query":  {`db.collection.bulkWrite([{"insertOne":{"document":{"_id":3}}},{"updateOne":{"filter":{"_id":1},"update":{"$set":{"k":10}}}},{"deleteOne":{"filter":{"_id":2}}}])`},
+		},
+		result: `[{"_id":1,"k":10},{"_id":3}]`,
+	},
+	{
+		name: `bulkWrite unsupported operation`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.bulkWrite([{"insert":{"document":{"_id":3}}}])`},
+		},
+		result: `unsupported operation 'insert' in bulkWrite(), supported operations are: insertOne, updateOne, updateMany, replaceOne, deleteOne, deleteMany`,
+	},
+	{
+		name: `find with sort and limit`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":3},{"_id":2,"k":1},{"_id":3,"k":2}]`},
+			"query":  {`db.collection.find({}).sort({"k":1}).limit(2)`},
+		},
+		result:    `[{"_id":2,"k":1},{"_id":3,"k":2}]`,
+		dbCreated: true,
+	},
+	{
+		name: `find with sort skip and limit on several lines`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1},{"_id":2},{"_id":3},{"_id":4}]`},
+			"query": {`db.collection.find()
+  .sort({"_id":-1})
+  .skip(1)
+  .limit(2)`},
+		},
+		result:    `[{"_id":3},{"_id":2}]`,
+		dbCreated: true,
+	},
+	{
+		name: `find with projection()`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1,"n":2}]`},
+			"query":  {`db.collection.find().projection({"_id":0,"n":1})`},
+		},
+		result:    `[{"n":2}]`,
+		dbCreated: true,
+	},
+	{
+		name: `find with count`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":1},{"_id":3,"k":2}]`},
+			"query":  {`db.collection.find({"k":1}).limit(1).count()`},
+		},
+		result:    `2`,
+		dbCreated: true,
+	},
+	{
+		name: `find with count applying skip and limit`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":1},{"_id":3,"k":3}]`},
+			"query":  {`db.collection.find({"k":1}).limit(1).count(true)`},
+		},
+		result:    `1`,
+		dbCreated: true,
+	},
+	{
+		name: `find with parenthesis in string`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":"a)b"},{"_id":2,"k":"c"}]`},
+			"query":  {`db.collection.find({"k":"a)b"}).limit(5)`},
+		},
+		result:    `[{"_id":1,"k":"a)b"}]`,
+		dbCreated: true,
+	},
+	{
+		name: `find with unsupported cursor method`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.find().pretty()`},
+		},
+		result: "error in query:\n  unsupported method pretty() after find(), supported methods are: sort, skip, limit, collation, hint, min, max, projection, count",
+	},
+	{
+		name: `cursor method after aggregate`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.aggregate([]).sort({"k":1})`},
+		},
+		result: "error in query:\n  sort() can't be chained after aggregate()",
+	},
+	{
+		name: `explain executionStats before find with sort`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":17},{"_id":2,"k":18}]`},
+			"query":  {`db.collection.explain("executionStats").find({"k":17}).sort({"k":-1}).limit(1)`},
+		},
+		result:    `{"command":{"$db":"1aa4f359b55c97f380460f7e0efb674a","filter":{"k":17},"find":"collection","limit":NumberLong(1),"maxTimeMS":NumberLong(20000),"projection":{},"sort":{"k":-1}},`,
+		dbCreated: true,
+	},
+	{
+		name: `aggregation with collation option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":"B"},{"_id":2,"k":"a"}]`},
+			"query":  {`db.collection.aggregate([{"$sort":{"k":1}}], {"collation":{"locale":"en","strength":2}})`},
+		},
+		result:    `[{"_id":2,"k":"a"},{"_id":1,"k":"B"}]`,
+		dbCreated: true,
+	},
+	{
+		name: `aggregation with let option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":"let"}]`},
+			"query":  {`db.collection.aggregate([{"$project":{"_id":0,"v":"$$x"}}], {"let":{"x":5}})`},
+		},
+		result:    `[{"v":5}]`,
+		dbCreated: true,
+	},
+	{
+		name: `aggregation with unsupported option`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.aggregate([], {"explain":true})`},
+		},
+		result: "error in query:\n  unsupported option 'explain' in aggregate(), supported options are: allowDiskUse, collation, comment, hint, let, maxTimeMS",
+	},
+	{
+		name: `script with several statements`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query": {`db.collection.insertOne({"_id":2});
+db.collection.updateOne({"_id":1},{"$set":{"k":1}})
+db.collection.find({"k":1})
+  .limit(1)`},
+		},
+		result: `[{"statement":"db.collection.insertOne({\"_id\":2})","result":[{"_id":1},{"_id":2}]},{"statement":"db.collection.updateOne({\"_id\":1},{\"$set\":{\"k\":1}})","result":[{"_id":1,"k":1},{"_id":2}]},{"statement":"db.collection.find({\"k\":1})\n  .limit(1)","result":[{"_id":1,"k":1}]}]`,
+	},
+	{
+		name: `script with empty result`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.deleteMany({});db.collection.countDocuments()`},
+		},
+		result: `[{"statement":"db.collection.deleteMany({})","result":[]},{"statement":"db.collection.countDocuments()","result":0}]`,
+	},
+	{
+		name: `script with invalid statement`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.find();db.collection.find().pretty()`},
+		},
+		result: "error in query:\n  statement 2: unsupported method pretty() after find(), supported methods are: sort, skip, limit, collation, hint, min, max, projection, count",
+	},
+	{
+		name: `script with failing statement`,
+		params: url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1}]`},
+			"query":  {`db.collection.find();db.collection.distinct()`},
+		},
+		result: "error in statement 2:\n  distinct() requires a field name as first argument, for example: distinct(\"field\")",
+	},
 	{
 		name: `fuzz entry 1`,
 		params: url.Values{
@@ -780,7 +1259,7 @@ var runTests = []runTest{
 			"config": {`[{"key":5}]`},
 			"query":  {`..)(`},
 		},
-		result: "error in query:\n  query must match db.coll.find(...) or db.coll.aggregate(...) or db.coll.update()",
+		result: "error in query:\n  query must match db.coll.method(...), where method is one of: find, aggregate, count, countDocuments, estimatedDocumentCount, distinct, update, updateOne, updateMany, replaceOne, insertOne, insertMany, deleteOne, deleteMany, bulkWrite, findOneAndUpdate, findOneAndReplace, findOneAndDelete",
 	},
 }
 
@@ -825,9 +1304,14 @@ func TestRunCreateDB(t *testing.T) {
 		if tt.result == errPlaygroundToBig || strings.HasPrefix(tt.result, "error in query") {
 			continue
 		}
-		// if it's an update, the db should be dropped when the query ends, and no entry
-		// should be kept in cache
-		if strings.Contains(tt.params["query"][0], ".update(") {
+		// if it's a write query, the db should be dropped when the query ends, and no entry
+		// should be kept in cache.
+		// Same goes for scripts with several statements
+		query := []byte(tt.params["query"][0])
+		if len(splitStatements(query)) > 1 {
+			continue
+		}
+		if q, _ := parseQuery(query); q != nil && writeMethods[q.method] {
 			continue
 		}
 		cacheSize++
@@ -861,7 +1345,7 @@ func TestRunExistingDB(t *testing.T) {
 	// there should be only one DB created, and it should be present in the
 	// cache.
 	DBHash := p.dbHash()
-	_, ok := testStorage.activeDB.list[DBHash]
+	_, ok := testStorage.defaultBackend.activeDB.list[DBHash]
 	if !ok {
 		t.Errorf("dbCreated should contain DB %s", DBHash)
 	}
@@ -933,6 +1417,267 @@ func TestConsistentError(t *testing.T) {
 	}
 }
 
+func TestRunAggregationStages(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	// duration of each stage is not deterministic
+	durationRegex := regexp.MustCompile(`"durationMs":[0-9.]+`)
+
+	stagesTests := []struct {
+		name   string
+		query  string
+		result string
+	}{
+		{
+			name:   "pipeline with two stages",
+			query:  `db.collection.aggregate([{"$match":{"k":{"$gt":1}}},{"$project":{"_id":0}}])`,
+			result: `[{"stage":{"$match":{"k":{"$gt":1}}},"documents":[{"_id":2,"k":2},{"_id":3,"k":3}],"count":2,"durationMs":0},{"stage":{"$project":{"_id":0}},"documents":[{"k":2},{"k":3}],"count":2,"durationMs":0}]`,
+		},
+		{
+			name:   "stage returning no document",
+			query:  `db.collection.aggregate([{"$match":{"k":0}},{"$count":"n"}])`,
+			result: `[{"stage":{"$match":{"k":0}},"documents":[],"count":0,"durationMs":0},{"stage":{"$count":"n"},"documents":[],"count":0,"durationMs":0}]`,
+		},
+		{
+			name:   "invalid stage",
+			query:  `db.collection.aggregate([{"$match":{}},{"$project":"_id"}])`,
+			result: "query failed at stage 2: (Location15969) $project specification must be an object",
+		},
+		{
+			name:   "not an aggregation",
+			query:  `db.collection.find()`,
+			result: "output 'stages' is only supported for aggregate() queries without explain()",
+		},
+	}
+
+	for _, tt := range stagesTests {
+
+		params := url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":2},{"_id":3,"k":3}]`},
+			"query":  {tt.query},
+			"output": {stagesOutput},
+		}
+		got := durationRegex.ReplaceAllString(httpBody(t, runEndpoint, http.MethodPost, params), `"durationMs":0`)
+		if want := tt.result; want != got {
+			t.Errorf("%s: expected\n '%s'\n but got\n '%s'", tt.name, want, got)
+		}
+	}
+
+	params := url.Values{"mode": {"bson"}, "config": {`[]`}, "query": {templateQuery}, "output": {"unknown"}}
+	if want, got := errInvalidOutput, httpBody(t, runEndpoint, http.MethodPost, params); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+}
+
+func TestWithMaxTimeMS(t *testing.T) {
+
+	t.Parallel()
+
+	maxTimeTests := []struct {
+		name string
+		cmd  bson.D
+		want string
+	}{
+		{
+			name: "no maxTimeMS",
+			cmd:  bson.D{{Key: "aggregate", Value: "collection"}},
+			want: "[{aggregate collection} {maxTimeMS 500}]",
+		},
+		{
+			name: "lower maxTimeMS",
+			cmd:  bson.D{{Key: "aggregate", Value: "collection"}, {Key: "maxTimeMS", Value: int64(100)}},
+			want: "[{aggregate collection} {maxTimeMS 100}]",
+		},
+		{
+			name: "greater maxTimeMS",
+			cmd:  bson.D{{Key: "aggregate", Value: "collection"}, {Key: "maxTimeMS", Value: int64(1000)}},
+			want: "[{aggregate collection} {maxTimeMS 500}]",
+		},
+	}
+
+	for _, tt := range maxTimeTests {
+		if got := fmt.Sprint(withMaxTimeMS(tt.cmd, 500)); tt.want != got {
+			t.Errorf("%s: expected %s but got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestRunWriteDiff(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	diffTests := []struct {
+		name   string
+		query  string
+		result string
+	}{
+		{
+			name:   "update one document",
+			query:  `db.collection.updateOne({"_id":1},{"$set":{"k":10,"n":1}})`,
+			result: `{"result":{"insertedCount":0,"matchedCount":1,"modifiedCount":1,"deletedCount":0,"upsertedCount":0},"diff":[{"_id":1,"status":"changed","addedFields":{"n":1},"changedFields":{"k":{"before":1,"after":10}}}],"documents":[{"_id":1,"k":10,"n":1},{"_id":2,"k":2}]}`,
+		},
+		{
+			name:   "unset a field",
+			query:  `db.collection.updateMany({},{"$unset":{"k":1}})`,
+			result: `{"result":{"insertedCount":0,"matchedCount":2,"modifiedCount":2,"deletedCount":0,"upsertedCount":0},"diff":[{"_id":1,"status":"changed","removedFields":{"k":1}},{"_id":2,"status":"changed","removedFields":{"k":2}}],"documents":[{"_id":1},{"_id":2}]}`,
+		},
+		{
+			name:   "update matching no document",
+			query:  `db.collection.update({"_id":3},{"$set":{"k":3}})`,
+			result: `{"result":{"insertedCount":0,"matchedCount":0,"modifiedCount":0,"deletedCount":0,"upsertedCount":0},"diff":[],"documents":[{"_id":1,"k":1},{"_id":2,"k":2}]}`,
+		},
+		{
+			name:   "upsert",
+			query:  `db.collection.updateOne({"_id":3},{"$set":{"k":3}},{"upsert":true})`,
+			result: `{"result":{"insertedCount":0,"matchedCount":0,"modifiedCount":0,"deletedCount":0,"upsertedCount":1,"upsertedId":3},"diff":[{"_id":3,"status":"added","document":{"_id":3,"k":3}}],"documents":[{"_id":1,"k":1},{"_id":2,"k":2},{"_id":3,"k":3}]}`,
+		},
+		{
+			name:   "delete",
+			query:  `db.collection.deleteMany({"k":{"$gt":1}})`,
+			result: `{"result":{"insertedCount":0,"matchedCount":0,"modifiedCount":0,"deletedCount":1,"upsertedCount":0},"diff":[{"_id":2,"status":"removed","document":{"_id":2,"k":2}}],"documents":[{"_id":1,"k":1}]}`,
+		},
+		{
+			name:   "bulkWrite",
+			query:  `db.collection.bulkWrite([{"insertOne":{"document":{"_id":3}}},{"replaceOne":{"filter":{"_id":1},"replacement":{"v":1}}}])`,
+			result: `{"result":{"insertedCount":1,"matchedCount":1,"modifiedCount":1,"deletedCount":0,"upsertedCount":0},"diff":[{"_id":1,"status":"changed","addedFields":{"v":1},"removedFields":{"k":1}},{"_id":3,"status":"added","document":{"_id":3}}],"documents":[{"_id":1,"v":1},{"_id":2,"k":2},{"_id":3}]}`,
+		},
+		{
+			name:   "invalid update",
+			query:  `db.collection.updateOne({},{"k":1})`,
+			result: "fail to run updateOne: update document must contain key beginning with '$'",
+		},
+		{
+			name:   "not a write query",
+			query:  `db.collection.find()`,
+			result: "output 'diff' is only supported for update, insert, delete, replace and bulkWrite queries without explain()",
+		},
+		{
+			name:   "findOneAndUpdate",
+			query:  `db.collection.findOneAndUpdate({"_id":1},{"$set":{"k":2}})`,
+			result: "output 'diff' is only supported for update, insert, delete, replace and bulkWrite queries without explain()",
+		},
+	}
+
+	for _, tt := range diffTests {
+
+		params := url.Values{
+			"mode":   {"bson"},
+			"config": {`[{"_id":1,"k":1},{"_id":2,"k":2}]`},
+			"query":  {tt.query},
+			"output": {diffOutput},
+		}
+		if want, got := tt.result, httpBody(t, runEndpoint, http.MethodPost, params); want != got {
+			t.Errorf("%s: expected\n '%s'\n but got\n '%s'", tt.name, want, got)
+		}
+	}
+}
+
+func TestRunResultLimits(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	// a single document unwound in 1500 documents, so more
+	// than one batch is needed to read the whole result
+	params := url.Values{
+		"mode":   {"bson"},
+		"config": {`[{"_id":1}]`},
+		"query":  {`db.collection.aggregate([{"$project":{"_id":0,"a":{"$range":[0,1500]}}},{"$unwind":"$a"}])`},
+	}
+	if want, got := 1500, strings.Count(httpBody(t, runEndpoint, http.MethodPost, params), `"a":`); want != got {
+		t.Errorf("expected %d documents but got %d", want, got)
+	}
+
+	defaultLimits := testStorage.resultLimits
+	defer func() { testStorage.resultLimits = defaultLimits }()
+
+	limitTests := []struct {
+		name   string
+		limits *ResultLimits
+		params url.Values
+		result string
+	}{
+		{
+			name:   "max docs reached",
+			limits: NewResultLimits(2, 0),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2},{"_id":3}]`}, "query": {`db.collection.find()`}},
+			result: `{"truncated":"results truncated at 2 documents","total":3,"documents":[{"_id":1},{"_id":2}]}`,
+		},
+		{
+			name:   "max bytes reached",
+			limits: NewResultLimits(0, 40),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2},{"_id":3}]`}, "query": {`db.collection.aggregate([{"$match":{}}])`}},
+			result: `{"truncated":"results truncated at 2 documents","total":3,"documents":[{"_id":1},{"_id":2}]}`,
+		},
+		{
+			name:   "single document bigger than max bytes",
+			limits: NewResultLimits(0, 20),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1,"k":"a string longer than the limit"}]`}, "query": {`db.collection.find()`}},
+			result: `{"truncated":"results truncated at 0 documents","total":1,"documents":[]}`,
+		},
+		{
+			name:   "limit not reached",
+			limits: NewResultLimits(3, 0),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2},{"_id":3}]`}, "query": {`db.collection.find({"_id":{"$gt":1}})`}},
+			result: `[{"_id":2},{"_id":3}]`,
+		},
+		{
+			name:   "aggregation stages",
+			limits: NewResultLimits(1, 0),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2}]`}, "query": {`db.collection.aggregate([{"$match":{}}])`}, "output": {stagesOutput}},
+			result: `[{"stage":{"$match":{}},"documents":[{"_id":1}],"count":2,"truncated":true,"durationMs":0}]`,
+		},
+		{
+			name:   "write diff",
+			limits: NewResultLimits(2, 0),
+			params: url.Values{"mode": {"bson"}, "config": {`[{"_id":1},{"_id":2},{"_id":3}]`}, "query": {`db.collection.updateOne({"_id":1},{"$set":{"k":1}})`}, "output": {diffOutput}},
+			result: `{"truncated":"collection truncated at 2 documents, only those documents are compared","result":{"insertedCount":0,"matchedCount":1,"modifiedCount":1,"deletedCount":0,"upsertedCount":0},"diff":[{"_id":1,"status":"changed","addedFields":{"k":1}}],"documents":[{"_id":1,"k":1},{"_id":2}]}`,
+		},
+	}
+
+	durationRegex := regexp.MustCompile(`"durationMs":[0-9.]+`)
+
+	for _, tt := range limitTests {
+
+		testStorage.resultLimits = tt.limits
+
+		got := durationRegex.ReplaceAllString(httpBody(t, runEndpoint, http.MethodPost, tt.params), `"durationMs":0`)
+		if want := tt.result; want != got {
+			t.Errorf("%s: expected\n '%s'\n but got\n '%s'", tt.name, want, got)
+		}
+	}
+}
+
+func TestRunWithVersion(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	version := testStorage.defaultBackend.version
+
+	params := url.Values{"mode": {"bson"}, "config": {`[{"_id":1}]`}, "query": {templateQuery}, "version": {version}}
+	if want, got := `[{"_id":1}]`, httpBody(t, runEndpoint, http.MethodPost, params); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	// the database is created on the requested backend
+	p, _ := newPage("bson", `[{"_id":1}]`, templateQuery, version)
+	if _, ok := testStorage.backends[version].activeDB.list[p.dbHash()]; !ok {
+		t.Errorf("DB %s should be in the cache of backend %s", p.dbHash(), version)
+	}
+
+	want := fmt.Sprintf("unsupported MongoDB version '1.0', available versions are: %s", version)
+	params.Set("version", "1.0")
+	if got := httpBody(t, runEndpoint, http.MethodPost, params); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+	if got := httpBody(t, saveEndpoint, http.MethodPost, params); want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+
+	testStorageContent(t, 1, 1, 0)
+}
+
 // for https://github.com/feliixx/mongoplayground/issues/120
 func TestUniqueBinaryUUID(t *testing.T) {
 
@@ -20,14 +20,18 @@ import (
 	"log"
 	"fmt"
 	"net/http"
-
-	"github.com/dgraph-io/badger/v2"
+	"strings"
 )
 
 // save the playground and return the playground url, which looks
 // like:
 //
 //	https://mongoplayground.net/p/nJhd-dhf3Ea
+//
+// if the playground was edited from a saved one, the id of the
+// original playground can be sent in the 'parent' field. An optional
+// title, description and comma separated list of tags can be sent in
+// the 'title', 'description' and 'tags' fields
 func (s *storage) saveHandler(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Cache-control", "no-transform")
@@ -37,13 +41,22 @@ func (s *storage) saveHandler(w http.ResponseWriter, r *http.Request) {
 		r.FormValue("mode"),
 		r.FormValue("config"),
 		r.FormValue("query"),
+		r.FormValue("version"),
 	)
+	if err == nil {
+		err = p.setMetadata(r.FormValue("title"), r.FormValue("description"), splitTags(r.Form["tags"]))
+	}
 	if err != nil {
 		w.Write([]byte(err.Error()))
 		return
 	}
+	// don't save a playground that can't be run
+	if _, err := s.backend(p.Version); err != nil {
+		w.Write([]byte(err.Error()))
+		return
+	}
 
-	id, err := s.save(p)
+	id, err := s.save(p, []byte(r.FormValue("parent")))
 	if err != nil {
 		w.Write([]byte(fmt.Errorf("fail to save playground: %w", err).Error()))
 		return
@@ -52,27 +65,35 @@ func (s *storage) saveHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "%sp/%s", r.Referer(), id)
 }
 
-func (s *storage) save(p *page) ([]byte, error) {
+// split tags sent as 'tags=a,b' or 'tags=a&tags=b'
+func splitTags(values []string) []string {
+	tags := make([]string, 0, len(values))
+	for _, v := range values {
+		tags = append(tags, strings.Split(v, ",")...)
+	}
+	return tags
+}
+
+// save the page. parent is the id of the page p was forked from, and
+// is ignored if empty or if it doesn't match any saved page. The parent
+// of a page can't change once the page is saved, see history()
+func (s *storage) save(p *page, parent []byte) ([]byte, error) {
 
 	key := p.ID()
 	// before saving, check if the playground is not already
 	// saved
-	alreadySaved := false
-	s.kvStore.View(func(txn *badger.Txn) error {
-		_, err := txn.Get(key)
-		// if the key is not found, an 'ErrKeyNotFound' is returned.
-		// hence if the error is nil, the playground is already saved
-		if err == nil {
-			alreadySaved = true
-		}
-		return nil
-	})
+	alreadySaved, _ := s.pageStore.Has(key)
 
 	if !alreadySaved {
 		val := p.encode()
-		err := s.kvStore.Update(func(txn *badger.Txn) error {
-			return txn.Set(key, val)
-		})
+		entries := []Entry{{Key: key, Value: val}}
+		entries = append(entries, searchEntries(key, p)...)
+
+		parentEntries, err := s.parentEntries(key, parent)
+		if err == nil {
+			entries = append(entries, parentEntries...)
+			err = s.pageStore.Put(entries...)
+		}
 		if err != nil {
 			log.Printf("fail to save page with id %s: %v", key, err)
 			return nil, err
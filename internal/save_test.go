@@ -133,7 +133,7 @@ func testPlaygroundStats(t *testing.T, nbMgoDatagen, nbBsonSingle, nbBsonMultipl
 
 	// reset saved playground metrics
 	savedPlaygroundSize.Reset()
-	computeSavedPlaygroundStats(testStorage.kvStore)
+	computeSavedPlaygroundStats(testStorage.pageStore)
 
 	resp := httptest.NewRecorder()
 	req, _ := http.NewRequest(http.MethodGet, metricsEndpoint, nil)
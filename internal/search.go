@@ -0,0 +1,395 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	searchEndpoint = "/search"
+
+	// the search index is stored in the page store next to the pages. For each
+	// term of a page, a key is stored:
+	//
+	//	search/<term>\x00<page id> -> weight of the term in the page
+	//
+	// so the pages matching a term can be listed with a prefix scan
+	searchPrefix = "search/"
+	// version of the index stored in the page store. Increment it when the
+	// way terms are extracted from a page changes, so the index
+	// is rebuilt on startup, see rebuildSearchIndex()
+	searchIndexVersion    = 3
+	searchIndexVersionKey = "search_index_version"
+
+	// weight of a term depending on where it was found in a page
+	titleWeight      = 5
+	tagWeight        = 5
+	collectionWeight = 3
+	operatorWeight   = 2
+	fieldWeight      = 2
+	methodWeight     = 1
+
+	minTermLength = 2
+	maxTermLength = 64
+	// max number of distinct terms indexed for a single page
+	maxTermsPerPage = 100
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// number of chars displayed around a match in a snippet
+	snippetContext = 60
+)
+
+// max number of index entries read by a single search
+var maxSearchCandidates = 10000
+
+// returned once maxSearchCandidates index entries have been read, as
+// the results would be incomplete
+var errSearchTooBroad = errors.New("search is too broad, please add more specific terms")
+
+type searchResult struct {
+	ID      string   `json:"id"`
+	Title   string   `json:"title,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Score   int      `json:"score"`
+	Snippet string   `json:"snippet"`
+}
+
+type searchResponse struct {
+	Query   string         `json:"query"`
+	Results []searchResult `json:"results"`
+}
+
+// search saved playgrounds matching all the terms of the query, like
+//
+//	/search?q=$lookup orders
+//
+// only playgrounds saved with a title or tags are indexed, so a playground
+// shared by its link alone can't be found. Words of the title, tags,
+// collection names, operators, stages, methods and field names are
+// indexed. Results are sorted by score, and returned like:
+//
+//	{"ok":true,"result":{"query":"$lookup orders","results":[{"id":"nJhd-dhf3Ea","title":"Join orders","score":10,"snippet":"Join orders"}]}}
+func (s *storage) searchHandler(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	query := strings.TrimSpace(r.FormValue("q"))
+	terms := queryTerms(query)
+	if len(terms) == 0 {
+		writeAPIError(w, newRunError(errKindInvalidRequest, "", errors.New("missing search terms in parameter 'q'")))
+		return
+	}
+
+	limit := defaultSearchLimit
+	if l, err := strconv.Atoi(r.FormValue("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	results, err := s.search(terms, limit)
+	if errors.Is(err, errSearchTooBroad) {
+		writeAPIError(w, newRunError(errKindInvalidRequest, "", err))
+		return
+	}
+	if err != nil {
+		writeAPIError(w, newRunError(errKindInternal, "", fmt.Errorf("fail to search playgrounds: %w", err)))
+		return
+	}
+	writeAPIResult(w, searchResponse{Query: query, Results: results})
+}
+
+func (s *storage) search(terms []string, limit int) ([]searchResult, error) {
+
+	matches := map[string]int{}
+	scores := map[string]int{}
+
+	// stop reading the index once maxSearchCandidates entries have been
+	// read, so a query made of common terms remains cheap
+	candidates := 0
+	for _, term := range terms {
+		prefix := searchKey(term, nil)
+		err := s.pageStore.Iterate(prefix, func(key, value []byte) error {
+			if candidates >= maxSearchCandidates {
+				return errSearchTooBroad
+			}
+			candidates++
+			id := string(key[len(prefix):])
+			weight, _ := binary.Uvarint(value)
+			matches[id]++
+			scores[id] += int(weight)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]string, 0, len(matches))
+	for id, n := range matches {
+		if n == len(terms) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	results := make([]searchResult, 0, len(ids))
+	for _, id := range ids {
+		p, err := s.loadPage([]byte(id))
+		if err != nil {
+			log.Printf("fail to load search result %s: %v", id, err)
+			continue
+		}
+		var tags []string
+		for _, tag := range p.Tags {
+			tags = append(tags, string(tag))
+		}
+		results = append(results, searchResult{
+			ID:      id,
+			Title:   string(p.Title),
+			Tags:    tags,
+			Score:   scores[id],
+			Snippet: snippet(p, terms),
+		})
+	}
+	return results, nil
+}
+
+// returns the key of a term in the search index
+func searchKey(term string, id []byte) []byte {
+	key := make([]byte, 0, len(searchPrefix)+len(term)+1+len(id))
+	key = append(key, searchPrefix...)
+	key = append(key, term...)
+	key = append(key, 0)
+	return append(key, id...)
+}
+
+// returns the entries adding the terms of a page to the search index. Pages
+// without title or tags are not indexed
+func searchEntries(id []byte, p *page) []Entry {
+	if len(p.Title) == 0 && len(p.Tags) == 0 {
+		return nil
+	}
+	terms := pageTerms(p)
+	entries := make([]Entry, 0, len(terms))
+	for term, weight := range terms {
+		entries = append(entries, Entry{Key: searchKey(term, id), Value: appendUvarint(nil, uint64(weight))})
+	}
+	return entries
+}
+
+// returns the terms of a page with their weight
+func pageTerms(p *page) map[string]int {
+
+	terms := map[string]int{}
+	add := func(term string, weight int) {
+		term = normalizeTerm(term)
+		if term == "" {
+			return
+		}
+		if _, ok := terms[term]; !ok && len(terms) >= maxTermsPerPage {
+			return
+		}
+		terms[term] += weight
+	}
+
+	for _, word := range words(string(p.Title)) {
+		add(word, titleWeight)
+	}
+	for _, tag := range p.Tags {
+		add(string(tag), tagWeight)
+	}
+	for _, name := range collectionNames(p) {
+		add(name, collectionWeight)
+	}
+	for _, statement := range splitStatements(p.Query) {
+		// parse a copy, so the stored page is never modified
+		if q, err := parseQuery(append([]byte(nil), statement...)); err == nil {
+			add(q.collectionName, collectionWeight)
+		}
+	}
+	// values of the query and of the config are not indexed
+	for _, source := range [][]byte{p.Query, p.Config} {
+		for _, line := range strings.Split(string(source), "\n") {
+			tokens := tokenize(line)
+			for i, t := range tokens {
+				switch t.kind {
+				case operatorToken:
+					add(t.text, operatorWeight)
+				case keyToken:
+					key := strings.Trim(t.text, `"'`)
+					if strings.HasPrefix(key, "$") {
+						add(key, operatorWeight)
+						continue
+					}
+					add(key, fieldWeight)
+					// index 'a.b' as well as 'a' and 'b'
+					if strings.Contains(key, ".") {
+						for _, part := range strings.Split(key, ".") {
+							add(part, fieldWeight)
+						}
+					}
+				case functionToken:
+					// skip functions like ObjectId()
+					if i > 0 && tokens[i-1].text == "." {
+						add(t.text, methodWeight)
+					}
+				}
+			}
+		}
+	}
+	return terms
+}
+
+// split a search query in normalized terms
+func queryTerms(query string) []string {
+	terms := make([]string, 0)
+	seen := map[string]bool{}
+	for _, field := range strings.Fields(query) {
+		term := normalizeTerm(strings.Trim(field, `"'.,;:()[]{}`))
+		if term != "" && !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func normalizeTerm(term string) string {
+	term = strings.ToLower(term)
+	if n := utf8.RuneCountInString(term); n < minTermLength || n > maxTermLength {
+		return ""
+	}
+	return term
+}
+
+// split a text in words made of letters and digits
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// returns the part of the page around the first match of a term, looking
+// successively in the title and the query. Values of the config are not
+// indexed and the description isn't either, so they are never shown
+func snippet(p *page, terms []string) string {
+
+	for _, source := range [][]byte{p.Title, p.Query} {
+
+		lower := bytes.ToLower(source)
+		for _, term := range terms {
+
+			i := bytes.Index(lower, []byte(term))
+			if i < 0 {
+				continue
+			}
+
+			start, end := i-snippetContext, i+len(term)+snippetContext
+			prefix, suffix := "…", "…"
+			if start <= 0 {
+				start, prefix = 0, ""
+			}
+			if end >= len(source) {
+				end, suffix = len(source), ""
+			}
+			// don't cut a multi-bytes char
+			for start > 0 && !utf8.RuneStart(source[start]) {
+				start--
+			}
+			for end < len(source) && !utf8.RuneStart(source[end]) {
+				end++
+			}
+			return prefix + strings.Join(strings.Fields(string(source[start:end])), " ") + suffix
+		}
+	}
+	return truncate(strings.Join(strings.Fields(string(p.Query)), " "), 2*snippetContext)
+}
+
+// rebuild the search index from all saved pages if the index was built
+// by a previous version of searchIndexVersion
+func rebuildSearchIndex(store PageStore) error {
+
+	version, err := store.Get([]byte(searchIndexVersionKey))
+	if err == nil && string(version) == strconv.Itoa(searchIndexVersion) {
+		return nil
+	}
+
+	log.Print("rebuilding search index...")
+
+	if err := store.Delete([]byte(searchPrefix)); err != nil {
+		return err
+	}
+
+	keys, err := listKeys(store, nil, isPageKey)
+	if err != nil {
+		return err
+	}
+
+	batch := newWriteBatch(store)
+	indexed := 0
+	for _, key := range keys {
+
+		val, err := store.Get(key)
+		if err != nil {
+			return err
+		}
+		p := &page{}
+		if err := p.decode(val); err != nil {
+			log.Printf("fail to decode page %s, skipping indexing: %v", key, err)
+			continue
+		}
+		if err := batch.put(searchEntries(key, p)...); err != nil {
+			return err
+		}
+		indexed++
+	}
+	err = batch.put(Entry{Key: []byte(searchIndexVersionKey), Value: []byte(strconv.Itoa(searchIndexVersion))})
+	if err != nil {
+		return err
+	}
+	if err := batch.flush(); err != nil {
+		return err
+	}
+
+	log.Printf("search index rebuilt from %d pages", indexed)
+	return nil
+}
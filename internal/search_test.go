@@ -0,0 +1,247 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPageTerms(t *testing.T) {
+
+	t.Parallel()
+
+	p := &page{
+		Mode:        bsonMode,
+		Config:      []byte(`db={"orders":[{"_id":1,"item":"a"}],"inventory":[{"sku":"a"}]}`),
+		Query:       []byte(`db.orders.aggregate([{"$lookup":{"from":"inventory","localField":"item","foreignField":"sku","as":"stock"}}])`),
+		Title:       []byte("Join orders with inventory"),
+		Description: []byte("Uses a *lookup* stage"),
+		Tags:        [][]byte{[]byte("aggregation")},
+	}
+
+	terms := pageTerms(p)
+
+	want := map[string]int{
+		"$lookup":     operatorWeight,
+		"localfield":  fieldWeight,
+		"sku":         fieldWeight,
+		"aggregate":   methodWeight,
+		"aggregation": tagWeight,
+		"with":        titleWeight,
+	}
+	for term, weight := range want {
+		if got := terms[term]; weight != got {
+			t.Errorf("expected weight %d for term %s but got %d", weight, term, got)
+		}
+	}
+	// found in the title and as a collection name
+	if got := terms["orders"]; got < titleWeight+collectionWeight {
+		t.Errorf("expected weight of at least %d for term orders but got %d", titleWeight+collectionWeight, got)
+	}
+	// too short, or not indexed
+	for _, term := range []string{"a", "lookup", "stage"} {
+		if _, ok := terms[term]; ok {
+			t.Errorf("term '%s' should not be indexed", term)
+		}
+	}
+
+	// a query ending with an escape char in an unterminated string
+	p.Query = []byte(`db.collection.find({"a":"x\`)
+	if got := pageTerms(p); got["find"] != methodWeight {
+		t.Errorf("expected method find to be indexed but got %v", got)
+	}
+
+	// only playgrounds with a title or tags are searchable
+	p.Title, p.Tags = nil, nil
+	if entries := searchEntries(p.ID(), p); len(entries) != 0 {
+		t.Errorf("page without title or tags should not be indexed, but got %d entries", len(entries))
+	}
+}
+
+func TestQueryTerms(t *testing.T) {
+
+	t.Parallel()
+
+	queryTests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{}},
+		{query: "  a  ", want: []string{}},
+		{query: "$Lookup orders", want: []string{"$lookup", "orders"}},
+		{query: `"orders", orders ($match)`, want: []string{"orders", "$match"}},
+	}
+
+	for _, tt := range queryTests {
+		if got := queryTerms(tt.query); !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%s: expected %v but got %v", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	save := func(query, title string) string {
+		params := url.Values{"mode": {"bson"}, "config": {templateConfig}, "query": {query}, "title": {title}}
+		return strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, params), "p/")
+	}
+
+	lookup := save(`db.orders.aggregate([{"$lookup":{"from":"collection","localField":"k","foreignField":"k","as":"joined"}}])`, "Join")
+	match := save(`db.orders.aggregate([{"$match":{"status":"A"}}])`, "Filter orders by status")
+	count := save(`db.collection.count()`, "Number of documents")
+	save(`db.collection.find({"$expr":{"$gt":["$a","$b"]}})`, "")
+
+	searchTests := []struct {
+		name    string
+		query   string
+		want    []string
+		snippet string
+	}{
+		{
+			name:    "operator",
+			query:   "$lookup",
+			want:    []string{lookup},
+			snippet: `db.orders.aggregate([{"$lookup":`,
+		},
+		{
+			name:    "collection",
+			query:   "orders",
+			want:    []string{match, lookup},
+			snippet: "Filter orders by status",
+		},
+		{
+			name:  "all terms must match",
+			query: "orders $match",
+			want:  []string{match},
+		},
+		{
+			name:  "field",
+			query: "STATUS",
+			want:  []string{match},
+		},
+		{
+			name:  "method",
+			query: "count",
+			want:  []string{count},
+		},
+		{
+			name:  "playground without title or tags",
+			query: "$expr",
+			want:  []string{},
+		},
+		{
+			name:  "no match",
+			query: "$lookup $match",
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range searchTests {
+
+		resp := apiRequestRecorder(http.MethodGet, searchEndpoint+"?q="+url.QueryEscape(tt.query), "")
+		if want, got := http.StatusOK, resp.Code; want != got {
+			t.Errorf("%s: expected response code %d but got %d", tt.name, want, got)
+			continue
+		}
+
+		var body struct {
+			Result searchResponse `json:"result"`
+		}
+		if err := json.Unmarshal(resp.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]string, 0)
+		for _, r := range body.Result.Results {
+			got = append(got, r.ID)
+		}
+		if !reflect.DeepEqual(tt.want, got) {
+			t.Errorf("%s: expected %v but got %v", tt.name, tt.want, got)
+		}
+		if tt.snippet != "" && len(body.Result.Results) > 0 && !strings.Contains(body.Result.Results[0].Snippet, tt.snippet) {
+			t.Errorf("%s: expected snippet to contain %s but got %s", tt.name, tt.snippet, body.Result.Results[0].Snippet)
+		}
+	}
+
+	resp := apiRequestRecorder(http.MethodGet, searchEndpoint+"?q=", "")
+	if want, got := http.StatusBadRequest, resp.Code; want != got {
+		t.Errorf("expected response code %d but got %d", want, got)
+	}
+
+	// results would be incomplete
+	defer func(max int) { maxSearchCandidates = max }(maxSearchCandidates)
+	maxSearchCandidates = 1
+	resp = apiRequestRecorder(http.MethodGet, searchEndpoint+"?q=orders", "")
+	if want, got := http.StatusBadRequest, resp.Code; want != got {
+		t.Errorf("expected response code %d but got %d", want, got)
+	}
+	if !strings.Contains(resp.Body.String(), errSearchTooBroad.Error()) {
+		t.Errorf("expected error %s but got %s", errSearchTooBroad, resp.Body.String())
+	}
+}
+
+func TestRebuildSearchIndex(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	// pages saved before the search index was added
+	p := &page{Mode: bsonMode, Config: []byte(templateConfig), Query: []byte(`db.collection.find({"$expr":{"$gt":["$a","$b"]}})`), Title: []byte("Compare fields")}
+	err := testStorage.pageStore.Delete([]byte(searchIndexVersionKey))
+	if err == nil {
+		err = testStorage.pageStore.Put(Entry{Key: p.ID(), Value: p.encode()})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := testStorage.search([]string{"$expr"}, defaultSearchLimit)
+	if err != nil || len(results) != 0 {
+		t.Fatalf("page should not be indexed yet, but got %v, %v", results, err)
+	}
+
+	if err := rebuildSearchIndex(testStorage.pageStore); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err = testStorage.search([]string{"$expr"}, defaultSearchLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != string(p.ID()) {
+		t.Errorf("expected page %s in results but got %v", p.ID(), results)
+	}
+
+	// the index is up to date, so it's not rebuilt
+	err = testStorage.pageStore.Delete([]byte(searchPrefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rebuildSearchIndex(testStorage.pageStore); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = testStorage.search([]string{"$expr"}, defaultSearchLimit)
+	if len(results) != 0 {
+		t.Errorf("index should not be rebuilt, but got %v", results)
+	}
+}
@@ -32,6 +32,9 @@ const (
 	viewEndpoint       = "/p/"
 	runEndpoint        = "/run"
 	saveEndpoint       = "/save"
+	compareEndpoint    = "/compare"
+	embedEndpoint      = "/embed/"
+	oembedEndpoint     = "/oembed"
 	staticEndpoint     = "/static/"
 	metricsEndpoint    = "/metrics"
 	healthEndpoint     = "/health"
@@ -44,11 +47,33 @@ const (
 	errInternalServerError = "Internal server error.\n  Please file an issue here:\n\n  https://github.com/feliixx/mongoplayground/issues"
 )
 
+// ServerOptions holds the configuration of the server. Nil limits are
+// replaced by their default values, and a nil CloudflareInfo, MailInfo
+// or GoogleDriveInfo disables the corresponding service
+type ServerOptions struct {
+	MongoURI string
+	// additional MongoDB backends, keyed by version
+	MongoURIs map[string]string
+	// drop all existing databases on startup
+	DropFirst bool
+	// max size in bytes of the cached databases
+	CacheMaxSize int64
+	PageStore    PageStore
+
+	CloudflareInfo  *CloudflareInfo
+	MailInfo        *MailInfo
+	GoogleDriveInfo *GoogleDriveInfo
+
+	ResultLimits *ResultLimits
+	RateLimits   *RateLimits
+	QueueLimits  *QueueLimits
+}
+
 // NewServer initialize a badger and a mongodb connection,
 // and return an http server
-func NewServer(mongoUri string, dropFirst bool, cloudflareInfo *CloudflareInfo, mailInfo *MailInfo, googleDriveInfo *GoogleDriveInfo) (*http.Server, error) {
+func NewServer(opts ServerOptions) (*http.Server, error) {
 
-	storage, err := newStorage(mongoUri, dropFirst, cloudflareInfo, mailInfo, googleDriveInfo)
+	storage, err := newStorage(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +86,15 @@ func newHttpServerWithStorage(storage *storage) *http.Server {
 
 	mux.HandleFunc(homeEndpoint, storage.homeHandler)
 	mux.HandleFunc(viewEndpoint, storage.viewHandler)
-	mux.HandleFunc(runEndpoint, storage.runHandler)
-	mux.HandleFunc(saveEndpoint, storage.saveHandler)
+	mux.HandleFunc(runEndpoint, storage.rateLimit(storage.runHandler))
+	mux.HandleFunc(saveEndpoint, storage.rateLimit(storage.saveHandler))
+	mux.HandleFunc(compareEndpoint, storage.rateLimit(storage.compareHandler))
+	mux.HandleFunc(apiRunEndpoint, storage.rateLimit(storage.apiRunHandler))
+	mux.HandleFunc(apiSaveEndpoint, storage.rateLimit(storage.apiSaveHandler))
+	mux.HandleFunc(apiPageEndpoint, storage.apiPageHandler)
+	mux.HandleFunc(embedEndpoint, storage.embedHandler)
+	mux.HandleFunc(oembedEndpoint, storage.oembedHandler)
+	mux.HandleFunc(searchEndpoint, storage.rateLimit(storage.searchHandler))
 	mux.HandleFunc(healthEndpoint, storage.healthHandler)
 	mux.HandleFunc(clearCacheEndpoint, storage.cloudflareInfo.clearCacheHandler)
 	mux.HandleFunc(staticEndpoint, newStaticContent().staticHandler)
@@ -93,7 +125,12 @@ func latencyAndPanicObserver(handler http.Handler, mailInfo *MailInfo) http.Hand
 
 		// unsafe-inline is needed for style-src because of ace.js
 		// allow js script from static.cloudflareinsights.com for web analytics
-		w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'self' static.cloudflareinsights.com; style-src 'self' 'unsafe-inline'; img-src 'self' data:")
+		csp := "default-src 'self'; script-src 'self' static.cloudflareinsights.com; style-src 'self' 'unsafe-inline'; img-src 'self' data:"
+		// embedded playgrounds can be displayed in an iframe on any site
+		if strings.HasPrefix(r.URL.Path, embedEndpoint) {
+			csp += "; frame-ancestors *"
+		}
+		w.Header().Set("Content-Security-Policy", csp)
 		w.Header().Set("Strict-Transport-Security", "max-age=63072000; includeSubDomains; preload")
 		w.Header().Set("X-Content-Type-Options", "nosniff")
 
@@ -104,11 +141,22 @@ func latencyAndPanicObserver(handler http.Handler, mailInfo *MailInfo) http.Hand
 			label = staticEndpoint
 		} else if strings.HasPrefix(label, viewEndpoint) {
 			label = viewEndpoint
+		} else if strings.HasPrefix(label, apiPageEndpoint) {
+			label = apiPageEndpoint
+		} else if strings.HasPrefix(label, embedEndpoint) {
+			label = embedEndpoint
 		}
 
 		if label != viewEndpoint &&
 			label != runEndpoint &&
 			label != saveEndpoint &&
+			label != compareEndpoint &&
+			label != apiRunEndpoint &&
+			label != apiSaveEndpoint &&
+			label != apiPageEndpoint &&
+			label != embedEndpoint &&
+			label != oembedEndpoint &&
+			label != searchEndpoint &&
 			label != staticEndpoint &&
 			label != healthEndpoint &&
 			label != metricsEndpoint {
@@ -58,17 +58,20 @@ func TestMain(m *testing.M) {
 
 	log.SetOutput(os.Stdout)
 
-	os.MkdirTemp(os.TempDir(), "storage")
 	os.MkdirTemp(os.TempDir(), "backups")
 
 	var err error
-	testStorage, err = newStorage("mongodb://localhost:27017", true, nil, nil, nil)
+	testStorage, err = newStorage(ServerOptions{
+		MongoURI:  "mongodb://localhost:27017",
+		DropFirst: true,
+		PageStore: newMemoryStore(),
+	})
 	if err != nil {
 		fmt.Printf("aborting: %v\n", err)
 		os.Exit(1)
 	}
-	defer testStorage.mongoSession.Disconnect(context.Background())
-	defer testStorage.kvStore.Close()
+	defer testStorage.defaultBackend.mongoSession.Disconnect(context.Background())
+	defer testStorage.pageStore.Close()
 
 	testServer = newHttpServerWithStorage(testStorage)
 
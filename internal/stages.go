@@ -0,0 +1,109 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/feliixx/mongoextjson"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// output of a pipeline truncated after a specific stage
+type stageResult struct {
+	Stage     any    `json:"stage"`
+	Documents bson.A `json:"documents"`
+	// number of documents output by the stage, even if
+	// the returned documents are truncated
+	Count int64 `json:"count"`
+	// true if the stage outputs more documents than
+	// the returned ones, see ResultLimits
+	Truncated  bool    `json:"truncated,omitempty"`
+	DurationMs float64 `json:"durationMs"`
+}
+
+// run an aggregation pipeline once for each of its stages, truncated
+// after this stage, so a whole pipeline can be debugged in one request.
+// The result looks like:
+//
+//	[{"stage":{"$match":{k:1}},"documents":[{_id:1,k:1}],"count":1,"durationMs":0.9},{"stage":{"$project":{_id:0}},"documents":[{k:1}],"count":1,"durationMs":0.8}]
+func runStages(ctx context.Context, collection *mongo.Collection, q *parsedQuery, limits *ResultLimits) ([]byte, error) {
+
+	pipeline := sanitizeAggregationStages(q.stages)
+	results := make([]stageResult, 0, len(pipeline))
+
+	// the pipeline is run once per stage, so the whole run and not
+	// each stage has to fit in maxQueryTime
+	ctx, cancel := context.WithTimeout(ctx, maxQueryTime)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	for i := range pipeline {
+
+		remaining := time.Until(deadline).Milliseconds()
+		if remaining <= 0 {
+			return nil, fmt.Errorf("query failed at stage %d: %v", i+1, context.DeadlineExceeded)
+		}
+		cmd := withMaxTimeMS(aggregateCommand(collection, pipeline[:i+1], q.cmdOpts), remaining)
+
+		start := time.Now()
+		cursor, err := collection.Database().RunCommandCursor(ctx, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("query failed at stage %d: %v", i+1, err)
+		}
+		docs, truncated, err := limits.readCursor(ctx, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("query failed at stage %d: %v", i+1, err)
+		}
+		duration := time.Since(start)
+
+		count := int64(len(docs))
+		if truncated {
+			count, err = countResults(ctx, collection.Database(), cmd)
+			if err != nil {
+				return nil, fmt.Errorf("fail to count results at stage %d: %v", i+1, err)
+			}
+		}
+
+		results = append(results, stageResult{
+			Stage:      pipeline[i],
+			Documents:  docs,
+			Count:      count,
+			Truncated:  truncated,
+			DurationMs: float64(duration.Microseconds()) / 1000,
+		})
+	}
+	return mongoextjson.Marshal(results)
+}
+
+// set the maxTimeMS option of cmd to maxTimeMS, unless
+// it's already set to a lower value
+func withMaxTimeMS(cmd bson.D, maxTimeMS int64) bson.D {
+	for i, e := range cmd {
+		if e.Key != "maxTimeMS" {
+			continue
+		}
+		if n, ok := e.Value.(int64); !ok || n > maxTimeMS {
+			cmd[i].Value = maxTimeMS
+		}
+		return cmd
+	}
+	return append(cmd, bson.E{Key: "maxTimeMS", Value: maxTimeMS})
+}
@@ -30,7 +30,7 @@ import (
 const gzipEncoding = "gzip"
 
 var (
-	//go:embed web/static web/src/playground.html
+	//go:embed web/static web/src/playground.html web/src/embed.html web/src/history.html
 	assets embed.FS
 
 	// regex to match a md5 hash
@@ -85,6 +85,7 @@ func newStaticContent() *staticContent {
 			"playground-min.js":  newResource("web/static/playground-min.js", "application/javascript; charset=utf-8", true),
 			"docs.html":          newResource("web/static/docs.html", "text/html; charset=utf-8", true),
 			"about.html":         newResource("web/static/about.html", "text/html; charset=utf-8", true),
+			"embed.js":           newResource("web/static/embed.js", "application/javascript; charset=utf-8", true),
 		},
 	}
 }
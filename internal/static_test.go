@@ -17,7 +17,10 @@
 package internal
 
 import (
+	"crypto/md5"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -59,6 +62,12 @@ func TestStaticHandlers(t *testing.T) {
 			contentType:  "application/javascript; charset=utf-8",
 			responseCode: http.StatusOK,
 		},
+		{
+			name:         "embed js with md5 hash",
+			url:          "/static/embed-2c7d7455b8f36b6d3e32cbb456ad0311.js",
+			contentType:  "application/javascript; charset=utf-8",
+			responseCode: http.StatusOK,
+		},
 		{
 			name:         "favicon",
 			url:          "/static/favicon.png",
@@ -89,3 +98,35 @@ func TestStaticHandlers(t *testing.T) {
 		})
 	}
 }
+
+// bundles are generated by web/bundle.sh, which also sets their hash in
+// the html pages. Bundles edited by hand don't match their hash anymore
+func TestBundleHash(t *testing.T) {
+
+	t.Parallel()
+
+	bundleTests := []struct {
+		page   string
+		bundle string
+		name   string
+	}{
+		{page: "web/src/playground.html", bundle: "web/static/playground-min.js", name: "playground-min-%x.js"},
+		{page: "web/src/playground.html", bundle: "web/static/playground-min.css", name: "playground-min-%x.css"},
+		{page: "web/src/embed.html", bundle: "web/static/embed.js", name: "embed-%x.js"},
+	}
+
+	for _, tt := range bundleTests {
+
+		page, err := assets.ReadFile(tt.page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bundle, err := assets.ReadFile(tt.bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name := fmt.Sprintf(tt.name, md5.Sum(bundle)); !strings.Contains(string(page), name) {
+			t.Errorf("%s should reference %s, run web/bundle.sh to regenerate it", tt.page, name)
+		}
+	}
+}
@@ -17,14 +17,12 @@
 package internal
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -35,62 +33,100 @@ const (
 	cleanupInterval = 10 * time.Minute
 	// how long a database is kept after its last use 
 	maxUnusedDuration = 6 * time.Hour
-	// interval between two Badger backup
+	// interval between two page store backup
 	backupInterval = 24 * time.Hour
 )
 
 type storage struct {
-	mongoSession *mongo.Client
-	mongoVersion []byte
-
-	kvStore *badger.DB
-	// local dir to store badger backups
+	// MongoDB servers playgrounds can be run against, keyed by version
+	backends map[string]*backend
+	// backend used when a playground doesn't request a specific version
+	defaultBackend *backend
+
+	// where saved playgrounds are persisted
+	pageStore PageStore
+	// local dir to store page store backups
 	backupDir           string
 	backupServiceStatus serviceInfo
 
-	activeDB *cache
-
 	mailInfo *MailInfo
 
 	cloudflareInfo *CloudflareInfo
 
 	googleDriveInfo *GoogleDriveInfo
+
+	resultLimits *ResultLimits
+
+	rateLimiter *rateLimiter
+	runQueue    *runQueue
 }
 
-func newStorage(mongoUri string, dropFirst bool, cloudflareInfo *CloudflareInfo, mailInfo *MailInfo, googleDriveInfo *GoogleDriveInfo) (*storage, error) {
+func newStorage(opts ServerOptions) (*storage, error) {
+
+	if opts.ResultLimits == nil {
+		opts.ResultLimits = NewResultLimits(0, 0)
+	}
+	if opts.RateLimits == nil {
+		opts.RateLimits = NewRateLimits(0, 0, 0, 0, false)
+	}
+	if opts.QueueLimits == nil {
+		opts.QueueLimits = NewQueueLimits(0, 0, 0)
+	}
+	rateLimiter := newRateLimiter(opts.RateLimits)
+	pageStore := opts.PageStore
 
-	session, err := createMongodbSession(mongoUri)
+	defaultBackend, backends, err := newBackends(opts.MongoURI, opts.MongoURIs, opts.CacheMaxSize)
 	if err != nil {
 		return nil, err
 	}
+	for _, b := range backends {
+		b.rateLimiter = rateLimiter
+	}
 
-	kvStore, err := badger.Open(badger.DefaultOptions("storage"))
+	migrated, err := migratePages(pageStore)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fail to migrate saved playgrounds: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("%d saved playgrounds migrated to the current format", migrated)
 	}
 
+	// the index may take a while to rebuild on a large store, so don't
+	// delay the startup of the server. Until then, search results are
+	// incomplete
+	go func(pageStore PageStore) {
+		if err := rebuildSearchIndex(pageStore); err != nil {
+			log.Printf("fail to rebuild search index: %v", err)
+		}
+	}(pageStore)
+
 	s := &storage{
-		mongoSession: session,
-		mongoVersion: getMongoVersion(session),
-		kvStore:      kvStore,
-		activeDB: &cache{
-			list: map[string]dbMetaInfo{},
-		},
-		backupDir: "backups",
+		backends:       backends,
+		defaultBackend: defaultBackend,
+		pageStore:      pageStore,
+		backupDir:      "backups",
 		backupServiceStatus: serviceInfo{
 			Name:   "backup",
 			Status: statusUp,
 		},
-		mailInfo:        mailInfo,
-		cloudflareInfo:  cloudflareInfo,
-		googleDriveInfo: googleDriveInfo,
+		mailInfo:        opts.MailInfo,
+		cloudflareInfo:  opts.CloudflareInfo,
+		googleDriveInfo: opts.GoogleDriveInfo,
+		resultLimits:    opts.ResultLimits,
+		rateLimiter:     rateLimiter,
+		runQueue:        newRunQueue(opts.QueueLimits),
 	}
 
-	if dropFirst {
+	if opts.DropFirst {
 		s.deleteExistingDB()
+	} else {
+		// reading all databases may take a while, so don't delay the
+		// startup of the server
+		go s.reconcileDB()
 	}
 
-	initPrometheusCounter(s.kvStore)
+	initPrometheusCounter(s.pageStore)
+	dbCacheMaxSize.Set(float64(opts.CacheMaxSize))
 
 	go func(s *storage) {
 		for range time.Tick(cleanupInterval) {
@@ -107,57 +143,56 @@ func newStorage(mongoUri string, dropFirst bool, cloudflareInfo *CloudflareInfo,
 	return s, nil
 }
 
-// delete all database having a name with 32 char
+// delete all database having a name with 32 char, on all backends
 func (s *storage) deleteExistingDB() error {
 
-	dbNames, err := s.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
-	if err != nil {
-		return err
+	for _, b := range s.backends {
+		if err := b.deleteExistingDB(); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	for _, name := range dbNames {
-		if len(name) == 32 {
-			log.Printf("Deleting db '%s'", name)
-			err = s.mongoSession.Database(name).Drop(context.Background())
-			if err != nil {
-				return err
-			}
+// add the databases created before a restart to the cache of each backend
+func (s *storage) reconcileDB() {
+
+	nbActiveDB := 0
+	for _, b := range s.backends {
+		restored, dropped, err := b.reconcileDB(time.Now())
+		if err != nil {
+			log.Printf("fail to reconcile databases of mongodb %s: %v", b.version, err)
 		}
+		log.Printf("mongodb %s: %d databases restored, %d dropped", b.version, restored, dropped)
+
+		b.activeDB.Lock()
+		nbActiveDB += len(b.activeDB.list)
+		b.activeDB.Unlock()
 	}
-	return nil
+	activeDatabasesCounter.Set(float64(nbActiveDB))
 }
 
 func (s *storage) removeUnusedDB() {
 
 	now := time.Now()
 
-	s.activeDB.Lock()
-	for name, info := range s.activeDB.list {
-
-		// if database creation failed, always remove it from the cache 
-		// as soon as possible, to prevent temporary error due to MongoDB 
-		// from being kept for too long.
-		if info.err != nil {
-			delete(s.activeDB.list, name)
-		}
+	nbActiveDB := 0
+	for _, b := range s.backends {
+		b.removeUnusedDB(now)
+		b.writeMarkers()
 
-		if now.Sub(time.Unix(info.lastUsed, 0)) > maxUnusedDuration {
-			if info.err == nil {
-				err := s.mongoSession.Database(name).Drop(context.Background())
-				if err != nil {
-					log.Printf("fail to drop database %v: %v", name, err)
-				}
-			}
-			delete(s.activeDB.list, name)
-		}
+		b.activeDB.Lock()
+		nbActiveDB += len(b.activeDB.list)
+		b.activeDB.Unlock()
 	}
-	s.activeDB.Unlock()
+
+	s.rateLimiter.cleanup(now)
 
 	cleanupDuration.Set(time.Since(now).Seconds())
-	activeDatabasesCounter.Set(float64(len(s.activeDB.list)))
+	activeDatabasesCounter.Set(float64(nbActiveDB))
 }
 
-// create a backup from the badger db, and store it in backupDir.
+// create a backup from the page store, and store it in backupDir.
 // keep a backup of last seven days only. Older backups are
 // overwritten
 // upload the last backup to google drive. Previous backup is moved to trash
@@ -170,9 +205,9 @@ func (s *storage) backup() {
 		os.Mkdir(s.backupDir, os.ModePerm)
 	}
 
-	fileName := fmt.Sprintf("%s/badger_%d.bak", s.backupDir, time.Now().Weekday())
+	fileName := fmt.Sprintf("%s/%s_%d.bak", s.backupDir, pageStoreKind(s.pageStore), time.Now().Weekday())
 
-	err := localBackup(s.kvStore, fileName)
+	err := localBackup(s.pageStore, fileName)
 	if err != nil {
 		s.handleBackupError("error in local backup", err)
 		return
@@ -190,11 +225,15 @@ func (s *storage) backup() {
 	s.backupServiceStatus.Cause = ""
 
 	// as backup() run once a day, also update the mongodb
-	// server version ( in case the cluster has automatically
+	// server versions ( in case a cluster has automatically
 	// been upgraded )
-	currentMongoVersion := getMongoVersion(s.mongoSession)
-	if !bytes.Equal(currentMongoVersion, s.mongoVersion) && s.cloudflareInfo != nil {
-		s.mongoVersion = currentMongoVersion
+	versionChanged := false
+	for _, b := range s.backends {
+		if b.refreshMongoVersion() {
+			versionChanged = true
+		}
+	}
+	if versionChanged && s.cloudflareInfo != nil {
 		s.cloudflareInfo.clearCloudflareCache()
 	}
 }
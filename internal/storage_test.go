@@ -23,10 +23,10 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 	"time"
 
-	"github.com/dgraph-io/badger/v2"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -35,8 +35,8 @@ func TestDeleteExistingDB(t *testing.T) {
 
 	defer clearDatabases(t)
 
-	p, _ := newPage("", "", "")
-	testStorage.mongoSession.
+	p, _ := newPage("", "", "", "")
+	testStorage.defaultBackend.mongoSession.
 		Database(p.dbHash()).
 		Collection("c").
 		InsertOne(context.Background(), bson.M{"_id": 1})
@@ -64,11 +64,11 @@ func TestRemoveExpiredDB(t *testing.T) {
 
 	// this db should be removed: too old
 	DBHash := p.dbHash()
-	testStorage.activeDB.Lock()
-	dbInfo := testStorage.activeDB.list[DBHash]
+	testStorage.defaultBackend.activeDB.Lock()
+	dbInfo := testStorage.defaultBackend.activeDB.list[DBHash]
 	dbInfo.lastUsed = time.Now().Add(-maxUnusedDuration).Unix()
-	testStorage.activeDB.list[DBHash] = dbInfo
-	testStorage.activeDB.Unlock()
+	testStorage.defaultBackend.activeDB.list[DBHash] = dbInfo
+	testStorage.defaultBackend.activeDB.Unlock()
 
 	// this db should be removed: creation error
 	params = url.Values{"mode": {"mgodatagen"}, "config": {"[{}]"}, "query": {templateQuery}}
@@ -90,12 +90,12 @@ func TestRemoveExpiredDB(t *testing.T) {
 
 	testStorage.removeUnusedDB()
 
-	_, ok := testStorage.activeDB.list[DBHash]
+	_, ok := testStorage.defaultBackend.activeDB.list[DBHash]
 	if ok {
 		t.Errorf("DB %s should not be present in activeDB", DBHash)
 	}
 
-	dbNames, err := testStorage.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
+	dbNames, err := testStorage.defaultBackend.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -106,6 +106,88 @@ func TestRemoveExpiredDB(t *testing.T) {
 	testStorageContent(t, 1, 1, 0)
 }
 
+func TestReconcileDB(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {"[{_id:1}]"}, "query": {templateQuery}}
+	want := `[{"_id":1}]`
+	got := httpBody(t, runEndpoint, http.MethodPost, params)
+	if want != got {
+		t.Errorf("expected %s but got %s", want, got)
+	}
+	cached := (&page{Mode: bsonMode, Config: []byte("[{_id:1}]")}).dbHash()
+
+	session := testStorage.defaultBackend.mongoSession
+	ctx := context.Background()
+
+	markers := testStorage.defaultBackend.markers()
+
+	dbHash := func(config string) string {
+		return (&page{Mode: bsonMode, Config: []byte(config)}).dbHash()
+	}
+
+	// created before markers were written
+	unmarked := session.Database(dbHash("[{_id:2}]"))
+	unmarked.Collection("collection").InsertOne(ctx, bson.M{"_id": 1})
+
+	// left by a write query
+	orphaned := session.Database(uniqueDBHash())
+	orphaned.Collection("collection").InsertOne(ctx, bson.M{"_id": 1})
+
+	expired := session.Database(dbHash("[{_id:3}]"))
+	expired.Collection("collection").InsertOne(ctx, bson.M{"_id": 1})
+	writeMarker(ctx, markers, expired.Name(), time.Now().Add(-maxUnusedDuration-time.Hour).Unix())
+
+	corrupted := session.Database(dbHash("[{_id:4}]"))
+	corrupted.Collection("collection").InsertOne(ctx, bson.M{"_id": 1})
+	markers.InsertOne(ctx, bson.M{"_id": corrupted.Name(), "lastUsed": "yesterday"})
+
+	// the database was dropped, but not its marker
+	writeMarker(ctx, markers, dbHash("[{_id:5}]"), time.Now().Unix())
+
+	// as if the server was restarted
+	testStorage.defaultBackend.activeDB = newCache(0)
+
+	restored, dropped, err := testStorage.defaultBackend.reconcileDB(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored != 2 || dropped != 3 {
+		t.Errorf("expected 2 restored and 3 dropped databases, but got %d and %d", restored, dropped)
+	}
+	if _, ok := testStorage.defaultBackend.activeDB.list[orphaned.Name()]; ok {
+		t.Errorf("database %s left by a write query should be dropped", orphaned.Name())
+	}
+
+	info, ok := testStorage.defaultBackend.activeDB.list[cached]
+	if !ok || !info.ready || !info.hasCollection("collection") {
+		t.Errorf("database %s should be restored in cache, but got %+v", cached, info)
+	}
+	// databases without marker are considered as used now
+	info, ok = testStorage.defaultBackend.activeDB.list[unmarked.Name()]
+	if !ok || time.Since(time.Unix(info.lastUsed, 0)) > time.Minute {
+		t.Errorf("database %s should be restored in cache as recently used, but got %+v", unmarked.Name(), info)
+	}
+
+	dbNames, err := session.ListDatabaseNames(ctx, bson.D{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := 2, len(filterDBNames(dbNames)); want != got {
+		t.Errorf("expected %d DB, but got %d", want, got)
+	}
+	// only the marker of the cached database is left
+	if n, err := markers.CountDocuments(ctx, bson.D{}); err != nil || n != 1 {
+		t.Errorf("expected 1 marker, but got %d, %v", n, err)
+	}
+	// user databases don't hold any marker
+	names, _ := session.Database(cached).ListCollectionNames(ctx, bson.D{})
+	if !reflect.DeepEqual([]string{"collection"}, names) {
+		t.Errorf("expected collections [collection] in database %s but got %v", cached, names)
+	}
+}
+
 func TestBackup(t *testing.T) {
 
 	dir, _ := os.ReadDir(testStorage.backupDir)
@@ -122,76 +204,52 @@ func TestBackup(t *testing.T) {
 }
 
 func clearDatabases(t *testing.T) {
-	dbNames, err := testStorage.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
+	dbNames, err := testStorage.defaultBackend.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
 	if err != nil {
 		t.Error(err)
 	}
 
 	for _, name := range filterDBNames(dbNames) {
-		err = testStorage.mongoSession.Database(name).Drop(context.Background())
+		err = testStorage.defaultBackend.mongoSession.Database(name).Drop(context.Background())
 		if err != nil {
 			fmt.Printf("fail to drop db: %v", err)
 		}
-		delete(testStorage.activeDB.list, name)
+		delete(testStorage.defaultBackend.activeDB.list, name)
+	}
+	if err := testStorage.defaultBackend.markers().Drop(context.Background()); err != nil {
+		fmt.Printf("fail to drop markers: %v", err)
 	}
 
-	for _, db := range testStorage.activeDB.list {
+	for _, db := range testStorage.defaultBackend.activeDB.list {
 		if db.err == nil {
 			t.Errorf(`Database leaked: %+v`, db)
 		}
 	}
-	testStorage.activeDB = &cache{
-		list: map[string]dbMetaInfo{},
-	}
+	testStorage.defaultBackend.activeDB = newCache(testStorage.defaultBackend.activeDB.maxSize)
 	// reset prometheus metrics
 	activeDatabasesCounter.Set(0)
 
-	keys := make([][]byte, 0)
-	err = testStorage.kvStore.View(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchValues = false
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Rewind(); it.Valid(); it.Next() {
-			item := it.Item()
-			key := make([]byte, len(item.Key()))
-			copy(key, item.Key())
-			keys = append(keys, key)
-		}
-		return err
-	})
+	err = testStorage.pageStore.Delete(nil)
 	if err != nil {
-		t.Error(err)
-	}
-
-	deleteTxn := testStorage.kvStore.NewTransaction(true)
-	for i := 0; i < len(keys); i++ {
-		err = deleteTxn.Delete(keys[i])
-		if err != nil {
-			t.Error(err)
-		}
-	}
-	err = deleteTxn.Commit()
-	if err != nil {
-		t.Errorf("fail to commit delete transcation: %v", err)
+		t.Errorf("fail to clear page store: %v", err)
 	}
 }
 
 func testStorageContent(t *testing.T, cacheSize, nbMongoDatabases, nbBadgerRecords int) {
-	dbNames, err := testStorage.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
+	dbNames, err := testStorage.defaultBackend.mongoSession.ListDatabaseNames(context.Background(), bson.D{})
 	if err != nil {
 		t.Error(err)
 	}
 	if want, got := nbMongoDatabases, len(filterDBNames(dbNames)); want != got {
 		t.Errorf("expected %d DB, but got %d", want, got)
 	}
-	if want, got := cacheSize, len(testStorage.activeDB.list); want != got {
+	if want, got := cacheSize, len(testStorage.defaultBackend.activeDB.list); want != got {
 		t.Errorf("expected %d db in map, but got %d", want, got)
 	}
 	if want, got := nbMongoDatabases, int(testutil.ToFloat64(activeDatabasesCounter)); want != got {
 		t.Errorf("expected %d active db in prometheus counter, but got %d", want, got)
 	}
-	if want, got := nbBadgerRecords, countSavedPages(testStorage.kvStore); want != got {
+	if want, got := nbBadgerRecords, countSavedPages(testStorage.pageStore); want != got {
 		t.Errorf("expected %d page saved, but got %d", want, got)
 	}
 }
@@ -207,14 +265,7 @@ func filterDBNames(dbNames []string) []string {
 	return r
 }
 
-func countSavedPages(kvStore *badger.DB) (count int) {
-	kvStore.View(func(txn *badger.Txn) error {
-		it := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer it.Close()
-		for it.Rewind(); it.Valid(); it.Next() {
-			count++
-		}
-		return nil
-	})
-	return count
+func countSavedPages(store PageStore) int {
+	keys, _ := listKeys(store, nil, isPageKey)
+	return len(keys)
 }
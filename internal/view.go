@@ -17,17 +17,22 @@
 package internal
 
 import (
+	"bytes"
 	"errors"
+	"html/template"
 	"log"
 	"net/http"
 	"strings"
 
-	"github.com/dgraph-io/badger/v2"
+	"github.com/yuin/goldmark"
 )
 
 const errNoMatchingPlayground = "this playground doesn't exist"
 
-// view a saved playground page identified by its ID
+// view a saved playground page identified by its ID. The raw content
+// of the playground is returned if the url ends with a known suffix,
+// see serveExport(), an image of the query if it ends with /preview.png
+// and the ancestors and forks of the playground if it ends with /history
 func (s *storage) viewHandler(w http.ResponseWriter, r *http.Request) {
 
 	id := extractPageIDFromURL(r.URL.Path)
@@ -39,6 +44,20 @@ func (s *storage) viewHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	suffix := strings.TrimPrefix(r.URL.Path, viewEndpoint+string(id))
+	switch suffix {
+	case previewSuffix:
+		s.servePreview(w, id, page)
+		return
+	case historySuffix:
+		s.serveHistory(w, id, page)
+		return
+	}
+	if serveExport(w, id, page, suffix) {
+		return
+	}
+
+	page.Meta = newPageMeta(r, id, page)
 	serveHomeTemplate(w, page)
 }
 
@@ -57,20 +76,33 @@ func (s *storage) loadPage(id []byte) (*page, error) {
 		return nil, errors.New("invalid page id length")
 	}
 
-	p := &page{
-		MongoVersion: s.mongoVersion,
+	p := &page{}
+	val, err := s.pageStore.Get(id)
+	if err != nil {
+		return p, err
+	}
+	if err := p.decode(val); err != nil {
+		return p, err
+	}
+
+	// the backend of a page may have been removed from the
+	// configuration since the page was saved
+	b, err := s.backend(p.Version)
+	if err != nil {
+		b = s.defaultBackend
+	}
+	p.MongoVersion = b.mongoVersion
+	return p, nil
+}
+
+// render the markdown description of the page as html. Raw html
+// and dangerous links like 'javascript:' are not rendered by goldmark
+func (p *page) DescriptionHTML() template.HTML {
+	var buf bytes.Buffer
+	if err := goldmark.Convert(p.Description, &buf); err != nil {
+		return template.HTML(template.HTMLEscapeString(string(p.Description)))
 	}
-	err := s.kvStore.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(id)
-		if err != nil {
-			return err
-		}
-		return item.Value(func(val []byte) error {
-			p.decode(val)
-			return nil
-		})
-	})
-	return p, err
+	return template.HTML(buf.String())
 }
 
 func serveNoMatchingPlayground(w http.ResponseWriter) {
@@ -17,9 +17,13 @@
 package internal
 
 import (
+	"compress/gzip"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -87,3 +91,144 @@ func TestView(t *testing.T) {
 		}
 	})
 }
+
+func TestViewExport(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{"mode": {"bson"}, "config": {`[{"_id": 1}]`}, "query": {templateQuery}}
+	httpBody(t, saveEndpoint, http.MethodPost, params)
+
+	mgodatagenParams := url.Values{"mode": {"mgodatagen"}, "config": {`[{"collection":"c","count":1,"content":{}}]`}, "query": {"db.c.find()"}}
+	mgodatagenID := strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, mgodatagenParams), "p/")
+
+	exportTests := []struct {
+		name        string
+		url         string
+		contentType string
+		disposition string
+		content     string
+	}{
+		{
+			name:        "json",
+			url:         "/p/DEz-pkpheLX.json",
+			contentType: "application/json; charset=utf-8",
+			content:     `{"id":"DEz-pkpheLX","mode":"bson","config":"[{\"_id\": 1}]","query":"db.collection.find()"}`,
+		},
+		{
+			name:        "config",
+			url:         "/p/DEz-pkpheLX/config",
+			contentType: "text/plain; charset=utf-8",
+			content:     `[{"_id": 1}]`,
+		},
+		{
+			name:        "query",
+			url:         "/p/DEz-pkpheLX/query",
+			contentType: "text/plain; charset=utf-8",
+			content:     templateQuery,
+		},
+		{
+			name:        "mongosh script",
+			url:         "/p/DEz-pkpheLX.js",
+			contentType: "text/plain; charset=utf-8",
+			disposition: `attachment; filename="DEz-pkpheLX.js"`,
+			content: `// playground DEz-pkpheLX
+const collections = {"collection": [{"_id": 1}]};
+for (const [name, docs] of Object.entries(collections)) {
+  if (docs.length > 0) db.getCollection(name).insertMany(docs);
+}
+
+db.collection.find()
+`,
+		},
+		{
+			name:        "mongosh script in mgodatagen mode",
+			url:         "/p/" + mgodatagenID + ".js",
+			contentType: "text/plain; charset=utf-8",
+			disposition: `attachment; filename="` + mgodatagenID + `.js"`,
+			content: `// playground ` + mgodatagenID + `
+// mgodatagen configurations can't be converted to a script, see https://github.com/feliixx/mgodatagen
+/*
+[{"collection":"c","count":1,"content":{}}]
+*/
+
+db.c.find()
+`,
+		},
+	}
+
+	for _, tt := range exportTests {
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+		testServer.Handler.ServeHTTP(resp, req)
+
+		if want, got := http.StatusOK, resp.Code; want != got {
+			t.Errorf("%s: expected response code %d but got %d", tt.name, want, got)
+		}
+		if want, got := tt.contentType, resp.Header().Get("Content-Type"); want != got {
+			t.Errorf("%s: expected Content-Type %s but got %s", tt.name, want, got)
+		}
+		if want, got := tt.disposition, resp.Header().Get("Content-Disposition"); want != got {
+			t.Errorf("%s: expected Content-Disposition %s but got %s", tt.name, want, got)
+		}
+		if want, got := "public, max-age=31536000, immutable", resp.Header().Get("Cache-Control"); want != got {
+			t.Errorf("%s: expected Cache-Control %s but got %s", tt.name, want, got)
+		}
+		if want, got := tt.content, resp.Body.String(); want != got {
+			t.Errorf("%s: expected\n%s\nbut got\n%s", tt.name, want, got)
+		}
+	}
+
+	checkServerResponse(t, "/p/unknownURL.json", http.StatusNotFound, "", "")
+}
+
+func TestViewMetadata(t *testing.T) {
+
+	defer clearDatabases(t)
+
+	params := url.Values{
+		"mode":        {"bson"},
+		"config":      {`[{"_id": 1}]`},
+		"query":       {templateQuery},
+		"title":       {"Find <all>"},
+		"description": {"Find **all** docs <script>alert(1)</script> [link](javascript:alert(1))"},
+		"tags":        {"find,Basic"},
+	}
+	id := strings.TrimPrefix(httpBody(t, saveEndpoint, http.MethodPost, params), "p/")
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, viewEndpoint+id, nil)
+	testServer.Handler.ServeHTTP(resp, req)
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(reader)
+	body := string(b)
+
+	for _, want := range []string{
+		`<title>Find &lt;all&gt; - Mongo playground</title>`,
+		`<code>#basic</code> <code>#find</code>`,
+		`<strong>all</strong>`,
+		`<meta property="og:title" content="Find &lt;all&gt;">`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected page to contain\n%s\nbut got\n%s", want, body)
+		}
+	}
+	for _, unwanted := range []string{"<script>alert", `href="javascript:`} {
+		if strings.Contains(body, unwanted) {
+			t.Errorf("page should not contain %s", unwanted)
+		}
+	}
+
+	content := apiRequestRecorder(http.MethodGet, apiPageEndpoint+id, "").Body.String()
+	if want := `"title":"Find \u003call\u003e","description":"Find **all**`; !strings.Contains(content, want) {
+		t.Errorf("expected api output to contain %s but got %s", want, content)
+	}
+	if want := `"tags":["basic","find"]`; !strings.Contains(content, want) {
+		t.Errorf("expected api output to contain %s but got %s", want, content)
+	}
+}
@@ -30,4 +30,7 @@ CSS_HASH=$(md5sum static/playground-min.css | cut -d " " -f1)
 sed -i "s/playground-min-.*\.css/playground-min-$CSS_HASH.css/" src/playground.html
 sed -i "s/playground-min-.*\.css/playground-min-$CSS_HASH.css/" static/about.html
 
+EMBED_HASH=$(md5sum static/embed.js | cut -d " " -f1)
+sed -i "s/embed-.*\.js/embed-$EMBED_HASH.js/" src/embed.html
+
 rm -f bundle.js
\ No newline at end of file
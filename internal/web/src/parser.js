@@ -44,7 +44,7 @@ var Parser = function () {
         aggregationStagesLimit = -1, // -1 means keep all stages 
         aggregationStages = [],      // list of stages name for aggregation queries
 
-        queryType // type of query, can be one of ["find", "aggregate", "update", "unknown"]
+        queryType // type of query, like "find" or "aggregate", "script" for several statements or "unknown"
 
     /**
      * Indent a bson content, comments are kept
@@ -821,43 +821,96 @@ var Parser = function () {
         }
     }
 
+    // methods that can be called on a collection, or chained after
+    // another method, like find().sort()
+    const supportedMethods = [
+        "find", "aggregate", "update", "explain",
+        "count", "countDocuments", "estimatedDocumentCount", "distinct",
+        "updateOne", "updateMany", "replaceOne",
+        "insertOne", "insertMany", "deleteOne", "deleteMany", "bulkWrite",
+        "findOneAndUpdate", "findOneAndReplace", "findOneAndDelete",
+        "sort", "skip", "limit", "collation", "hint", "min", "max", "projection"
+    ]
+
+    // a query is a script made of one or several statements, separated
+    // by ';' or by a new line
     function query() {
 
+        let statementsNb = 0
+        // index in output of the ';' ending the previous statement, if any
+        let semicolon = -1
+
         white()
+        while (ch) {
+            if (statementsNb > 0) {
+                // the first char of the statement has already been added
+                // to the output, so add the separator before it
+                const first = output.slice(-1)
+                output = output.slice(0, -1)
+                if (semicolon >= 0) {
+                    output = output.slice(0, semicolon) + output.slice(semicolon + 1)
+                }
+                output += (doIndent ? "\n" : ";") + first
+            }
+            statement()
+            statementsNb++
+            white()
+            semicolon = -1
+            if (ch === ";") {
+                semicolon = output.length - 1
+                next()
+                white()
+            }
+        }
+        if (statementsNb === 0) {
+            error("Expected a query like 'db.collection.find()'")
+        }
+        // remove the trailing semicolon
+        if (semicolon >= 0) {
+            output = output.slice(0, semicolon) + output.slice(semicolon + 1)
+        }
+        if (statementsNb > 1) {
+            queryType = "script"
+        }
+    }
+
+    function statement() {
         next("d")
         next("b")
         next(".")
         anyWord()
         method()
-        if (ch === ".") {
-            return method()
+        white()
+        while (ch === ".") {
+            method()
+            white()
         }
     }
 
     function method() {
         next(".")
-        switch (ch) {
-            case "f":
+        const name = anyWord()
+        if (!supportedMethods.includes(name)) {
+            error(`Unsupported method: '${name}'`)
+        }
+        if (queryType === "unknown" && name !== "explain") {
+            queryType = name
+        }
+        switch (name) {
+            case "find":
                 return find()
-            case "a":
+            case "aggregate":
                 return aggregate()
-            case "u":
+            case "update":
                 return update()
-            case "e":
+            case "explain":
                 return explain()
             default:
-                error("Unsupported method: only find(), aggregate(), update() and explain() are supported")
+                return args()
         }
     }
 
     function explain() {
-        next("e")
-        next("x")
-        next("p")
-        next("l")
-        next("a")
-        next("i")
-        next("n")
         next("(")
         white()
         if (ch === ")") {
@@ -871,14 +924,23 @@ var Parser = function () {
         next(")")
     }
 
-    function find() {
-
-        queryType = "find"
+    // a list of any values, like ("k", {k: {$gt: 1}})
+    function args() {
+        next("(")
+        white()
+        while (ch && ch !== ")") {
+            value()
+            white()
+            if (ch !== ",") {
+                break
+            }
+            next()
+            white()
+        }
+        next(")")
+    }
 
-        next("f")
-        next("i")
-        next("n")
-        next("d")
+    function find() {
         next("(")
         white()
         nObject(2)
@@ -887,23 +949,20 @@ var Parser = function () {
     }
 
     function aggregate() {
-
-        queryType = "aggregate"
-
-        next("a")
-        next("g")
-        next("g")
-        next("r")
-        next("e")
-        next("g")
-        next("a")
-        next("t")
-        next("e")
         next("(")
         white()
         switch (ch) {
             case "[":
                 pipeline()
+                white()
+                // options of the aggregation, like {allowDiskUse: true}
+                if (ch === ",") {
+                    next()
+                    white()
+                    if (ch === "{") {
+                        object()
+                    }
+                }
                 break
             case "{":
                 nObject(-1)
@@ -1028,15 +1087,6 @@ var Parser = function () {
     }
 
     function update() {
-
-        queryType = "update"
-
-        next("u")
-        next("p")
-        next("d")
-        next("a")
-        next("t")
-        next("e")
         next("(")
         white()
         object()
@@ -1084,7 +1134,7 @@ var Parser = function () {
     /**
      * Get the type of query if applicable
      * 
-     * @returns {string} one of ["find", "aggregate", "update"] or "" if not applicable
+     * @returns {string} the first method of the query, like "find", "script" if the query has several statements, or "unknown"
      */
     function getQueryType() {
         return queryType
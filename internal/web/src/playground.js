@@ -22,6 +22,9 @@ var Playground = function () {
     let configChangedSinceLastRun = true
     let queryChangedSinceLastRun = true
     let configOrQueryChangedSinceLastSave = true
+    // id of the saved playground being edited, sent when saving
+    // to keep track of forks
+    let parentID = window.location.pathname.startsWith("/p/") ? window.location.pathname.substring(3) : ""
 
     let isConfigHandlerDragging = false
     let isQueryHandlerDragging = false
@@ -321,12 +324,14 @@ var Playground = function () {
         queryChangedSinceLastRun = false
 
         const result = await r.text()
-        if (result.startsWith("[") || result.startsWith("{")) {
-            return showResult(result, true)
-        }
         if (result === "no document found") {
             return showResult(result, false)
         }
+        // errors are returned as plain text, while results are documents,
+        // arrays or single values like the result of count()
+        if (parser.parse(result, "result", comboMode.getValue()) === null) {
+            return showResult(result, true)
+        }
         showError(result)
     }
 
@@ -338,7 +343,12 @@ var Playground = function () {
 
         formatAll()
 
-        const r = await fetch("/save", { method: "POST", body: encodePlayground(true) })
+        const formData = encodePlayground(true)
+        if (parentID) {
+            formData.append("parent", parentID)
+        }
+
+        const r = await fetch("/save", { method: "POST", body: formData })
         if (!r.ok) {
             return showError(`Failed to save playground: ${r.status} ${await r.text()}`)
         }
@@ -350,6 +360,7 @@ var Playground = function () {
             return showError(result)
         }
         redirect(result, true)
+        parentID = result.substring(result.lastIndexOf("/") + 1)
         navigator.clipboard.writeText(result);
         document.getElementById("link_tooltip").classList.add("tooltip-fadein-fadeout")
     }
@@ -365,11 +376,13 @@ var Playground = function () {
 
         let mode = comboMode.getValue()
         let compactFunc = keepComment ? parser.compact : parser.compactAndRemoveComment
+        // stages can only be selected for a single aggregate() query
+        let nbStages = customStages.style.visibility === "visible" ? comboStages.getSelectedIndex() + 1 : -1
 
         const formData = new FormData()
         formData.append("mode", mode)
         formData.append("config", compactFunc(configEditor.getValue(), "config", mode))
-        formData.append("query", compactFunc(queryEditor.getValue(), "query", mode, comboStages.getSelectedIndex() + 1))
+        formData.append("query", compactFunc(queryEditor.getValue(), "query", mode, nbStages))
 
         return formData
     }
@@ -0,0 +1,59 @@
+/* ***** BEGIN LICENSE BLOCK *****
+* mongoplayground: a sandbox to test and share MongoDB queries
+* Copyright (C) 2023 Adrien Petel
+*
+* This program is free software: you can redistribute it and/or modify
+* it under the terms of the GNU Affero General Public License as published
+* by the Free Software Foundation, either version 3 of the License, or
+* (at your option) any later version.
+*
+* This program is distributed in the hope that it will be useful,
+* but WITHOUT ANY WARRANTY; without even the implied warranty of
+* MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+* GNU Affero General Public License for more details.
+*
+* You should have received a copy of the GNU Affero General Public License
+* along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * ***** END LICENSE BLOCK ***** */
+
+/**
+ * Run the read-only playground displayed in /embed/{id}, using
+ * the json api so errors can be told apart from results
+ */
+document.addEventListener("DOMContentLoaded", function () {
+
+    const playground = document.getElementById("playground")
+    const result = document.getElementById("result")
+
+    document.getElementById("run").addEventListener("click", async function () {
+
+        result.classList.remove("text_red")
+        result.textContent = "running query..."
+
+        const r = await fetch("/api/v1/run", {
+            method: "POST",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({
+                mode: playground.dataset.mode,
+                config: document.getElementById("config").textContent,
+                query: document.getElementById("query").textContent,
+                version: playground.dataset.version
+            })
+        })
+
+        let response
+        try {
+            response = await r.json()
+        } catch (e) {
+            response = { ok: false, error: { message: `Failed to run playground: ${r.status}` } }
+        }
+
+        if (!response.ok) {
+            result.classList.add("text_red")
+            result.textContent = response.error.message
+            return
+        }
+        result.textContent = JSON.stringify(response.result)
+    })
+})
@@ -1,21 +1,21 @@
-(function(){var L="",b=function(){return this}();if(!b&&typeof window<"u"&&(b=window),!L&&typeof requirejs<"u")return;var B=function(t,e,i){if(typeof t!="string"){B.original?B.original.apply(this,arguments):(console.error("dropping module because define wasn't a string."),console.trace());return}arguments.length==2&&(i=e),B.modules[t]||(B.payloads[t]=i,B.modules[t]=null)};B.modules={},B.payloads={};var E=function(t,e,i){if(typeof e=="string"){var r=h(t,e);if(r!=null)return i&&i(),r}else if(Object.prototype.toString.call(e)==="[object Array]"){for(var n=[],o=0,m=e.length;o<m;++o){var w=h(t,e[o]);if(w==null&&A.original)return;n.push(w)}return i&&i.apply(null,n)||!0}},A=function(t,e){var i=E("",t,e);return i==null&&A.original?A.original.apply(this,arguments):i},p=function(t,e){if(e.indexOf("!")!==-1){var i=e.split("!");return p(t,i[0])+"!"+p(t,i[1])}if(e.charAt(0)=="."){var r=t.split("/").slice(0,-1).join("/");for(e=r+"/"+e;e.indexOf(".")!==-1&&n!=e;){var n=e;e=e.replace(/\/\.\//,"/").replace(/[^\/]+\/\.\.\//,"")}}return e},h=function(t,e){e=p(t,e);var i=B.modules[e];if(!i){if(i=B.payloads[e],typeof i=="function"){var r={},n={id:e,uri:"",exports:r,packaged:!0},o=function(w,S){return E(e,w,S)},m=i(o,r,n);r=m||n.exports,B.modules[e]=r,delete B.payloads[e]}i=B.modules[e]=r||i}return i};function u(t){var e=b;t&&(b[t]||(b[t]={}),e=b[t]),(!e.define||!e.define.packaged)&&(B.original=e.define,e.define=B,e.define.packaged=!0),(!e.require||!e.require.packaged)&&(A.original=e.require,e.require=A,e.require.packaged=!0)}u(L)})(),define("ace/lib/fixoldbrowsers",["require","exports","module"],function(L,b,B){"use strict";typeof Element<"u"&&!Element.prototype.remove&&Object.defineProperty(Element.prototype,"remove",{enumerable:!1,writable:!0,configurable:!0,value:function(){this.parentNode&&this.parentNode.removeChild(this)}})}),define("ace/lib/useragent",["require","exports","module"],function(L,b,B){"use strict";b.OS={LINUX:"LINUX",MAC:"MAC",WINDOWS:"WINDOWS"},b.getOS=function(){return b.isMac?b.OS.MAC:b.isLinux?b.OS.LINUX:b.OS.WINDOWS};var E=typeof navigator=="object"?navigator:{},A=(/mac|win|linux/i.exec(E.platform)||["other"])[0].toLowerCase(),p=E.userAgent||"",h=E.appName||"";b.isWin=A=="win",b.isMac=A=="mac",b.isLinux=A=="linux",b.isIE=h=="Microsoft Internet Explorer"||h.indexOf("MSAppHost")>=0?parseFloat((p.match(/(?:MSIE |Trident\/[0-9]+[\.0-9]+;.*rv:)([0-9]+[\.0-9]+)/)||[])[1]):parseFloat((p.match(/(?:Trident\/[0-9]+[\.0-9]+;.*rv:)([0-9]+[\.0-9]+)/)||[])[1]),b.isOldIE=b.isIE&&b.isIE<9,b.isGecko=b.isMozilla=p.match(/ Gecko\/\d+/),b.isOpera=typeof opera=="object"&&Object.prototype.toString.call(window.opera)=="[object Opera]",b.isWebKit=parseFloat(p.split("WebKit/")[1])||void 0,b.isChrome=parseFloat(p.split(" Chrome/")[1])||void 0,b.isEdge=parseFloat(p.split(" Edge/")[1])||void 0,b.isAIR=p.indexOf("AdobeAIR")>=0,b.isAndroid=p.indexOf("Android")>=0,b.isChromeOS=p.indexOf(" CrOS ")>=0,b.isIOS=/iPad|iPhone|iPod/.test(p)&&!window.MSStream,b.isIOS&&(b.isMac=!0),b.isMobile=b.isIOS||b.isAndroid}),define("ace/lib/dom",["require","exports","module","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("./useragent"),A="http://www.w3.org/1999/xhtml";if(b.buildDom=function h(u,t,e){if(typeof u=="string"&&u){var i=document.createTextNode(u);return t&&t.appendChild(i),i}if(!Array.isArray(u))return u&&u.appendChild&&t&&t.appendChild(u),u;if(typeof u[0]!="string"||!u[0]){for(var r=[],n=0;n<u.length;n++){var o=h(u[n],t,e);o&&r.push(o)}return r}var m=document.createElement(u[0]),w=u[1],S=1;w&&typeof w=="object"&&!Array.isArray(w)&&(S=2);for(var n=S;n<u.length;n++)h(u[n],m,e);return S==2&&Object.keys(w).forEach(function(y){var c=w[y];y==="class"?m.className=Array.isArray(c)?c.join(" "):c:typeof c=="function"||y=="value"||y[0]=="$"?m[y]=c:y==="ref"?e&&(e[c]=m):c!=null&&m.setAttribute(y,c)}),t&&t.appendChild(m),m},b.getDocumentHead=function(h){return h||(h=document),h.head||h.getElementsByTagName("head")[0]||h.documentElement},b.createElement=function(h,u){return document.createElementNS?document.createElementNS(u||A,h):document.createElement(h)},b.removeChildren=function(h){h.innerHTML=""},b.createTextNode=function(h,u){var t=u?u.ownerDocument:document;return t.createTextNode(h)},b.createFragment=function(h){var u=h?h.ownerDocument:document;return u.createDocumentFragment()},b.hasCssClass=function(h,u){var t=(h.className+"").split(/\s+/g);return t.indexOf(u)!==-1},b.addCssClass=function(h,u){b.hasCssClass(h,u)||(h.className+=" "+u)},b.removeCssClass=function(h,u){for(var t=h.className.split(/\s+/g);;){var e=t.indexOf(u);if(e==-1)break;t.splice(e,1)}h.className=t.join(" ")},b.toggleCssClass=function(h,u){for(var t=h.className.split(/\s+/g),e=!0;;){var i=t.indexOf(u);if(i==-1)break;e=!1,t.splice(i,1)}return e&&t.push(u),h.className=t.join(" "),e},b.setCssClass=function(h,u,t){t?b.addCssClass(h,u):b.removeCssClass(h,u)},b.hasCssString=function(h,u){var t=0,e;if(u=u||document,e=u.querySelectorAll("style")){for(;t<e.length;)if(e[t++].id===h)return!0}},b.importCssString=function(u,t,e){var i=e;!e||!e.getRootNode?i=document:(i=e.getRootNode(),(!i||i==e)&&(i=document));var r=i.ownerDocument||i;if(t&&b.hasCssString(t,i))return null;t&&(u+=`
-/*# sourceURL=ace/css/`+t+" */");var n=b.createElement("style");n.appendChild(r.createTextNode(u)),t&&(n.id=t),i==r&&(i=b.getDocumentHead(r)),i.insertBefore(n,i.firstChild)},b.importCssStylsheet=function(h,u){b.buildDom(["link",{rel:"stylesheet",href:h}],b.getDocumentHead(u))},b.scrollbarWidth=function(h){var u=b.createElement("ace_inner");u.style.width="100%",u.style.minWidth="0px",u.style.height="200px",u.style.display="block";var t=b.createElement("ace_outer"),e=t.style;e.position="absolute",e.left="-10000px",e.overflow="hidden",e.width="200px",e.minWidth="0px",e.height="150px",e.display="block",t.appendChild(u);var i=h.documentElement;i.appendChild(t);var r=u.offsetWidth;e.overflow="scroll";var n=u.offsetWidth;return r==n&&(n=t.clientWidth),i.removeChild(t),r-n},typeof document>"u"&&(b.importCssString=function(){}),b.computedStyle=function(h,u){return window.getComputedStyle(h,"")||{}},b.setStyle=function(h,u,t){h[u]!==t&&(h[u]=t)},b.HAS_CSS_ANIMATION=!1,b.HAS_CSS_TRANSFORMS=!1,b.HI_DPI=E.isWin?typeof window<"u"&&window.devicePixelRatio>=1.5:!0,typeof document<"u"){var p=document.createElement("div");b.HI_DPI&&p.style.transform!==void 0&&(b.HAS_CSS_TRANSFORMS=!0),!E.isEdge&&typeof p.style.animationName<"u"&&(b.HAS_CSS_ANIMATION=!0),p=null}b.HAS_CSS_TRANSFORMS?b.translate=function(h,u,t){h.style.transform="translate("+Math.round(u)+"px, "+Math.round(t)+"px)"}:b.translate=function(h,u,t){h.style.top=Math.round(t)+"px",h.style.left=Math.round(u)+"px"}}),define("ace/lib/oop",["require","exports","module"],function(L,b,B){"use strict";b.inherits=function(E,A){E.super_=A,E.prototype=Object.create(A.prototype,{constructor:{value:E,enumerable:!1,writable:!0,configurable:!0}})},b.mixin=function(E,A){for(var p in A)E[p]=A[p];return E},b.implement=function(E,A){b.mixin(E,A)}}),define("ace/lib/keys",["require","exports","module","ace/lib/oop"],function(L,b,B){"use strict";var E=L("./oop"),A=function(){var p={MODIFIER_KEYS:{16:"Shift",17:"Ctrl",18:"Alt",224:"Meta",91:"MetaLeft",92:"MetaRight",93:"ContextMenu"},KEY_MODS:{ctrl:1,alt:2,option:2,shift:4,super:8,meta:8,command:8,cmd:8,control:1},FUNCTION_KEYS:{8:"Backspace",9:"Tab",13:"Return",19:"Pause",27:"Esc",32:"Space",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"Left",38:"Up",39:"Right",40:"Down",44:"Print",45:"Insert",46:"Delete",96:"Numpad0",97:"Numpad1",98:"Numpad2",99:"Numpad3",100:"Numpad4",101:"Numpad5",102:"Numpad6",103:"Numpad7",104:"Numpad8",105:"Numpad9","-13":"NumpadEnter",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"Numlock",145:"Scrolllock"},PRINTABLE_KEYS:{32:" ",48:"0",49:"1",50:"2",51:"3",52:"4",53:"5",54:"6",55:"7",56:"8",57:"9",59:";",61:"=",65:"a",66:"b",67:"c",68:"d",69:"e",70:"f",71:"g",72:"h",73:"i",74:"j",75:"k",76:"l",77:"m",78:"n",79:"o",80:"p",81:"q",82:"r",83:"s",84:"t",85:"u",86:"v",87:"w",88:"x",89:"y",90:"z",107:"+",109:"-",110:".",186:";",187:"=",188:",",189:"-",190:".",191:"/",192:"`",219:"[",220:"\\",221:"]",222:"'",111:"/",106:"*"}},h,u;for(u in p.FUNCTION_KEYS)h=p.FUNCTION_KEYS[u].toLowerCase(),p[h]=parseInt(u,10);for(u in p.PRINTABLE_KEYS)h=p.PRINTABLE_KEYS[u].toLowerCase(),p[h]=parseInt(u,10);return E.mixin(p,p.MODIFIER_KEYS),E.mixin(p,p.PRINTABLE_KEYS),E.mixin(p,p.FUNCTION_KEYS),p.enter=p.return,p.escape=p.esc,p.del=p.delete,p[173]="-",function(){for(var t=["cmd","ctrl","alt","shift"],e=Math.pow(2,t.length);e--;)p.KEY_MODS[e]=t.filter(function(i){return e&p.KEY_MODS[i]}).join("-")+"-"}(),p.KEY_MODS[0]="",p.KEY_MODS[-1]="input-",p}();E.mixin(b,A),b.keyCodeToString=function(p){var h=A[p];return typeof h!="string"&&(h=String.fromCharCode(p)),h.toLowerCase()}}),define("ace/lib/event",["require","exports","module","ace/lib/keys","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("./keys"),A=L("./useragent"),p=null,h=0,u;function t(){u=!1;try{document.createComment("").addEventListener("test",function(){},{get passive(){u={passive:!1}}})}catch{}}function e(){return u==null&&t(),u}function i(y,c,l){this.elem=y,this.type=c,this.callback=l}i.prototype.destroy=function(){n(this.elem,this.type,this.callback),this.elem=this.type=this.callback=void 0};var r=b.addListener=function(y,c,l,g){y.addEventListener(c,l,e()),g&&g.$toDestroy.push(new i(y,c,l))},n=b.removeListener=function(y,c,l){y.removeEventListener(c,l,e())};b.stopEvent=function(y){return b.stopPropagation(y),b.preventDefault(y),!1},b.stopPropagation=function(y){y.stopPropagation&&y.stopPropagation()},b.preventDefault=function(y){y.preventDefault&&y.preventDefault()},b.getButton=function(y){return y.type=="dblclick"?0:y.type=="contextmenu"||A.isMac&&y.ctrlKey&&!y.altKey&&!y.shiftKey?2:y.button},b.capture=function(y,c,l){var g=y&&y.ownerDocument||document;function d(a){c&&c(a),l&&l(a),n(g,"mousemove",c),n(g,"mouseup",d),n(g,"dragstart",d)}return r(g,"mousemove",c),r(g,"mouseup",d),r(g,"dragstart",d),d},b.addMouseWheelListener=function(y,c,l){"onmousewheel"in y?r(y,"mousewheel",function(g){var d=8;g.wheelDeltaX!==void 0?(g.wheelX=-g.wheelDeltaX/d,g.wheelY=-g.wheelDeltaY/d):(g.wheelX=0,g.wheelY=-g.wheelDelta/d),c(g)},l):"onwheel"in y?r(y,"wheel",function(g){var d=.35;switch(g.deltaMode){case g.DOM_DELTA_PIXEL:g.wheelX=g.deltaX*d||0,g.wheelY=g.deltaY*d||0;break;case g.DOM_DELTA_LINE:case g.DOM_DELTA_PAGE:g.wheelX=(g.deltaX||0)*5,g.wheelY=(g.deltaY||0)*5;break}c(g)},l):r(y,"DOMMouseScroll",function(g){g.axis&&g.axis==g.HORIZONTAL_AXIS?(g.wheelX=(g.detail||0)*5,g.wheelY=0):(g.wheelX=0,g.wheelY=(g.detail||0)*5),c(g)},l)},b.addMultiMouseDownListener=function(y,c,l,g,d){var a=0,f,s,v,$={2:"dblclick",3:"tripleclick",4:"quadclick"};function x(C){if(b.getButton(C)!==0?a=0:C.detail>1?(a++,a>4&&(a=1)):a=1,A.isIE){var k=Math.abs(C.clientX-f)>5||Math.abs(C.clientY-s)>5;(!v||k)&&(a=1),v&&clearTimeout(v),v=setTimeout(function(){v=null},c[a-1]||600),a==1&&(f=C.clientX,s=C.clientY)}if(C._clicks=a,l[g]("mousedown",C),a>4)a=0;else if(a>1)return l[g]($[a],C)}Array.isArray(y)||(y=[y]),y.forEach(function(C){r(C,"mousedown",x,d)})};var o=function(y){return 0|(y.ctrlKey?1:0)|(y.altKey?2:0)|(y.shiftKey?4:0)|(y.metaKey?8:0)};b.getModifierString=function(y){return E.KEY_MODS[o(y)]};function m(y,c,l){var g=o(c);if(!A.isMac&&p){if(c.getModifierState&&(c.getModifierState("OS")||c.getModifierState("Win"))&&(g|=8),p.altGr)if((3&g)!=3)p.altGr=0;else return;if(l===18||l===17){var d="location"in c?c.location:c.keyLocation;if(l===17&&d===1)p[l]==1&&(h=c.timeStamp);else if(l===18&&g===3&&d===2){var a=c.timeStamp-h;a<50&&(p.altGr=!0)}}}if(l in E.MODIFIER_KEYS&&(l=-1),!g&&l===13){var d="location"in c?c.location:c.keyLocation;if(d===3&&(y(c,g,-l),c.defaultPrevented))return}if(A.isChromeOS&&g&8){if(y(c,g,l),c.defaultPrevented)return;g&=-9}return!g&&!(l in E.FUNCTION_KEYS)&&!(l in E.PRINTABLE_KEYS)?!1:y(c,g,l)}b.addCommandKeyListener=function(y,c,l){if(A.isOldGecko||A.isOpera&&!("KeyboardEvent"in window)){var g=null;r(y,"keydown",function(a){g=a.keyCode},l),r(y,"keypress",function(a){return m(c,a,g)},l)}else{var d=null;r(y,"keydown",function(a){p[a.keyCode]=(p[a.keyCode]||0)+1;var f=m(c,a,a.keyCode);return d=a.defaultPrevented,f},l),r(y,"keypress",function(a){d&&(a.ctrlKey||a.altKey||a.shiftKey||a.metaKey)&&(b.stopEvent(a),d=null)},l),r(y,"keyup",function(a){p[a.keyCode]=null},l),p||(w(),r(window,"focus",w))}};function w(){p=Object.create(null)}if(typeof window=="object"&&window.postMessage&&!A.isOldIE){var S=1;b.nextTick=function(y,c){c=c||window;var l="zero-timeout-message-"+S++,g=function(d){d.data==l&&(b.stopPropagation(d),n(c,"message",g),y())};r(c,"message",g),c.postMessage(l,"*")}}b.$idleBlocked=!1,b.onIdle=function(y,c){return setTimeout(function l(){b.$idleBlocked?setTimeout(l,100):y()},c)},b.$idleBlockId=null,b.blockIdle=function(y){b.$idleBlockId&&clearTimeout(b.$idleBlockId),b.$idleBlocked=!0,b.$idleBlockId=setTimeout(function(){b.$idleBlocked=!1},y||100)},b.nextFrame=typeof window=="object"&&(window.requestAnimationFrame||window.mozRequestAnimationFrame||window.webkitRequestAnimationFrame||window.msRequestAnimationFrame||window.oRequestAnimationFrame),b.nextFrame?b.nextFrame=b.nextFrame.bind(window):b.nextFrame=function(y){setTimeout(y,17)}}),define("ace/range",["require","exports","module"],function(L,b,B){"use strict";var E=function(p,h){return p.row-h.row||p.column-h.column},A=function(p,h,u,t){this.start={row:p,column:h},this.end={row:u,column:t}};(function(){this.isEqual=function(p){return this.start.row===p.start.row&&this.end.row===p.end.row&&this.start.column===p.start.column&&this.end.column===p.end.column},this.toString=function(){return"Range: ["+this.start.row+"/"+this.start.column+"] -> ["+this.end.row+"/"+this.end.column+"]"},this.contains=function(p,h){return this.compare(p,h)==0},this.compareRange=function(p){var h,u=p.end,t=p.start;return h=this.compare(u.row,u.column),h==1?(h=this.compare(t.row,t.column),h==1?2:h==0?1:0):h==-1?-2:(h=this.compare(t.row,t.column),h==-1?-1:h==1?42:0)},this.comparePoint=function(p){return this.compare(p.row,p.column)},this.containsRange=function(p){return this.comparePoint(p.start)==0&&this.comparePoint(p.end)==0},this.intersects=function(p){var h=this.compareRange(p);return h==-1||h==0||h==1},this.isEnd=function(p,h){return this.end.row==p&&this.end.column==h},this.isStart=function(p,h){return this.start.row==p&&this.start.column==h},this.setStart=function(p,h){typeof p=="object"?(this.start.column=p.column,this.start.row=p.row):(this.start.row=p,this.start.column=h)},this.setEnd=function(p,h){typeof p=="object"?(this.end.column=p.column,this.end.row=p.row):(this.end.row=p,this.end.column=h)},this.inside=function(p,h){return this.compare(p,h)==0?!(this.isEnd(p,h)||this.isStart(p,h)):!1},this.insideStart=function(p,h){return this.compare(p,h)==0?!this.isEnd(p,h):!1},this.insideEnd=function(p,h){return this.compare(p,h)==0?!this.isStart(p,h):!1},this.compare=function(p,h){return!this.isMultiLine()&&p===this.start.row?h<this.start.column?-1:h>this.end.column?1:0:p<this.start.row?-1:p>this.end.row?1:this.start.row===p?h>=this.start.column?0:-1:this.end.row===p?h<=this.end.column?0:1:0},this.compareStart=function(p,h){return this.start.row==p&&this.start.column==h?-1:this.compare(p,h)},this.compareEnd=function(p,h){return this.end.row==p&&this.end.column==h?1:this.compare(p,h)},this.compareInside=function(p,h){return this.end.row==p&&this.end.column==h?1:this.start.row==p&&this.start.column==h?-1:this.compare(p,h)},this.clipRows=function(p,h){if(this.end.row>h)var u={row:h+1,column:0};else if(this.end.row<p)var u={row:p,column:0};if(this.start.row>h)var t={row:h+1,column:0};else if(this.start.row<p)var t={row:p,column:0};return A.fromPoints(t||this.start,u||this.end)},this.extend=function(p,h){var u=this.compare(p,h);if(u==0)return this;if(u==-1)var t={row:p,column:h};else var e={row:p,column:h};return A.fromPoints(t||this.start,e||this.end)},this.isEmpty=function(){return this.start.row===this.end.row&&this.start.column===this.end.column},this.isMultiLine=function(){return this.start.row!==this.end.row},this.clone=function(){return A.fromPoints(this.start,this.end)},this.collapseRows=function(){return this.end.column==0?new A(this.start.row,0,Math.max(this.start.row,this.end.row-1),0):new A(this.start.row,0,this.end.row,0)},this.toScreenRange=function(p){var h=p.documentToScreenPosition(this.start),u=p.documentToScreenPosition(this.end);return new A(h.row,h.column,u.row,u.column)},this.moveBy=function(p,h){this.start.row+=p,this.start.column+=h,this.end.row+=p,this.end.column+=h}}).call(A.prototype),A.fromPoints=function(p,h){return new A(p.row,p.column,h.row,h.column)},A.comparePoints=E,A.comparePoints=function(p,h){return p.row-h.row||p.column-h.column},b.Range=A}),define("ace/lib/lang",["require","exports","module"],function(L,b,B){"use strict";b.last=function(p){return p[p.length-1]},b.stringReverse=function(p){return p.split("").reverse().join("")},b.stringRepeat=function(p,h){for(var u="";h>0;)h&1&&(u+=p),(h>>=1)&&(p+=p);return u};var E=/^\s\s*/,A=/\s\s*$/;b.stringTrimLeft=function(p){return p.replace(E,"")},b.stringTrimRight=function(p){return p.replace(A,"")},b.copyObject=function(p){var h={};for(var u in p)h[u]=p[u];return h},b.copyArray=function(p){for(var h=[],u=0,t=p.length;u<t;u++)p[u]&&typeof p[u]=="object"?h[u]=this.copyObject(p[u]):h[u]=p[u];return h},b.deepCopy=function p(h){if(typeof h!="object"||!h)return h;var u;if(Array.isArray(h)){u=[];for(var t=0;t<h.length;t++)u[t]=p(h[t]);return u}if(Object.prototype.toString.call(h)!=="[object Object]")return h;u={};for(var t in h)u[t]=p(h[t]);return u},b.arrayToMap=function(p){for(var h={},u=0;u<p.length;u++)h[p[u]]=1;return h},b.createMap=function(p){var h=Object.create(null);for(var u in p)h[u]=p[u];return h},b.arrayRemove=function(p,h){for(var u=0;u<=p.length;u++)h===p[u]&&p.splice(u,1)},b.escapeRegExp=function(p){return p.replace(/([.*+?^${}()|[\]\/\\])/g,"\\$1")},b.escapeHTML=function(p){return(""+p).replace(/&/g,"&#38;").replace(/"/g,"&#34;").replace(/'/g,"&#39;").replace(/</g,"&#60;")},b.getMatchOffsets=function(p,h){var u=[];return p.replace(h,function(t){u.push({offset:arguments[arguments.length-2],length:t.length})}),u},b.deferredCall=function(p){var h=null,u=function(){h=null,p()},t=function(e){return t.cancel(),h=setTimeout(u,e||0),t};return t.schedule=t,t.call=function(){return this.cancel(),p(),t},t.cancel=function(){return clearTimeout(h),h=null,t},t.isPending=function(){return h},t},b.delayedCall=function(p,h){var u=null,t=function(){u=null,p()},e=function(i){u==null&&(u=setTimeout(t,i||h))};return e.delay=function(i){u&&clearTimeout(u),u=setTimeout(t,i||h)},e.schedule=e,e.call=function(){this.cancel(),p()},e.cancel=function(){u&&clearTimeout(u),u=null},e.isPending=function(){return u},e}}),define("ace/clipboard",["require","exports","module"],function(L,b,B){"use strict";var E;B.exports={lineMode:!1,pasteCancelled:function(){return E&&E>Date.now()-50?!0:E=!1},cancel:function(){E=Date.now()}}}),define("ace/keyboard/textinput",["require","exports","module","ace/lib/event","ace/lib/useragent","ace/lib/dom","ace/lib/lang","ace/clipboard","ace/lib/keys"],function(L,b,B){"use strict";var E=L("../lib/event"),A=L("../lib/useragent"),p=L("../lib/dom"),h=L("../lib/lang"),u=L("../clipboard"),t=A.isChrome<18,e=A.isIE,i=A.isChrome>63,r=400,n=L("../lib/keys"),o=n.KEY_MODS,m=A.isIOS,w=m?/\s/:/\n/,S=A.isMobile,y=function(c,l){var g=p.createElement("textarea");g.className="ace_text-input",g.setAttribute("wrap","off"),g.setAttribute("autocorrect","off"),g.setAttribute("autocapitalize","off"),g.setAttribute("spellcheck",!1),g.style.opacity="0",c.insertBefore(g,c.firstChild);var d=!1,a=!1,f=!1,s=!1,v="";S||(g.style.fontSize="1px");var $=!1,x=!1,C="",k=0,R=0,_=0;try{var O=document.activeElement===g}catch{}E.addListener(g,"blur",function(F){x||(l.onBlur(F),O=!1)},l),E.addListener(g,"focus",function(F){if(!x){if(O=!0,A.isEdge)try{if(!document.hasFocus())return}catch{}l.onFocus(F),A.isEdge?setTimeout(I):I()}},l),this.$focusScroll=!1,this.focus=function(){if(v||i||this.$focusScroll=="browser")return g.focus({preventScroll:!0});var F=g.style.top;g.style.position="fixed",g.style.top="0px";try{var H=g.getBoundingClientRect().top!=0}catch{return}var U=[];if(H)for(var Z=g.parentElement;Z&&Z.nodeType==1;)U.push(Z),Z.setAttribute("ace_nocontext",!0),!Z.parentElement&&Z.getRootNode?Z=Z.getRootNode().host:Z=Z.parentElement;g.focus({preventScroll:!0}),H&&U.forEach(function(ne){ne.removeAttribute("ace_nocontext")}),setTimeout(function(){g.style.position="",g.style.top=="0px"&&(g.style.top=F)},0)},this.blur=function(){g.blur()},this.isFocused=function(){return O},l.on("beforeEndOperation",function(){var F=l.curOp,H=F&&F.command&&F.command.name;if(H!="insertstring"){var U=H&&(F.docChanged||F.selectionChanged);f&&U&&(C=g.value="",q()),I()}});var I=m?function(F){if(!(!O||d&&!F||s)){F||(F="");var H=`
- ab`+F+`cde fg
-`;H!=g.value&&(g.value=C=H);var U=4,Z=4+(F.length||(l.selection.isEmpty()?0:1));(k!=U||R!=Z)&&g.setSelectionRange(U,Z),k=U,R=Z}}:function(){if(!(f||s)&&!(!O&&!M)){f=!0;var F=0,H=0,U="";if(l.session){var Z=l.selection,ne=Z.getRange(),se=Z.cursor.row;if(F=ne.start.column,H=ne.end.column,U=l.session.getLine(se),ne.start.row!=se){var ae=l.session.getLine(se-1);F=ne.start.row<se-1?0:F,H+=ae.length+1,U=ae+`
-`+U}else if(ne.end.row!=se){var oe=l.session.getLine(se+1);H=ne.end.row>se+1?oe.length:H,H+=U.length+1,U=U+`
-`+oe}else S&&se>0&&(U=`
-`+U,H+=1,F+=1);U.length>r&&(F<r&&H<r?U=U.slice(0,r):(U=`
-`,F==H?F=H=0:(F=0,H=1)))}var re=U+`
+(function(){var k="",y=function(){return this}();if(!y&&typeof window<"u"&&(y=window),!k&&typeof requirejs<"u")return;var B=function(t,e,i){if(typeof t!="string"){B.original?B.original.apply(this,arguments):(console.error("dropping module because define wasn't a string."),console.trace());return}arguments.length==2&&(i=e),B.modules[t]||(B.payloads[t]=i,B.modules[t]=null)};B.modules={},B.payloads={};var E=function(t,e,i){if(typeof e=="string"){var r=h(t,e);if(r!=null)return i&&i(),r}else if(Object.prototype.toString.call(e)==="[object Array]"){for(var n=[],a=0,m=e.length;a<m;++a){var w=h(t,e[a]);if(w==null&&A.original)return;n.push(w)}return i&&i.apply(null,n)||!0}},A=function(t,e){var i=E("",t,e);return i==null&&A.original?A.original.apply(this,arguments):i},p=function(t,e){if(e.indexOf("!")!==-1){var i=e.split("!");return p(t,i[0])+"!"+p(t,i[1])}if(e.charAt(0)=="."){var r=t.split("/").slice(0,-1).join("/");for(e=r+"/"+e;e.indexOf(".")!==-1&&n!=e;){var n=e;e=e.replace(/\/\.\//,"/").replace(/[^\/]+\/\.\.\//,"")}}return e},h=function(t,e){e=p(t,e);var i=B.modules[e];if(!i){if(i=B.payloads[e],typeof i=="function"){var r={},n={id:e,uri:"",exports:r,packaged:!0},a=function(w,S){return E(e,w,S)},m=i(a,r,n);r=m||n.exports,B.modules[e]=r,delete B.payloads[e]}i=B.modules[e]=r||i}return i};function d(t){var e=y;t&&(y[t]||(y[t]={}),e=y[t]),(!e.define||!e.define.packaged)&&(B.original=e.define,e.define=B,e.define.packaged=!0),(!e.require||!e.require.packaged)&&(A.original=e.require,e.require=A,e.require.packaged=!0)}d(k)})(),define("ace/lib/fixoldbrowsers",["require","exports","module"],function(k,y,B){"use strict";typeof Element<"u"&&!Element.prototype.remove&&Object.defineProperty(Element.prototype,"remove",{enumerable:!1,writable:!0,configurable:!0,value:function(){this.parentNode&&this.parentNode.removeChild(this)}})}),define("ace/lib/useragent",["require","exports","module"],function(k,y,B){"use strict";y.OS={LINUX:"LINUX",MAC:"MAC",WINDOWS:"WINDOWS"},y.getOS=function(){return y.isMac?y.OS.MAC:y.isLinux?y.OS.LINUX:y.OS.WINDOWS};var E=typeof navigator=="object"?navigator:{},A=(/mac|win|linux/i.exec(E.platform)||["other"])[0].toLowerCase(),p=E.userAgent||"",h=E.appName||"";y.isWin=A=="win",y.isMac=A=="mac",y.isLinux=A=="linux",y.isIE=h=="Microsoft Internet Explorer"||h.indexOf("MSAppHost")>=0?parseFloat((p.match(/(?:MSIE |Trident\/[0-9]+[\.0-9]+;.*rv:)([0-9]+[\.0-9]+)/)||[])[1]):parseFloat((p.match(/(?:Trident\/[0-9]+[\.0-9]+;.*rv:)([0-9]+[\.0-9]+)/)||[])[1]),y.isOldIE=y.isIE&&y.isIE<9,y.isGecko=y.isMozilla=p.match(/ Gecko\/\d+/),y.isOpera=typeof opera=="object"&&Object.prototype.toString.call(window.opera)=="[object Opera]",y.isWebKit=parseFloat(p.split("WebKit/")[1])||void 0,y.isChrome=parseFloat(p.split(" Chrome/")[1])||void 0,y.isEdge=parseFloat(p.split(" Edge/")[1])||void 0,y.isAIR=p.indexOf("AdobeAIR")>=0,y.isAndroid=p.indexOf("Android")>=0,y.isChromeOS=p.indexOf(" CrOS ")>=0,y.isIOS=/iPad|iPhone|iPod/.test(p)&&!window.MSStream,y.isIOS&&(y.isMac=!0),y.isMobile=y.isIOS||y.isAndroid}),define("ace/lib/dom",["require","exports","module","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("./useragent"),A="http://www.w3.org/1999/xhtml";if(y.buildDom=function h(d,t,e){if(typeof d=="string"&&d){var i=document.createTextNode(d);return t&&t.appendChild(i),i}if(!Array.isArray(d))return d&&d.appendChild&&t&&t.appendChild(d),d;if(typeof d[0]!="string"||!d[0]){for(var r=[],n=0;n<d.length;n++){var a=h(d[n],t,e);a&&r.push(a)}return r}var m=document.createElement(d[0]),w=d[1],S=1;w&&typeof w=="object"&&!Array.isArray(w)&&(S=2);for(var n=S;n<d.length;n++)h(d[n],m,e);return S==2&&Object.keys(w).forEach(function(b){var c=w[b];b==="class"?m.className=Array.isArray(c)?c.join(" "):c:typeof c=="function"||b=="value"||b[0]=="$"?m[b]=c:b==="ref"?e&&(e[c]=m):c!=null&&m.setAttribute(b,c)}),t&&t.appendChild(m),m},y.getDocumentHead=function(h){return h||(h=document),h.head||h.getElementsByTagName("head")[0]||h.documentElement},y.createElement=function(h,d){return document.createElementNS?document.createElementNS(d||A,h):document.createElement(h)},y.removeChildren=function(h){h.innerHTML=""},y.createTextNode=function(h,d){var t=d?d.ownerDocument:document;return t.createTextNode(h)},y.createFragment=function(h){var d=h?h.ownerDocument:document;return d.createDocumentFragment()},y.hasCssClass=function(h,d){var t=(h.className+"").split(/\s+/g);return t.indexOf(d)!==-1},y.addCssClass=function(h,d){y.hasCssClass(h,d)||(h.className+=" "+d)},y.removeCssClass=function(h,d){for(var t=h.className.split(/\s+/g);;){var e=t.indexOf(d);if(e==-1)break;t.splice(e,1)}h.className=t.join(" ")},y.toggleCssClass=function(h,d){for(var t=h.className.split(/\s+/g),e=!0;;){var i=t.indexOf(d);if(i==-1)break;e=!1,t.splice(i,1)}return e&&t.push(d),h.className=t.join(" "),e},y.setCssClass=function(h,d,t){t?y.addCssClass(h,d):y.removeCssClass(h,d)},y.hasCssString=function(h,d){var t=0,e;if(d=d||document,e=d.querySelectorAll("style")){for(;t<e.length;)if(e[t++].id===h)return!0}},y.importCssString=function(d,t,e){var i=e;!e||!e.getRootNode?i=document:(i=e.getRootNode(),(!i||i==e)&&(i=document));var r=i.ownerDocument||i;if(t&&y.hasCssString(t,i))return null;t&&(d+=`
+/*# sourceURL=ace/css/`+t+" */");var n=y.createElement("style");n.appendChild(r.createTextNode(d)),t&&(n.id=t),i==r&&(i=y.getDocumentHead(r)),i.insertBefore(n,i.firstChild)},y.importCssStylsheet=function(h,d){y.buildDom(["link",{rel:"stylesheet",href:h}],y.getDocumentHead(d))},y.scrollbarWidth=function(h){var d=y.createElement("ace_inner");d.style.width="100%",d.style.minWidth="0px",d.style.height="200px",d.style.display="block";var t=y.createElement("ace_outer"),e=t.style;e.position="absolute",e.left="-10000px",e.overflow="hidden",e.width="200px",e.minWidth="0px",e.height="150px",e.display="block",t.appendChild(d);var i=h.documentElement;i.appendChild(t);var r=d.offsetWidth;e.overflow="scroll";var n=d.offsetWidth;return r==n&&(n=t.clientWidth),i.removeChild(t),r-n},typeof document>"u"&&(y.importCssString=function(){}),y.computedStyle=function(h,d){return window.getComputedStyle(h,"")||{}},y.setStyle=function(h,d,t){h[d]!==t&&(h[d]=t)},y.HAS_CSS_ANIMATION=!1,y.HAS_CSS_TRANSFORMS=!1,y.HI_DPI=E.isWin?typeof window<"u"&&window.devicePixelRatio>=1.5:!0,typeof document<"u"){var p=document.createElement("div");y.HI_DPI&&p.style.transform!==void 0&&(y.HAS_CSS_TRANSFORMS=!0),!E.isEdge&&typeof p.style.animationName<"u"&&(y.HAS_CSS_ANIMATION=!0),p=null}y.HAS_CSS_TRANSFORMS?y.translate=function(h,d,t){h.style.transform="translate("+Math.round(d)+"px, "+Math.round(t)+"px)"}:y.translate=function(h,d,t){h.style.top=Math.round(t)+"px",h.style.left=Math.round(d)+"px"}}),define("ace/lib/oop",["require","exports","module"],function(k,y,B){"use strict";y.inherits=function(E,A){E.super_=A,E.prototype=Object.create(A.prototype,{constructor:{value:E,enumerable:!1,writable:!0,configurable:!0}})},y.mixin=function(E,A){for(var p in A)E[p]=A[p];return E},y.implement=function(E,A){y.mixin(E,A)}}),define("ace/lib/keys",["require","exports","module","ace/lib/oop"],function(k,y,B){"use strict";var E=k("./oop"),A=function(){var p={MODIFIER_KEYS:{16:"Shift",17:"Ctrl",18:"Alt",224:"Meta",91:"MetaLeft",92:"MetaRight",93:"ContextMenu"},KEY_MODS:{ctrl:1,alt:2,option:2,shift:4,super:8,meta:8,command:8,cmd:8,control:1},FUNCTION_KEYS:{8:"Backspace",9:"Tab",13:"Return",19:"Pause",27:"Esc",32:"Space",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"Left",38:"Up",39:"Right",40:"Down",44:"Print",45:"Insert",46:"Delete",96:"Numpad0",97:"Numpad1",98:"Numpad2",99:"Numpad3",100:"Numpad4",101:"Numpad5",102:"Numpad6",103:"Numpad7",104:"Numpad8",105:"Numpad9","-13":"NumpadEnter",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"Numlock",145:"Scrolllock"},PRINTABLE_KEYS:{32:" ",48:"0",49:"1",50:"2",51:"3",52:"4",53:"5",54:"6",55:"7",56:"8",57:"9",59:";",61:"=",65:"a",66:"b",67:"c",68:"d",69:"e",70:"f",71:"g",72:"h",73:"i",74:"j",75:"k",76:"l",77:"m",78:"n",79:"o",80:"p",81:"q",82:"r",83:"s",84:"t",85:"u",86:"v",87:"w",88:"x",89:"y",90:"z",107:"+",109:"-",110:".",186:";",187:"=",188:",",189:"-",190:".",191:"/",192:"`",219:"[",220:"\\",221:"]",222:"'",111:"/",106:"*"}},h,d;for(d in p.FUNCTION_KEYS)h=p.FUNCTION_KEYS[d].toLowerCase(),p[h]=parseInt(d,10);for(d in p.PRINTABLE_KEYS)h=p.PRINTABLE_KEYS[d].toLowerCase(),p[h]=parseInt(d,10);return E.mixin(p,p.MODIFIER_KEYS),E.mixin(p,p.PRINTABLE_KEYS),E.mixin(p,p.FUNCTION_KEYS),p.enter=p.return,p.escape=p.esc,p.del=p.delete,p[173]="-",function(){for(var t=["cmd","ctrl","alt","shift"],e=Math.pow(2,t.length);e--;)p.KEY_MODS[e]=t.filter(function(i){return e&p.KEY_MODS[i]}).join("-")+"-"}(),p.KEY_MODS[0]="",p.KEY_MODS[-1]="input-",p}();E.mixin(y,A),y.keyCodeToString=function(p){var h=A[p];return typeof h!="string"&&(h=String.fromCharCode(p)),h.toLowerCase()}}),define("ace/lib/event",["require","exports","module","ace/lib/keys","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("./keys"),A=k("./useragent"),p=null,h=0,d;function t(){d=!1;try{document.createComment("").addEventListener("test",function(){},{get passive(){d={passive:!1}}})}catch{}}function e(){return d==null&&t(),d}function i(b,c,l){this.elem=b,this.type=c,this.callback=l}i.prototype.destroy=function(){n(this.elem,this.type,this.callback),this.elem=this.type=this.callback=void 0};var r=y.addListener=function(b,c,l,g){b.addEventListener(c,l,e()),g&&g.$toDestroy.push(new i(b,c,l))},n=y.removeListener=function(b,c,l){b.removeEventListener(c,l,e())};y.stopEvent=function(b){return y.stopPropagation(b),y.preventDefault(b),!1},y.stopPropagation=function(b){b.stopPropagation&&b.stopPropagation()},y.preventDefault=function(b){b.preventDefault&&b.preventDefault()},y.getButton=function(b){return b.type=="dblclick"?0:b.type=="contextmenu"||A.isMac&&b.ctrlKey&&!b.altKey&&!b.shiftKey?2:b.button},y.capture=function(b,c,l){var g=b&&b.ownerDocument||document;function u(o){c&&c(o),l&&l(o),n(g,"mousemove",c),n(g,"mouseup",u),n(g,"dragstart",u)}return r(g,"mousemove",c),r(g,"mouseup",u),r(g,"dragstart",u),u},y.addMouseWheelListener=function(b,c,l){"onmousewheel"in b?r(b,"mousewheel",function(g){var u=8;g.wheelDeltaX!==void 0?(g.wheelX=-g.wheelDeltaX/u,g.wheelY=-g.wheelDeltaY/u):(g.wheelX=0,g.wheelY=-g.wheelDelta/u),c(g)},l):"onwheel"in b?r(b,"wheel",function(g){var u=.35;switch(g.deltaMode){case g.DOM_DELTA_PIXEL:g.wheelX=g.deltaX*u||0,g.wheelY=g.deltaY*u||0;break;case g.DOM_DELTA_LINE:case g.DOM_DELTA_PAGE:g.wheelX=(g.deltaX||0)*5,g.wheelY=(g.deltaY||0)*5;break}c(g)},l):r(b,"DOMMouseScroll",function(g){g.axis&&g.axis==g.HORIZONTAL_AXIS?(g.wheelX=(g.detail||0)*5,g.wheelY=0):(g.wheelX=0,g.wheelY=(g.detail||0)*5),c(g)},l)},y.addMultiMouseDownListener=function(b,c,l,g,u){var o=0,f,s,v,$={2:"dblclick",3:"tripleclick",4:"quadclick"};function C(x){if(y.getButton(x)!==0?o=0:x.detail>1?(o++,o>4&&(o=1)):o=1,A.isIE){var L=Math.abs(x.clientX-f)>5||Math.abs(x.clientY-s)>5;(!v||L)&&(o=1),v&&clearTimeout(v),v=setTimeout(function(){v=null},c[o-1]||600),o==1&&(f=x.clientX,s=x.clientY)}if(x._clicks=o,l[g]("mousedown",x),o>4)o=0;else if(o>1)return l[g]($[o],x)}Array.isArray(b)||(b=[b]),b.forEach(function(x){r(x,"mousedown",C,u)})};var a=function(b){return 0|(b.ctrlKey?1:0)|(b.altKey?2:0)|(b.shiftKey?4:0)|(b.metaKey?8:0)};y.getModifierString=function(b){return E.KEY_MODS[a(b)]};function m(b,c,l){var g=a(c);if(!A.isMac&&p){if(c.getModifierState&&(c.getModifierState("OS")||c.getModifierState("Win"))&&(g|=8),p.altGr)if((3&g)!=3)p.altGr=0;else return;if(l===18||l===17){var u="location"in c?c.location:c.keyLocation;if(l===17&&u===1)p[l]==1&&(h=c.timeStamp);else if(l===18&&g===3&&u===2){var o=c.timeStamp-h;o<50&&(p.altGr=!0)}}}if(l in E.MODIFIER_KEYS&&(l=-1),!g&&l===13){var u="location"in c?c.location:c.keyLocation;if(u===3&&(b(c,g,-l),c.defaultPrevented))return}if(A.isChromeOS&&g&8){if(b(c,g,l),c.defaultPrevented)return;g&=-9}return!g&&!(l in E.FUNCTION_KEYS)&&!(l in E.PRINTABLE_KEYS)?!1:b(c,g,l)}y.addCommandKeyListener=function(b,c,l){if(A.isOldGecko||A.isOpera&&!("KeyboardEvent"in window)){var g=null;r(b,"keydown",function(o){g=o.keyCode},l),r(b,"keypress",function(o){return m(c,o,g)},l)}else{var u=null;r(b,"keydown",function(o){p[o.keyCode]=(p[o.keyCode]||0)+1;var f=m(c,o,o.keyCode);return u=o.defaultPrevented,f},l),r(b,"keypress",function(o){u&&(o.ctrlKey||o.altKey||o.shiftKey||o.metaKey)&&(y.stopEvent(o),u=null)},l),r(b,"keyup",function(o){p[o.keyCode]=null},l),p||(w(),r(window,"focus",w))}};function w(){p=Object.create(null)}if(typeof window=="object"&&window.postMessage&&!A.isOldIE){var S=1;y.nextTick=function(b,c){c=c||window;var l="zero-timeout-message-"+S++,g=function(u){u.data==l&&(y.stopPropagation(u),n(c,"message",g),b())};r(c,"message",g),c.postMessage(l,"*")}}y.$idleBlocked=!1,y.onIdle=function(b,c){return setTimeout(function l(){y.$idleBlocked?setTimeout(l,100):b()},c)},y.$idleBlockId=null,y.blockIdle=function(b){y.$idleBlockId&&clearTimeout(y.$idleBlockId),y.$idleBlocked=!0,y.$idleBlockId=setTimeout(function(){y.$idleBlocked=!1},b||100)},y.nextFrame=typeof window=="object"&&(window.requestAnimationFrame||window.mozRequestAnimationFrame||window.webkitRequestAnimationFrame||window.msRequestAnimationFrame||window.oRequestAnimationFrame),y.nextFrame?y.nextFrame=y.nextFrame.bind(window):y.nextFrame=function(b){setTimeout(b,17)}}),define("ace/range",["require","exports","module"],function(k,y,B){"use strict";var E=function(p,h){return p.row-h.row||p.column-h.column},A=function(p,h,d,t){this.start={row:p,column:h},this.end={row:d,column:t}};(function(){this.isEqual=function(p){return this.start.row===p.start.row&&this.end.row===p.end.row&&this.start.column===p.start.column&&this.end.column===p.end.column},this.toString=function(){return"Range: ["+this.start.row+"/"+this.start.column+"] -> ["+this.end.row+"/"+this.end.column+"]"},this.contains=function(p,h){return this.compare(p,h)==0},this.compareRange=function(p){var h,d=p.end,t=p.start;return h=this.compare(d.row,d.column),h==1?(h=this.compare(t.row,t.column),h==1?2:h==0?1:0):h==-1?-2:(h=this.compare(t.row,t.column),h==-1?-1:h==1?42:0)},this.comparePoint=function(p){return this.compare(p.row,p.column)},this.containsRange=function(p){return this.comparePoint(p.start)==0&&this.comparePoint(p.end)==0},this.intersects=function(p){var h=this.compareRange(p);return h==-1||h==0||h==1},this.isEnd=function(p,h){return this.end.row==p&&this.end.column==h},this.isStart=function(p,h){return this.start.row==p&&this.start.column==h},this.setStart=function(p,h){typeof p=="object"?(this.start.column=p.column,this.start.row=p.row):(this.start.row=p,this.start.column=h)},this.setEnd=function(p,h){typeof p=="object"?(this.end.column=p.column,this.end.row=p.row):(this.end.row=p,this.end.column=h)},this.inside=function(p,h){return this.compare(p,h)==0?!(this.isEnd(p,h)||this.isStart(p,h)):!1},this.insideStart=function(p,h){return this.compare(p,h)==0?!this.isEnd(p,h):!1},this.insideEnd=function(p,h){return this.compare(p,h)==0?!this.isStart(p,h):!1},this.compare=function(p,h){return!this.isMultiLine()&&p===this.start.row?h<this.start.column?-1:h>this.end.column?1:0:p<this.start.row?-1:p>this.end.row?1:this.start.row===p?h>=this.start.column?0:-1:this.end.row===p?h<=this.end.column?0:1:0},this.compareStart=function(p,h){return this.start.row==p&&this.start.column==h?-1:this.compare(p,h)},this.compareEnd=function(p,h){return this.end.row==p&&this.end.column==h?1:this.compare(p,h)},this.compareInside=function(p,h){return this.end.row==p&&this.end.column==h?1:this.start.row==p&&this.start.column==h?-1:this.compare(p,h)},this.clipRows=function(p,h){if(this.end.row>h)var d={row:h+1,column:0};else if(this.end.row<p)var d={row:p,column:0};if(this.start.row>h)var t={row:h+1,column:0};else if(this.start.row<p)var t={row:p,column:0};return A.fromPoints(t||this.start,d||this.end)},this.extend=function(p,h){var d=this.compare(p,h);if(d==0)return this;if(d==-1)var t={row:p,column:h};else var e={row:p,column:h};return A.fromPoints(t||this.start,e||this.end)},this.isEmpty=function(){return this.start.row===this.end.row&&this.start.column===this.end.column},this.isMultiLine=function(){return this.start.row!==this.end.row},this.clone=function(){return A.fromPoints(this.start,this.end)},this.collapseRows=function(){return this.end.column==0?new A(this.start.row,0,Math.max(this.start.row,this.end.row-1),0):new A(this.start.row,0,this.end.row,0)},this.toScreenRange=function(p){var h=p.documentToScreenPosition(this.start),d=p.documentToScreenPosition(this.end);return new A(h.row,h.column,d.row,d.column)},this.moveBy=function(p,h){this.start.row+=p,this.start.column+=h,this.end.row+=p,this.end.column+=h}}).call(A.prototype),A.fromPoints=function(p,h){return new A(p.row,p.column,h.row,h.column)},A.comparePoints=E,A.comparePoints=function(p,h){return p.row-h.row||p.column-h.column},y.Range=A}),define("ace/lib/lang",["require","exports","module"],function(k,y,B){"use strict";y.last=function(p){return p[p.length-1]},y.stringReverse=function(p){return p.split("").reverse().join("")},y.stringRepeat=function(p,h){for(var d="";h>0;)h&1&&(d+=p),(h>>=1)&&(p+=p);return d};var E=/^\s\s*/,A=/\s\s*$/;y.stringTrimLeft=function(p){return p.replace(E,"")},y.stringTrimRight=function(p){return p.replace(A,"")},y.copyObject=function(p){var h={};for(var d in p)h[d]=p[d];return h},y.copyArray=function(p){for(var h=[],d=0,t=p.length;d<t;d++)p[d]&&typeof p[d]=="object"?h[d]=this.copyObject(p[d]):h[d]=p[d];return h},y.deepCopy=function p(h){if(typeof h!="object"||!h)return h;var d;if(Array.isArray(h)){d=[];for(var t=0;t<h.length;t++)d[t]=p(h[t]);return d}if(Object.prototype.toString.call(h)!=="[object Object]")return h;d={};for(var t in h)d[t]=p(h[t]);return d},y.arrayToMap=function(p){for(var h={},d=0;d<p.length;d++)h[p[d]]=1;return h},y.createMap=function(p){var h=Object.create(null);for(var d in p)h[d]=p[d];return h},y.arrayRemove=function(p,h){for(var d=0;d<=p.length;d++)h===p[d]&&p.splice(d,1)},y.escapeRegExp=function(p){return p.replace(/([.*+?^${}()|[\]\/\\])/g,"\\$1")},y.escapeHTML=function(p){return(""+p).replace(/&/g,"&#38;").replace(/"/g,"&#34;").replace(/'/g,"&#39;").replace(/</g,"&#60;")},y.getMatchOffsets=function(p,h){var d=[];return p.replace(h,function(t){d.push({offset:arguments[arguments.length-2],length:t.length})}),d},y.deferredCall=function(p){var h=null,d=function(){h=null,p()},t=function(e){return t.cancel(),h=setTimeout(d,e||0),t};return t.schedule=t,t.call=function(){return this.cancel(),p(),t},t.cancel=function(){return clearTimeout(h),h=null,t},t.isPending=function(){return h},t},y.delayedCall=function(p,h){var d=null,t=function(){d=null,p()},e=function(i){d==null&&(d=setTimeout(t,i||h))};return e.delay=function(i){d&&clearTimeout(d),d=setTimeout(t,i||h)},e.schedule=e,e.call=function(){this.cancel(),p()},e.cancel=function(){d&&clearTimeout(d),d=null},e.isPending=function(){return d},e}}),define("ace/clipboard",["require","exports","module"],function(k,y,B){"use strict";var E;B.exports={lineMode:!1,pasteCancelled:function(){return E&&E>Date.now()-50?!0:E=!1},cancel:function(){E=Date.now()}}}),define("ace/keyboard/textinput",["require","exports","module","ace/lib/event","ace/lib/useragent","ace/lib/dom","ace/lib/lang","ace/clipboard","ace/lib/keys"],function(k,y,B){"use strict";var E=k("../lib/event"),A=k("../lib/useragent"),p=k("../lib/dom"),h=k("../lib/lang"),d=k("../clipboard"),t=A.isChrome<18,e=A.isIE,i=A.isChrome>63,r=400,n=k("../lib/keys"),a=n.KEY_MODS,m=A.isIOS,w=m?/\s/:/\n/,S=A.isMobile,b=function(c,l){var g=p.createElement("textarea");g.className="ace_text-input",g.setAttribute("wrap","off"),g.setAttribute("autocorrect","off"),g.setAttribute("autocapitalize","off"),g.setAttribute("spellcheck",!1),g.style.opacity="0",c.insertBefore(g,c.firstChild);var u=!1,o=!1,f=!1,s=!1,v="";S||(g.style.fontSize="1px");var $=!1,C=!1,x="",L=0,R=0,T=0;try{var _=document.activeElement===g}catch{}E.addListener(g,"blur",function(W){C||(l.onBlur(W),_=!1)},l),E.addListener(g,"focus",function(W){if(!C){if(_=!0,A.isEdge)try{if(!document.hasFocus())return}catch{}l.onFocus(W),A.isEdge?setTimeout(F):F()}},l),this.$focusScroll=!1,this.focus=function(){if(v||i||this.$focusScroll=="browser")return g.focus({preventScroll:!0});var W=g.style.top;g.style.position="fixed",g.style.top="0px";try{var Y=g.getBoundingClientRect().top!=0}catch{return}var j=[];if(Y)for(var H=g.parentElement;H&&H.nodeType==1;)j.push(H),H.setAttribute("ace_nocontext",!0),!H.parentElement&&H.getRootNode?H=H.getRootNode().host:H=H.parentElement;g.focus({preventScroll:!0}),Y&&j.forEach(function(K){K.removeAttribute("ace_nocontext")}),setTimeout(function(){g.style.position="",g.style.top=="0px"&&(g.style.top=W)},0)},this.blur=function(){g.blur()},this.isFocused=function(){return _},l.on("beforeEndOperation",function(){var W=l.curOp,Y=W&&W.command&&W.command.name;if(Y!="insertstring"){var j=Y&&(W.docChanged||W.selectionChanged);f&&j&&(x=g.value="",ie()),F()}});var F=m?function(W){if(!(!_||u&&!W||s)){W||(W="");var Y=`
+ ab`+W+`cde fg
+`;Y!=g.value&&(g.value=x=Y);var j=4,H=4+(W.length||(l.selection.isEmpty()?0:1));(L!=j||R!=H)&&g.setSelectionRange(j,H),L=j,R=H}}:function(){if(!(f||s)&&!(!_&&!I)){f=!0;var W=0,Y=0,j="";if(l.session){var H=l.selection,K=H.getRange(),ee=H.cursor.row;if(W=K.start.column,Y=K.end.column,j=l.session.getLine(ee),K.start.row!=ee){var oe=l.session.getLine(ee-1);W=K.start.row<ee-1?0:W,Y+=oe.length+1,j=oe+`
+`+j}else if(K.end.row!=ee){var le=l.session.getLine(ee+1);Y=K.end.row>ee+1?le.length:Y,Y+=j.length+1,j=j+`
+`+le}else S&&ee>0&&(j=`
+`+j,Y+=1,W+=1);j.length>r&&(W<r&&Y<r?j=j.slice(0,r):(j=`
+`,W==Y?W=Y=0:(W=0,Y=1)))}var ae=j+`
 
-`;if(re!=C&&(g.value=C=re,k=R=re.length),M&&(k=g.selectionStart,R=g.selectionEnd),R!=H||k!=F||g.selectionEnd!=R)try{g.setSelectionRange(F,H),k=F,R=H}catch{}f=!1}};this.resetSelection=I,O&&l.onFocus();var D=function(F){return F.selectionStart===0&&F.selectionEnd>=C.length&&F.value===C&&C&&F.selectionEnd!==R},T=function(F){f||(d?d=!1:D(g)?(l.selectAll(),I()):S&&g.selectionStart!=k&&I())},P=null;this.setInputHandler=function(F){P=F},this.getInputHandler=function(){return P};var M=!1,W=function(F,H){if(M&&(M=!1),a)return I(),F&&l.onPaste(F),a=!1,"";for(var U=g.selectionStart,Z=g.selectionEnd,ne=k,se=C.length-R,ae=F,oe=F.length-U,re=F.length-Z,ie=0;ne>0&&C[ie]==F[ie];)ie++,ne--;for(ae=ae.slice(ie),ie=1;se>0&&C.length-ie>k-1&&C[C.length-ie]==F[F.length-ie];)ie++,se--;oe-=ie-1,re-=ie-1;var ue=ae.length-ie+1;if(ue<0&&(ne=-ue,ue=0),ae=ae.slice(0,ue),!H&&!ae&&!oe&&!ne&&!se&&!re)return"";s=!0;var de=!1;return A.isAndroid&&ae==". "&&(ae="  ",de=!0),ae&&!ne&&!se&&!oe&&!re||$?l.onTextInput(ae):l.onTextInput(ae,{extendLeft:ne,extendRight:se,restoreStart:oe,restoreEnd:re}),s=!1,C=F,k=U,R=Z,_=re,de?`
-`:ae},N=function(F){if(f)return K();if(F&&F.inputType){if(F.inputType=="historyUndo")return l.execCommand("undo");if(F.inputType=="historyRedo")return l.execCommand("redo")}var H=g.value,U=W(H,!0);(H.length>r+100||w.test(U)||S&&k<1&&k==R)&&I()},G=function(F,H,U){var Z=F.clipboardData||window.clipboardData;if(!(!Z||t)){var ne=e||U?"Text":"text/plain";try{return H?Z.setData(ne,H)!==!1:Z.getData(ne)}catch(se){if(!U)return G(se,H,!0)}}},z=function(F,H){var U=l.getCopyText();if(!U)return E.preventDefault(F);G(F,U)?(m&&(I(U),d=U,setTimeout(function(){d=!1},10)),H?l.onCut():l.onCopy(),E.preventDefault(F)):(d=!0,g.value=U,g.select(),setTimeout(function(){d=!1,I(),H?l.onCut():l.onCopy()}))},J=function(F){z(F,!0)},X=function(F){z(F,!1)},V=function(F){var H=G(F);u.pasteCancelled()||(typeof H=="string"?(H&&l.onPaste(H,F),A.isIE&&setTimeout(I),E.preventDefault(F)):(g.value="",a=!0))};E.addCommandKeyListener(g,l.onCommandKey.bind(l),l),E.addListener(g,"select",T,l),E.addListener(g,"input",N,l),E.addListener(g,"cut",J,l),E.addListener(g,"copy",X,l),E.addListener(g,"paste",V,l),(!("oncut"in g)||!("oncopy"in g)||!("onpaste"in g))&&E.addListener(c,"keydown",function(F){if(!(A.isMac&&!F.metaKey||!F.ctrlKey))switch(F.keyCode){case 67:X(F);break;case 86:V(F);break;case 88:J(F);break}},l);var j=function(F){if(!(f||!l.onCompositionStart||l.$readOnly)&&(f={},!$)){F.data&&(f.useTextareaForIME=!1),setTimeout(K,0),l._signal("compositionStart"),l.on("mousedown",ee);var H=l.getSelectionRange();H.end.row=H.start.row,H.end.column=H.start.column,f.markerRange=H,f.selectionStart=k,l.onCompositionStart(f),f.useTextareaForIME?(C=g.value="",k=0,R=0):(g.msGetInputContext&&(f.context=g.msGetInputContext()),g.getInputContext&&(f.context=g.getInputContext()))}},K=function(){if(!(!f||!l.onCompositionUpdate||l.$readOnly)){if($)return ee();if(f.useTextareaForIME)l.onCompositionUpdate(g.value);else{var F=g.value;W(F),f.markerRange&&(f.context&&(f.markerRange.start.column=f.selectionStart=f.context.compositionStartOffset),f.markerRange.end.column=f.markerRange.start.column+R-f.selectionStart+_)}}},q=function(F){!l.onCompositionEnd||l.$readOnly||(f=!1,l.onCompositionEnd(),l.off("mousedown",ee),F&&N())};function ee(){x=!0,g.blur(),g.focus(),x=!1}var te=h.delayedCall(K,50).schedule.bind(null,null);function Q(F){F.keyCode==27&&g.value.length<g.selectionStart&&(f||(C=g.value),k=R=-1,I()),te()}E.addListener(g,"compositionstart",j,l),E.addListener(g,"compositionupdate",K,l),E.addListener(g,"keyup",Q,l),E.addListener(g,"keydown",te,l),E.addListener(g,"compositionend",q,l),this.getElement=function(){return g},this.setCommandMode=function(F){$=F,g.readOnly=!1},this.setReadOnly=function(F){$||(g.readOnly=F)},this.setCopyWithEmptySelection=function(F){},this.onContextMenu=function(F){M=!0,I(),l._emit("nativecontextmenu",{target:l,domEvent:F}),this.moveToMouse(F,!0)},this.moveToMouse=function(F,H){v||(v=g.style.cssText),g.style.cssText=(H?"z-index:100000;":"")+(A.isIE?"opacity:0.1;":"")+"text-indent: -"+(k+R)*l.renderer.characterWidth*.5+"px;";var U=l.container.getBoundingClientRect(),Z=p.computedStyle(l.container),ne=U.top+(parseInt(Z.borderTopWidth)||0),se=U.left+(parseInt(U.borderLeftWidth)||0),ae=U.bottom-ne-g.clientHeight-2,oe=function(re){p.translate(g,re.clientX-se-2,Math.min(re.clientY-ne-2,ae))};oe(F),F.type=="mousedown"&&(l.renderer.$isMousePressed=!0,clearTimeout(Y),A.isWin&&E.capture(l.container,oe,le))},this.onContextMenuClose=le;var Y;function le(){clearTimeout(Y),Y=setTimeout(function(){v&&(g.style.cssText=v,v=""),l.renderer.$isMousePressed=!1,l.renderer.$keepTextAreaAtCursor&&l.renderer.$moveTextAreaToCursor()},0)}var he=function(F){l.textInput.onContextMenu(F),le()};E.addListener(g,"mouseup",he,l),E.addListener(g,"mousedown",function(F){F.preventDefault(),le()},l),E.addListener(l.renderer.scroller,"contextmenu",he,l),E.addListener(g,"contextmenu",he,l),m&&ce(c,l,g);function ce(F,H,U){var Z=null,ne=!1;U.addEventListener("keydown",function(ae){Z&&clearTimeout(Z),ne=!0},!0),U.addEventListener("keyup",function(ae){Z=setTimeout(function(){ne=!1},100)},!0);var se=function(ae){if(document.activeElement===U&&!(ne||f||H.$mouseHandler.isMousePressed)&&!d){var oe=U.selectionStart,re=U.selectionEnd,ie=null,ue=0;if(oe==0?ie=n.up:oe==1?ie=n.home:re>R&&C[re]==`
-`?ie=n.end:oe<k&&C[oe-1]==" "?(ie=n.left,ue=o.option):oe<k||oe==k&&R!=k&&oe==re?ie=n.left:re>R&&C.slice(0,re).split(`
-`).length>2?ie=n.down:re>R&&C[re-1]==" "?(ie=n.right,ue=o.option):(re>R||re==R&&R!=k&&oe==re)&&(ie=n.right),oe!==re&&(ue|=o.shift),ie){var de=H.onCommandKey({},ue,ie);if(!de&&H.commands){ie=n.keyCodeToString(ie);var fe=H.commands.findKeyCommand(ue,ie);fe&&H.execCommand(fe)}k=oe,R=re,I("")}}};document.addEventListener("selectionchange",se),H.on("destroy",function(){document.removeEventListener("selectionchange",se)})}};b.TextInput=y,b.$setUserAgentForTests=function(c,l){S=c,m=l}}),define("ace/mouse/default_handlers",["require","exports","module","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("../lib/useragent"),A=0,p=550;function h(e){e.$clickSelection=null;var i=e.editor;i.setDefaultHandler("mousedown",this.onMouseDown.bind(e)),i.setDefaultHandler("dblclick",this.onDoubleClick.bind(e)),i.setDefaultHandler("tripleclick",this.onTripleClick.bind(e)),i.setDefaultHandler("quadclick",this.onQuadClick.bind(e)),i.setDefaultHandler("mousewheel",this.onMouseWheel.bind(e));var r=["select","startSelect","selectEnd","selectAllEnd","selectByWordsEnd","selectByLinesEnd","dragWait","dragWaitEnd","focusWait"];r.forEach(function(n){e[n]=this[n]},this),e.selectByLines=this.extendSelectionBy.bind(e,"getLineRange"),e.selectByWords=this.extendSelectionBy.bind(e,"getWordRange")}(function(){this.onMouseDown=function(e){var i=e.inSelection(),r=e.getDocumentPosition();this.mousedownEvent=e;var n=this.editor,o=e.getButton();if(o!==0){var m=n.getSelectionRange(),w=m.isEmpty();(w||o==1)&&n.selection.moveToPosition(r),o==2&&(n.textInput.onContextMenu(e.domEvent),E.isMozilla||e.preventDefault());return}if(this.mousedownEvent.time=Date.now(),i&&!n.isFocused()&&(n.focus(),this.$focusTimeout&&!this.$clickSelection&&!n.inMultiSelectMode)){this.setState("focusWait"),this.captureMouse(e);return}return this.captureMouse(e),this.startSelect(r,e.domEvent._clicks>1),e.preventDefault()},this.startSelect=function(e,i){e=e||this.editor.renderer.screenToTextCoordinates(this.x,this.y);var r=this.editor;this.mousedownEvent&&(this.mousedownEvent.getShiftKey()?r.selection.selectToPosition(e):i||r.selection.moveToPosition(e),i||this.select(),r.renderer.scroller.setCapture&&r.renderer.scroller.setCapture(),r.setStyle("ace_selecting"),this.setState("select"))},this.select=function(){var e,i=this.editor,r=i.renderer.screenToTextCoordinates(this.x,this.y);if(this.$clickSelection){var n=this.$clickSelection.comparePoint(r);if(n==-1)e=this.$clickSelection.end;else if(n==1)e=this.$clickSelection.start;else{var o=t(this.$clickSelection,r);r=o.cursor,e=o.anchor}i.selection.setSelectionAnchor(e.row,e.column)}i.selection.selectToPosition(r),i.renderer.scrollCursorIntoView()},this.extendSelectionBy=function(e){var i,r=this.editor,n=r.renderer.screenToTextCoordinates(this.x,this.y),o=r.selection[e](n.row,n.column);if(this.$clickSelection){var m=this.$clickSelection.comparePoint(o.start),w=this.$clickSelection.comparePoint(o.end);if(m==-1&&w<=0)i=this.$clickSelection.end,(o.end.row!=n.row||o.end.column!=n.column)&&(n=o.start);else if(w==1&&m>=0)i=this.$clickSelection.start,(o.start.row!=n.row||o.start.column!=n.column)&&(n=o.end);else if(m==-1&&w==1)n=o.end,i=o.start;else{var S=t(this.$clickSelection,n);n=S.cursor,i=S.anchor}r.selection.setSelectionAnchor(i.row,i.column)}r.selection.selectToPosition(n),r.renderer.scrollCursorIntoView()},this.selectEnd=this.selectAllEnd=this.selectByWordsEnd=this.selectByLinesEnd=function(){this.$clickSelection=null,this.editor.unsetStyle("ace_selecting"),this.editor.renderer.scroller.releaseCapture&&this.editor.renderer.scroller.releaseCapture()},this.focusWait=function(){var e=u(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y),i=Date.now();(e>A||i-this.mousedownEvent.time>this.$focusTimeout)&&this.startSelect(this.mousedownEvent.getDocumentPosition())},this.onDoubleClick=function(e){var i=e.getDocumentPosition(),r=this.editor,n=r.session,o=n.getBracketRange(i);o?(o.isEmpty()&&(o.start.column--,o.end.column++),this.setState("select")):(o=r.selection.getWordRange(i.row,i.column),this.setState("selectByWords")),this.$clickSelection=o,this.select()},this.onTripleClick=function(e){var i=e.getDocumentPosition(),r=this.editor;this.setState("selectByLines");var n=r.getSelectionRange();n.isMultiLine()&&n.contains(i.row,i.column)?(this.$clickSelection=r.selection.getLineRange(n.start.row),this.$clickSelection.end=r.selection.getLineRange(n.end.row).end):this.$clickSelection=r.selection.getLineRange(i.row),this.select()},this.onQuadClick=function(e){var i=this.editor;i.selectAll(),this.$clickSelection=i.getSelectionRange(),this.setState("selectAll")},this.onMouseWheel=function(e){if(!e.getAccelKey()){e.getShiftKey()&&e.wheelY&&!e.wheelX&&(e.wheelX=e.wheelY,e.wheelY=0);var i=this.editor;this.$lastScroll||(this.$lastScroll={t:0,vx:0,vy:0,allowed:0});var r=this.$lastScroll,n=e.domEvent.timeStamp,o=n-r.t,m=o?e.wheelX/o:r.vx,w=o?e.wheelY/o:r.vy;o<p&&(m=(m+r.vx)/2,w=(w+r.vy)/2);var S=Math.abs(m/w),y=!1;if(S>=1&&i.renderer.isScrollableBy(e.wheelX*e.speed,0)&&(y=!0),S<=1&&i.renderer.isScrollableBy(0,e.wheelY*e.speed)&&(y=!0),y)r.allowed=n;else if(n-r.allowed<p){var c=Math.abs(m)<=1.5*Math.abs(r.vx)&&Math.abs(w)<=1.5*Math.abs(r.vy);c?(y=!0,r.allowed=n):r.allowed=0}if(r.t=n,r.vx=m,r.vy=w,y)return i.renderer.scrollBy(e.wheelX*e.speed,e.wheelY*e.speed),e.stop()}}}).call(h.prototype),b.DefaultHandlers=h;function u(e,i,r,n){return Math.sqrt(Math.pow(r-e,2)+Math.pow(n-i,2))}function t(e,i){if(e.start.row==e.end.row)var r=2*i.column-e.start.column-e.end.column;else if(e.start.row==e.end.row-1&&!e.start.column&&!e.end.column)var r=i.column-4;else var r=2*i.row-e.start.row-e.end.row;return r<0?{cursor:e.start,anchor:e.end}:{cursor:e.end,anchor:e.start}}}),define("ace/tooltip",["require","exports","module","ace/lib/oop","ace/lib/dom"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/dom");function p(h){this.isOpen=!1,this.$element=null,this.$parentNode=h}(function(){this.$init=function(){return this.$element=A.createElement("div"),this.$element.className="ace_tooltip",this.$element.style.display="none",this.$parentNode.appendChild(this.$element),this.$element},this.getElement=function(){return this.$element||this.$init()},this.setText=function(h){this.getElement().textContent=h},this.setHtml=function(h){this.getElement().innerHTML=h},this.setPosition=function(h,u){this.getElement().style.left=h+"px",this.getElement().style.top=u+"px"},this.setClassName=function(h){A.addCssClass(this.getElement(),h)},this.show=function(h,u,t){h!=null&&this.setText(h),u!=null&&t!=null&&this.setPosition(u,t),this.isOpen||(this.getElement().style.display="block",this.isOpen=!0)},this.hide=function(){this.isOpen&&(this.getElement().style.display="none",this.isOpen=!1)},this.getHeight=function(){return this.getElement().offsetHeight},this.getWidth=function(){return this.getElement().offsetWidth},this.destroy=function(){this.isOpen=!1,this.$element&&this.$element.parentNode&&this.$element.parentNode.removeChild(this.$element)}}).call(p.prototype),b.Tooltip=p}),define("ace/mouse/default_gutter_handler",["require","exports","module","ace/lib/dom","ace/lib/oop","ace/lib/event","ace/tooltip"],function(L,b,B){"use strict";var E=L("../lib/dom"),A=L("../lib/oop"),p=L("../lib/event"),h=L("../tooltip").Tooltip;function u(e){var i=e.editor,r=i.renderer.$gutterLayer,n=new t(i.container);e.editor.setDefaultHandler("guttermousedown",function(l){if(!(!i.isFocused()||l.getButton()!=0)){var g=r.getRegion(l);if(g!="foldWidgets"){var d=l.getDocumentPosition().row,a=i.session.selection;if(l.getShiftKey())a.selectTo(d,0);else{if(l.domEvent.detail==2)return i.selectAll(),l.preventDefault();e.$clickSelection=i.selection.getLineRange(d)}return e.setState("selectByLines"),e.captureMouse(l),l.preventDefault()}}});var o,m,w;function S(){var l=m.getDocumentPosition().row,g=r.$annotations[l];if(!g)return y();var d=i.session.getLength();if(l==d){var a=i.renderer.pixelToScreenCoordinates(0,m.y).row,f=m.$pos;if(a>i.session.documentToScreenRow(f.row,f.column))return y()}if(w!=g)if(w=g.text.join("<br/>"),n.setHtml(w),n.show(),i._signal("showGutterTooltip",n),i.on("mousewheel",y),e.$tooltipFollowsMouse)c(m);else{var s=m.domEvent.target,v=s.getBoundingClientRect(),$=n.getElement().style;$.left=v.right+"px",$.top=v.bottom+"px"}}function y(){o&&(o=clearTimeout(o)),w&&(n.hide(),w=null,i._signal("hideGutterTooltip",n),i.off("mousewheel",y))}function c(l){n.setPosition(l.x,l.y)}e.editor.setDefaultHandler("guttermousemove",function(l){var g=l.domEvent.target||l.domEvent.srcElement;if(E.hasCssClass(g,"ace_fold-widget"))return y();w&&e.$tooltipFollowsMouse&&c(l),m=l,!o&&(o=setTimeout(function(){o=null,m&&!e.isMousePressed?S():y()},50))}),p.addListener(i.renderer.$gutter,"mouseout",function(l){m=null,!(!w||o)&&(o=setTimeout(function(){o=null,y()},50))},i),i.on("changeSession",y)}function t(e){h.call(this,e)}A.inherits(t,h),function(){this.setPosition=function(e,i){var r=window.innerWidth||document.documentElement.clientWidth,n=window.innerHeight||document.documentElement.clientHeight,o=this.getWidth(),m=this.getHeight();e+=15,i+=15,e+o>r&&(e-=e+o-r),i+m>n&&(i-=20+m),h.prototype.setPosition.call(this,e,i)}}.call(t.prototype),b.GutterHandler=u}),define("ace/mouse/mouse_event",["require","exports","module","ace/lib/event","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("../lib/event"),A=L("../lib/useragent"),p=b.MouseEvent=function(h,u){this.domEvent=h,this.editor=u,this.x=this.clientX=h.clientX,this.y=this.clientY=h.clientY,this.$pos=null,this.$inSelection=null,this.propagationStopped=!1,this.defaultPrevented=!1};(function(){this.stopPropagation=function(){E.stopPropagation(this.domEvent),this.propagationStopped=!0},this.preventDefault=function(){E.preventDefault(this.domEvent),this.defaultPrevented=!0},this.stop=function(){this.stopPropagation(),this.preventDefault()},this.getDocumentPosition=function(){return this.$pos?this.$pos:(this.$pos=this.editor.renderer.screenToTextCoordinates(this.clientX,this.clientY),this.$pos)},this.inSelection=function(){if(this.$inSelection!==null)return this.$inSelection;var h=this.editor,u=h.getSelectionRange();if(u.isEmpty())this.$inSelection=!1;else{var t=this.getDocumentPosition();this.$inSelection=u.contains(t.row,t.column)}return this.$inSelection},this.getButton=function(){return E.getButton(this.domEvent)},this.getShiftKey=function(){return this.domEvent.shiftKey},this.getAccelKey=A.isMac?function(){return this.domEvent.metaKey}:function(){return this.domEvent.ctrlKey}}).call(p.prototype)}),define("ace/mouse/dragdrop_handler",["require","exports","module","ace/lib/dom","ace/lib/event","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("../lib/dom"),A=L("../lib/event"),p=L("../lib/useragent"),h=200,u=200,t=5;function e(r){var n=r.editor,o=E.createElement("img");o.src="data:image/gif;base64,R0lGODlhAQABAAAAACH5BAEKAAEALAAAAAABAAEAAAICTAEAOw==",p.isOpera&&(o.style.cssText="width:1px;height:1px;position:fixed;top:0;left:0;z-index:2147483647;opacity:0;");var m=["dragWait","dragWaitEnd","startDrag","dragReadyEnd","onMouseDrag"];m.forEach(function(M){r[M]=this[M]},this),n.on("mousedown",this.onMouseDown.bind(r));var w=n.container,S,y,c,l,g,d,a=0,f,s,v,$,x;this.onDragStart=function(M){if(this.cancelDrag||!w.draggable){var W=this;return setTimeout(function(){W.startSelect(),W.captureMouse(M)},0),M.preventDefault()}g=n.getSelectionRange();var N=M.dataTransfer;N.effectAllowed=n.getReadOnly()?"copy":"copyMove",p.isOpera&&(n.container.appendChild(o),o.scrollTop=0),N.setDragImage&&N.setDragImage(o,0,0),p.isOpera&&n.container.removeChild(o),N.clearData(),N.setData("Text",n.session.getTextRange()),s=!0,this.setState("drag")},this.onDragEnd=function(M){if(w.draggable=!1,s=!1,this.setState(null),!n.getReadOnly()){var W=M.dataTransfer.dropEffect;!f&&W=="move"&&n.session.remove(n.getSelectionRange()),n.$resetCursorStyle()}this.editor.unsetStyle("ace_dragging"),this.editor.renderer.setCursorStyle("")},this.onDragEnter=function(M){if(!(n.getReadOnly()||!T(M.dataTransfer)))return y=M.clientX,c=M.clientY,S||_(),a++,M.dataTransfer.dropEffect=f=P(M),A.preventDefault(M)},this.onDragOver=function(M){if(!(n.getReadOnly()||!T(M.dataTransfer)))return y=M.clientX,c=M.clientY,S||(_(),a++),I!==null&&(I=null),M.dataTransfer.dropEffect=f=P(M),A.preventDefault(M)},this.onDragLeave=function(M){if(a--,a<=0&&S)return O(),f=null,A.preventDefault(M)},this.onDrop=function(M){if(d){var W=M.dataTransfer;if(s)switch(f){case"move":g.contains(d.row,d.column)?g={start:d,end:d}:g=n.moveText(g,d);break;case"copy":g=n.moveText(g,d,!0);break}else{var N=W.getData("Text");g={start:d,end:n.session.insert(d,N)},n.focus(),f=null}return O(),A.preventDefault(M)}},A.addListener(w,"dragstart",this.onDragStart.bind(r),n),A.addListener(w,"dragend",this.onDragEnd.bind(r),n),A.addListener(w,"dragenter",this.onDragEnter.bind(r),n),A.addListener(w,"dragover",this.onDragOver.bind(r),n),A.addListener(w,"dragleave",this.onDragLeave.bind(r),n),A.addListener(w,"drop",this.onDrop.bind(r),n);function C(M,W){var N=Date.now(),G=!W||M.row!=W.row,z=!W||M.column!=W.column;if(!$||G||z)n.moveCursorToPosition(M),$=N,x={x:y,y:c};else{var J=i(x.x,x.y,y,c);J>t?$=null:N-$>=u&&(n.renderer.scrollCursorIntoView(),$=null)}}function k(M,W){var N=Date.now(),G=n.renderer.layerConfig.lineHeight,z=n.renderer.layerConfig.characterWidth,J=n.renderer.scroller.getBoundingClientRect(),X={x:{left:y-J.left,right:J.right-y},y:{top:c-J.top,bottom:J.bottom-c}},V=Math.min(X.x.left,X.x.right),j=Math.min(X.y.top,X.y.bottom),K={row:M.row,column:M.column};V/z<=2&&(K.column+=X.x.left<X.x.right?-3:2),j/G<=1&&(K.row+=X.y.top<X.y.bottom?-1:1);var q=M.row!=K.row,ee=M.column!=K.column,te=!W||M.row!=W.row;q||ee&&!te?v?N-v>=h&&n.renderer.scrollCursorIntoView(K):v=N:v=null}function R(){var M=d;d=n.renderer.screenToTextCoordinates(y,c),C(d,M),k(d,M)}function _(){g=n.selection.toOrientedRange(),S=n.session.addMarker(g,"ace_selection",n.getSelectionStyle()),n.clearSelection(),n.isFocused()&&n.renderer.$cursorLayer.setBlinking(!1),clearInterval(l),R(),l=setInterval(R,20),a=0,A.addListener(document,"mousemove",D)}function O(){clearInterval(l),n.session.removeMarker(S),S=null,n.selection.fromOrientedRange(g),n.isFocused()&&!s&&n.$resetCursorStyle(),g=null,d=null,a=0,v=null,$=null,A.removeListener(document,"mousemove",D)}var I=null;function D(){I==null&&(I=setTimeout(function(){I!=null&&S&&O()},20))}function T(M){var W=M.types;return!W||Array.prototype.some.call(W,function(N){return N=="text/plain"||N=="Text"})}function P(M){var W=["copy","copymove","all","uninitialized"],N=["move","copymove","linkmove","all","uninitialized"],G=p.isMac?M.altKey:M.ctrlKey,z="uninitialized";try{z=M.dataTransfer.effectAllowed.toLowerCase()}catch{}var J="none";return G&&W.indexOf(z)>=0?J="copy":N.indexOf(z)>=0?J="move":W.indexOf(z)>=0&&(J="copy"),J}}(function(){this.dragWait=function(){var r=Date.now()-this.mousedownEvent.time;r>this.editor.getDragDelay()&&this.startDrag()},this.dragWaitEnd=function(){var r=this.editor.container;r.draggable=!1,this.startSelect(this.mousedownEvent.getDocumentPosition()),this.selectEnd()},this.dragReadyEnd=function(r){this.editor.$resetCursorStyle(),this.editor.unsetStyle("ace_dragging"),this.editor.renderer.setCursorStyle(""),this.dragWaitEnd()},this.startDrag=function(){this.cancelDrag=!1;var r=this.editor,n=r.container;n.draggable=!0,r.renderer.$cursorLayer.setBlinking(!1),r.setStyle("ace_dragging");var o=p.isWin?"default":"move";r.renderer.setCursorStyle(o),this.setState("dragReady")},this.onMouseDrag=function(r){var n=this.editor.container;if(p.isIE&&this.state=="dragReady"){var o=i(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y);o>3&&n.dragDrop()}if(this.state==="dragWait"){var o=i(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y);o>0&&(n.draggable=!1,this.startSelect(this.mousedownEvent.getDocumentPosition()))}},this.onMouseDown=function(r){if(this.$dragEnabled){this.mousedownEvent=r;var n=this.editor,o=r.inSelection(),m=r.getButton(),w=r.domEvent.detail||1;if(w===1&&m===0&&o){if(r.editor.inMultiSelectMode&&(r.getAccelKey()||r.getShiftKey()))return;this.mousedownEvent.time=Date.now();var S=r.domEvent.target||r.domEvent.srcElement;if("unselectable"in S&&(S.unselectable="on"),n.getDragDelay()){if(p.isWebKit){this.cancelDrag=!0;var y=n.container;y.draggable=!0}this.setState("dragWait")}else this.startDrag();this.captureMouse(r,this.onMouseDrag.bind(this)),r.defaultPrevented=!0}}}}).call(e.prototype);function i(r,n,o,m){return Math.sqrt(Math.pow(o-r,2)+Math.pow(m-n,2))}b.DragdropHandler=e}),define("ace/mouse/touch_handler",["require","exports","module","ace/mouse/mouse_event","ace/lib/event","ace/lib/dom"],function(L,b,B){"use strict";var E=L("./mouse_event").MouseEvent,A=L("../lib/event"),p=L("../lib/dom");b.addTouchListeners=function(h,u){var t="scroll",e,i,r,n,o,m,w=0,S,y=0,c=0,l=0,g,d;function a(){var C=window.navigator&&window.navigator.clipboard,k=!1,R=function(){var O=u.getCopyText(),I=u.session.getUndoManager().hasUndo();d.replaceChild(p.buildDom(k?["span",!O&&["span",{class:"ace_mobile-button",action:"selectall"},"Select All"],O&&["span",{class:"ace_mobile-button",action:"copy"},"Copy"],O&&["span",{class:"ace_mobile-button",action:"cut"},"Cut"],C&&["span",{class:"ace_mobile-button",action:"paste"},"Paste"],I&&["span",{class:"ace_mobile-button",action:"undo"},"Undo"],["span",{class:"ace_mobile-button",action:"find"},"Find"],["span",{class:"ace_mobile-button",action:"openCommandPallete"},"Pallete"]]:["span"]),d.firstChild)},_=function(O){var I=O.target.getAttribute("action");if(I=="more"||!k)return k=!k,R();I=="paste"?C.readText().then(function(D){u.execCommand(I,D)}):I&&((I=="cut"||I=="copy")&&(C?C.writeText(u.getCopyText()):document.execCommand("copy")),u.execCommand(I)),d.firstChild.style.display="none",k=!1,I!="openCommandPallete"&&u.focus()};d=p.buildDom(["div",{class:"ace_mobile-menu",ontouchstart:function(O){t="menu",O.stopPropagation(),O.preventDefault(),u.textInput.focus()},ontouchend:function(O){O.stopPropagation(),O.preventDefault(),_(O)},onclick:_},["span"],["span",{class:"ace_mobile-button",action:"more"},"..."]],u.container)}function f(){d||a();var C=u.selection.cursor,k=u.renderer.textToScreenCoordinates(C.row,C.column),R=u.renderer.textToScreenCoordinates(0,0).pageX,_=u.renderer.scrollLeft,O=u.container.getBoundingClientRect();d.style.top=k.pageY-O.top-3+"px",k.pageX-O.left<O.width-70?(d.style.left="",d.style.right="10px"):(d.style.right="",d.style.left=R+_-O.left+"px"),d.style.display="",d.firstChild.style.display="none",u.on("input",s)}function s(C){d&&(d.style.display="none"),u.off("input",s)}function v(){o=null,clearTimeout(o);var C=u.selection.getRange(),k=C.contains(S.row,S.column);(C.isEmpty()||!k)&&(u.selection.moveToPosition(S),u.selection.selectWord()),t="wait",f()}function $(){o=null,clearTimeout(o),u.selection.moveToPosition(S);var C=y>=2?u.selection.getLineRange(S.row):u.session.getBracketRange(S);C&&!C.isEmpty()?u.selection.setRange(C):u.selection.selectWord(),t="wait"}A.addListener(h,"contextmenu",function(C){if(g){var k=u.textInput.getElement();k.focus()}},u),A.addListener(h,"touchstart",function(C){var k=C.touches;if(o||k.length>1){clearTimeout(o),o=null,r=-1,t="zoom";return}g=u.$mouseHandler.isMousePressed=!0;var R=u.renderer.layerConfig.lineHeight,_=u.renderer.layerConfig.lineHeight,O=C.timeStamp;n=O;var I=k[0],D=I.clientX,T=I.clientY;Math.abs(e-D)+Math.abs(i-T)>R&&(r=-1),e=C.clientX=D,i=C.clientY=T,c=l=0;var P=new E(C,u);if(S=P.getDocumentPosition(),O-r<500&&k.length==1&&!w)y++,C.preventDefault(),C.button=0,$();else{y=0;var M=u.selection.cursor,W=u.selection.isEmpty()?M:u.selection.anchor,N=u.renderer.$cursorLayer.getPixelPosition(M,!0),G=u.renderer.$cursorLayer.getPixelPosition(W,!0),z=u.renderer.scroller.getBoundingClientRect(),J=u.renderer.layerConfig.offset,X=u.renderer.scrollLeft,V=function(q,ee){return q=q/_,ee=ee/R-.75,q*q+ee*ee};if(C.clientX<z.left){t="zoom";return}var j=V(C.clientX-z.left-N.left+X,C.clientY-z.top-N.top+J),K=V(C.clientX-z.left-G.left+X,C.clientY-z.top-G.top+J);j<3.5&&K<3.5&&(t=j>K?"cursor":"anchor"),K<3.5?t="anchor":j<3.5?t="cursor":t="scroll",o=setTimeout(v,450)}r=O},u),A.addListener(h,"touchend",function(C){g=u.$mouseHandler.isMousePressed=!1,m&&clearInterval(m),t=="zoom"?(t="",w=0):o?(u.selection.moveToPosition(S),w=0,f()):t=="scroll"?(x(),s()):f(),clearTimeout(o),o=null},u),A.addListener(h,"touchmove",function(C){o&&(clearTimeout(o),o=null);var k=C.touches;if(!(k.length>1||t=="zoom")){var R=k[0],_=e-R.clientX,O=i-R.clientY;if(t=="wait")if(_*_+O*O>4)t="cursor";else return C.preventDefault();e=R.clientX,i=R.clientY,C.clientX=R.clientX,C.clientY=R.clientY;var I=C.timeStamp,D=I-n;if(n=I,t=="scroll"){var T=new E(C,u);T.speed=1,T.wheelX=_,T.wheelY=O,10*Math.abs(_)<Math.abs(O)&&(_=0),10*Math.abs(O)<Math.abs(_)&&(O=0),D!=0&&(c=_/D,l=O/D),u._emit("mousewheel",T),T.propagationStopped||(c=l=0)}else{var P=new E(C,u),M=P.getDocumentPosition();t=="cursor"?u.selection.moveCursorToPosition(M):t=="anchor"&&u.selection.setSelectionAnchor(M.row,M.column),u.renderer.scrollCursorIntoView(M),C.preventDefault()}}},u);function x(){w+=60,m=setInterval(function(){w--<=0&&(clearInterval(m),m=null),Math.abs(c)<.01&&(c=0),Math.abs(l)<.01&&(l=0),w<20&&(c=.9*c),w<20&&(l=.9*l);var C=u.session.getScrollTop();u.renderer.scrollBy(10*c,10*l),C==u.session.getScrollTop()&&(w=0)},10)}}}),define("ace/lib/net",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";var E=L("./dom");b.get=function(A,p){var h=new XMLHttpRequest;h.open("GET",A,!0),h.onreadystatechange=function(){h.readyState===4&&p(h.responseText)},h.send(null)},b.loadScript=function(A,p){var h=E.getDocumentHead(),u=document.createElement("script");u.src=A,h.appendChild(u),u.onload=u.onreadystatechange=function(t,e){(e||!u.readyState||u.readyState=="loaded"||u.readyState=="complete")&&(u=u.onload=u.onreadystatechange=null,e||p())}},b.qualifyURL=function(A){var p=document.createElement("a");return p.href=A,p.href}}),define("ace/lib/event_emitter",["require","exports","module"],function(L,b,B){"use strict";var E={},A=function(){this.propagationStopped=!0},p=function(){this.defaultPrevented=!0};E._emit=E._dispatchEvent=function(h,u){this._eventRegistry||(this._eventRegistry={}),this._defaultHandlers||(this._defaultHandlers={});var t=this._eventRegistry[h]||[],e=this._defaultHandlers[h];if(!(!t.length&&!e)){(typeof u!="object"||!u)&&(u={}),u.type||(u.type=h),u.stopPropagation||(u.stopPropagation=A),u.preventDefault||(u.preventDefault=p),t=t.slice();for(var i=0;i<t.length&&(t[i](u,this),!u.propagationStopped);i++);if(e&&!u.defaultPrevented)return e(u,this)}},E._signal=function(h,u){var t=(this._eventRegistry||{})[h];if(t){t=t.slice();for(var e=0;e<t.length;e++)t[e](u,this)}},E.once=function(h,u){var t=this;if(this.on(h,function e(){t.off(h,e),u.apply(null,arguments)}),!u)return new Promise(function(e){u=e})},E.setDefaultHandler=function(h,u){var t=this._defaultHandlers;if(t||(t=this._defaultHandlers={_disabled_:{}}),t[h]){var e=t[h],i=t._disabled_[h];i||(t._disabled_[h]=i=[]),i.push(e);var r=i.indexOf(u);r!=-1&&i.splice(r,1)}t[h]=u},E.removeDefaultHandler=function(h,u){var t=this._defaultHandlers;if(t){var e=t._disabled_[h];if(t[h]==u)e&&this.setDefaultHandler(h,e.pop());else if(e){var i=e.indexOf(u);i!=-1&&e.splice(i,1)}}},E.on=E.addEventListener=function(h,u,t){this._eventRegistry=this._eventRegistry||{};var e=this._eventRegistry[h];return e||(e=this._eventRegistry[h]=[]),e.indexOf(u)==-1&&e[t?"unshift":"push"](u),u},E.off=E.removeListener=E.removeEventListener=function(h,u){this._eventRegistry=this._eventRegistry||{};var t=this._eventRegistry[h];if(t){var e=t.indexOf(u);e!==-1&&t.splice(e,1)}},E.removeAllListeners=function(h){h||(this._eventRegistry=this._defaultHandlers=void 0),this._eventRegistry&&(this._eventRegistry[h]=void 0),this._defaultHandlers&&(this._defaultHandlers[h]=void 0)},b.EventEmitter=E}),define("ace/lib/app_config",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(L,b,B){var E=L("./oop"),A=L("./event_emitter").EventEmitter,p={setOptions:function(e){Object.keys(e).forEach(function(i){this.setOption(i,e[i])},this)},getOptions:function(e){var i={};if(e)Array.isArray(e)||(i=e,e=Object.keys(i));else{var r=this.$options;e=Object.keys(r).filter(function(n){return!r[n].hidden})}return e.forEach(function(n){i[n]=this.getOption(n)},this),i},setOption:function(e,i){if(this["$"+e]!==i){var r=this.$options[e];if(!r)return h('misspelled option "'+e+'"');if(r.forwardTo)return this[r.forwardTo]&&this[r.forwardTo].setOption(e,i);r.handlesSet||(this["$"+e]=i),r&&r.set&&r.set.call(this,i)}},getOption:function(e){var i=this.$options[e];return i?i.forwardTo?this[i.forwardTo]&&this[i.forwardTo].getOption(e):i&&i.get?i.get.call(this):this["$"+e]:h('misspelled option "'+e+'"')}};function h(e){typeof console<"u"&&console.warn&&console.warn.apply(console,arguments)}function u(e,i){var r=new Error(e);r.data=i,typeof console=="object"&&console.error&&console.error(r),setTimeout(function(){throw r})}var t=function(){this.$defaultOptions={}};(function(){E.implement(this,A),this.defineOptions=function(e,i,r){return e.$options||(this.$defaultOptions[i]=e.$options={}),Object.keys(r).forEach(function(n){var o=r[n];typeof o=="string"&&(o={forwardTo:o}),o.name||(o.name=n),e.$options[o.name]=o,"initialValue"in o&&(e["$"+o.name]=o.initialValue)}),E.implement(e,p),this},this.resetOptions=function(e){Object.keys(e.$options).forEach(function(i){var r=e.$options[i];"value"in r&&e.setOption(i,r.value)})},this.setDefaultValue=function(e,i,r){if(!e){for(e in this.$defaultOptions)if(this.$defaultOptions[e][i])break;if(!this.$defaultOptions[e][i])return!1}var n=this.$defaultOptions[e]||(this.$defaultOptions[e]={});n[i]&&(n.forwardTo?this.setDefaultValue(n.forwardTo,i,r):n[i].value=r)},this.setDefaultValues=function(e,i){Object.keys(i).forEach(function(r){this.setDefaultValue(e,r,i[r])},this)},this.warn=h,this.reportError=u}).call(t.prototype),b.AppConfig=t}),define("ace/config",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/lib/net","ace/lib/app_config"],function(L,b,B){var E=L("./lib/lang"),A=L("./lib/oop"),p=L("./lib/net"),h=L("./lib/app_config").AppConfig;B.exports=b=new h;var u=function(){return this||typeof window<"u"&&window}(),t={packaged:!1,workerPath:null,modePath:null,themePath:null,basePath:"",suffix:".js",$moduleUrls:{},loadWorkerFromBlob:!0,sharedPopups:!1};b.get=function(n){if(!t.hasOwnProperty(n))throw new Error("Unknown config key: "+n);return t[n]},b.set=function(n,o){if(t.hasOwnProperty(n))t[n]=o;else if(this.setDefaultValue("",n,o)==!1)throw new Error("Unknown config key: "+n)},b.all=function(){return E.copyObject(t)},b.$modes={},b.moduleUrl=function(n,o){if(t.$moduleUrls[n])return t.$moduleUrls[n];var m=n.split("/");o=o||m[m.length-2]||"";var w=o=="snippets"?"/":"-",S=m[m.length-1];if(o=="worker"&&w=="-"){var y=new RegExp("^"+o+"[\\-_]|[\\-_]"+o+"$","g");S=S.replace(y,"")}(!S||S==o)&&m.length>1&&(S=m[m.length-2]);var c=t[o+"Path"];return c==null?c=t.basePath:w=="/"&&(o=w=""),c&&c.slice(-1)!="/"&&(c+="/"),c+o+w+S+this.get("suffix")},b.setModuleUrl=function(n,o){return t.$moduleUrls[n]=o},b.$loading={},b.loadModule=function(n,o){var m,w;Array.isArray(n)&&(w=n[0],n=n[1]);try{m=L(n)}catch{}if(m&&!b.$loading[n])return o&&o(m);if(b.$loading[n]||(b.$loading[n]=[]),b.$loading[n].push(o),!(b.$loading[n].length>1)){var S=function(){L([n],function(y){b._emit("load.module",{name:n,module:y});var c=b.$loading[n];b.$loading[n]=null,c.forEach(function(l){l&&l(y)})})};if(!b.get("packaged"))return S();p.loadScript(b.moduleUrl(n,w),S),e()}};var e=function(){!t.basePath&&!t.workerPath&&!t.modePath&&!t.themePath&&!Object.keys(t.$moduleUrls).length&&(console.error("Unable to infer path to ace from script src,","use ace.config.set('basePath', 'path') to enable dynamic loading of modes and themes","or with webpack use ace/webpack-resolver"),e=function(){})};i(!0);function i(n){if(!(!u||!u.document)){t.packaged=n||L.packaged||B.packaged||u.define&&define.packaged;for(var o={},m="",w=document.currentScript||document._currentScript,S=w&&w.ownerDocument||document,y=S.getElementsByTagName("script"),c=0;c<y.length;c++){var l=y[c],g=l.src||l.getAttribute("src");if(g){for(var d=l.attributes,a=0,f=d.length;a<f;a++){var s=d[a];s.name.indexOf("data-ace-")===0&&(o[r(s.name.replace(/^data-ace-/,""))]=s.value)}var v=g.match(/^(.*)\/ace(\-\w+)?\.js(\?|$)/);v&&(m=v[1])}}m&&(o.base=o.base||m,o.packaged=!0),o.basePath=o.base,o.workerPath=o.workerPath||o.base,o.modePath=o.modePath||o.base,o.themePath=o.themePath||o.base,delete o.base;for(var $ in o)typeof o[$]<"u"&&b.set($,o[$])}}b.init=i;function r(n){return n.replace(/-(.)/g,function(o,m){return m.toUpperCase()})}b.version="1.4.12"}),define("ace/mouse/mouse_handler",["require","exports","module","ace/lib/event","ace/lib/useragent","ace/mouse/default_handlers","ace/mouse/default_gutter_handler","ace/mouse/mouse_event","ace/mouse/dragdrop_handler","ace/mouse/touch_handler","ace/config"],function(L,b,B){"use strict";var E=L("../lib/event"),A=L("../lib/useragent"),p=L("./default_handlers").DefaultHandlers,h=L("./default_gutter_handler").GutterHandler,u=L("./mouse_event").MouseEvent,t=L("./dragdrop_handler").DragdropHandler,e=L("./touch_handler").addTouchListeners,i=L("../config"),r=function(n){var o=this;this.editor=n,new p(this),new h(this),new t(this);var m=function(y){var c=!document.hasFocus||!document.hasFocus()||!n.isFocused()&&document.activeElement==(n.textInput&&n.textInput.getElement());c&&window.focus(),n.focus()},w=n.renderer.getMouseEventTarget();E.addListener(w,"click",this.onMouseEvent.bind(this,"click"),n),E.addListener(w,"mousemove",this.onMouseMove.bind(this,"mousemove"),n),E.addMultiMouseDownListener([w,n.renderer.scrollBarV&&n.renderer.scrollBarV.inner,n.renderer.scrollBarH&&n.renderer.scrollBarH.inner,n.textInput&&n.textInput.getElement()].filter(Boolean),[400,300,250],this,"onMouseEvent",n),E.addMouseWheelListener(n.container,this.onMouseWheel.bind(this,"mousewheel"),n),e(n.container,n);var S=n.renderer.$gutter;E.addListener(S,"mousedown",this.onMouseEvent.bind(this,"guttermousedown"),n),E.addListener(S,"click",this.onMouseEvent.bind(this,"gutterclick"),n),E.addListener(S,"dblclick",this.onMouseEvent.bind(this,"gutterdblclick"),n),E.addListener(S,"mousemove",this.onMouseEvent.bind(this,"guttermousemove"),n),E.addListener(w,"mousedown",m,n),E.addListener(S,"mousedown",m,n),A.isIE&&n.renderer.scrollBarV&&(E.addListener(n.renderer.scrollBarV.element,"mousedown",m,n),E.addListener(n.renderer.scrollBarH.element,"mousedown",m,n)),n.on("mousemove",function(y){if(!(o.state||o.$dragDelay||!o.$dragEnabled)){var c=n.renderer.screenToTextCoordinates(y.x,y.y),l=n.session.selection.getRange(),g=n.renderer;!l.isEmpty()&&l.insideStart(c.row,c.column)?g.setCursorStyle("default"):g.setCursorStyle("")}},n)};(function(){this.onMouseEvent=function(n,o){this.editor._emit(n,new u(o,this.editor))},this.onMouseMove=function(n,o){var m=this.editor._eventRegistry&&this.editor._eventRegistry.mousemove;!m||!m.length||this.editor._emit(n,new u(o,this.editor))},this.onMouseWheel=function(n,o){var m=new u(o,this.editor);m.speed=this.$scrollSpeed*2,m.wheelX=o.wheelX,m.wheelY=o.wheelY,this.editor._emit(n,m)},this.setState=function(n){this.state=n},this.captureMouse=function(n,o){this.x=n.x,this.y=n.y,this.isMousePressed=!0;var m=this.editor,w=this.editor.renderer;w.$isMousePressed=!0;var S=this,y=function(a){if(a){if(A.isWebKit&&!a.which&&S.releaseMouse)return S.releaseMouse();S.x=a.clientX,S.y=a.clientY,o&&o(a),S.mouseEvent=new u(a,S.editor),S.$mouseMoved=!0}},c=function(a){m.off("beforeEndOperation",g),clearInterval(d),l(),S[S.state+"End"]&&S[S.state+"End"](a),S.state="",S.isMousePressed=w.$isMousePressed=!1,w.$keepTextAreaAtCursor&&w.$moveTextAreaToCursor(),S.$onCaptureMouseMove=S.releaseMouse=null,a&&S.onMouseEvent("mouseup",a),m.endOperation()},l=function(){S[S.state]&&S[S.state](),S.$mouseMoved=!1};if(A.isOldIE&&n.domEvent.type=="dblclick")return setTimeout(function(){c(n)});var g=function(a){S.releaseMouse&&m.curOp.command.name&&m.curOp.selectionChanged&&(S[S.state+"End"]&&S[S.state+"End"](),S.state="",S.releaseMouse())};m.on("beforeEndOperation",g),m.startOperation({command:{name:"mouse"}}),S.$onCaptureMouseMove=y,S.releaseMouse=E.capture(this.editor.container,y,c);var d=setInterval(l,20)},this.releaseMouse=null,this.cancelContextMenu=function(){var n=function(o){o&&o.domEvent&&o.domEvent.type!="contextmenu"||(this.editor.off("nativecontextmenu",n),o&&o.domEvent&&E.stopEvent(o.domEvent))}.bind(this);setTimeout(n,10),this.editor.on("nativecontextmenu",n)},this.destroy=function(){this.releaseMouse&&this.releaseMouse()}}).call(r.prototype),i.defineOptions(r.prototype,"mouseHandler",{scrollSpeed:{initialValue:2},dragDelay:{initialValue:A.isMac?150:0},dragEnabled:{initialValue:!0},focusTimeout:{initialValue:0},tooltipFollowsMouse:{initialValue:!0}}),b.MouseHandler=r}),define("ace/mouse/fold_handler",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";var E=L("../lib/dom");function A(p){p.on("click",function(h){var u=h.getDocumentPosition(),t=p.session,e=t.getFoldAt(u.row,u.column,1);e&&(h.getAccelKey()?t.removeFold(e):t.expandFold(e),h.stop());var i=h.domEvent&&h.domEvent.target;i&&E.hasCssClass(i,"ace_inline_button")&&E.hasCssClass(i,"ace_toggle_wrap")&&(t.setOption("wrap",!t.getUseWrapMode()),p.renderer.scrollCursorIntoView())}),p.on("gutterclick",function(h){var u=p.renderer.$gutterLayer.getRegion(h);if(u=="foldWidgets"){var t=h.getDocumentPosition().row,e=p.session;e.foldWidgets&&e.foldWidgets[t]&&p.session.onFoldWidgetClick(t,h),p.isFocused()||p.focus(),h.stop()}}),p.on("gutterdblclick",function(h){var u=p.renderer.$gutterLayer.getRegion(h);if(u=="foldWidgets"){var t=h.getDocumentPosition().row,e=p.session,i=e.getParentFoldRangeData(t,!0),r=i.range||i.firstRange;if(r){t=r.start.row;var n=e.getFoldAt(t,e.getLine(t).length,1);n?e.removeFold(n):(e.addFold("...",r),p.renderer.scrollCursorIntoView({row:r.start.row,column:0}))}h.stop()}})}b.FoldHandler=A}),define("ace/keyboard/keybinding",["require","exports","module","ace/lib/keys","ace/lib/event"],function(L,b,B){"use strict";var E=L("../lib/keys"),A=L("../lib/event"),p=function(h){this.$editor=h,this.$data={editor:h},this.$handlers=[],this.setDefaultHandler(h.commands)};(function(){this.setDefaultHandler=function(h){this.removeKeyboardHandler(this.$defaultHandler),this.$defaultHandler=h,this.addKeyboardHandler(h,0)},this.setKeyboardHandler=function(h){var u=this.$handlers;if(u[u.length-1]!=h){for(;u[u.length-1]&&u[u.length-1]!=this.$defaultHandler;)this.removeKeyboardHandler(u[u.length-1]);this.addKeyboardHandler(h,1)}},this.addKeyboardHandler=function(h,u){if(h){typeof h=="function"&&!h.handleKeyboard&&(h.handleKeyboard=h);var t=this.$handlers.indexOf(h);t!=-1&&this.$handlers.splice(t,1),u==null?this.$handlers.push(h):this.$handlers.splice(u,0,h),t==-1&&h.attach&&h.attach(this.$editor)}},this.removeKeyboardHandler=function(h){var u=this.$handlers.indexOf(h);return u==-1?!1:(this.$handlers.splice(u,1),h.detach&&h.detach(this.$editor),!0)},this.getKeyboardHandler=function(){return this.$handlers[this.$handlers.length-1]},this.getStatusText=function(){var h=this.$data,u=h.editor;return this.$handlers.map(function(t){return t.getStatusText&&t.getStatusText(u,h)||""}).filter(Boolean).join(" ")},this.$callKeyboardHandlers=function(h,u,t,e){for(var i,r=!1,n=this.$editor.commands,o=this.$handlers.length;o--&&(i=this.$handlers[o].handleKeyboard(this.$data,h,u,t,e),!(!(!i||!i.command)&&(i.command=="null"?r=!0:r=n.exec(i.command,this.$editor,i.args,e),r&&e&&h!=-1&&i.passEvent!=!0&&i.command.passEvent!=!0&&A.stopEvent(e),r))););return!r&&h==-1&&(i={command:"insertstring"},r=n.exec("insertstring",this.$editor,u)),r&&this.$editor._signal&&this.$editor._signal("keyboardActivity",i),r},this.onCommandKey=function(h,u,t){var e=E.keyCodeToString(t);return this.$callKeyboardHandlers(u,e,t,h)},this.onTextInput=function(h){return this.$callKeyboardHandlers(-1,h)}}).call(p.prototype),b.KeyBinding=p}),define("ace/lib/bidiutil",["require","exports","module"],function(L,b,B){"use strict";var E=["\u0621","\u0641"],A=["\u063A","\u064A"],p=0,h=0,u=!1,t=!1,e=!1,i=!1,r=!1,n=!1,o=[[0,3,0,1,0,0,0],[0,3,0,1,2,2,0],[0,3,0,17,2,0,1],[0,3,5,5,4,1,0],[0,3,21,21,4,0,1],[0,3,5,5,4,2,0]],m=[[2,0,1,1,0,1,0],[2,0,1,1,0,2,0],[2,0,2,1,3,2,0],[2,0,2,33,3,1,1]],w=0,S=1,y=0,c=1,l=2,g=3,d=4,a=5,f=6,s=7,v=8,$=9,x=10,C=11,k=12,R=13,_=14,O=15,I=16,D=17,T=18,P=[T,T,T,T,T,T,T,T,T,f,a,f,v,a,T,T,T,T,T,T,T,T,T,T,T,T,T,T,a,a,a,f,v,d,d,C,C,C,d,d,d,d,d,x,$,x,$,$,l,l,l,l,l,l,l,l,l,l,$,d,d,d,d,d,d,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,d,d,d,d,d,d,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,y,d,d,d,d,T,T,T,T,T,T,a,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,T,$,d,C,C,C,C,d,d,d,d,y,d,d,T,d,d,C,C,l,l,d,y,d,d,d,l,y,d,d,d,d,d],M=[v,v,v,v,v,v,v,v,v,v,v,T,T,T,y,c,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,v,a,R,_,O,I,D,$,C,C,C,C,C,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,$,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,d,v];function W(X,V,j,K){var q=p?m:o,ee=null,te=null,Q=null,Y=0,le=null,he=null,ce=-1,F=null,H=null,U=[];if(!K)for(F=0,K=[];F<j;F++)K[F]=z(X[F]);for(h=p,u=!1,t=!1,e=!1,i=!1,H=0;H<j;H++){if(ee=Y,U[H]=te=G(X,K,U,H),Y=q[ee][te],le=Y&240,Y&=15,V[H]=Q=q[Y][5],le>0)if(le==16){for(F=ce;F<H;F++)V[F]=1;ce=-1}else ce=-1;if(he=q[Y][6],he)ce==-1&&(ce=H);else if(ce>-1){for(F=ce;F<H;F++)V[F]=Q;ce=-1}K[H]==a&&(V[H]=0),h|=Q}if(i){for(F=0;F<j;F++)if(K[F]==f){V[F]=p;for(var Z=F-1;Z>=0&&K[Z]==v;Z--)V[Z]=p}}}function N(X,V,j){if(!(h<X)){if(X==1&&p==S&&!e){j.reverse();return}for(var K=j.length,q=0,ee,te,Q,Y;q<K;){if(V[q]>=X){for(ee=q+1;ee<K&&V[ee]>=X;)ee++;for(te=q,Q=ee-1;te<Q;te++,Q--)Y=j[te],j[te]=j[Q],j[Q]=Y;q=ee}q++}}}function G(X,V,j,K){var q=V[K],ee,te,Q,Y;switch(q){case y:case c:u=!1;case d:case g:return q;case l:return u?g:l;case s:return u=!0,t=!0,c;case v:return d;case $:return K<1||K+1>=V.length||(ee=j[K-1])!=l&&ee!=g||(te=V[K+1])!=l&&te!=g?d:(u&&(te=g),te==ee?te:d);case x:return ee=K>0?j[K-1]:a,ee==l&&K+1<V.length&&V[K+1]==l?l:d;case C:if(K>0&&j[K-1]==l)return l;if(u)return d;for(Y=K+1,Q=V.length;Y<Q&&V[Y]==C;)Y++;return Y<Q&&V[Y]==l?l:d;case k:for(Q=V.length,Y=K+1;Y<Q&&V[Y]==k;)Y++;if(Y<Q){var le=X[K],he=le>=1425&&le<=2303||le==64286;if(ee=V[Y],he&&(ee==c||ee==s))return c}return K<1||(ee=V[K-1])==a?d:j[K-1];case a:return u=!1,e=!0,p;case f:return i=!0,d;case R:case _:case I:case D:case O:u=!1;case T:return d}}function z(X){var V=X.charCodeAt(0),j=V>>8;return j==0?V>191?y:P[V]:j==5?/[\u0591-\u05f4]/.test(X)?c:y:j==6?/[\u0610-\u061a\u064b-\u065f\u06d6-\u06e4\u06e7-\u06ed]/.test(X)?k:/[\u0660-\u0669\u066b-\u066c]/.test(X)?g:V==1642?C:/[\u06f0-\u06f9]/.test(X)?l:s:j==32&&V<=8287?M[V&255]:j==254&&V>=65136?s:d}function J(X){return X>="\u064B"&&X<="\u0655"}b.L=y,b.R=c,b.EN=l,b.ON_R=3,b.AN=4,b.R_H=5,b.B=6,b.RLE=7,b.DOT="\xB7",b.doBidiReorder=function(X,V,j){if(X.length<2)return{};var K=X.split(""),q=new Array(K.length),ee=new Array(K.length),te=[];p=j?S:w,W(K,te,K.length,V);for(var Q=0;Q<q.length;q[Q]=Q,Q++);N(2,te,q),N(1,te,q);for(var Q=0;Q<q.length-1;Q++)V[Q]===g?te[Q]=b.AN:te[Q]===c&&(V[Q]>s&&V[Q]<R||V[Q]===d||V[Q]===T)?te[Q]=b.ON_R:Q>0&&K[Q-1]==="\u0644"&&/\u0622|\u0623|\u0625|\u0627/.test(K[Q])&&(te[Q-1]=te[Q]=b.R_H,Q++);K[K.length-1]===b.DOT&&(te[K.length-1]=b.B),K[0]==="\u202B"&&(te[0]=b.RLE);for(var Q=0;Q<q.length;Q++)ee[Q]=te[q[Q]];return{logicalFromVisual:q,bidiLevels:ee}},b.hasBidiCharacters=function(X,V){for(var j=!1,K=0;K<X.length;K++)V[K]=z(X.charAt(K)),!j&&(V[K]==c||V[K]==s||V[K]==g)&&(j=!0);return j},b.getVisualFromLogicalIdx=function(X,V){for(var j=0;j<V.logicalFromVisual.length;j++)if(V.logicalFromVisual[j]==X)return j;return 0}}),define("ace/bidihandler",["require","exports","module","ace/lib/bidiutil","ace/lib/lang"],function(L,b,B){"use strict";var E=L("./lib/bidiutil"),A=L("./lib/lang"),p=/[\u0590-\u05f4\u0600-\u06ff\u0700-\u08ac\u202B]/,h=function(u){this.session=u,this.bidiMap={},this.currentRow=null,this.bidiUtil=E,this.charWidths=[],this.EOL="\xAC",this.showInvisibles=!0,this.isRtlDir=!1,this.$isRtl=!1,this.line="",this.wrapIndent=0,this.EOF="\xB6",this.RLE="\u202B",this.contentWidth=0,this.fontMetrics=null,this.rtlLineOffset=0,this.wrapOffset=0,this.isMoveLeftOperation=!1,this.seenBidi=p.test(u.getValue())};(function(){this.isBidiRow=function(u,t,e){return this.seenBidi?(u!==this.currentRow&&(this.currentRow=u,this.updateRowLine(t,e),this.updateBidiMap()),this.bidiMap.bidiLevels):!1},this.onChange=function(u){this.seenBidi?this.currentRow=null:u.action=="insert"&&p.test(u.lines.join(`
-`))&&(this.seenBidi=!0,this.currentRow=null)},this.getDocumentRow=function(){var u=0,t=this.session.$screenRowCache;if(t.length){var e=this.session.$getRowCacheIndex(t,this.currentRow);e>=0&&(u=this.session.$docRowCache[e])}return u},this.getSplitIndex=function(){var u=0,t=this.session.$screenRowCache;if(t.length)for(var e,i=this.session.$getRowCacheIndex(t,this.currentRow);this.currentRow-u>0&&(e=this.session.$getRowCacheIndex(t,this.currentRow-u-1),e===i);)i=e,u++;else u=this.currentRow;return u},this.updateRowLine=function(u,t){u===void 0&&(u=this.getDocumentRow());var e=u===this.session.getLength()-1,i=e?this.EOF:this.EOL;if(this.wrapIndent=0,this.line=this.session.getLine(u),this.isRtlDir=this.$isRtl||this.line.charAt(0)===this.RLE,this.session.$useWrapMode){var r=this.session.$wrapData[u];r&&(t===void 0&&(t=this.getSplitIndex()),t>0&&r.length?(this.wrapIndent=r.indent,this.wrapOffset=this.wrapIndent*this.charWidths[E.L],this.line=t<r.length?this.line.substring(r[t-1],r[t]):this.line.substring(r[r.length-1])):this.line=this.line.substring(0,r[t])),t==r.length&&(this.line+=this.showInvisibles?i:E.DOT)}else this.line+=this.showInvisibles?i:E.DOT;var n=this.session,o=0,m;this.line=this.line.replace(/\t|[\u1100-\u2029, \u202F-\uFFE6]/g,function(w,S){return w==="	"||n.isFullWidth(w.charCodeAt(0))?(m=w==="	"?n.getScreenTabSize(S+o):2,o+=m-1,A.stringRepeat(E.DOT,m)):w}),this.isRtlDir&&(this.fontMetrics.$main.textContent=this.line.charAt(this.line.length-1)==E.DOT?this.line.substr(0,this.line.length-1):this.line,this.rtlLineOffset=this.contentWidth-this.fontMetrics.$main.getBoundingClientRect().width)},this.updateBidiMap=function(){var u=[];E.hasBidiCharacters(this.line,u)||this.isRtlDir?this.bidiMap=E.doBidiReorder(this.line,u,this.isRtlDir):this.bidiMap={}},this.markAsDirty=function(){this.currentRow=null},this.updateCharacterWidths=function(u){if(this.characterWidth!==u.$characterSize.width){this.fontMetrics=u;var t=this.characterWidth=u.$characterSize.width,e=u.$measureCharWidth("\u05D4");this.charWidths[E.L]=this.charWidths[E.EN]=this.charWidths[E.ON_R]=t,this.charWidths[E.R]=this.charWidths[E.AN]=e,this.charWidths[E.R_H]=e*.45,this.charWidths[E.B]=this.charWidths[E.RLE]=0,this.currentRow=null}},this.setShowInvisibles=function(u){this.showInvisibles=u,this.currentRow=null},this.setEolChar=function(u){this.EOL=u},this.setContentWidth=function(u){this.contentWidth=u},this.isRtlLine=function(u){return this.$isRtl?!0:u!=null?this.session.getLine(u).charAt(0)==this.RLE:this.isRtlDir},this.setRtlDirection=function(u,t){for(var e=u.getCursorPosition(),i=u.selection.getSelectionAnchor().row;i<=e.row;i++)!t&&u.session.getLine(i).charAt(0)===u.session.$bidiHandler.RLE?u.session.doc.removeInLine(i,0,1):t&&u.session.getLine(i).charAt(0)!==u.session.$bidiHandler.RLE&&u.session.doc.insert({column:0,row:i},u.session.$bidiHandler.RLE)},this.getPosLeft=function(u){u-=this.wrapIndent;var t=this.line.charAt(0)===this.RLE?1:0,e=u>t?this.session.getOverwrite()?u:u-1:t,i=E.getVisualFromLogicalIdx(e,this.bidiMap),r=this.bidiMap.bidiLevels,n=0;!this.session.getOverwrite()&&u<=t&&r[i]%2!==0&&i++;for(var o=0;o<i;o++)n+=this.charWidths[r[o]];return!this.session.getOverwrite()&&u>t&&r[i]%2===0&&(n+=this.charWidths[r[i]]),this.wrapIndent&&(n+=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset),this.isRtlDir&&(n+=this.rtlLineOffset),n},this.getSelections=function(u,t){var e=this.bidiMap,i=e.bidiLevels,r,n=[],o=0,m=Math.min(u,t)-this.wrapIndent,w=Math.max(u,t)-this.wrapIndent,S=!1,y=!1,c=0;this.wrapIndent&&(o+=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset);for(var l,g=0;g<i.length;g++)l=e.logicalFromVisual[g],r=i[g],S=l>=m&&l<w,S&&!y?c=o:!S&&y&&n.push({left:c,width:o-c}),o+=this.charWidths[r],y=S;if(S&&g===i.length&&n.push({left:c,width:o-c}),this.isRtlDir)for(var d=0;d<n.length;d++)n[d].left+=this.rtlLineOffset;return n},this.offsetToCol=function(e){this.isRtlDir&&(e-=this.rtlLineOffset);var t=0,e=Math.max(e,0),i=0,r=0,n=this.bidiMap.bidiLevels,o=this.charWidths[n[r]];for(this.wrapIndent&&(e-=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset);e>i+o/2;){if(i+=o,r===n.length-1){o=0;break}o=this.charWidths[n[++r]]}return r>0&&n[r-1]%2!==0&&n[r]%2===0?(e<i&&r--,t=this.bidiMap.logicalFromVisual[r]):r>0&&n[r-1]%2===0&&n[r]%2!==0?t=1+(e>i?this.bidiMap.logicalFromVisual[r]:this.bidiMap.logicalFromVisual[r-1]):this.isRtlDir&&r===n.length-1&&o===0&&n[r-1]%2===0||!this.isRtlDir&&r===0&&n[r]%2!==0?t=1+this.bidiMap.logicalFromVisual[r]:(r>0&&n[r-1]%2!==0&&o!==0&&r--,t=this.bidiMap.logicalFromVisual[r]),t===0&&this.isRtlDir&&t++,t+this.wrapIndent}}).call(h.prototype),b.BidiHandler=h}),define("ace/selection",["require","exports","module","ace/lib/oop","ace/lib/lang","ace/lib/event_emitter","ace/range"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/lang"),p=L("./lib/event_emitter").EventEmitter,h=L("./range").Range,u=function(t){this.session=t,this.doc=t.getDocument(),this.clearSelection(),this.cursor=this.lead=this.doc.createAnchor(0,0),this.anchor=this.doc.createAnchor(0,0),this.$silent=!1;var e=this;this.cursor.on("change",function(i){e.$cursorChanged=!0,e.$silent||e._emit("changeCursor"),!e.$isEmpty&&!e.$silent&&e._emit("changeSelection"),!e.$keepDesiredColumnOnChange&&i.old.column!=i.value.column&&(e.$desiredColumn=null)}),this.anchor.on("change",function(){e.$anchorChanged=!0,!e.$isEmpty&&!e.$silent&&e._emit("changeSelection")})};(function(){E.implement(this,p),this.isEmpty=function(){return this.$isEmpty||this.anchor.row==this.lead.row&&this.anchor.column==this.lead.column},this.isMultiLine=function(){return!this.$isEmpty&&this.anchor.row!=this.cursor.row},this.getCursor=function(){return this.lead.getPosition()},this.setSelectionAnchor=function(t,e){this.$isEmpty=!1,this.anchor.setPosition(t,e)},this.getAnchor=this.getSelectionAnchor=function(){return this.$isEmpty?this.getSelectionLead():this.anchor.getPosition()},this.getSelectionLead=function(){return this.lead.getPosition()},this.isBackwards=function(){var t=this.anchor,e=this.lead;return t.row>e.row||t.row==e.row&&t.column>e.column},this.getRange=function(){var t=this.anchor,e=this.lead;return this.$isEmpty?h.fromPoints(e,e):this.isBackwards()?h.fromPoints(e,t):h.fromPoints(t,e)},this.clearSelection=function(){this.$isEmpty||(this.$isEmpty=!0,this._emit("changeSelection"))},this.selectAll=function(){this.$setSelection(0,0,Number.MAX_VALUE,Number.MAX_VALUE)},this.setRange=this.setSelectionRange=function(t,e){var i=e?t.end:t.start,r=e?t.start:t.end;this.$setSelection(i.row,i.column,r.row,r.column)},this.$setSelection=function(t,e,i,r){if(!this.$silent){var n=this.$isEmpty,o=this.inMultiSelectMode;this.$silent=!0,this.$cursorChanged=this.$anchorChanged=!1,this.anchor.setPosition(t,e),this.cursor.setPosition(i,r),this.$isEmpty=!h.comparePoints(this.anchor,this.cursor),this.$silent=!1,this.$cursorChanged&&this._emit("changeCursor"),(this.$cursorChanged||this.$anchorChanged||n!=this.$isEmpty||o)&&this._emit("changeSelection")}},this.$moveSelection=function(t){var e=this.lead;this.$isEmpty&&this.setSelectionAnchor(e.row,e.column),t.call(this)},this.selectTo=function(t,e){this.$moveSelection(function(){this.moveCursorTo(t,e)})},this.selectToPosition=function(t){this.$moveSelection(function(){this.moveCursorToPosition(t)})},this.moveTo=function(t,e){this.clearSelection(),this.moveCursorTo(t,e)},this.moveToPosition=function(t){this.clearSelection(),this.moveCursorToPosition(t)},this.selectUp=function(){this.$moveSelection(this.moveCursorUp)},this.selectDown=function(){this.$moveSelection(this.moveCursorDown)},this.selectRight=function(){this.$moveSelection(this.moveCursorRight)},this.selectLeft=function(){this.$moveSelection(this.moveCursorLeft)},this.selectLineStart=function(){this.$moveSelection(this.moveCursorLineStart)},this.selectLineEnd=function(){this.$moveSelection(this.moveCursorLineEnd)},this.selectFileEnd=function(){this.$moveSelection(this.moveCursorFileEnd)},this.selectFileStart=function(){this.$moveSelection(this.moveCursorFileStart)},this.selectWordRight=function(){this.$moveSelection(this.moveCursorWordRight)},this.selectWordLeft=function(){this.$moveSelection(this.moveCursorWordLeft)},this.getWordRange=function(t,e){if(typeof e>"u"){var i=t||this.lead;t=i.row,e=i.column}return this.session.getWordRange(t,e)},this.selectWord=function(){this.setSelectionRange(this.getWordRange())},this.selectAWord=function(){var t=this.getCursor(),e=this.session.getAWordRange(t.row,t.column);this.setSelectionRange(e)},this.getLineRange=function(t,e){var i=typeof t=="number"?t:this.lead.row,r,n=this.session.getFoldLine(i);return n?(i=n.start.row,r=n.end.row):r=i,e===!0?new h(i,0,r,this.session.getLine(r).length):new h(i,0,r+1,0)},this.selectLine=function(){this.setSelectionRange(this.getLineRange())},this.moveCursorUp=function(){this.moveCursorBy(-1,0)},this.moveCursorDown=function(){this.moveCursorBy(1,0)},this.wouldMoveIntoSoftTab=function(t,e,i){var r=t.column,n=t.column+e;return i<0&&(r=t.column-e,n=t.column),this.session.isTabStop(t)&&this.doc.getLine(t.row).slice(r,n).split(" ").length-1==e},this.moveCursorLeft=function(){var t=this.lead.getPosition(),e;if(e=this.session.getFoldAt(t.row,t.column,-1))this.moveCursorTo(e.start.row,e.start.column);else if(t.column===0)t.row>0&&this.moveCursorTo(t.row-1,this.doc.getLine(t.row-1).length);else{var i=this.session.getTabSize();this.wouldMoveIntoSoftTab(t,i,-1)&&!this.session.getNavigateWithinSoftTabs()?this.moveCursorBy(0,-i):this.moveCursorBy(0,-1)}},this.moveCursorRight=function(){var t=this.lead.getPosition(),e;if(e=this.session.getFoldAt(t.row,t.column,1))this.moveCursorTo(e.end.row,e.end.column);else if(this.lead.column==this.doc.getLine(this.lead.row).length)this.lead.row<this.doc.getLength()-1&&this.moveCursorTo(this.lead.row+1,0);else{var i=this.session.getTabSize(),t=this.lead;this.wouldMoveIntoSoftTab(t,i,1)&&!this.session.getNavigateWithinSoftTabs()?this.moveCursorBy(0,i):this.moveCursorBy(0,1)}},this.moveCursorLineStart=function(){var t=this.lead.row,e=this.lead.column,i=this.session.documentToScreenRow(t,e),r=this.session.screenToDocumentPosition(i,0),n=this.session.getDisplayLine(t,null,r.row,r.column),o=n.match(/^\s*/);o[0].length!=e&&!this.session.$useEmacsStyleLineStart&&(r.column+=o[0].length),this.moveCursorToPosition(r)},this.moveCursorLineEnd=function(){var t=this.lead,e=this.session.getDocumentLastRowColumnPosition(t.row,t.column);if(this.lead.column==e.column){var i=this.session.getLine(e.row);if(e.column==i.length){var r=i.search(/\s+$/);r>0&&(e.column=r)}}this.moveCursorTo(e.row,e.column)},this.moveCursorFileEnd=function(){var t=this.doc.getLength()-1,e=this.doc.getLine(t).length;this.moveCursorTo(t,e)},this.moveCursorFileStart=function(){this.moveCursorTo(0,0)},this.moveCursorLongWordRight=function(){var t=this.lead.row,e=this.lead.column,i=this.doc.getLine(t),r=i.substring(e);this.session.nonTokenRe.lastIndex=0,this.session.tokenRe.lastIndex=0;var n=this.session.getFoldAt(t,e,1);if(n){this.moveCursorTo(n.end.row,n.end.column);return}if(this.session.nonTokenRe.exec(r)&&(e+=this.session.nonTokenRe.lastIndex,this.session.nonTokenRe.lastIndex=0,r=i.substring(e)),e>=i.length){this.moveCursorTo(t,i.length),this.moveCursorRight(),t<this.doc.getLength()-1&&this.moveCursorWordRight();return}this.session.tokenRe.exec(r)&&(e+=this.session.tokenRe.lastIndex,this.session.tokenRe.lastIndex=0),this.moveCursorTo(t,e)},this.moveCursorLongWordLeft=function(){var t=this.lead.row,e=this.lead.column,i;if(i=this.session.getFoldAt(t,e,-1)){this.moveCursorTo(i.start.row,i.start.column);return}var r=this.session.getFoldStringAt(t,e,-1);r==null&&(r=this.doc.getLine(t).substring(0,e));var n=A.stringReverse(r);if(this.session.nonTokenRe.lastIndex=0,this.session.tokenRe.lastIndex=0,this.session.nonTokenRe.exec(n)&&(e-=this.session.nonTokenRe.lastIndex,n=n.slice(this.session.nonTokenRe.lastIndex),this.session.nonTokenRe.lastIndex=0),e<=0){this.moveCursorTo(t,0),this.moveCursorLeft(),t>0&&this.moveCursorWordLeft();return}this.session.tokenRe.exec(n)&&(e-=this.session.tokenRe.lastIndex,this.session.tokenRe.lastIndex=0),this.moveCursorTo(t,e)},this.$shortWordEndIndex=function(t){var e=0,i,r=/\s/,n=this.session.tokenRe;if(n.lastIndex=0,this.session.tokenRe.exec(t))e=this.session.tokenRe.lastIndex;else{for(;(i=t[e])&&r.test(i);)e++;if(e<1){for(n.lastIndex=0;(i=t[e])&&!n.test(i);)if(n.lastIndex=0,e++,r.test(i))if(e>2){e--;break}else{for(;(i=t[e])&&r.test(i);)e++;if(e>2)break}}}return n.lastIndex=0,e},this.moveCursorShortWordRight=function(){var t=this.lead.row,e=this.lead.column,i=this.doc.getLine(t),r=i.substring(e),n=this.session.getFoldAt(t,e,1);if(n)return this.moveCursorTo(n.end.row,n.end.column);if(e==i.length){var o=this.doc.getLength();do t++,r=this.doc.getLine(t);while(t<o&&/^\s*$/.test(r));/^\s+/.test(r)||(r=""),e=0}var m=this.$shortWordEndIndex(r);this.moveCursorTo(t,e+m)},this.moveCursorShortWordLeft=function(){var t=this.lead.row,e=this.lead.column,i;if(i=this.session.getFoldAt(t,e,-1))return this.moveCursorTo(i.start.row,i.start.column);var r=this.session.getLine(t).substring(0,e);if(e===0){do t--,r=this.doc.getLine(t);while(t>0&&/^\s*$/.test(r));e=r.length,/\s+$/.test(r)||(r="")}var n=A.stringReverse(r),o=this.$shortWordEndIndex(n);return this.moveCursorTo(t,e-o)},this.moveCursorWordRight=function(){this.session.$selectLongWords?this.moveCursorLongWordRight():this.moveCursorShortWordRight()},this.moveCursorWordLeft=function(){this.session.$selectLongWords?this.moveCursorLongWordLeft():this.moveCursorShortWordLeft()},this.moveCursorBy=function(t,e){var i=this.session.documentToScreenPosition(this.lead.row,this.lead.column),r;if(e===0&&(t!==0&&(this.session.$bidiHandler.isBidiRow(i.row,this.lead.row)?(r=this.session.$bidiHandler.getPosLeft(i.column),i.column=Math.round(r/this.session.$bidiHandler.charWidths[0])):r=i.column*this.session.$bidiHandler.charWidths[0]),this.$desiredColumn?i.column=this.$desiredColumn:this.$desiredColumn=i.column),t!=0&&this.session.lineWidgets&&this.session.lineWidgets[this.lead.row]){var n=this.session.lineWidgets[this.lead.row];t<0?t-=n.rowsAbove||0:t>0&&(t+=n.rowCount-(n.rowsAbove||0))}var o=this.session.screenToDocumentPosition(i.row+t,i.column,r);t!==0&&e===0&&o.row===this.lead.row&&(o.column,this.lead.column),this.moveCursorTo(o.row,o.column+e,e===0)},this.moveCursorToPosition=function(t){this.moveCursorTo(t.row,t.column)},this.moveCursorTo=function(t,e,i){var r=this.session.getFoldAt(t,e,1);r&&(t=r.start.row,e=r.start.column),this.$keepDesiredColumnOnChange=!0;var n=this.session.getLine(t);/[\uDC00-\uDFFF]/.test(n.charAt(e))&&n.charAt(e-1)&&(this.lead.row==t&&this.lead.column==e+1?e=e-1:e=e+1),this.lead.setPosition(t,e),this.$keepDesiredColumnOnChange=!1,i||(this.$desiredColumn=null)},this.moveCursorToScreen=function(t,e,i){var r=this.session.screenToDocumentPosition(t,e);this.moveCursorTo(r.row,r.column,i)},this.detach=function(){this.lead.detach(),this.anchor.detach(),this.session=this.doc=null},this.fromOrientedRange=function(t){this.setSelectionRange(t,t.cursor==t.start),this.$desiredColumn=t.desiredColumn||this.$desiredColumn},this.toOrientedRange=function(t){var e=this.getRange();return t?(t.start.column=e.start.column,t.start.row=e.start.row,t.end.column=e.end.column,t.end.row=e.end.row):t=e,t.cursor=this.isBackwards()?t.start:t.end,t.desiredColumn=this.$desiredColumn,t},this.getRangeOfMovements=function(t){var e=this.getCursor();try{t(this);var i=this.getCursor();return h.fromPoints(e,i)}catch{return h.fromPoints(e,e)}finally{this.moveCursorToPosition(e)}},this.toJSON=function(){if(this.rangeCount)var t=this.ranges.map(function(e){var i=e.clone();return i.isBackwards=e.cursor==e.start,i});else{var t=this.getRange();t.isBackwards=this.isBackwards()}return t},this.fromJSON=function(t){if(t.start==null)if(this.rangeList&&t.length>1){this.toSingleRange(t[0]);for(var e=t.length;e--;){var i=h.fromPoints(t[e].start,t[e].end);t[e].isBackwards&&(i.cursor=i.start),this.addRange(i,!0)}return}else t=t[0];this.rangeList&&this.toSingleRange(t),this.setSelectionRange(t,t.isBackwards)},this.isEqual=function(t){if((t.length||this.rangeCount)&&t.length!=this.rangeCount)return!1;if(!t.length||!this.ranges)return this.getRange().isEqual(t);for(var e=this.ranges.length;e--;)if(!this.ranges[e].isEqual(t[e]))return!1;return!0}}).call(u.prototype),b.Selection=u}),define("ace/tokenizer",["require","exports","module","ace/config"],function(L,b,B){"use strict";var E=L("./config"),A=2e3,p=function(h){this.states=h,this.regExps={},this.matchMappings={};for(var u in this.states){for(var t=this.states[u],e=[],i=0,r=this.matchMappings[u]={defaultToken:"text"},n="g",o=[],m=0;m<t.length;m++){var w=t[m];if(w.defaultToken&&(r.defaultToken=w.defaultToken),w.caseInsensitive&&(n="gi"),w.regex!=null){w.regex instanceof RegExp&&(w.regex=w.regex.toString().slice(1,-1));var S=w.regex,y=new RegExp("(?:("+S+")|(.))").exec("a").length-2;Array.isArray(w.token)?w.token.length==1||y==1?w.token=w.token[0]:y-1!=w.token.length?(this.reportError("number of classes and regexp groups doesn't match",{rule:w,groupCount:y-1}),w.token=w.token[0]):(w.tokenArray=w.token,w.token=null,w.onMatch=this.$arrayTokens):typeof w.token=="function"&&!w.onMatch&&(y>1?w.onMatch=this.$applyToken:w.onMatch=w.token),y>1&&(/\\\d/.test(w.regex)?S=w.regex.replace(/\\([0-9]+)/g,function(c,l){return"\\"+(parseInt(l,10)+i+1)}):(y=1,S=this.removeCapturingGroups(w.regex)),!w.splitRegex&&typeof w.token!="string"&&o.push(w)),r[i]=m,i+=y,e.push(S),w.onMatch||(w.onMatch=null)}}e.length||(r[0]=0,e.push("$")),o.forEach(function(c){c.splitRegex=this.createSplitterRegexp(c.regex,n)},this),this.regExps[u]=new RegExp("("+e.join(")|(")+")|($)",n)}};(function(){this.$setMaxTokenCount=function(h){A=h|0},this.$applyToken=function(h){var u=this.splitRegex.exec(h).slice(1),t=this.token.apply(this,u);if(typeof t=="string")return[{type:t,value:h}];for(var e=[],i=0,r=t.length;i<r;i++)u[i]&&(e[e.length]={type:t[i],value:u[i]});return e},this.$arrayTokens=function(h){if(!h)return[];var u=this.splitRegex.exec(h);if(!u)return"text";for(var t=[],e=this.tokenArray,i=0,r=e.length;i<r;i++)u[i+1]&&(t[t.length]={type:e[i],value:u[i+1]});return t},this.removeCapturingGroups=function(h){var u=h.replace(/\\.|\[(?:\\.|[^\\\]])*|\(\?[:=!]|(\()/g,function(t,e){return e?"(?:":t});return u},this.createSplitterRegexp=function(h,u){if(h.indexOf("(?=")!=-1){var t=0,e=!1,i={};h.replace(/(\\.)|(\((?:\?[=!])?)|(\))|([\[\]])/g,function(r,n,o,m,w,S){return e?e=w!="]":w?e=!0:m?(t==i.stack&&(i.end=S+1,i.stack=-1),t--):o&&(t++,o.length!=1&&(i.stack=t,i.start=S)),r}),i.end!=null&&/^\)*$/.test(h.substr(i.end))&&(h=h.substring(0,i.start)+h.substr(i.end))}return h.charAt(0)!="^"&&(h="^"+h),h.charAt(h.length-1)!="$"&&(h+="$"),new RegExp(h,(u||"").replace("g",""))},this.getLineTokens=function(h,u){if(u&&typeof u!="string"){var t=u.slice(0);u=t[0],u==="#tmp"&&(t.shift(),u=t.shift())}else var t=[];var e=u||"start",i=this.states[e];i||(e="start",i=this.states[e]);var r=this.matchMappings[e],n=this.regExps[e];n.lastIndex=0;for(var o,m=[],w=0,S=0,y={type:null,value:""};o=n.exec(h);){var c=r.defaultToken,l=null,g=o[0],d=n.lastIndex;if(d-g.length>w){var a=h.substring(w,d-g.length);y.type==c?y.value+=a:(y.type&&m.push(y),y={type:c,value:a})}for(var f=0;f<o.length-2;f++)if(o[f+1]!==void 0){l=i[r[f]],l.onMatch?c=l.onMatch(g,e,t,h):c=l.token,l.next&&(typeof l.next=="string"?e=l.next:e=l.next(e,t),i=this.states[e],i||(this.reportError("state doesn't exist",e),e="start",i=this.states[e]),r=this.matchMappings[e],w=d,n=this.regExps[e],n.lastIndex=d),l.consumeLineEnd&&(w=d);break}if(g){if(typeof c=="string")(!l||l.merge!==!1)&&y.type===c?y.value+=g:(y.type&&m.push(y),y={type:c,value:g});else if(c){y.type&&m.push(y),y={type:null,value:""};for(var f=0;f<c.length;f++)m.push(c[f])}}if(w==h.length)break;if(w=d,S++>A){for(S>2*h.length&&this.reportError("infinite loop with in ace tokenizer",{startState:u,line:h});w<h.length;)y.type&&m.push(y),y={value:h.substring(w,w+=500),type:"overflow"};e="start",t=[];break}}return y.type&&m.push(y),t.length>1&&t[0]!==e&&t.unshift("#tmp",e),{tokens:m,state:t.length?t:e}},this.reportError=E.reportError}).call(p.prototype),b.Tokenizer=p}),define("ace/mode/text_highlight_rules",["require","exports","module","ace/lib/lang"],function(L,b,B){"use strict";var E=L("../lib/lang"),A=function(){this.$rules={start:[{token:"empty_line",regex:"^$"},{defaultToken:"text"}]}};(function(){this.addRules=function(u,t){if(!t){for(var e in u)this.$rules[e]=u[e];return}for(var e in u){for(var i=u[e],r=0;r<i.length;r++){var n=i[r];(n.next||n.onMatch)&&(typeof n.next=="string"&&n.next.indexOf(t)!==0&&(n.next=t+n.next),n.nextState&&n.nextState.indexOf(t)!==0&&(n.nextState=t+n.nextState))}this.$rules[t+e]=i}},this.getRules=function(){return this.$rules},this.embedRules=function(u,t,e,i,r){var n=typeof u=="function"?new u().getRules():u;if(i)for(var o=0;o<i.length;o++)i[o]=t+i[o];else{i=[];for(var m in n)i.push(t+m)}if(this.addRules(n,t),e)for(var w=Array.prototype[r?"push":"unshift"],o=0;o<i.length;o++)w.apply(this.$rules[i[o]],E.deepCopy(e));this.$embeds||(this.$embeds=[]),this.$embeds.push(t)},this.getEmbeds=function(){return this.$embeds};var p=function(u,t){return(u!="start"||t.length)&&t.unshift(this.nextState,u),this.nextState},h=function(u,t){return t.shift(),t.shift()||"start"};this.normalizeRules=function(){var u=0,t=this.$rules;function e(i){var r=t[i];r.processed=!0;for(var n=0;n<r.length;n++){var o=r[n],m=null;Array.isArray(o)&&(m=o,o={}),!o.regex&&o.start&&(o.regex=o.start,o.next||(o.next=[]),o.next.push({defaultToken:o.token},{token:o.token+".end",regex:o.end||o.start,next:"pop"}),o.token=o.token+".start",o.push=!0);var w=o.next||o.push;if(w&&Array.isArray(w)){var S=o.stateName;S||(S=o.token,typeof S!="string"&&(S=S[0]||""),t[S]&&(S+=u++)),t[S]=w,o.next=S,e(S)}else w=="pop"&&(o.next=h);if(o.push&&(o.nextState=o.next||o.push,o.next=p,delete o.push),o.rules)for(var y in o.rules)t[y]?t[y].push&&t[y].push.apply(t[y],o.rules[y]):t[y]=o.rules[y];var c=typeof o=="string"?o:o.include;if(c&&(Array.isArray(c)?m=c.map(function(g){return t[g]}):m=t[c]),m){var l=[n,1].concat(m);o.noEscape&&(l=l.filter(function(g){return!g.next})),r.splice.apply(r,l),n--}o.keywordMap&&(o.token=this.createKeywordMapper(o.keywordMap,o.defaultToken||"text",o.caseInsensitive),delete o.defaultToken)}}Object.keys(t).forEach(e,this)},this.createKeywordMapper=function(u,t,e,i){var r=Object.create(null);return this.$keywordList=[],Object.keys(u).forEach(function(n){for(var o=u[n],m=o.split(i||"|"),w=m.length;w--;){var S=m[w];this.$keywordList.push(S),e&&(S=S.toLowerCase()),r[S]=n}},this),u=null,e?function(n){return r[n.toLowerCase()]||t}:function(n){return r[n]||t}},this.getKeywords=function(){return this.$keywords}}).call(A.prototype),b.TextHighlightRules=A}),define("ace/mode/behaviour",["require","exports","module"],function(L,b,B){"use strict";var E=function(){this.$behaviours={}};(function(){this.add=function(A,p,h){switch(void 0){case this.$behaviours:this.$behaviours={};case this.$behaviours[A]:this.$behaviours[A]={}}this.$behaviours[A][p]=h},this.addBehaviours=function(A){for(var p in A)for(var h in A[p])this.add(p,h,A[p][h])},this.remove=function(A){this.$behaviours&&this.$behaviours[A]&&delete this.$behaviours[A]},this.inherit=function(A,p){if(typeof A=="function")var h=new A().getBehaviours(p);else var h=A.getBehaviours(p);this.addBehaviours(h)},this.getBehaviours=function(A){if(A){for(var p={},h=0;h<A.length;h++)this.$behaviours[A[h]]&&(p[A[h]]=this.$behaviours[A[h]]);return p}else return this.$behaviours}}).call(E.prototype),b.Behaviour=E}),define("ace/token_iterator",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=L("./range").Range,A=function(p,h,u){this.$session=p,this.$row=h,this.$rowTokens=p.getTokens(h);var t=p.getTokenAt(h,u);this.$tokenIndex=t?t.index:-1};(function(){this.stepBackward=function(){for(this.$tokenIndex-=1;this.$tokenIndex<0;){if(this.$row-=1,this.$row<0)return this.$row=0,null;this.$rowTokens=this.$session.getTokens(this.$row),this.$tokenIndex=this.$rowTokens.length-1}return this.$rowTokens[this.$tokenIndex]},this.stepForward=function(){this.$tokenIndex+=1;for(var p;this.$tokenIndex>=this.$rowTokens.length;){if(this.$row+=1,p||(p=this.$session.getLength()),this.$row>=p)return this.$row=p-1,null;this.$rowTokens=this.$session.getTokens(this.$row),this.$tokenIndex=0}return this.$rowTokens[this.$tokenIndex]},this.getCurrentToken=function(){return this.$rowTokens[this.$tokenIndex]},this.getCurrentTokenRow=function(){return this.$row},this.getCurrentTokenColumn=function(){var p=this.$rowTokens,h=this.$tokenIndex,u=p[h].start;if(u!==void 0)return u;for(u=0;h>0;)h-=1,u+=p[h].value.length;return u},this.getCurrentTokenPosition=function(){return{row:this.$row,column:this.getCurrentTokenColumn()}},this.getCurrentTokenRange=function(){var p=this.$rowTokens[this.$tokenIndex],h=this.getCurrentTokenColumn();return new E(this.$row,h,this.$row,h+p.value.length)}}).call(A.prototype),b.TokenIterator=A}),define("ace/mode/behaviour/cstyle",["require","exports","module","ace/lib/oop","ace/mode/behaviour","ace/token_iterator","ace/lib/lang"],function(L,b,B){"use strict";var E=L("../../lib/oop"),A=L("../behaviour").Behaviour,p=L("../../token_iterator").TokenIterator,h=L("../../lib/lang"),u=["text","paren.rparen","rparen","paren","punctuation.operator"],t=["text","paren.rparen","rparen","paren","punctuation.operator","comment"],e,i={},r={'"':'"',"'":"'"},n=function(w){var S=-1;if(w.multiSelect&&(S=w.selection.index,i.rangeCount!=w.multiSelect.rangeCount&&(i={rangeCount:w.multiSelect.rangeCount})),i[S])return e=i[S];e=i[S]={autoInsertedBrackets:0,autoInsertedRow:-1,autoInsertedLineEnd:"",maybeInsertedBrackets:0,maybeInsertedRow:-1,maybeInsertedLineStart:"",maybeInsertedLineEnd:""}},o=function(w,S,y,c){var l=w.end.row-w.start.row;return{text:y+S+c,selection:[0,w.start.column+1,l,w.end.column+(l?0:1)]}},m=function(w){this.add("braces","insertion",function(S,y,c,l,g){var d=c.getCursorPosition(),a=l.doc.getLine(d.row);if(g=="{"){n(c);var f=c.getSelectionRange(),s=l.doc.getTextRange(f);if(s!==""&&s!=="{"&&c.getWrapBehavioursEnabled())return o(f,s,"{","}");if(m.isSaneInsertion(c,l))return/[\]\}\)]/.test(a[d.column])||c.inMultiSelectMode||w&&w.braces?(m.recordAutoInsert(c,l,"}"),{text:"{}",selection:[1,1]}):(m.recordMaybeInsert(c,l,"{"),{text:"{",selection:[1,1]})}else if(g=="}"){n(c);var v=a.substring(d.column,d.column+1);if(v=="}"){var $=l.$findOpeningBracket("}",{column:d.column+1,row:d.row});if($!==null&&m.isAutoInsertedClosing(d,a,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}else if(g==`
+`;if(ae!=x&&(g.value=x=ae,L=R=ae.length),I&&(L=g.selectionStart,R=g.selectionEnd),R!=Y||L!=W||g.selectionEnd!=R)try{g.setSelectionRange(W,Y),L=W,R=Y}catch{}f=!1}};this.resetSelection=F,_&&l.onFocus();var O=function(W){return W.selectionStart===0&&W.selectionEnd>=x.length&&W.value===x&&x&&W.selectionEnd!==R},M=function(W){f||(u?u=!1:O(g)?(l.selectAll(),F()):S&&g.selectionStart!=L&&F())},P=null;this.setInputHandler=function(W){P=W},this.getInputHandler=function(){return P};var I=!1,D=function(W,Y){if(I&&(I=!1),o)return F(),W&&l.onPaste(W),o=!1,"";for(var j=g.selectionStart,H=g.selectionEnd,K=L,ee=x.length-R,oe=W,le=W.length-j,ae=W.length-H,se=0;K>0&&x[se]==W[se];)se++,K--;for(oe=oe.slice(se),se=1;ee>0&&x.length-se>L-1&&x[x.length-se]==W[W.length-se];)se++,ee--;le-=se-1,ae-=se-1;var ue=oe.length-se+1;if(ue<0&&(K=-ue,ue=0),oe=oe.slice(0,ue),!Y&&!oe&&!le&&!K&&!ee&&!ae)return"";s=!0;var de=!1;return A.isAndroid&&oe==". "&&(oe="  ",de=!0),oe&&!K&&!ee&&!le&&!ae||$?l.onTextInput(oe):l.onTextInput(oe,{extendLeft:K,extendRight:ee,restoreStart:le,restoreEnd:ae}),s=!1,x=W,L=j,R=H,T=ae,de?`
+`:oe},N=function(W){if(f)return G();if(W&&W.inputType){if(W.inputType=="historyUndo")return l.execCommand("undo");if(W.inputType=="historyRedo")return l.execCommand("redo")}var Y=g.value,j=D(Y,!0);(Y.length>r+100||w.test(j)||S&&L<1&&L==R)&&F()},V=function(W,Y,j){var H=W.clipboardData||window.clipboardData;if(!(!H||t)){var K=e||j?"Text":"text/plain";try{return Y?H.setData(K,Y)!==!1:H.getData(K)}catch(ee){if(!j)return V(ee,Y,!0)}}},z=function(W,Y){var j=l.getCopyText();if(!j)return E.preventDefault(W);V(W,j)?(m&&(F(j),u=j,setTimeout(function(){u=!1},10)),Y?l.onCut():l.onCopy(),E.preventDefault(W)):(u=!0,g.value=j,g.select(),setTimeout(function(){u=!1,F(),Y?l.onCut():l.onCopy()}))},Q=function(W){z(W,!0)},X=function(W){z(W,!1)},U=function(W){var Y=V(W);d.pasteCancelled()||(typeof Y=="string"?(Y&&l.onPaste(Y,W),A.isIE&&setTimeout(F),E.preventDefault(W)):(g.value="",o=!0))};E.addCommandKeyListener(g,l.onCommandKey.bind(l),l),E.addListener(g,"select",M,l),E.addListener(g,"input",N,l),E.addListener(g,"cut",Q,l),E.addListener(g,"copy",X,l),E.addListener(g,"paste",U,l),(!("oncut"in g)||!("oncopy"in g)||!("onpaste"in g))&&E.addListener(c,"keydown",function(W){if(!(A.isMac&&!W.metaKey||!W.ctrlKey))switch(W.keyCode){case 67:X(W);break;case 86:U(W);break;case 88:Q(W);break}},l);var Z=function(W){if(!(f||!l.onCompositionStart||l.$readOnly)&&(f={},!$)){W.data&&(f.useTextareaForIME=!1),setTimeout(G,0),l._signal("compositionStart"),l.on("mousedown",te);var Y=l.getSelectionRange();Y.end.row=Y.start.row,Y.end.column=Y.start.column,f.markerRange=Y,f.selectionStart=L,l.onCompositionStart(f),f.useTextareaForIME?(x=g.value="",L=0,R=0):(g.msGetInputContext&&(f.context=g.msGetInputContext()),g.getInputContext&&(f.context=g.getInputContext()))}},G=function(){if(!(!f||!l.onCompositionUpdate||l.$readOnly)){if($)return te();if(f.useTextareaForIME)l.onCompositionUpdate(g.value);else{var W=g.value;D(W),f.markerRange&&(f.context&&(f.markerRange.start.column=f.selectionStart=f.context.compositionStartOffset),f.markerRange.end.column=f.markerRange.start.column+R-f.selectionStart+T)}}},ie=function(W){!l.onCompositionEnd||l.$readOnly||(f=!1,l.onCompositionEnd(),l.off("mousedown",te),W&&N())};function te(){C=!0,g.blur(),g.focus(),C=!1}var ne=h.delayedCall(G,50).schedule.bind(null,null);function q(W){W.keyCode==27&&g.value.length<g.selectionStart&&(f||(x=g.value),L=R=-1,F()),ne()}E.addListener(g,"compositionstart",Z,l),E.addListener(g,"compositionupdate",G,l),E.addListener(g,"keyup",q,l),E.addListener(g,"keydown",ne,l),E.addListener(g,"compositionend",ie,l),this.getElement=function(){return g},this.setCommandMode=function(W){$=W,g.readOnly=!1},this.setReadOnly=function(W){$||(g.readOnly=W)},this.setCopyWithEmptySelection=function(W){},this.onContextMenu=function(W){I=!0,F(),l._emit("nativecontextmenu",{target:l,domEvent:W}),this.moveToMouse(W,!0)},this.moveToMouse=function(W,Y){v||(v=g.style.cssText),g.style.cssText=(Y?"z-index:100000;":"")+(A.isIE?"opacity:0.1;":"")+"text-indent: -"+(L+R)*l.renderer.characterWidth*.5+"px;";var j=l.container.getBoundingClientRect(),H=p.computedStyle(l.container),K=j.top+(parseInt(H.borderTopWidth)||0),ee=j.left+(parseInt(j.borderLeftWidth)||0),oe=j.bottom-K-g.clientHeight-2,le=function(ae){p.translate(g,ae.clientX-ee-2,Math.min(ae.clientY-K-2,oe))};le(W),W.type=="mousedown"&&(l.renderer.$isMousePressed=!0,clearTimeout(re),A.isWin&&E.capture(l.container,le,ce))},this.onContextMenuClose=ce;var re;function ce(){clearTimeout(re),re=setTimeout(function(){v&&(g.style.cssText=v,v=""),l.renderer.$isMousePressed=!1,l.renderer.$keepTextAreaAtCursor&&l.renderer.$moveTextAreaToCursor()},0)}var he=function(W){l.textInput.onContextMenu(W),ce()};E.addListener(g,"mouseup",he,l),E.addListener(g,"mousedown",function(W){W.preventDefault(),ce()},l),E.addListener(l.renderer.scroller,"contextmenu",he,l),E.addListener(g,"contextmenu",he,l),m&&J(c,l,g);function J(W,Y,j){var H=null,K=!1;j.addEventListener("keydown",function(oe){H&&clearTimeout(H),K=!0},!0),j.addEventListener("keyup",function(oe){H=setTimeout(function(){K=!1},100)},!0);var ee=function(oe){if(document.activeElement===j&&!(K||f||Y.$mouseHandler.isMousePressed)&&!u){var le=j.selectionStart,ae=j.selectionEnd,se=null,ue=0;if(le==0?se=n.up:le==1?se=n.home:ae>R&&x[ae]==`
+`?se=n.end:le<L&&x[le-1]==" "?(se=n.left,ue=a.option):le<L||le==L&&R!=L&&le==ae?se=n.left:ae>R&&x.slice(0,ae).split(`
+`).length>2?se=n.down:ae>R&&x[ae-1]==" "?(se=n.right,ue=a.option):(ae>R||ae==R&&R!=L&&le==ae)&&(se=n.right),le!==ae&&(ue|=a.shift),se){var de=Y.onCommandKey({},ue,se);if(!de&&Y.commands){se=n.keyCodeToString(se);var fe=Y.commands.findKeyCommand(ue,se);fe&&Y.execCommand(fe)}L=le,R=ae,F("")}}};document.addEventListener("selectionchange",ee),Y.on("destroy",function(){document.removeEventListener("selectionchange",ee)})}};y.TextInput=b,y.$setUserAgentForTests=function(c,l){S=c,m=l}}),define("ace/mouse/default_handlers",["require","exports","module","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("../lib/useragent"),A=0,p=550;function h(e){e.$clickSelection=null;var i=e.editor;i.setDefaultHandler("mousedown",this.onMouseDown.bind(e)),i.setDefaultHandler("dblclick",this.onDoubleClick.bind(e)),i.setDefaultHandler("tripleclick",this.onTripleClick.bind(e)),i.setDefaultHandler("quadclick",this.onQuadClick.bind(e)),i.setDefaultHandler("mousewheel",this.onMouseWheel.bind(e));var r=["select","startSelect","selectEnd","selectAllEnd","selectByWordsEnd","selectByLinesEnd","dragWait","dragWaitEnd","focusWait"];r.forEach(function(n){e[n]=this[n]},this),e.selectByLines=this.extendSelectionBy.bind(e,"getLineRange"),e.selectByWords=this.extendSelectionBy.bind(e,"getWordRange")}(function(){this.onMouseDown=function(e){var i=e.inSelection(),r=e.getDocumentPosition();this.mousedownEvent=e;var n=this.editor,a=e.getButton();if(a!==0){var m=n.getSelectionRange(),w=m.isEmpty();(w||a==1)&&n.selection.moveToPosition(r),a==2&&(n.textInput.onContextMenu(e.domEvent),E.isMozilla||e.preventDefault());return}if(this.mousedownEvent.time=Date.now(),i&&!n.isFocused()&&(n.focus(),this.$focusTimeout&&!this.$clickSelection&&!n.inMultiSelectMode)){this.setState("focusWait"),this.captureMouse(e);return}return this.captureMouse(e),this.startSelect(r,e.domEvent._clicks>1),e.preventDefault()},this.startSelect=function(e,i){e=e||this.editor.renderer.screenToTextCoordinates(this.x,this.y);var r=this.editor;this.mousedownEvent&&(this.mousedownEvent.getShiftKey()?r.selection.selectToPosition(e):i||r.selection.moveToPosition(e),i||this.select(),r.renderer.scroller.setCapture&&r.renderer.scroller.setCapture(),r.setStyle("ace_selecting"),this.setState("select"))},this.select=function(){var e,i=this.editor,r=i.renderer.screenToTextCoordinates(this.x,this.y);if(this.$clickSelection){var n=this.$clickSelection.comparePoint(r);if(n==-1)e=this.$clickSelection.end;else if(n==1)e=this.$clickSelection.start;else{var a=t(this.$clickSelection,r);r=a.cursor,e=a.anchor}i.selection.setSelectionAnchor(e.row,e.column)}i.selection.selectToPosition(r),i.renderer.scrollCursorIntoView()},this.extendSelectionBy=function(e){var i,r=this.editor,n=r.renderer.screenToTextCoordinates(this.x,this.y),a=r.selection[e](n.row,n.column);if(this.$clickSelection){var m=this.$clickSelection.comparePoint(a.start),w=this.$clickSelection.comparePoint(a.end);if(m==-1&&w<=0)i=this.$clickSelection.end,(a.end.row!=n.row||a.end.column!=n.column)&&(n=a.start);else if(w==1&&m>=0)i=this.$clickSelection.start,(a.start.row!=n.row||a.start.column!=n.column)&&(n=a.end);else if(m==-1&&w==1)n=a.end,i=a.start;else{var S=t(this.$clickSelection,n);n=S.cursor,i=S.anchor}r.selection.setSelectionAnchor(i.row,i.column)}r.selection.selectToPosition(n),r.renderer.scrollCursorIntoView()},this.selectEnd=this.selectAllEnd=this.selectByWordsEnd=this.selectByLinesEnd=function(){this.$clickSelection=null,this.editor.unsetStyle("ace_selecting"),this.editor.renderer.scroller.releaseCapture&&this.editor.renderer.scroller.releaseCapture()},this.focusWait=function(){var e=d(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y),i=Date.now();(e>A||i-this.mousedownEvent.time>this.$focusTimeout)&&this.startSelect(this.mousedownEvent.getDocumentPosition())},this.onDoubleClick=function(e){var i=e.getDocumentPosition(),r=this.editor,n=r.session,a=n.getBracketRange(i);a?(a.isEmpty()&&(a.start.column--,a.end.column++),this.setState("select")):(a=r.selection.getWordRange(i.row,i.column),this.setState("selectByWords")),this.$clickSelection=a,this.select()},this.onTripleClick=function(e){var i=e.getDocumentPosition(),r=this.editor;this.setState("selectByLines");var n=r.getSelectionRange();n.isMultiLine()&&n.contains(i.row,i.column)?(this.$clickSelection=r.selection.getLineRange(n.start.row),this.$clickSelection.end=r.selection.getLineRange(n.end.row).end):this.$clickSelection=r.selection.getLineRange(i.row),this.select()},this.onQuadClick=function(e){var i=this.editor;i.selectAll(),this.$clickSelection=i.getSelectionRange(),this.setState("selectAll")},this.onMouseWheel=function(e){if(!e.getAccelKey()){e.getShiftKey()&&e.wheelY&&!e.wheelX&&(e.wheelX=e.wheelY,e.wheelY=0);var i=this.editor;this.$lastScroll||(this.$lastScroll={t:0,vx:0,vy:0,allowed:0});var r=this.$lastScroll,n=e.domEvent.timeStamp,a=n-r.t,m=a?e.wheelX/a:r.vx,w=a?e.wheelY/a:r.vy;a<p&&(m=(m+r.vx)/2,w=(w+r.vy)/2);var S=Math.abs(m/w),b=!1;if(S>=1&&i.renderer.isScrollableBy(e.wheelX*e.speed,0)&&(b=!0),S<=1&&i.renderer.isScrollableBy(0,e.wheelY*e.speed)&&(b=!0),b)r.allowed=n;else if(n-r.allowed<p){var c=Math.abs(m)<=1.5*Math.abs(r.vx)&&Math.abs(w)<=1.5*Math.abs(r.vy);c?(b=!0,r.allowed=n):r.allowed=0}if(r.t=n,r.vx=m,r.vy=w,b)return i.renderer.scrollBy(e.wheelX*e.speed,e.wheelY*e.speed),e.stop()}}}).call(h.prototype),y.DefaultHandlers=h;function d(e,i,r,n){return Math.sqrt(Math.pow(r-e,2)+Math.pow(n-i,2))}function t(e,i){if(e.start.row==e.end.row)var r=2*i.column-e.start.column-e.end.column;else if(e.start.row==e.end.row-1&&!e.start.column&&!e.end.column)var r=i.column-4;else var r=2*i.row-e.start.row-e.end.row;return r<0?{cursor:e.start,anchor:e.end}:{cursor:e.end,anchor:e.start}}}),define("ace/tooltip",["require","exports","module","ace/lib/oop","ace/lib/dom"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/dom");function p(h){this.isOpen=!1,this.$element=null,this.$parentNode=h}(function(){this.$init=function(){return this.$element=A.createElement("div"),this.$element.className="ace_tooltip",this.$element.style.display="none",this.$parentNode.appendChild(this.$element),this.$element},this.getElement=function(){return this.$element||this.$init()},this.setText=function(h){this.getElement().textContent=h},this.setHtml=function(h){this.getElement().innerHTML=h},this.setPosition=function(h,d){this.getElement().style.left=h+"px",this.getElement().style.top=d+"px"},this.setClassName=function(h){A.addCssClass(this.getElement(),h)},this.show=function(h,d,t){h!=null&&this.setText(h),d!=null&&t!=null&&this.setPosition(d,t),this.isOpen||(this.getElement().style.display="block",this.isOpen=!0)},this.hide=function(){this.isOpen&&(this.getElement().style.display="none",this.isOpen=!1)},this.getHeight=function(){return this.getElement().offsetHeight},this.getWidth=function(){return this.getElement().offsetWidth},this.destroy=function(){this.isOpen=!1,this.$element&&this.$element.parentNode&&this.$element.parentNode.removeChild(this.$element)}}).call(p.prototype),y.Tooltip=p}),define("ace/mouse/default_gutter_handler",["require","exports","module","ace/lib/dom","ace/lib/oop","ace/lib/event","ace/tooltip"],function(k,y,B){"use strict";var E=k("../lib/dom"),A=k("../lib/oop"),p=k("../lib/event"),h=k("../tooltip").Tooltip;function d(e){var i=e.editor,r=i.renderer.$gutterLayer,n=new t(i.container);e.editor.setDefaultHandler("guttermousedown",function(l){if(!(!i.isFocused()||l.getButton()!=0)){var g=r.getRegion(l);if(g!="foldWidgets"){var u=l.getDocumentPosition().row,o=i.session.selection;if(l.getShiftKey())o.selectTo(u,0);else{if(l.domEvent.detail==2)return i.selectAll(),l.preventDefault();e.$clickSelection=i.selection.getLineRange(u)}return e.setState("selectByLines"),e.captureMouse(l),l.preventDefault()}}});var a,m,w;function S(){var l=m.getDocumentPosition().row,g=r.$annotations[l];if(!g)return b();var u=i.session.getLength();if(l==u){var o=i.renderer.pixelToScreenCoordinates(0,m.y).row,f=m.$pos;if(o>i.session.documentToScreenRow(f.row,f.column))return b()}if(w!=g)if(w=g.text.join("<br/>"),n.setHtml(w),n.show(),i._signal("showGutterTooltip",n),i.on("mousewheel",b),e.$tooltipFollowsMouse)c(m);else{var s=m.domEvent.target,v=s.getBoundingClientRect(),$=n.getElement().style;$.left=v.right+"px",$.top=v.bottom+"px"}}function b(){a&&(a=clearTimeout(a)),w&&(n.hide(),w=null,i._signal("hideGutterTooltip",n),i.off("mousewheel",b))}function c(l){n.setPosition(l.x,l.y)}e.editor.setDefaultHandler("guttermousemove",function(l){var g=l.domEvent.target||l.domEvent.srcElement;if(E.hasCssClass(g,"ace_fold-widget"))return b();w&&e.$tooltipFollowsMouse&&c(l),m=l,!a&&(a=setTimeout(function(){a=null,m&&!e.isMousePressed?S():b()},50))}),p.addListener(i.renderer.$gutter,"mouseout",function(l){m=null,!(!w||a)&&(a=setTimeout(function(){a=null,b()},50))},i),i.on("changeSession",b)}function t(e){h.call(this,e)}A.inherits(t,h),function(){this.setPosition=function(e,i){var r=window.innerWidth||document.documentElement.clientWidth,n=window.innerHeight||document.documentElement.clientHeight,a=this.getWidth(),m=this.getHeight();e+=15,i+=15,e+a>r&&(e-=e+a-r),i+m>n&&(i-=20+m),h.prototype.setPosition.call(this,e,i)}}.call(t.prototype),y.GutterHandler=d}),define("ace/mouse/mouse_event",["require","exports","module","ace/lib/event","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("../lib/event"),A=k("../lib/useragent"),p=y.MouseEvent=function(h,d){this.domEvent=h,this.editor=d,this.x=this.clientX=h.clientX,this.y=this.clientY=h.clientY,this.$pos=null,this.$inSelection=null,this.propagationStopped=!1,this.defaultPrevented=!1};(function(){this.stopPropagation=function(){E.stopPropagation(this.domEvent),this.propagationStopped=!0},this.preventDefault=function(){E.preventDefault(this.domEvent),this.defaultPrevented=!0},this.stop=function(){this.stopPropagation(),this.preventDefault()},this.getDocumentPosition=function(){return this.$pos?this.$pos:(this.$pos=this.editor.renderer.screenToTextCoordinates(this.clientX,this.clientY),this.$pos)},this.inSelection=function(){if(this.$inSelection!==null)return this.$inSelection;var h=this.editor,d=h.getSelectionRange();if(d.isEmpty())this.$inSelection=!1;else{var t=this.getDocumentPosition();this.$inSelection=d.contains(t.row,t.column)}return this.$inSelection},this.getButton=function(){return E.getButton(this.domEvent)},this.getShiftKey=function(){return this.domEvent.shiftKey},this.getAccelKey=A.isMac?function(){return this.domEvent.metaKey}:function(){return this.domEvent.ctrlKey}}).call(p.prototype)}),define("ace/mouse/dragdrop_handler",["require","exports","module","ace/lib/dom","ace/lib/event","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("../lib/dom"),A=k("../lib/event"),p=k("../lib/useragent"),h=200,d=200,t=5;function e(r){var n=r.editor,a=E.createElement("img");a.src="data:image/gif;base64,R0lGODlhAQABAAAAACH5BAEKAAEALAAAAAABAAEAAAICTAEAOw==",p.isOpera&&(a.style.cssText="width:1px;height:1px;position:fixed;top:0;left:0;z-index:2147483647;opacity:0;");var m=["dragWait","dragWaitEnd","startDrag","dragReadyEnd","onMouseDrag"];m.forEach(function(I){r[I]=this[I]},this),n.on("mousedown",this.onMouseDown.bind(r));var w=n.container,S,b,c,l,g,u,o=0,f,s,v,$,C;this.onDragStart=function(I){if(this.cancelDrag||!w.draggable){var D=this;return setTimeout(function(){D.startSelect(),D.captureMouse(I)},0),I.preventDefault()}g=n.getSelectionRange();var N=I.dataTransfer;N.effectAllowed=n.getReadOnly()?"copy":"copyMove",p.isOpera&&(n.container.appendChild(a),a.scrollTop=0),N.setDragImage&&N.setDragImage(a,0,0),p.isOpera&&n.container.removeChild(a),N.clearData(),N.setData("Text",n.session.getTextRange()),s=!0,this.setState("drag")},this.onDragEnd=function(I){if(w.draggable=!1,s=!1,this.setState(null),!n.getReadOnly()){var D=I.dataTransfer.dropEffect;!f&&D=="move"&&n.session.remove(n.getSelectionRange()),n.$resetCursorStyle()}this.editor.unsetStyle("ace_dragging"),this.editor.renderer.setCursorStyle("")},this.onDragEnter=function(I){if(!(n.getReadOnly()||!M(I.dataTransfer)))return b=I.clientX,c=I.clientY,S||T(),o++,I.dataTransfer.dropEffect=f=P(I),A.preventDefault(I)},this.onDragOver=function(I){if(!(n.getReadOnly()||!M(I.dataTransfer)))return b=I.clientX,c=I.clientY,S||(T(),o++),F!==null&&(F=null),I.dataTransfer.dropEffect=f=P(I),A.preventDefault(I)},this.onDragLeave=function(I){if(o--,o<=0&&S)return _(),f=null,A.preventDefault(I)},this.onDrop=function(I){if(u){var D=I.dataTransfer;if(s)switch(f){case"move":g.contains(u.row,u.column)?g={start:u,end:u}:g=n.moveText(g,u);break;case"copy":g=n.moveText(g,u,!0);break}else{var N=D.getData("Text");g={start:u,end:n.session.insert(u,N)},n.focus(),f=null}return _(),A.preventDefault(I)}},A.addListener(w,"dragstart",this.onDragStart.bind(r),n),A.addListener(w,"dragend",this.onDragEnd.bind(r),n),A.addListener(w,"dragenter",this.onDragEnter.bind(r),n),A.addListener(w,"dragover",this.onDragOver.bind(r),n),A.addListener(w,"dragleave",this.onDragLeave.bind(r),n),A.addListener(w,"drop",this.onDrop.bind(r),n);function x(I,D){var N=Date.now(),V=!D||I.row!=D.row,z=!D||I.column!=D.column;if(!$||V||z)n.moveCursorToPosition(I),$=N,C={x:b,y:c};else{var Q=i(C.x,C.y,b,c);Q>t?$=null:N-$>=d&&(n.renderer.scrollCursorIntoView(),$=null)}}function L(I,D){var N=Date.now(),V=n.renderer.layerConfig.lineHeight,z=n.renderer.layerConfig.characterWidth,Q=n.renderer.scroller.getBoundingClientRect(),X={x:{left:b-Q.left,right:Q.right-b},y:{top:c-Q.top,bottom:Q.bottom-c}},U=Math.min(X.x.left,X.x.right),Z=Math.min(X.y.top,X.y.bottom),G={row:I.row,column:I.column};U/z<=2&&(G.column+=X.x.left<X.x.right?-3:2),Z/V<=1&&(G.row+=X.y.top<X.y.bottom?-1:1);var ie=I.row!=G.row,te=I.column!=G.column,ne=!D||I.row!=D.row;ie||te&&!ne?v?N-v>=h&&n.renderer.scrollCursorIntoView(G):v=N:v=null}function R(){var I=u;u=n.renderer.screenToTextCoordinates(b,c),x(u,I),L(u,I)}function T(){g=n.selection.toOrientedRange(),S=n.session.addMarker(g,"ace_selection",n.getSelectionStyle()),n.clearSelection(),n.isFocused()&&n.renderer.$cursorLayer.setBlinking(!1),clearInterval(l),R(),l=setInterval(R,20),o=0,A.addListener(document,"mousemove",O)}function _(){clearInterval(l),n.session.removeMarker(S),S=null,n.selection.fromOrientedRange(g),n.isFocused()&&!s&&n.$resetCursorStyle(),g=null,u=null,o=0,v=null,$=null,A.removeListener(document,"mousemove",O)}var F=null;function O(){F==null&&(F=setTimeout(function(){F!=null&&S&&_()},20))}function M(I){var D=I.types;return!D||Array.prototype.some.call(D,function(N){return N=="text/plain"||N=="Text"})}function P(I){var D=["copy","copymove","all","uninitialized"],N=["move","copymove","linkmove","all","uninitialized"],V=p.isMac?I.altKey:I.ctrlKey,z="uninitialized";try{z=I.dataTransfer.effectAllowed.toLowerCase()}catch{}var Q="none";return V&&D.indexOf(z)>=0?Q="copy":N.indexOf(z)>=0?Q="move":D.indexOf(z)>=0&&(Q="copy"),Q}}(function(){this.dragWait=function(){var r=Date.now()-this.mousedownEvent.time;r>this.editor.getDragDelay()&&this.startDrag()},this.dragWaitEnd=function(){var r=this.editor.container;r.draggable=!1,this.startSelect(this.mousedownEvent.getDocumentPosition()),this.selectEnd()},this.dragReadyEnd=function(r){this.editor.$resetCursorStyle(),this.editor.unsetStyle("ace_dragging"),this.editor.renderer.setCursorStyle(""),this.dragWaitEnd()},this.startDrag=function(){this.cancelDrag=!1;var r=this.editor,n=r.container;n.draggable=!0,r.renderer.$cursorLayer.setBlinking(!1),r.setStyle("ace_dragging");var a=p.isWin?"default":"move";r.renderer.setCursorStyle(a),this.setState("dragReady")},this.onMouseDrag=function(r){var n=this.editor.container;if(p.isIE&&this.state=="dragReady"){var a=i(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y);a>3&&n.dragDrop()}if(this.state==="dragWait"){var a=i(this.mousedownEvent.x,this.mousedownEvent.y,this.x,this.y);a>0&&(n.draggable=!1,this.startSelect(this.mousedownEvent.getDocumentPosition()))}},this.onMouseDown=function(r){if(this.$dragEnabled){this.mousedownEvent=r;var n=this.editor,a=r.inSelection(),m=r.getButton(),w=r.domEvent.detail||1;if(w===1&&m===0&&a){if(r.editor.inMultiSelectMode&&(r.getAccelKey()||r.getShiftKey()))return;this.mousedownEvent.time=Date.now();var S=r.domEvent.target||r.domEvent.srcElement;if("unselectable"in S&&(S.unselectable="on"),n.getDragDelay()){if(p.isWebKit){this.cancelDrag=!0;var b=n.container;b.draggable=!0}this.setState("dragWait")}else this.startDrag();this.captureMouse(r,this.onMouseDrag.bind(this)),r.defaultPrevented=!0}}}}).call(e.prototype);function i(r,n,a,m){return Math.sqrt(Math.pow(a-r,2)+Math.pow(m-n,2))}y.DragdropHandler=e}),define("ace/mouse/touch_handler",["require","exports","module","ace/mouse/mouse_event","ace/lib/event","ace/lib/dom"],function(k,y,B){"use strict";var E=k("./mouse_event").MouseEvent,A=k("../lib/event"),p=k("../lib/dom");y.addTouchListeners=function(h,d){var t="scroll",e,i,r,n,a,m,w=0,S,b=0,c=0,l=0,g,u;function o(){var x=window.navigator&&window.navigator.clipboard,L=!1,R=function(){var _=d.getCopyText(),F=d.session.getUndoManager().hasUndo();u.replaceChild(p.buildDom(L?["span",!_&&["span",{class:"ace_mobile-button",action:"selectall"},"Select All"],_&&["span",{class:"ace_mobile-button",action:"copy"},"Copy"],_&&["span",{class:"ace_mobile-button",action:"cut"},"Cut"],x&&["span",{class:"ace_mobile-button",action:"paste"},"Paste"],F&&["span",{class:"ace_mobile-button",action:"undo"},"Undo"],["span",{class:"ace_mobile-button",action:"find"},"Find"],["span",{class:"ace_mobile-button",action:"openCommandPallete"},"Pallete"]]:["span"]),u.firstChild)},T=function(_){var F=_.target.getAttribute("action");if(F=="more"||!L)return L=!L,R();F=="paste"?x.readText().then(function(O){d.execCommand(F,O)}):F&&((F=="cut"||F=="copy")&&(x?x.writeText(d.getCopyText()):document.execCommand("copy")),d.execCommand(F)),u.firstChild.style.display="none",L=!1,F!="openCommandPallete"&&d.focus()};u=p.buildDom(["div",{class:"ace_mobile-menu",ontouchstart:function(_){t="menu",_.stopPropagation(),_.preventDefault(),d.textInput.focus()},ontouchend:function(_){_.stopPropagation(),_.preventDefault(),T(_)},onclick:T},["span"],["span",{class:"ace_mobile-button",action:"more"},"..."]],d.container)}function f(){u||o();var x=d.selection.cursor,L=d.renderer.textToScreenCoordinates(x.row,x.column),R=d.renderer.textToScreenCoordinates(0,0).pageX,T=d.renderer.scrollLeft,_=d.container.getBoundingClientRect();u.style.top=L.pageY-_.top-3+"px",L.pageX-_.left<_.width-70?(u.style.left="",u.style.right="10px"):(u.style.right="",u.style.left=R+T-_.left+"px"),u.style.display="",u.firstChild.style.display="none",d.on("input",s)}function s(x){u&&(u.style.display="none"),d.off("input",s)}function v(){a=null,clearTimeout(a);var x=d.selection.getRange(),L=x.contains(S.row,S.column);(x.isEmpty()||!L)&&(d.selection.moveToPosition(S),d.selection.selectWord()),t="wait",f()}function $(){a=null,clearTimeout(a),d.selection.moveToPosition(S);var x=b>=2?d.selection.getLineRange(S.row):d.session.getBracketRange(S);x&&!x.isEmpty()?d.selection.setRange(x):d.selection.selectWord(),t="wait"}A.addListener(h,"contextmenu",function(x){if(g){var L=d.textInput.getElement();L.focus()}},d),A.addListener(h,"touchstart",function(x){var L=x.touches;if(a||L.length>1){clearTimeout(a),a=null,r=-1,t="zoom";return}g=d.$mouseHandler.isMousePressed=!0;var R=d.renderer.layerConfig.lineHeight,T=d.renderer.layerConfig.lineHeight,_=x.timeStamp;n=_;var F=L[0],O=F.clientX,M=F.clientY;Math.abs(e-O)+Math.abs(i-M)>R&&(r=-1),e=x.clientX=O,i=x.clientY=M,c=l=0;var P=new E(x,d);if(S=P.getDocumentPosition(),_-r<500&&L.length==1&&!w)b++,x.preventDefault(),x.button=0,$();else{b=0;var I=d.selection.cursor,D=d.selection.isEmpty()?I:d.selection.anchor,N=d.renderer.$cursorLayer.getPixelPosition(I,!0),V=d.renderer.$cursorLayer.getPixelPosition(D,!0),z=d.renderer.scroller.getBoundingClientRect(),Q=d.renderer.layerConfig.offset,X=d.renderer.scrollLeft,U=function(ie,te){return ie=ie/T,te=te/R-.75,ie*ie+te*te};if(x.clientX<z.left){t="zoom";return}var Z=U(x.clientX-z.left-N.left+X,x.clientY-z.top-N.top+Q),G=U(x.clientX-z.left-V.left+X,x.clientY-z.top-V.top+Q);Z<3.5&&G<3.5&&(t=Z>G?"cursor":"anchor"),G<3.5?t="anchor":Z<3.5?t="cursor":t="scroll",a=setTimeout(v,450)}r=_},d),A.addListener(h,"touchend",function(x){g=d.$mouseHandler.isMousePressed=!1,m&&clearInterval(m),t=="zoom"?(t="",w=0):a?(d.selection.moveToPosition(S),w=0,f()):t=="scroll"?(C(),s()):f(),clearTimeout(a),a=null},d),A.addListener(h,"touchmove",function(x){a&&(clearTimeout(a),a=null);var L=x.touches;if(!(L.length>1||t=="zoom")){var R=L[0],T=e-R.clientX,_=i-R.clientY;if(t=="wait")if(T*T+_*_>4)t="cursor";else return x.preventDefault();e=R.clientX,i=R.clientY,x.clientX=R.clientX,x.clientY=R.clientY;var F=x.timeStamp,O=F-n;if(n=F,t=="scroll"){var M=new E(x,d);M.speed=1,M.wheelX=T,M.wheelY=_,10*Math.abs(T)<Math.abs(_)&&(T=0),10*Math.abs(_)<Math.abs(T)&&(_=0),O!=0&&(c=T/O,l=_/O),d._emit("mousewheel",M),M.propagationStopped||(c=l=0)}else{var P=new E(x,d),I=P.getDocumentPosition();t=="cursor"?d.selection.moveCursorToPosition(I):t=="anchor"&&d.selection.setSelectionAnchor(I.row,I.column),d.renderer.scrollCursorIntoView(I),x.preventDefault()}}},d);function C(){w+=60,m=setInterval(function(){w--<=0&&(clearInterval(m),m=null),Math.abs(c)<.01&&(c=0),Math.abs(l)<.01&&(l=0),w<20&&(c=.9*c),w<20&&(l=.9*l);var x=d.session.getScrollTop();d.renderer.scrollBy(10*c,10*l),x==d.session.getScrollTop()&&(w=0)},10)}}}),define("ace/lib/net",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";var E=k("./dom");y.get=function(A,p){var h=new XMLHttpRequest;h.open("GET",A,!0),h.onreadystatechange=function(){h.readyState===4&&p(h.responseText)},h.send(null)},y.loadScript=function(A,p){var h=E.getDocumentHead(),d=document.createElement("script");d.src=A,h.appendChild(d),d.onload=d.onreadystatechange=function(t,e){(e||!d.readyState||d.readyState=="loaded"||d.readyState=="complete")&&(d=d.onload=d.onreadystatechange=null,e||p())}},y.qualifyURL=function(A){var p=document.createElement("a");return p.href=A,p.href}}),define("ace/lib/event_emitter",["require","exports","module"],function(k,y,B){"use strict";var E={},A=function(){this.propagationStopped=!0},p=function(){this.defaultPrevented=!0};E._emit=E._dispatchEvent=function(h,d){this._eventRegistry||(this._eventRegistry={}),this._defaultHandlers||(this._defaultHandlers={});var t=this._eventRegistry[h]||[],e=this._defaultHandlers[h];if(!(!t.length&&!e)){(typeof d!="object"||!d)&&(d={}),d.type||(d.type=h),d.stopPropagation||(d.stopPropagation=A),d.preventDefault||(d.preventDefault=p),t=t.slice();for(var i=0;i<t.length&&(t[i](d,this),!d.propagationStopped);i++);if(e&&!d.defaultPrevented)return e(d,this)}},E._signal=function(h,d){var t=(this._eventRegistry||{})[h];if(t){t=t.slice();for(var e=0;e<t.length;e++)t[e](d,this)}},E.once=function(h,d){var t=this;if(this.on(h,function e(){t.off(h,e),d.apply(null,arguments)}),!d)return new Promise(function(e){d=e})},E.setDefaultHandler=function(h,d){var t=this._defaultHandlers;if(t||(t=this._defaultHandlers={_disabled_:{}}),t[h]){var e=t[h],i=t._disabled_[h];i||(t._disabled_[h]=i=[]),i.push(e);var r=i.indexOf(d);r!=-1&&i.splice(r,1)}t[h]=d},E.removeDefaultHandler=function(h,d){var t=this._defaultHandlers;if(t){var e=t._disabled_[h];if(t[h]==d)e&&this.setDefaultHandler(h,e.pop());else if(e){var i=e.indexOf(d);i!=-1&&e.splice(i,1)}}},E.on=E.addEventListener=function(h,d,t){this._eventRegistry=this._eventRegistry||{};var e=this._eventRegistry[h];return e||(e=this._eventRegistry[h]=[]),e.indexOf(d)==-1&&e[t?"unshift":"push"](d),d},E.off=E.removeListener=E.removeEventListener=function(h,d){this._eventRegistry=this._eventRegistry||{};var t=this._eventRegistry[h];if(t){var e=t.indexOf(d);e!==-1&&t.splice(e,1)}},E.removeAllListeners=function(h){h||(this._eventRegistry=this._defaultHandlers=void 0),this._eventRegistry&&(this._eventRegistry[h]=void 0),this._defaultHandlers&&(this._defaultHandlers[h]=void 0)},y.EventEmitter=E}),define("ace/lib/app_config",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(k,y,B){var E=k("./oop"),A=k("./event_emitter").EventEmitter,p={setOptions:function(e){Object.keys(e).forEach(function(i){this.setOption(i,e[i])},this)},getOptions:function(e){var i={};if(e)Array.isArray(e)||(i=e,e=Object.keys(i));else{var r=this.$options;e=Object.keys(r).filter(function(n){return!r[n].hidden})}return e.forEach(function(n){i[n]=this.getOption(n)},this),i},setOption:function(e,i){if(this["$"+e]!==i){var r=this.$options[e];if(!r)return h('misspelled option "'+e+'"');if(r.forwardTo)return this[r.forwardTo]&&this[r.forwardTo].setOption(e,i);r.handlesSet||(this["$"+e]=i),r&&r.set&&r.set.call(this,i)}},getOption:function(e){var i=this.$options[e];return i?i.forwardTo?this[i.forwardTo]&&this[i.forwardTo].getOption(e):i&&i.get?i.get.call(this):this["$"+e]:h('misspelled option "'+e+'"')}};function h(e){typeof console<"u"&&console.warn&&console.warn.apply(console,arguments)}function d(e,i){var r=new Error(e);r.data=i,typeof console=="object"&&console.error&&console.error(r),setTimeout(function(){throw r})}var t=function(){this.$defaultOptions={}};(function(){E.implement(this,A),this.defineOptions=function(e,i,r){return e.$options||(this.$defaultOptions[i]=e.$options={}),Object.keys(r).forEach(function(n){var a=r[n];typeof a=="string"&&(a={forwardTo:a}),a.name||(a.name=n),e.$options[a.name]=a,"initialValue"in a&&(e["$"+a.name]=a.initialValue)}),E.implement(e,p),this},this.resetOptions=function(e){Object.keys(e.$options).forEach(function(i){var r=e.$options[i];"value"in r&&e.setOption(i,r.value)})},this.setDefaultValue=function(e,i,r){if(!e){for(e in this.$defaultOptions)if(this.$defaultOptions[e][i])break;if(!this.$defaultOptions[e][i])return!1}var n=this.$defaultOptions[e]||(this.$defaultOptions[e]={});n[i]&&(n.forwardTo?this.setDefaultValue(n.forwardTo,i,r):n[i].value=r)},this.setDefaultValues=function(e,i){Object.keys(i).forEach(function(r){this.setDefaultValue(e,r,i[r])},this)},this.warn=h,this.reportError=d}).call(t.prototype),y.AppConfig=t}),define("ace/config",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/lib/net","ace/lib/app_config"],function(k,y,B){var E=k("./lib/lang"),A=k("./lib/oop"),p=k("./lib/net"),h=k("./lib/app_config").AppConfig;B.exports=y=new h;var d=function(){return this||typeof window<"u"&&window}(),t={packaged:!1,workerPath:null,modePath:null,themePath:null,basePath:"",suffix:".js",$moduleUrls:{},loadWorkerFromBlob:!0,sharedPopups:!1};y.get=function(n){if(!t.hasOwnProperty(n))throw new Error("Unknown config key: "+n);return t[n]},y.set=function(n,a){if(t.hasOwnProperty(n))t[n]=a;else if(this.setDefaultValue("",n,a)==!1)throw new Error("Unknown config key: "+n)},y.all=function(){return E.copyObject(t)},y.$modes={},y.moduleUrl=function(n,a){if(t.$moduleUrls[n])return t.$moduleUrls[n];var m=n.split("/");a=a||m[m.length-2]||"";var w=a=="snippets"?"/":"-",S=m[m.length-1];if(a=="worker"&&w=="-"){var b=new RegExp("^"+a+"[\\-_]|[\\-_]"+a+"$","g");S=S.replace(b,"")}(!S||S==a)&&m.length>1&&(S=m[m.length-2]);var c=t[a+"Path"];return c==null?c=t.basePath:w=="/"&&(a=w=""),c&&c.slice(-1)!="/"&&(c+="/"),c+a+w+S+this.get("suffix")},y.setModuleUrl=function(n,a){return t.$moduleUrls[n]=a},y.$loading={},y.loadModule=function(n,a){var m,w;Array.isArray(n)&&(w=n[0],n=n[1]);try{m=k(n)}catch{}if(m&&!y.$loading[n])return a&&a(m);if(y.$loading[n]||(y.$loading[n]=[]),y.$loading[n].push(a),!(y.$loading[n].length>1)){var S=function(){k([n],function(b){y._emit("load.module",{name:n,module:b});var c=y.$loading[n];y.$loading[n]=null,c.forEach(function(l){l&&l(b)})})};if(!y.get("packaged"))return S();p.loadScript(y.moduleUrl(n,w),S),e()}};var e=function(){!t.basePath&&!t.workerPath&&!t.modePath&&!t.themePath&&!Object.keys(t.$moduleUrls).length&&(console.error("Unable to infer path to ace from script src,","use ace.config.set('basePath', 'path') to enable dynamic loading of modes and themes","or with webpack use ace/webpack-resolver"),e=function(){})};i(!0);function i(n){if(!(!d||!d.document)){t.packaged=n||k.packaged||B.packaged||d.define&&define.packaged;for(var a={},m="",w=document.currentScript||document._currentScript,S=w&&w.ownerDocument||document,b=S.getElementsByTagName("script"),c=0;c<b.length;c++){var l=b[c],g=l.src||l.getAttribute("src");if(g){for(var u=l.attributes,o=0,f=u.length;o<f;o++){var s=u[o];s.name.indexOf("data-ace-")===0&&(a[r(s.name.replace(/^data-ace-/,""))]=s.value)}var v=g.match(/^(.*)\/ace(\-\w+)?\.js(\?|$)/);v&&(m=v[1])}}m&&(a.base=a.base||m,a.packaged=!0),a.basePath=a.base,a.workerPath=a.workerPath||a.base,a.modePath=a.modePath||a.base,a.themePath=a.themePath||a.base,delete a.base;for(var $ in a)typeof a[$]<"u"&&y.set($,a[$])}}y.init=i;function r(n){return n.replace(/-(.)/g,function(a,m){return m.toUpperCase()})}y.version="1.4.12"}),define("ace/mouse/mouse_handler",["require","exports","module","ace/lib/event","ace/lib/useragent","ace/mouse/default_handlers","ace/mouse/default_gutter_handler","ace/mouse/mouse_event","ace/mouse/dragdrop_handler","ace/mouse/touch_handler","ace/config"],function(k,y,B){"use strict";var E=k("../lib/event"),A=k("../lib/useragent"),p=k("./default_handlers").DefaultHandlers,h=k("./default_gutter_handler").GutterHandler,d=k("./mouse_event").MouseEvent,t=k("./dragdrop_handler").DragdropHandler,e=k("./touch_handler").addTouchListeners,i=k("../config"),r=function(n){var a=this;this.editor=n,new p(this),new h(this),new t(this);var m=function(b){var c=!document.hasFocus||!document.hasFocus()||!n.isFocused()&&document.activeElement==(n.textInput&&n.textInput.getElement());c&&window.focus(),n.focus()},w=n.renderer.getMouseEventTarget();E.addListener(w,"click",this.onMouseEvent.bind(this,"click"),n),E.addListener(w,"mousemove",this.onMouseMove.bind(this,"mousemove"),n),E.addMultiMouseDownListener([w,n.renderer.scrollBarV&&n.renderer.scrollBarV.inner,n.renderer.scrollBarH&&n.renderer.scrollBarH.inner,n.textInput&&n.textInput.getElement()].filter(Boolean),[400,300,250],this,"onMouseEvent",n),E.addMouseWheelListener(n.container,this.onMouseWheel.bind(this,"mousewheel"),n),e(n.container,n);var S=n.renderer.$gutter;E.addListener(S,"mousedown",this.onMouseEvent.bind(this,"guttermousedown"),n),E.addListener(S,"click",this.onMouseEvent.bind(this,"gutterclick"),n),E.addListener(S,"dblclick",this.onMouseEvent.bind(this,"gutterdblclick"),n),E.addListener(S,"mousemove",this.onMouseEvent.bind(this,"guttermousemove"),n),E.addListener(w,"mousedown",m,n),E.addListener(S,"mousedown",m,n),A.isIE&&n.renderer.scrollBarV&&(E.addListener(n.renderer.scrollBarV.element,"mousedown",m,n),E.addListener(n.renderer.scrollBarH.element,"mousedown",m,n)),n.on("mousemove",function(b){if(!(a.state||a.$dragDelay||!a.$dragEnabled)){var c=n.renderer.screenToTextCoordinates(b.x,b.y),l=n.session.selection.getRange(),g=n.renderer;!l.isEmpty()&&l.insideStart(c.row,c.column)?g.setCursorStyle("default"):g.setCursorStyle("")}},n)};(function(){this.onMouseEvent=function(n,a){this.editor._emit(n,new d(a,this.editor))},this.onMouseMove=function(n,a){var m=this.editor._eventRegistry&&this.editor._eventRegistry.mousemove;!m||!m.length||this.editor._emit(n,new d(a,this.editor))},this.onMouseWheel=function(n,a){var m=new d(a,this.editor);m.speed=this.$scrollSpeed*2,m.wheelX=a.wheelX,m.wheelY=a.wheelY,this.editor._emit(n,m)},this.setState=function(n){this.state=n},this.captureMouse=function(n,a){this.x=n.x,this.y=n.y,this.isMousePressed=!0;var m=this.editor,w=this.editor.renderer;w.$isMousePressed=!0;var S=this,b=function(o){if(o){if(A.isWebKit&&!o.which&&S.releaseMouse)return S.releaseMouse();S.x=o.clientX,S.y=o.clientY,a&&a(o),S.mouseEvent=new d(o,S.editor),S.$mouseMoved=!0}},c=function(o){m.off("beforeEndOperation",g),clearInterval(u),l(),S[S.state+"End"]&&S[S.state+"End"](o),S.state="",S.isMousePressed=w.$isMousePressed=!1,w.$keepTextAreaAtCursor&&w.$moveTextAreaToCursor(),S.$onCaptureMouseMove=S.releaseMouse=null,o&&S.onMouseEvent("mouseup",o),m.endOperation()},l=function(){S[S.state]&&S[S.state](),S.$mouseMoved=!1};if(A.isOldIE&&n.domEvent.type=="dblclick")return setTimeout(function(){c(n)});var g=function(o){S.releaseMouse&&m.curOp.command.name&&m.curOp.selectionChanged&&(S[S.state+"End"]&&S[S.state+"End"](),S.state="",S.releaseMouse())};m.on("beforeEndOperation",g),m.startOperation({command:{name:"mouse"}}),S.$onCaptureMouseMove=b,S.releaseMouse=E.capture(this.editor.container,b,c);var u=setInterval(l,20)},this.releaseMouse=null,this.cancelContextMenu=function(){var n=function(a){a&&a.domEvent&&a.domEvent.type!="contextmenu"||(this.editor.off("nativecontextmenu",n),a&&a.domEvent&&E.stopEvent(a.domEvent))}.bind(this);setTimeout(n,10),this.editor.on("nativecontextmenu",n)},this.destroy=function(){this.releaseMouse&&this.releaseMouse()}}).call(r.prototype),i.defineOptions(r.prototype,"mouseHandler",{scrollSpeed:{initialValue:2},dragDelay:{initialValue:A.isMac?150:0},dragEnabled:{initialValue:!0},focusTimeout:{initialValue:0},tooltipFollowsMouse:{initialValue:!0}}),y.MouseHandler=r}),define("ace/mouse/fold_handler",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";var E=k("../lib/dom");function A(p){p.on("click",function(h){var d=h.getDocumentPosition(),t=p.session,e=t.getFoldAt(d.row,d.column,1);e&&(h.getAccelKey()?t.removeFold(e):t.expandFold(e),h.stop());var i=h.domEvent&&h.domEvent.target;i&&E.hasCssClass(i,"ace_inline_button")&&E.hasCssClass(i,"ace_toggle_wrap")&&(t.setOption("wrap",!t.getUseWrapMode()),p.renderer.scrollCursorIntoView())}),p.on("gutterclick",function(h){var d=p.renderer.$gutterLayer.getRegion(h);if(d=="foldWidgets"){var t=h.getDocumentPosition().row,e=p.session;e.foldWidgets&&e.foldWidgets[t]&&p.session.onFoldWidgetClick(t,h),p.isFocused()||p.focus(),h.stop()}}),p.on("gutterdblclick",function(h){var d=p.renderer.$gutterLayer.getRegion(h);if(d=="foldWidgets"){var t=h.getDocumentPosition().row,e=p.session,i=e.getParentFoldRangeData(t,!0),r=i.range||i.firstRange;if(r){t=r.start.row;var n=e.getFoldAt(t,e.getLine(t).length,1);n?e.removeFold(n):(e.addFold("...",r),p.renderer.scrollCursorIntoView({row:r.start.row,column:0}))}h.stop()}})}y.FoldHandler=A}),define("ace/keyboard/keybinding",["require","exports","module","ace/lib/keys","ace/lib/event"],function(k,y,B){"use strict";var E=k("../lib/keys"),A=k("../lib/event"),p=function(h){this.$editor=h,this.$data={editor:h},this.$handlers=[],this.setDefaultHandler(h.commands)};(function(){this.setDefaultHandler=function(h){this.removeKeyboardHandler(this.$defaultHandler),this.$defaultHandler=h,this.addKeyboardHandler(h,0)},this.setKeyboardHandler=function(h){var d=this.$handlers;if(d[d.length-1]!=h){for(;d[d.length-1]&&d[d.length-1]!=this.$defaultHandler;)this.removeKeyboardHandler(d[d.length-1]);this.addKeyboardHandler(h,1)}},this.addKeyboardHandler=function(h,d){if(h){typeof h=="function"&&!h.handleKeyboard&&(h.handleKeyboard=h);var t=this.$handlers.indexOf(h);t!=-1&&this.$handlers.splice(t,1),d==null?this.$handlers.push(h):this.$handlers.splice(d,0,h),t==-1&&h.attach&&h.attach(this.$editor)}},this.removeKeyboardHandler=function(h){var d=this.$handlers.indexOf(h);return d==-1?!1:(this.$handlers.splice(d,1),h.detach&&h.detach(this.$editor),!0)},this.getKeyboardHandler=function(){return this.$handlers[this.$handlers.length-1]},this.getStatusText=function(){var h=this.$data,d=h.editor;return this.$handlers.map(function(t){return t.getStatusText&&t.getStatusText(d,h)||""}).filter(Boolean).join(" ")},this.$callKeyboardHandlers=function(h,d,t,e){for(var i,r=!1,n=this.$editor.commands,a=this.$handlers.length;a--&&(i=this.$handlers[a].handleKeyboard(this.$data,h,d,t,e),!(!(!i||!i.command)&&(i.command=="null"?r=!0:r=n.exec(i.command,this.$editor,i.args,e),r&&e&&h!=-1&&i.passEvent!=!0&&i.command.passEvent!=!0&&A.stopEvent(e),r))););return!r&&h==-1&&(i={command:"insertstring"},r=n.exec("insertstring",this.$editor,d)),r&&this.$editor._signal&&this.$editor._signal("keyboardActivity",i),r},this.onCommandKey=function(h,d,t){var e=E.keyCodeToString(t);return this.$callKeyboardHandlers(d,e,t,h)},this.onTextInput=function(h){return this.$callKeyboardHandlers(-1,h)}}).call(p.prototype),y.KeyBinding=p}),define("ace/lib/bidiutil",["require","exports","module"],function(k,y,B){"use strict";var E=["\u0621","\u0641"],A=["\u063A","\u064A"],p=0,h=0,d=!1,t=!1,e=!1,i=!1,r=!1,n=!1,a=[[0,3,0,1,0,0,0],[0,3,0,1,2,2,0],[0,3,0,17,2,0,1],[0,3,5,5,4,1,0],[0,3,21,21,4,0,1],[0,3,5,5,4,2,0]],m=[[2,0,1,1,0,1,0],[2,0,1,1,0,2,0],[2,0,2,1,3,2,0],[2,0,2,33,3,1,1]],w=0,S=1,b=0,c=1,l=2,g=3,u=4,o=5,f=6,s=7,v=8,$=9,C=10,x=11,L=12,R=13,T=14,_=15,F=16,O=17,M=18,P=[M,M,M,M,M,M,M,M,M,f,o,f,v,o,M,M,M,M,M,M,M,M,M,M,M,M,M,M,o,o,o,f,v,u,u,x,x,x,u,u,u,u,u,C,$,C,$,$,l,l,l,l,l,l,l,l,l,l,$,u,u,u,u,u,u,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,u,u,u,u,u,u,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,b,u,u,u,u,M,M,M,M,M,M,o,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,M,$,u,x,x,x,x,u,u,u,u,b,u,u,M,u,u,x,x,l,l,u,b,u,u,u,l,b,u,u,u,u,u],I=[v,v,v,v,v,v,v,v,v,v,v,M,M,M,b,c,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,v,o,R,T,_,F,O,$,x,x,x,x,x,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,$,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,u,v];function D(X,U,Z,G){var ie=p?m:a,te=null,ne=null,q=null,re=0,ce=null,he=null,J=-1,W=null,Y=null,j=[];if(!G)for(W=0,G=[];W<Z;W++)G[W]=z(X[W]);for(h=p,d=!1,t=!1,e=!1,i=!1,Y=0;Y<Z;Y++){if(te=re,j[Y]=ne=V(X,G,j,Y),re=ie[te][ne],ce=re&240,re&=15,U[Y]=q=ie[re][5],ce>0)if(ce==16){for(W=J;W<Y;W++)U[W]=1;J=-1}else J=-1;if(he=ie[re][6],he)J==-1&&(J=Y);else if(J>-1){for(W=J;W<Y;W++)U[W]=q;J=-1}G[Y]==o&&(U[Y]=0),h|=q}if(i){for(W=0;W<Z;W++)if(G[W]==f){U[W]=p;for(var H=W-1;H>=0&&G[H]==v;H--)U[H]=p}}}function N(X,U,Z){if(!(h<X)){if(X==1&&p==S&&!e){Z.reverse();return}for(var G=Z.length,ie=0,te,ne,q,re;ie<G;){if(U[ie]>=X){for(te=ie+1;te<G&&U[te]>=X;)te++;for(ne=ie,q=te-1;ne<q;ne++,q--)re=Z[ne],Z[ne]=Z[q],Z[q]=re;ie=te}ie++}}}function V(X,U,Z,G){var ie=U[G],te,ne,q,re;switch(ie){case b:case c:d=!1;case u:case g:return ie;case l:return d?g:l;case s:return d=!0,t=!0,c;case v:return u;case $:return G<1||G+1>=U.length||(te=Z[G-1])!=l&&te!=g||(ne=U[G+1])!=l&&ne!=g?u:(d&&(ne=g),ne==te?ne:u);case C:return te=G>0?Z[G-1]:o,te==l&&G+1<U.length&&U[G+1]==l?l:u;case x:if(G>0&&Z[G-1]==l)return l;if(d)return u;for(re=G+1,q=U.length;re<q&&U[re]==x;)re++;return re<q&&U[re]==l?l:u;case L:for(q=U.length,re=G+1;re<q&&U[re]==L;)re++;if(re<q){var ce=X[G],he=ce>=1425&&ce<=2303||ce==64286;if(te=U[re],he&&(te==c||te==s))return c}return G<1||(te=U[G-1])==o?u:Z[G-1];case o:return d=!1,e=!0,p;case f:return i=!0,u;case R:case T:case F:case O:case _:d=!1;case M:return u}}function z(X){var U=X.charCodeAt(0),Z=U>>8;return Z==0?U>191?b:P[U]:Z==5?/[\u0591-\u05f4]/.test(X)?c:b:Z==6?/[\u0610-\u061a\u064b-\u065f\u06d6-\u06e4\u06e7-\u06ed]/.test(X)?L:/[\u0660-\u0669\u066b-\u066c]/.test(X)?g:U==1642?x:/[\u06f0-\u06f9]/.test(X)?l:s:Z==32&&U<=8287?I[U&255]:Z==254&&U>=65136?s:u}function Q(X){return X>="\u064B"&&X<="\u0655"}y.L=b,y.R=c,y.EN=l,y.ON_R=3,y.AN=4,y.R_H=5,y.B=6,y.RLE=7,y.DOT="\xB7",y.doBidiReorder=function(X,U,Z){if(X.length<2)return{};var G=X.split(""),ie=new Array(G.length),te=new Array(G.length),ne=[];p=Z?S:w,D(G,ne,G.length,U);for(var q=0;q<ie.length;ie[q]=q,q++);N(2,ne,ie),N(1,ne,ie);for(var q=0;q<ie.length-1;q++)U[q]===g?ne[q]=y.AN:ne[q]===c&&(U[q]>s&&U[q]<R||U[q]===u||U[q]===M)?ne[q]=y.ON_R:q>0&&G[q-1]==="\u0644"&&/\u0622|\u0623|\u0625|\u0627/.test(G[q])&&(ne[q-1]=ne[q]=y.R_H,q++);G[G.length-1]===y.DOT&&(ne[G.length-1]=y.B),G[0]==="\u202B"&&(ne[0]=y.RLE);for(var q=0;q<ie.length;q++)te[q]=ne[ie[q]];return{logicalFromVisual:ie,bidiLevels:te}},y.hasBidiCharacters=function(X,U){for(var Z=!1,G=0;G<X.length;G++)U[G]=z(X.charAt(G)),!Z&&(U[G]==c||U[G]==s||U[G]==g)&&(Z=!0);return Z},y.getVisualFromLogicalIdx=function(X,U){for(var Z=0;Z<U.logicalFromVisual.length;Z++)if(U.logicalFromVisual[Z]==X)return Z;return 0}}),define("ace/bidihandler",["require","exports","module","ace/lib/bidiutil","ace/lib/lang"],function(k,y,B){"use strict";var E=k("./lib/bidiutil"),A=k("./lib/lang"),p=/[\u0590-\u05f4\u0600-\u06ff\u0700-\u08ac\u202B]/,h=function(d){this.session=d,this.bidiMap={},this.currentRow=null,this.bidiUtil=E,this.charWidths=[],this.EOL="\xAC",this.showInvisibles=!0,this.isRtlDir=!1,this.$isRtl=!1,this.line="",this.wrapIndent=0,this.EOF="\xB6",this.RLE="\u202B",this.contentWidth=0,this.fontMetrics=null,this.rtlLineOffset=0,this.wrapOffset=0,this.isMoveLeftOperation=!1,this.seenBidi=p.test(d.getValue())};(function(){this.isBidiRow=function(d,t,e){return this.seenBidi?(d!==this.currentRow&&(this.currentRow=d,this.updateRowLine(t,e),this.updateBidiMap()),this.bidiMap.bidiLevels):!1},this.onChange=function(d){this.seenBidi?this.currentRow=null:d.action=="insert"&&p.test(d.lines.join(`
+`))&&(this.seenBidi=!0,this.currentRow=null)},this.getDocumentRow=function(){var d=0,t=this.session.$screenRowCache;if(t.length){var e=this.session.$getRowCacheIndex(t,this.currentRow);e>=0&&(d=this.session.$docRowCache[e])}return d},this.getSplitIndex=function(){var d=0,t=this.session.$screenRowCache;if(t.length)for(var e,i=this.session.$getRowCacheIndex(t,this.currentRow);this.currentRow-d>0&&(e=this.session.$getRowCacheIndex(t,this.currentRow-d-1),e===i);)i=e,d++;else d=this.currentRow;return d},this.updateRowLine=function(d,t){d===void 0&&(d=this.getDocumentRow());var e=d===this.session.getLength()-1,i=e?this.EOF:this.EOL;if(this.wrapIndent=0,this.line=this.session.getLine(d),this.isRtlDir=this.$isRtl||this.line.charAt(0)===this.RLE,this.session.$useWrapMode){var r=this.session.$wrapData[d];r&&(t===void 0&&(t=this.getSplitIndex()),t>0&&r.length?(this.wrapIndent=r.indent,this.wrapOffset=this.wrapIndent*this.charWidths[E.L],this.line=t<r.length?this.line.substring(r[t-1],r[t]):this.line.substring(r[r.length-1])):this.line=this.line.substring(0,r[t])),t==r.length&&(this.line+=this.showInvisibles?i:E.DOT)}else this.line+=this.showInvisibles?i:E.DOT;var n=this.session,a=0,m;this.line=this.line.replace(/\t|[\u1100-\u2029, \u202F-\uFFE6]/g,function(w,S){return w==="	"||n.isFullWidth(w.charCodeAt(0))?(m=w==="	"?n.getScreenTabSize(S+a):2,a+=m-1,A.stringRepeat(E.DOT,m)):w}),this.isRtlDir&&(this.fontMetrics.$main.textContent=this.line.charAt(this.line.length-1)==E.DOT?this.line.substr(0,this.line.length-1):this.line,this.rtlLineOffset=this.contentWidth-this.fontMetrics.$main.getBoundingClientRect().width)},this.updateBidiMap=function(){var d=[];E.hasBidiCharacters(this.line,d)||this.isRtlDir?this.bidiMap=E.doBidiReorder(this.line,d,this.isRtlDir):this.bidiMap={}},this.markAsDirty=function(){this.currentRow=null},this.updateCharacterWidths=function(d){if(this.characterWidth!==d.$characterSize.width){this.fontMetrics=d;var t=this.characterWidth=d.$characterSize.width,e=d.$measureCharWidth("\u05D4");this.charWidths[E.L]=this.charWidths[E.EN]=this.charWidths[E.ON_R]=t,this.charWidths[E.R]=this.charWidths[E.AN]=e,this.charWidths[E.R_H]=e*.45,this.charWidths[E.B]=this.charWidths[E.RLE]=0,this.currentRow=null}},this.setShowInvisibles=function(d){this.showInvisibles=d,this.currentRow=null},this.setEolChar=function(d){this.EOL=d},this.setContentWidth=function(d){this.contentWidth=d},this.isRtlLine=function(d){return this.$isRtl?!0:d!=null?this.session.getLine(d).charAt(0)==this.RLE:this.isRtlDir},this.setRtlDirection=function(d,t){for(var e=d.getCursorPosition(),i=d.selection.getSelectionAnchor().row;i<=e.row;i++)!t&&d.session.getLine(i).charAt(0)===d.session.$bidiHandler.RLE?d.session.doc.removeInLine(i,0,1):t&&d.session.getLine(i).charAt(0)!==d.session.$bidiHandler.RLE&&d.session.doc.insert({column:0,row:i},d.session.$bidiHandler.RLE)},this.getPosLeft=function(d){d-=this.wrapIndent;var t=this.line.charAt(0)===this.RLE?1:0,e=d>t?this.session.getOverwrite()?d:d-1:t,i=E.getVisualFromLogicalIdx(e,this.bidiMap),r=this.bidiMap.bidiLevels,n=0;!this.session.getOverwrite()&&d<=t&&r[i]%2!==0&&i++;for(var a=0;a<i;a++)n+=this.charWidths[r[a]];return!this.session.getOverwrite()&&d>t&&r[i]%2===0&&(n+=this.charWidths[r[i]]),this.wrapIndent&&(n+=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset),this.isRtlDir&&(n+=this.rtlLineOffset),n},this.getSelections=function(d,t){var e=this.bidiMap,i=e.bidiLevels,r,n=[],a=0,m=Math.min(d,t)-this.wrapIndent,w=Math.max(d,t)-this.wrapIndent,S=!1,b=!1,c=0;this.wrapIndent&&(a+=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset);for(var l,g=0;g<i.length;g++)l=e.logicalFromVisual[g],r=i[g],S=l>=m&&l<w,S&&!b?c=a:!S&&b&&n.push({left:c,width:a-c}),a+=this.charWidths[r],b=S;if(S&&g===i.length&&n.push({left:c,width:a-c}),this.isRtlDir)for(var u=0;u<n.length;u++)n[u].left+=this.rtlLineOffset;return n},this.offsetToCol=function(e){this.isRtlDir&&(e-=this.rtlLineOffset);var t=0,e=Math.max(e,0),i=0,r=0,n=this.bidiMap.bidiLevels,a=this.charWidths[n[r]];for(this.wrapIndent&&(e-=this.isRtlDir?-1*this.wrapOffset:this.wrapOffset);e>i+a/2;){if(i+=a,r===n.length-1){a=0;break}a=this.charWidths[n[++r]]}return r>0&&n[r-1]%2!==0&&n[r]%2===0?(e<i&&r--,t=this.bidiMap.logicalFromVisual[r]):r>0&&n[r-1]%2===0&&n[r]%2!==0?t=1+(e>i?this.bidiMap.logicalFromVisual[r]:this.bidiMap.logicalFromVisual[r-1]):this.isRtlDir&&r===n.length-1&&a===0&&n[r-1]%2===0||!this.isRtlDir&&r===0&&n[r]%2!==0?t=1+this.bidiMap.logicalFromVisual[r]:(r>0&&n[r-1]%2!==0&&a!==0&&r--,t=this.bidiMap.logicalFromVisual[r]),t===0&&this.isRtlDir&&t++,t+this.wrapIndent}}).call(h.prototype),y.BidiHandler=h}),define("ace/selection",["require","exports","module","ace/lib/oop","ace/lib/lang","ace/lib/event_emitter","ace/range"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/lang"),p=k("./lib/event_emitter").EventEmitter,h=k("./range").Range,d=function(t){this.session=t,this.doc=t.getDocument(),this.clearSelection(),this.cursor=this.lead=this.doc.createAnchor(0,0),this.anchor=this.doc.createAnchor(0,0),this.$silent=!1;var e=this;this.cursor.on("change",function(i){e.$cursorChanged=!0,e.$silent||e._emit("changeCursor"),!e.$isEmpty&&!e.$silent&&e._emit("changeSelection"),!e.$keepDesiredColumnOnChange&&i.old.column!=i.value.column&&(e.$desiredColumn=null)}),this.anchor.on("change",function(){e.$anchorChanged=!0,!e.$isEmpty&&!e.$silent&&e._emit("changeSelection")})};(function(){E.implement(this,p),this.isEmpty=function(){return this.$isEmpty||this.anchor.row==this.lead.row&&this.anchor.column==this.lead.column},this.isMultiLine=function(){return!this.$isEmpty&&this.anchor.row!=this.cursor.row},this.getCursor=function(){return this.lead.getPosition()},this.setSelectionAnchor=function(t,e){this.$isEmpty=!1,this.anchor.setPosition(t,e)},this.getAnchor=this.getSelectionAnchor=function(){return this.$isEmpty?this.getSelectionLead():this.anchor.getPosition()},this.getSelectionLead=function(){return this.lead.getPosition()},this.isBackwards=function(){var t=this.anchor,e=this.lead;return t.row>e.row||t.row==e.row&&t.column>e.column},this.getRange=function(){var t=this.anchor,e=this.lead;return this.$isEmpty?h.fromPoints(e,e):this.isBackwards()?h.fromPoints(e,t):h.fromPoints(t,e)},this.clearSelection=function(){this.$isEmpty||(this.$isEmpty=!0,this._emit("changeSelection"))},this.selectAll=function(){this.$setSelection(0,0,Number.MAX_VALUE,Number.MAX_VALUE)},this.setRange=this.setSelectionRange=function(t,e){var i=e?t.end:t.start,r=e?t.start:t.end;this.$setSelection(i.row,i.column,r.row,r.column)},this.$setSelection=function(t,e,i,r){if(!this.$silent){var n=this.$isEmpty,a=this.inMultiSelectMode;this.$silent=!0,this.$cursorChanged=this.$anchorChanged=!1,this.anchor.setPosition(t,e),this.cursor.setPosition(i,r),this.$isEmpty=!h.comparePoints(this.anchor,this.cursor),this.$silent=!1,this.$cursorChanged&&this._emit("changeCursor"),(this.$cursorChanged||this.$anchorChanged||n!=this.$isEmpty||a)&&this._emit("changeSelection")}},this.$moveSelection=function(t){var e=this.lead;this.$isEmpty&&this.setSelectionAnchor(e.row,e.column),t.call(this)},this.selectTo=function(t,e){this.$moveSelection(function(){this.moveCursorTo(t,e)})},this.selectToPosition=function(t){this.$moveSelection(function(){this.moveCursorToPosition(t)})},this.moveTo=function(t,e){this.clearSelection(),this.moveCursorTo(t,e)},this.moveToPosition=function(t){this.clearSelection(),this.moveCursorToPosition(t)},this.selectUp=function(){this.$moveSelection(this.moveCursorUp)},this.selectDown=function(){this.$moveSelection(this.moveCursorDown)},this.selectRight=function(){this.$moveSelection(this.moveCursorRight)},this.selectLeft=function(){this.$moveSelection(this.moveCursorLeft)},this.selectLineStart=function(){this.$moveSelection(this.moveCursorLineStart)},this.selectLineEnd=function(){this.$moveSelection(this.moveCursorLineEnd)},this.selectFileEnd=function(){this.$moveSelection(this.moveCursorFileEnd)},this.selectFileStart=function(){this.$moveSelection(this.moveCursorFileStart)},this.selectWordRight=function(){this.$moveSelection(this.moveCursorWordRight)},this.selectWordLeft=function(){this.$moveSelection(this.moveCursorWordLeft)},this.getWordRange=function(t,e){if(typeof e>"u"){var i=t||this.lead;t=i.row,e=i.column}return this.session.getWordRange(t,e)},this.selectWord=function(){this.setSelectionRange(this.getWordRange())},this.selectAWord=function(){var t=this.getCursor(),e=this.session.getAWordRange(t.row,t.column);this.setSelectionRange(e)},this.getLineRange=function(t,e){var i=typeof t=="number"?t:this.lead.row,r,n=this.session.getFoldLine(i);return n?(i=n.start.row,r=n.end.row):r=i,e===!0?new h(i,0,r,this.session.getLine(r).length):new h(i,0,r+1,0)},this.selectLine=function(){this.setSelectionRange(this.getLineRange())},this.moveCursorUp=function(){this.moveCursorBy(-1,0)},this.moveCursorDown=function(){this.moveCursorBy(1,0)},this.wouldMoveIntoSoftTab=function(t,e,i){var r=t.column,n=t.column+e;return i<0&&(r=t.column-e,n=t.column),this.session.isTabStop(t)&&this.doc.getLine(t.row).slice(r,n).split(" ").length-1==e},this.moveCursorLeft=function(){var t=this.lead.getPosition(),e;if(e=this.session.getFoldAt(t.row,t.column,-1))this.moveCursorTo(e.start.row,e.start.column);else if(t.column===0)t.row>0&&this.moveCursorTo(t.row-1,this.doc.getLine(t.row-1).length);else{var i=this.session.getTabSize();this.wouldMoveIntoSoftTab(t,i,-1)&&!this.session.getNavigateWithinSoftTabs()?this.moveCursorBy(0,-i):this.moveCursorBy(0,-1)}},this.moveCursorRight=function(){var t=this.lead.getPosition(),e;if(e=this.session.getFoldAt(t.row,t.column,1))this.moveCursorTo(e.end.row,e.end.column);else if(this.lead.column==this.doc.getLine(this.lead.row).length)this.lead.row<this.doc.getLength()-1&&this.moveCursorTo(this.lead.row+1,0);else{var i=this.session.getTabSize(),t=this.lead;this.wouldMoveIntoSoftTab(t,i,1)&&!this.session.getNavigateWithinSoftTabs()?this.moveCursorBy(0,i):this.moveCursorBy(0,1)}},this.moveCursorLineStart=function(){var t=this.lead.row,e=this.lead.column,i=this.session.documentToScreenRow(t,e),r=this.session.screenToDocumentPosition(i,0),n=this.session.getDisplayLine(t,null,r.row,r.column),a=n.match(/^\s*/);a[0].length!=e&&!this.session.$useEmacsStyleLineStart&&(r.column+=a[0].length),this.moveCursorToPosition(r)},this.moveCursorLineEnd=function(){var t=this.lead,e=this.session.getDocumentLastRowColumnPosition(t.row,t.column);if(this.lead.column==e.column){var i=this.session.getLine(e.row);if(e.column==i.length){var r=i.search(/\s+$/);r>0&&(e.column=r)}}this.moveCursorTo(e.row,e.column)},this.moveCursorFileEnd=function(){var t=this.doc.getLength()-1,e=this.doc.getLine(t).length;this.moveCursorTo(t,e)},this.moveCursorFileStart=function(){this.moveCursorTo(0,0)},this.moveCursorLongWordRight=function(){var t=this.lead.row,e=this.lead.column,i=this.doc.getLine(t),r=i.substring(e);this.session.nonTokenRe.lastIndex=0,this.session.tokenRe.lastIndex=0;var n=this.session.getFoldAt(t,e,1);if(n){this.moveCursorTo(n.end.row,n.end.column);return}if(this.session.nonTokenRe.exec(r)&&(e+=this.session.nonTokenRe.lastIndex,this.session.nonTokenRe.lastIndex=0,r=i.substring(e)),e>=i.length){this.moveCursorTo(t,i.length),this.moveCursorRight(),t<this.doc.getLength()-1&&this.moveCursorWordRight();return}this.session.tokenRe.exec(r)&&(e+=this.session.tokenRe.lastIndex,this.session.tokenRe.lastIndex=0),this.moveCursorTo(t,e)},this.moveCursorLongWordLeft=function(){var t=this.lead.row,e=this.lead.column,i;if(i=this.session.getFoldAt(t,e,-1)){this.moveCursorTo(i.start.row,i.start.column);return}var r=this.session.getFoldStringAt(t,e,-1);r==null&&(r=this.doc.getLine(t).substring(0,e));var n=A.stringReverse(r);if(this.session.nonTokenRe.lastIndex=0,this.session.tokenRe.lastIndex=0,this.session.nonTokenRe.exec(n)&&(e-=this.session.nonTokenRe.lastIndex,n=n.slice(this.session.nonTokenRe.lastIndex),this.session.nonTokenRe.lastIndex=0),e<=0){this.moveCursorTo(t,0),this.moveCursorLeft(),t>0&&this.moveCursorWordLeft();return}this.session.tokenRe.exec(n)&&(e-=this.session.tokenRe.lastIndex,this.session.tokenRe.lastIndex=0),this.moveCursorTo(t,e)},this.$shortWordEndIndex=function(t){var e=0,i,r=/\s/,n=this.session.tokenRe;if(n.lastIndex=0,this.session.tokenRe.exec(t))e=this.session.tokenRe.lastIndex;else{for(;(i=t[e])&&r.test(i);)e++;if(e<1){for(n.lastIndex=0;(i=t[e])&&!n.test(i);)if(n.lastIndex=0,e++,r.test(i))if(e>2){e--;break}else{for(;(i=t[e])&&r.test(i);)e++;if(e>2)break}}}return n.lastIndex=0,e},this.moveCursorShortWordRight=function(){var t=this.lead.row,e=this.lead.column,i=this.doc.getLine(t),r=i.substring(e),n=this.session.getFoldAt(t,e,1);if(n)return this.moveCursorTo(n.end.row,n.end.column);if(e==i.length){var a=this.doc.getLength();do t++,r=this.doc.getLine(t);while(t<a&&/^\s*$/.test(r));/^\s+/.test(r)||(r=""),e=0}var m=this.$shortWordEndIndex(r);this.moveCursorTo(t,e+m)},this.moveCursorShortWordLeft=function(){var t=this.lead.row,e=this.lead.column,i;if(i=this.session.getFoldAt(t,e,-1))return this.moveCursorTo(i.start.row,i.start.column);var r=this.session.getLine(t).substring(0,e);if(e===0){do t--,r=this.doc.getLine(t);while(t>0&&/^\s*$/.test(r));e=r.length,/\s+$/.test(r)||(r="")}var n=A.stringReverse(r),a=this.$shortWordEndIndex(n);return this.moveCursorTo(t,e-a)},this.moveCursorWordRight=function(){this.session.$selectLongWords?this.moveCursorLongWordRight():this.moveCursorShortWordRight()},this.moveCursorWordLeft=function(){this.session.$selectLongWords?this.moveCursorLongWordLeft():this.moveCursorShortWordLeft()},this.moveCursorBy=function(t,e){var i=this.session.documentToScreenPosition(this.lead.row,this.lead.column),r;if(e===0&&(t!==0&&(this.session.$bidiHandler.isBidiRow(i.row,this.lead.row)?(r=this.session.$bidiHandler.getPosLeft(i.column),i.column=Math.round(r/this.session.$bidiHandler.charWidths[0])):r=i.column*this.session.$bidiHandler.charWidths[0]),this.$desiredColumn?i.column=this.$desiredColumn:this.$desiredColumn=i.column),t!=0&&this.session.lineWidgets&&this.session.lineWidgets[this.lead.row]){var n=this.session.lineWidgets[this.lead.row];t<0?t-=n.rowsAbove||0:t>0&&(t+=n.rowCount-(n.rowsAbove||0))}var a=this.session.screenToDocumentPosition(i.row+t,i.column,r);t!==0&&e===0&&a.row===this.lead.row&&(a.column,this.lead.column),this.moveCursorTo(a.row,a.column+e,e===0)},this.moveCursorToPosition=function(t){this.moveCursorTo(t.row,t.column)},this.moveCursorTo=function(t,e,i){var r=this.session.getFoldAt(t,e,1);r&&(t=r.start.row,e=r.start.column),this.$keepDesiredColumnOnChange=!0;var n=this.session.getLine(t);/[\uDC00-\uDFFF]/.test(n.charAt(e))&&n.charAt(e-1)&&(this.lead.row==t&&this.lead.column==e+1?e=e-1:e=e+1),this.lead.setPosition(t,e),this.$keepDesiredColumnOnChange=!1,i||(this.$desiredColumn=null)},this.moveCursorToScreen=function(t,e,i){var r=this.session.screenToDocumentPosition(t,e);this.moveCursorTo(r.row,r.column,i)},this.detach=function(){this.lead.detach(),this.anchor.detach(),this.session=this.doc=null},this.fromOrientedRange=function(t){this.setSelectionRange(t,t.cursor==t.start),this.$desiredColumn=t.desiredColumn||this.$desiredColumn},this.toOrientedRange=function(t){var e=this.getRange();return t?(t.start.column=e.start.column,t.start.row=e.start.row,t.end.column=e.end.column,t.end.row=e.end.row):t=e,t.cursor=this.isBackwards()?t.start:t.end,t.desiredColumn=this.$desiredColumn,t},this.getRangeOfMovements=function(t){var e=this.getCursor();try{t(this);var i=this.getCursor();return h.fromPoints(e,i)}catch{return h.fromPoints(e,e)}finally{this.moveCursorToPosition(e)}},this.toJSON=function(){if(this.rangeCount)var t=this.ranges.map(function(e){var i=e.clone();return i.isBackwards=e.cursor==e.start,i});else{var t=this.getRange();t.isBackwards=this.isBackwards()}return t},this.fromJSON=function(t){if(t.start==null)if(this.rangeList&&t.length>1){this.toSingleRange(t[0]);for(var e=t.length;e--;){var i=h.fromPoints(t[e].start,t[e].end);t[e].isBackwards&&(i.cursor=i.start),this.addRange(i,!0)}return}else t=t[0];this.rangeList&&this.toSingleRange(t),this.setSelectionRange(t,t.isBackwards)},this.isEqual=function(t){if((t.length||this.rangeCount)&&t.length!=this.rangeCount)return!1;if(!t.length||!this.ranges)return this.getRange().isEqual(t);for(var e=this.ranges.length;e--;)if(!this.ranges[e].isEqual(t[e]))return!1;return!0}}).call(d.prototype),y.Selection=d}),define("ace/tokenizer",["require","exports","module","ace/config"],function(k,y,B){"use strict";var E=k("./config"),A=2e3,p=function(h){this.states=h,this.regExps={},this.matchMappings={};for(var d in this.states){for(var t=this.states[d],e=[],i=0,r=this.matchMappings[d]={defaultToken:"text"},n="g",a=[],m=0;m<t.length;m++){var w=t[m];if(w.defaultToken&&(r.defaultToken=w.defaultToken),w.caseInsensitive&&(n="gi"),w.regex!=null){w.regex instanceof RegExp&&(w.regex=w.regex.toString().slice(1,-1));var S=w.regex,b=new RegExp("(?:("+S+")|(.))").exec("a").length-2;Array.isArray(w.token)?w.token.length==1||b==1?w.token=w.token[0]:b-1!=w.token.length?(this.reportError("number of classes and regexp groups doesn't match",{rule:w,groupCount:b-1}),w.token=w.token[0]):(w.tokenArray=w.token,w.token=null,w.onMatch=this.$arrayTokens):typeof w.token=="function"&&!w.onMatch&&(b>1?w.onMatch=this.$applyToken:w.onMatch=w.token),b>1&&(/\\\d/.test(w.regex)?S=w.regex.replace(/\\([0-9]+)/g,function(c,l){return"\\"+(parseInt(l,10)+i+1)}):(b=1,S=this.removeCapturingGroups(w.regex)),!w.splitRegex&&typeof w.token!="string"&&a.push(w)),r[i]=m,i+=b,e.push(S),w.onMatch||(w.onMatch=null)}}e.length||(r[0]=0,e.push("$")),a.forEach(function(c){c.splitRegex=this.createSplitterRegexp(c.regex,n)},this),this.regExps[d]=new RegExp("("+e.join(")|(")+")|($)",n)}};(function(){this.$setMaxTokenCount=function(h){A=h|0},this.$applyToken=function(h){var d=this.splitRegex.exec(h).slice(1),t=this.token.apply(this,d);if(typeof t=="string")return[{type:t,value:h}];for(var e=[],i=0,r=t.length;i<r;i++)d[i]&&(e[e.length]={type:t[i],value:d[i]});return e},this.$arrayTokens=function(h){if(!h)return[];var d=this.splitRegex.exec(h);if(!d)return"text";for(var t=[],e=this.tokenArray,i=0,r=e.length;i<r;i++)d[i+1]&&(t[t.length]={type:e[i],value:d[i+1]});return t},this.removeCapturingGroups=function(h){var d=h.replace(/\\.|\[(?:\\.|[^\\\]])*|\(\?[:=!]|(\()/g,function(t,e){return e?"(?:":t});return d},this.createSplitterRegexp=function(h,d){if(h.indexOf("(?=")!=-1){var t=0,e=!1,i={};h.replace(/(\\.)|(\((?:\?[=!])?)|(\))|([\[\]])/g,function(r,n,a,m,w,S){return e?e=w!="]":w?e=!0:m?(t==i.stack&&(i.end=S+1,i.stack=-1),t--):a&&(t++,a.length!=1&&(i.stack=t,i.start=S)),r}),i.end!=null&&/^\)*$/.test(h.substr(i.end))&&(h=h.substring(0,i.start)+h.substr(i.end))}return h.charAt(0)!="^"&&(h="^"+h),h.charAt(h.length-1)!="$"&&(h+="$"),new RegExp(h,(d||"").replace("g",""))},this.getLineTokens=function(h,d){if(d&&typeof d!="string"){var t=d.slice(0);d=t[0],d==="#tmp"&&(t.shift(),d=t.shift())}else var t=[];var e=d||"start",i=this.states[e];i||(e="start",i=this.states[e]);var r=this.matchMappings[e],n=this.regExps[e];n.lastIndex=0;for(var a,m=[],w=0,S=0,b={type:null,value:""};a=n.exec(h);){var c=r.defaultToken,l=null,g=a[0],u=n.lastIndex;if(u-g.length>w){var o=h.substring(w,u-g.length);b.type==c?b.value+=o:(b.type&&m.push(b),b={type:c,value:o})}for(var f=0;f<a.length-2;f++)if(a[f+1]!==void 0){l=i[r[f]],l.onMatch?c=l.onMatch(g,e,t,h):c=l.token,l.next&&(typeof l.next=="string"?e=l.next:e=l.next(e,t),i=this.states[e],i||(this.reportError("state doesn't exist",e),e="start",i=this.states[e]),r=this.matchMappings[e],w=u,n=this.regExps[e],n.lastIndex=u),l.consumeLineEnd&&(w=u);break}if(g){if(typeof c=="string")(!l||l.merge!==!1)&&b.type===c?b.value+=g:(b.type&&m.push(b),b={type:c,value:g});else if(c){b.type&&m.push(b),b={type:null,value:""};for(var f=0;f<c.length;f++)m.push(c[f])}}if(w==h.length)break;if(w=u,S++>A){for(S>2*h.length&&this.reportError("infinite loop with in ace tokenizer",{startState:d,line:h});w<h.length;)b.type&&m.push(b),b={value:h.substring(w,w+=500),type:"overflow"};e="start",t=[];break}}return b.type&&m.push(b),t.length>1&&t[0]!==e&&t.unshift("#tmp",e),{tokens:m,state:t.length?t:e}},this.reportError=E.reportError}).call(p.prototype),y.Tokenizer=p}),define("ace/mode/text_highlight_rules",["require","exports","module","ace/lib/lang"],function(k,y,B){"use strict";var E=k("../lib/lang"),A=function(){this.$rules={start:[{token:"empty_line",regex:"^$"},{defaultToken:"text"}]}};(function(){this.addRules=function(d,t){if(!t){for(var e in d)this.$rules[e]=d[e];return}for(var e in d){for(var i=d[e],r=0;r<i.length;r++){var n=i[r];(n.next||n.onMatch)&&(typeof n.next=="string"&&n.next.indexOf(t)!==0&&(n.next=t+n.next),n.nextState&&n.nextState.indexOf(t)!==0&&(n.nextState=t+n.nextState))}this.$rules[t+e]=i}},this.getRules=function(){return this.$rules},this.embedRules=function(d,t,e,i,r){var n=typeof d=="function"?new d().getRules():d;if(i)for(var a=0;a<i.length;a++)i[a]=t+i[a];else{i=[];for(var m in n)i.push(t+m)}if(this.addRules(n,t),e)for(var w=Array.prototype[r?"push":"unshift"],a=0;a<i.length;a++)w.apply(this.$rules[i[a]],E.deepCopy(e));this.$embeds||(this.$embeds=[]),this.$embeds.push(t)},this.getEmbeds=function(){return this.$embeds};var p=function(d,t){return(d!="start"||t.length)&&t.unshift(this.nextState,d),this.nextState},h=function(d,t){return t.shift(),t.shift()||"start"};this.normalizeRules=function(){var d=0,t=this.$rules;function e(i){var r=t[i];r.processed=!0;for(var n=0;n<r.length;n++){var a=r[n],m=null;Array.isArray(a)&&(m=a,a={}),!a.regex&&a.start&&(a.regex=a.start,a.next||(a.next=[]),a.next.push({defaultToken:a.token},{token:a.token+".end",regex:a.end||a.start,next:"pop"}),a.token=a.token+".start",a.push=!0);var w=a.next||a.push;if(w&&Array.isArray(w)){var S=a.stateName;S||(S=a.token,typeof S!="string"&&(S=S[0]||""),t[S]&&(S+=d++)),t[S]=w,a.next=S,e(S)}else w=="pop"&&(a.next=h);if(a.push&&(a.nextState=a.next||a.push,a.next=p,delete a.push),a.rules)for(var b in a.rules)t[b]?t[b].push&&t[b].push.apply(t[b],a.rules[b]):t[b]=a.rules[b];var c=typeof a=="string"?a:a.include;if(c&&(Array.isArray(c)?m=c.map(function(g){return t[g]}):m=t[c]),m){var l=[n,1].concat(m);a.noEscape&&(l=l.filter(function(g){return!g.next})),r.splice.apply(r,l),n--}a.keywordMap&&(a.token=this.createKeywordMapper(a.keywordMap,a.defaultToken||"text",a.caseInsensitive),delete a.defaultToken)}}Object.keys(t).forEach(e,this)},this.createKeywordMapper=function(d,t,e,i){var r=Object.create(null);return this.$keywordList=[],Object.keys(d).forEach(function(n){for(var a=d[n],m=a.split(i||"|"),w=m.length;w--;){var S=m[w];this.$keywordList.push(S),e&&(S=S.toLowerCase()),r[S]=n}},this),d=null,e?function(n){return r[n.toLowerCase()]||t}:function(n){return r[n]||t}},this.getKeywords=function(){return this.$keywords}}).call(A.prototype),y.TextHighlightRules=A}),define("ace/mode/behaviour",["require","exports","module"],function(k,y,B){"use strict";var E=function(){this.$behaviours={}};(function(){this.add=function(A,p,h){switch(void 0){case this.$behaviours:this.$behaviours={};case this.$behaviours[A]:this.$behaviours[A]={}}this.$behaviours[A][p]=h},this.addBehaviours=function(A){for(var p in A)for(var h in A[p])this.add(p,h,A[p][h])},this.remove=function(A){this.$behaviours&&this.$behaviours[A]&&delete this.$behaviours[A]},this.inherit=function(A,p){if(typeof A=="function")var h=new A().getBehaviours(p);else var h=A.getBehaviours(p);this.addBehaviours(h)},this.getBehaviours=function(A){if(A){for(var p={},h=0;h<A.length;h++)this.$behaviours[A[h]]&&(p[A[h]]=this.$behaviours[A[h]]);return p}else return this.$behaviours}}).call(E.prototype),y.Behaviour=E}),define("ace/token_iterator",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=k("./range").Range,A=function(p,h,d){this.$session=p,this.$row=h,this.$rowTokens=p.getTokens(h);var t=p.getTokenAt(h,d);this.$tokenIndex=t?t.index:-1};(function(){this.stepBackward=function(){for(this.$tokenIndex-=1;this.$tokenIndex<0;){if(this.$row-=1,this.$row<0)return this.$row=0,null;this.$rowTokens=this.$session.getTokens(this.$row),this.$tokenIndex=this.$rowTokens.length-1}return this.$rowTokens[this.$tokenIndex]},this.stepForward=function(){this.$tokenIndex+=1;for(var p;this.$tokenIndex>=this.$rowTokens.length;){if(this.$row+=1,p||(p=this.$session.getLength()),this.$row>=p)return this.$row=p-1,null;this.$rowTokens=this.$session.getTokens(this.$row),this.$tokenIndex=0}return this.$rowTokens[this.$tokenIndex]},this.getCurrentToken=function(){return this.$rowTokens[this.$tokenIndex]},this.getCurrentTokenRow=function(){return this.$row},this.getCurrentTokenColumn=function(){var p=this.$rowTokens,h=this.$tokenIndex,d=p[h].start;if(d!==void 0)return d;for(d=0;h>0;)h-=1,d+=p[h].value.length;return d},this.getCurrentTokenPosition=function(){return{row:this.$row,column:this.getCurrentTokenColumn()}},this.getCurrentTokenRange=function(){var p=this.$rowTokens[this.$tokenIndex],h=this.getCurrentTokenColumn();return new E(this.$row,h,this.$row,h+p.value.length)}}).call(A.prototype),y.TokenIterator=A}),define("ace/mode/behaviour/cstyle",["require","exports","module","ace/lib/oop","ace/mode/behaviour","ace/token_iterator","ace/lib/lang"],function(k,y,B){"use strict";var E=k("../../lib/oop"),A=k("../behaviour").Behaviour,p=k("../../token_iterator").TokenIterator,h=k("../../lib/lang"),d=["text","paren.rparen","rparen","paren","punctuation.operator"],t=["text","paren.rparen","rparen","paren","punctuation.operator","comment"],e,i={},r={'"':'"',"'":"'"},n=function(w){var S=-1;if(w.multiSelect&&(S=w.selection.index,i.rangeCount!=w.multiSelect.rangeCount&&(i={rangeCount:w.multiSelect.rangeCount})),i[S])return e=i[S];e=i[S]={autoInsertedBrackets:0,autoInsertedRow:-1,autoInsertedLineEnd:"",maybeInsertedBrackets:0,maybeInsertedRow:-1,maybeInsertedLineStart:"",maybeInsertedLineEnd:""}},a=function(w,S,b,c){var l=w.end.row-w.start.row;return{text:b+S+c,selection:[0,w.start.column+1,l,w.end.column+(l?0:1)]}},m=function(w){this.add("braces","insertion",function(S,b,c,l,g){var u=c.getCursorPosition(),o=l.doc.getLine(u.row);if(g=="{"){n(c);var f=c.getSelectionRange(),s=l.doc.getTextRange(f);if(s!==""&&s!=="{"&&c.getWrapBehavioursEnabled())return a(f,s,"{","}");if(m.isSaneInsertion(c,l))return/[\]\}\)]/.test(o[u.column])||c.inMultiSelectMode||w&&w.braces?(m.recordAutoInsert(c,l,"}"),{text:"{}",selection:[1,1]}):(m.recordMaybeInsert(c,l,"{"),{text:"{",selection:[1,1]})}else if(g=="}"){n(c);var v=o.substring(u.column,u.column+1);if(v=="}"){var $=l.$findOpeningBracket("}",{column:u.column+1,row:u.row});if($!==null&&m.isAutoInsertedClosing(u,o,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}else if(g==`
 `||g==`\r
-`){n(c);var x="";m.isMaybeInsertedClosing(d,a)&&(x=h.stringRepeat("}",e.maybeInsertedBrackets),m.clearMaybeInsertedClosing());var v=a.substring(d.column,d.column+1);if(v==="}"){var C=l.findMatchingBracket({row:d.row,column:d.column+1},"}");if(!C)return null;var k=this.$getIndent(l.getLine(C.row))}else if(x)var k=this.$getIndent(a);else{m.clearMaybeInsertedClosing();return}var R=k+l.getTabString();return{text:`
+`){n(c);var C="";m.isMaybeInsertedClosing(u,o)&&(C=h.stringRepeat("}",e.maybeInsertedBrackets),m.clearMaybeInsertedClosing());var v=o.substring(u.column,u.column+1);if(v==="}"){var x=l.findMatchingBracket({row:u.row,column:u.column+1},"}");if(!x)return null;var L=this.$getIndent(l.getLine(x.row))}else if(C)var L=this.$getIndent(o);else{m.clearMaybeInsertedClosing();return}var R=L+l.getTabString();return{text:`
 `+R+`
-`+k+x,selection:[1,R.length,1,R.length]}}else m.clearMaybeInsertedClosing()}),this.add("braces","deletion",function(S,y,c,l,g){var d=l.doc.getTextRange(g);if(!g.isMultiLine()&&d=="{"){n(c);var a=l.doc.getLine(g.start.row),f=a.substring(g.end.column,g.end.column+1);if(f=="}")return g.end.column++,g;e.maybeInsertedBrackets--}}),this.add("parens","insertion",function(S,y,c,l,g){if(g=="("){n(c);var d=c.getSelectionRange(),a=l.doc.getTextRange(d);if(a!==""&&c.getWrapBehavioursEnabled())return o(d,a,"(",")");if(m.isSaneInsertion(c,l))return m.recordAutoInsert(c,l,")"),{text:"()",selection:[1,1]}}else if(g==")"){n(c);var f=c.getCursorPosition(),s=l.doc.getLine(f.row),v=s.substring(f.column,f.column+1);if(v==")"){var $=l.$findOpeningBracket(")",{column:f.column+1,row:f.row});if($!==null&&m.isAutoInsertedClosing(f,s,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}}),this.add("parens","deletion",function(S,y,c,l,g){var d=l.doc.getTextRange(g);if(!g.isMultiLine()&&d=="("){n(c);var a=l.doc.getLine(g.start.row),f=a.substring(g.start.column+1,g.start.column+2);if(f==")")return g.end.column++,g}}),this.add("brackets","insertion",function(S,y,c,l,g){if(g=="["){n(c);var d=c.getSelectionRange(),a=l.doc.getTextRange(d);if(a!==""&&c.getWrapBehavioursEnabled())return o(d,a,"[","]");if(m.isSaneInsertion(c,l))return m.recordAutoInsert(c,l,"]"),{text:"[]",selection:[1,1]}}else if(g=="]"){n(c);var f=c.getCursorPosition(),s=l.doc.getLine(f.row),v=s.substring(f.column,f.column+1);if(v=="]"){var $=l.$findOpeningBracket("]",{column:f.column+1,row:f.row});if($!==null&&m.isAutoInsertedClosing(f,s,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}}),this.add("brackets","deletion",function(S,y,c,l,g){var d=l.doc.getTextRange(g);if(!g.isMultiLine()&&d=="["){n(c);var a=l.doc.getLine(g.start.row),f=a.substring(g.start.column+1,g.start.column+2);if(f=="]")return g.end.column++,g}}),this.add("string_dquotes","insertion",function(S,y,c,l,g){var d=l.$mode.$quotes||r;if(g.length==1&&d[g]){if(this.lineCommentStart&&this.lineCommentStart.indexOf(g)!=-1)return;n(c);var a=g,f=c.getSelectionRange(),s=l.doc.getTextRange(f);if(s!==""&&(s.length!=1||!d[s])&&c.getWrapBehavioursEnabled())return o(f,s,a,a);if(!s){var v=c.getCursorPosition(),$=l.doc.getLine(v.row),x=$.substring(v.column-1,v.column),C=$.substring(v.column,v.column+1),k=l.getTokenAt(v.row,v.column),R=l.getTokenAt(v.row,v.column+1);if(x=="\\"&&k&&/escape/.test(k.type))return null;var _=k&&/string|escape/.test(k.type),O=!R||/string|escape/.test(R.type),I;if(C==a)I=_!==O,I&&/string\.end/.test(R.type)&&(I=!1);else{if(_&&!O||_&&O)return null;var D=l.$mode.tokenRe;D.lastIndex=0;var T=D.test(x);D.lastIndex=0;var P=D.test(x);if(T||P||C&&!/[\s;,.})\]\\]/.test(C))return null;var M=$[v.column-2];if(x==a&&(M==a||D.test(M)))return null;I=!0}return{text:I?a+a:"",selection:[1,1]}}}}),this.add("string_dquotes","deletion",function(S,y,c,l,g){var d=l.$mode.$quotes||r,a=l.doc.getTextRange(g);if(!g.isMultiLine()&&d.hasOwnProperty(a)){n(c);var f=l.doc.getLine(g.start.row),s=f.substring(g.start.column+1,g.start.column+2);if(s==a)return g.end.column++,g}})};m.isSaneInsertion=function(w,S){var y=w.getCursorPosition(),c=new p(S,y.row,y.column);if(!this.$matchTokenType(c.getCurrentToken()||"text",u)){if(/[)}\]]/.test(w.session.getLine(y.row)[y.column]))return!0;var l=new p(S,y.row,y.column+1);if(!this.$matchTokenType(l.getCurrentToken()||"text",u))return!1}return c.stepForward(),c.getCurrentTokenRow()!==y.row||this.$matchTokenType(c.getCurrentToken()||"text",t)},m.$matchTokenType=function(w,S){return S.indexOf(w.type||w)>-1},m.recordAutoInsert=function(w,S,y){var c=w.getCursorPosition(),l=S.doc.getLine(c.row);this.isAutoInsertedClosing(c,l,e.autoInsertedLineEnd[0])||(e.autoInsertedBrackets=0),e.autoInsertedRow=c.row,e.autoInsertedLineEnd=y+l.substr(c.column),e.autoInsertedBrackets++},m.recordMaybeInsert=function(w,S,y){var c=w.getCursorPosition(),l=S.doc.getLine(c.row);this.isMaybeInsertedClosing(c,l)||(e.maybeInsertedBrackets=0),e.maybeInsertedRow=c.row,e.maybeInsertedLineStart=l.substr(0,c.column)+y,e.maybeInsertedLineEnd=l.substr(c.column),e.maybeInsertedBrackets++},m.isAutoInsertedClosing=function(w,S,y){return e.autoInsertedBrackets>0&&w.row===e.autoInsertedRow&&y===e.autoInsertedLineEnd[0]&&S.substr(w.column)===e.autoInsertedLineEnd},m.isMaybeInsertedClosing=function(w,S){return e.maybeInsertedBrackets>0&&w.row===e.maybeInsertedRow&&S.substr(w.column)===e.maybeInsertedLineEnd&&S.substr(0,w.column)==e.maybeInsertedLineStart},m.popAutoInsertedClosing=function(){e.autoInsertedLineEnd=e.autoInsertedLineEnd.substr(1),e.autoInsertedBrackets--},m.clearMaybeInsertedClosing=function(){e&&(e.maybeInsertedBrackets=0,e.maybeInsertedRow=-1)},E.inherits(m,A),b.CstyleBehaviour=m}),define("ace/unicode",["require","exports","module"],function(L,b,B){"use strict";for(var E=[48,9,8,25,5,0,2,25,48,0,11,0,5,0,6,22,2,30,2,457,5,11,15,4,8,0,2,0,18,116,2,1,3,3,9,0,2,2,2,0,2,19,2,82,2,138,2,4,3,155,12,37,3,0,8,38,10,44,2,0,2,1,2,1,2,0,9,26,6,2,30,10,7,61,2,9,5,101,2,7,3,9,2,18,3,0,17,58,3,100,15,53,5,0,6,45,211,57,3,18,2,5,3,11,3,9,2,1,7,6,2,2,2,7,3,1,3,21,2,6,2,0,4,3,3,8,3,1,3,3,9,0,5,1,2,4,3,11,16,2,2,5,5,1,3,21,2,6,2,1,2,1,2,1,3,0,2,4,5,1,3,2,4,0,8,3,2,0,8,15,12,2,2,8,2,2,2,21,2,6,2,1,2,4,3,9,2,2,2,2,3,0,16,3,3,9,18,2,2,7,3,1,3,21,2,6,2,1,2,4,3,8,3,1,3,2,9,1,5,1,2,4,3,9,2,0,17,1,2,5,4,2,2,3,4,1,2,0,2,1,4,1,4,2,4,11,5,4,4,2,2,3,3,0,7,0,15,9,18,2,2,7,2,2,2,22,2,9,2,4,4,7,2,2,2,3,8,1,2,1,7,3,3,9,19,1,2,7,2,2,2,22,2,9,2,4,3,8,2,2,2,3,8,1,8,0,2,3,3,9,19,1,2,7,2,2,2,22,2,15,4,7,2,2,2,3,10,0,9,3,3,9,11,5,3,1,2,17,4,23,2,8,2,0,3,6,4,0,5,5,2,0,2,7,19,1,14,57,6,14,2,9,40,1,2,0,3,1,2,0,3,0,7,3,2,6,2,2,2,0,2,0,3,1,2,12,2,2,3,4,2,0,2,5,3,9,3,1,35,0,24,1,7,9,12,0,2,0,2,0,5,9,2,35,5,19,2,5,5,7,2,35,10,0,58,73,7,77,3,37,11,42,2,0,4,328,2,3,3,6,2,0,2,3,3,40,2,3,3,32,2,3,3,6,2,0,2,3,3,14,2,56,2,3,3,66,5,0,33,15,17,84,13,619,3,16,2,25,6,74,22,12,2,6,12,20,12,19,13,12,2,2,2,1,13,51,3,29,4,0,5,1,3,9,34,2,3,9,7,87,9,42,6,69,11,28,4,11,5,11,11,39,3,4,12,43,5,25,7,10,38,27,5,62,2,28,3,10,7,9,14,0,89,75,5,9,18,8,13,42,4,11,71,55,9,9,4,48,83,2,2,30,14,230,23,280,3,5,3,37,3,5,3,7,2,0,2,0,2,0,2,30,3,52,2,6,2,0,4,2,2,6,4,3,3,5,5,12,6,2,2,6,67,1,20,0,29,0,14,0,17,4,60,12,5,0,4,11,18,0,5,0,3,9,2,0,4,4,7,0,2,0,2,0,2,3,2,10,3,3,6,4,5,0,53,1,2684,46,2,46,2,132,7,6,15,37,11,53,10,0,17,22,10,6,2,6,2,6,2,6,2,6,2,6,2,6,2,6,2,31,48,0,470,1,36,5,2,4,6,1,5,85,3,1,3,2,2,89,2,3,6,40,4,93,18,23,57,15,513,6581,75,20939,53,1164,68,45,3,268,4,27,21,31,3,13,13,1,2,24,9,69,11,1,38,8,3,102,3,1,111,44,25,51,13,68,12,9,7,23,4,0,5,45,3,35,13,28,4,64,15,10,39,54,10,13,3,9,7,22,4,1,5,66,25,2,227,42,2,1,3,9,7,11171,13,22,5,48,8453,301,3,61,3,105,39,6,13,4,6,11,2,12,2,4,2,0,2,1,2,1,2,107,34,362,19,63,3,53,41,11,5,15,17,6,13,1,25,2,33,4,2,134,20,9,8,25,5,0,2,25,12,88,4,5,3,5,3,5,3,2],A=0,p=[],h=0;h<E.length;h+=2)p.push(A+=E[h]),E[h+1]&&p.push(45,A+=E[h+1]);b.wordChars=String.fromCharCode.apply(null,p)}),define("ace/mode/text",["require","exports","module","ace/config","ace/tokenizer","ace/mode/text_highlight_rules","ace/mode/behaviour/cstyle","ace/unicode","ace/lib/lang","ace/token_iterator","ace/range"],function(L,b,B){"use strict";var E=L("../config"),A=L("../tokenizer").Tokenizer,p=L("./text_highlight_rules").TextHighlightRules,h=L("./behaviour/cstyle").CstyleBehaviour,u=L("../unicode"),t=L("../lib/lang"),e=L("../token_iterator").TokenIterator,i=L("../range").Range,r=function(){this.HighlightRules=p};(function(){this.$defaultBehaviour=new h,this.tokenRe=new RegExp("^["+u.wordChars+"\\$_]+","g"),this.nonTokenRe=new RegExp("^(?:[^"+u.wordChars+"\\$_]|\\s])+","g"),this.getTokenizer=function(){return this.$tokenizer||(this.$highlightRules=this.$highlightRules||new this.HighlightRules(this.$highlightRuleConfig),this.$tokenizer=new A(this.$highlightRules.getRules())),this.$tokenizer},this.lineCommentStart="",this.blockComment="",this.toggleCommentLines=function(n,o,m,w){var S=o.doc,y=!0,c=!0,l=1/0,g=o.getTabSize(),d=!1;if(this.lineCommentStart){if(Array.isArray(this.lineCommentStart))var s=this.lineCommentStart.map(t.escapeRegExp).join("|"),a=this.lineCommentStart[0];else var s=t.escapeRegExp(this.lineCommentStart),a=this.lineCommentStart;s=new RegExp("^(\\s*)(?:"+s+") ?"),d=o.getUseSoftTabs();var x=function(P,M){var W=P.match(s);if(W){var N=W[1].length,G=W[0].length;!R(P,N,G)&&W[0][G-1]==" "&&G--,S.removeInLine(M,N,G)}},k=a+" ",$=function(P,M){(!y||/\S/.test(P))&&(R(P,l,l)?S.insertInLine({row:M,column:l},k):S.insertInLine({row:M,column:l},a))},C=function(P,M){return s.test(P)},R=function(P,M,W){for(var N=0;M--&&P.charAt(M)==" ";)N++;if(N%g!=0)return!1;for(var N=0;P.charAt(W++)==" ";)N++;return g>2?N%g!=g-1:N%g==0}}else{if(!this.blockComment)return!1;var a=this.blockComment.start,f=this.blockComment.end,s=new RegExp("^(\\s*)(?:"+t.escapeRegExp(a)+")"),v=new RegExp("(?:"+t.escapeRegExp(f)+")\\s*$"),$=function(I,D){C(I,D)||(!y||/\S/.test(I))&&(S.insertInLine({row:D,column:I.length},f),S.insertInLine({row:D,column:l},a))},x=function(I,D){var T;(T=I.match(v))&&S.removeInLine(D,I.length-T[0].length,I.length),(T=I.match(s))&&S.removeInLine(D,T[1].length,T[0].length)},C=function(I,D){if(s.test(I))return!0;for(var T=o.getTokens(D),P=0;P<T.length;P++)if(T[P].type==="comment")return!0}}function _(I){for(var D=m;D<=w;D++)I(S.getLine(D),D)}var O=1/0;_(function(I,D){var T=I.search(/\S/);T!==-1?(T<l&&(l=T),c&&!C(I,D)&&(c=!1)):O>I.length&&(O=I.length)}),l==1/0&&(l=O,y=!1,c=!1),d&&l%g!=0&&(l=Math.floor(l/g)*g),_(c?x:$)},this.toggleBlockComment=function(n,o,m,w){var S=this.blockComment;if(S){!S.start&&S[0]&&(S=S[0]);var y=new e(o,w.row,w.column),c=y.getCurrentToken(),l=o.selection,g=o.selection.toOrientedRange(),d,a;if(c&&/comment/.test(c.type)){for(var f,s;c&&/comment/.test(c.type);){var v=c.value.indexOf(S.start);if(v!=-1){var $=y.getCurrentTokenRow(),x=y.getCurrentTokenColumn()+v;f=new i($,x,$,x+S.start.length);break}c=y.stepBackward()}for(var y=new e(o,w.row,w.column),c=y.getCurrentToken();c&&/comment/.test(c.type);){var v=c.value.indexOf(S.end);if(v!=-1){var $=y.getCurrentTokenRow(),x=y.getCurrentTokenColumn()+v;s=new i($,x,$,x+S.end.length);break}c=y.stepForward()}s&&o.remove(s),f&&(o.remove(f),d=f.start.row,a=-S.start.length)}else a=S.start.length,d=m.start.row,o.insert(m.end,S.end),o.insert(m.start,S.start);g.start.row==d&&(g.start.column+=a),g.end.row==d&&(g.end.column+=a),o.selection.fromOrientedRange(g)}},this.getNextLineIndent=function(n,o,m){return this.$getIndent(o)},this.checkOutdent=function(n,o,m){return!1},this.autoOutdent=function(n,o,m){},this.$getIndent=function(n){return n.match(/^\s*/)[0]},this.createWorker=function(n){return null},this.createModeDelegates=function(n){this.$embeds=[],this.$modes={};for(var o in n)if(n[o]){var m=n[o],w=m.prototype.$id,S=E.$modes[w];S||(E.$modes[w]=S=new m),E.$modes[o]||(E.$modes[o]=S),this.$embeds.push(o),this.$modes[o]=S}for(var y=["toggleBlockComment","toggleCommentLines","getNextLineIndent","checkOutdent","autoOutdent","transformAction","getCompletions"],o=0;o<y.length;o++)(function(l){var g=y[o],d=l[g];l[y[o]]=function(){return this.$delegator(g,arguments,d)}})(this)},this.$delegator=function(n,o,m){var w=o[0]||"start";if(typeof w!="string"){if(Array.isArray(w[2])){var S=w[2][w[2].length-1],y=this.$modes[S];if(y)return y[n].apply(y,[w[1]].concat([].slice.call(o,1)))}w=w[0]||"start"}for(var c=0;c<this.$embeds.length;c++)if(this.$modes[this.$embeds[c]]){var l=w.split(this.$embeds[c]);if(!l[0]&&l[1]){o[0]=l[1];var y=this.$modes[this.$embeds[c]];return y[n].apply(y,o)}}var g=m.apply(this,o);return m?g:void 0},this.transformAction=function(n,o,m,w,S){if(this.$behaviour){var y=this.$behaviour.getBehaviours();for(var c in y)if(y[c][o]){var l=y[c][o].apply(this,arguments);if(l)return l}}},this.getKeywords=function(n){if(!this.completionKeywords){var o=this.$tokenizer.rules,m=[];for(var w in o)for(var S=o[w],y=0,c=S.length;y<c;y++)if(typeof S[y].token=="string")/keyword|support|storage/.test(S[y].token)&&m.push(S[y].regex);else if(typeof S[y].token=="object"){for(var l=0,g=S[y].token.length;l<g;l++)if(/keyword|support|storage/.test(S[y].token[l])){var w=S[y].regex.match(/\(.+?\)/g)[l];m.push(w.substr(1,w.length-2))}}this.completionKeywords=m}return n?m.concat(this.$keywordList||[]):this.$keywordList},this.$createKeywordList=function(){return this.$highlightRules||this.getTokenizer(),this.$keywordList=this.$highlightRules.$keywordList||[]},this.getCompletions=function(n,o,m,w){var S=this.$keywordList||this.$createKeywordList();return S.map(function(y){return{name:y,value:y,score:0,meta:"keyword"}})},this.$id="ace/mode/text"}).call(r.prototype),b.Mode=r}),define("ace/apply_delta",["require","exports","module"],function(L,b,B){"use strict";function E(h,u){throw console.log("Invalid Delta:",h),"Invalid Delta: "+u}function A(h,u){return u.row>=0&&u.row<h.length&&u.column>=0&&u.column<=h[u.row].length}function p(h,u){u.action!="insert"&&u.action!="remove"&&E(u,"delta.action must be 'insert' or 'remove'"),u.lines instanceof Array||E(u,"delta.lines must be an Array"),(!u.start||!u.end)&&E(u,"delta.start/end must be an present");var t=u.start;A(h,u.start)||E(u,"delta.start must be contained in document");var e=u.end;u.action=="remove"&&!A(h,e)&&E(u,"delta.end must contained in document for 'remove' actions");var i=e.row-t.row,r=e.column-(i==0?t.column:0);(i!=u.lines.length-1||u.lines[i].length!=r)&&E(u,"delta.range must match delta lines")}b.applyDelta=function(h,u,t){var e=u.start.row,i=u.start.column,r=h[e]||"";switch(u.action){case"insert":var n=u.lines;if(n.length===1)h[e]=r.substring(0,i)+u.lines[0]+r.substring(i);else{var o=[e,1].concat(u.lines);h.splice.apply(h,o),h[e]=r.substring(0,i)+h[e],h[e+u.lines.length-1]+=r.substring(i)}break;case"remove":var m=u.end.column,w=u.end.row;e===w?h[e]=r.substring(0,i)+r.substring(m):h.splice(e,w-e+1,r.substring(0,i)+h[w].substring(m));break}}}),define("ace/anchor",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/event_emitter").EventEmitter,p=b.Anchor=function(h,u,t){this.$onChange=this.onChange.bind(this),this.attach(h),typeof t>"u"?this.setPosition(u.row,u.column):this.setPosition(u,t)};(function(){E.implement(this,A),this.getPosition=function(){return this.$clipPositionToDocument(this.row,this.column)},this.getDocument=function(){return this.document},this.$insertRight=!1,this.onChange=function(t){if(!(t.start.row==t.end.row&&t.start.row!=this.row)&&!(t.start.row>this.row)){var e=u(t,{row:this.row,column:this.column},this.$insertRight);this.setPosition(e.row,e.column,!0)}};function h(t,e,i){var r=i?t.column<=e.column:t.column<e.column;return t.row<e.row||t.row==e.row&&r}function u(t,e,i){var r=t.action=="insert",n=(r?1:-1)*(t.end.row-t.start.row),o=(r?1:-1)*(t.end.column-t.start.column),m=t.start,w=r?m:t.end;return h(e,m,i)?{row:e.row,column:e.column}:h(w,e,!i)?{row:e.row+n,column:e.column+(e.row==w.row?o:0)}:{row:m.row,column:m.column}}this.setPosition=function(t,e,i){var r;if(i?r={row:t,column:e}:r=this.$clipPositionToDocument(t,e),!(this.row==r.row&&this.column==r.column)){var n={row:this.row,column:this.column};this.row=r.row,this.column=r.column,this._signal("change",{old:n,value:r})}},this.detach=function(){this.document.off("change",this.$onChange)},this.attach=function(t){this.document=t||this.document,this.document.on("change",this.$onChange)},this.$clipPositionToDocument=function(t,e){var i={};return t>=this.document.getLength()?(i.row=Math.max(0,this.document.getLength()-1),i.column=this.document.getLine(i.row).length):t<0?(i.row=0,i.column=0):(i.row=t,i.column=Math.min(this.document.getLine(i.row).length,Math.max(0,e))),e<0&&(i.column=0),i}}).call(p.prototype)}),define("ace/document",["require","exports","module","ace/lib/oop","ace/apply_delta","ace/lib/event_emitter","ace/range","ace/anchor"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./apply_delta").applyDelta,p=L("./lib/event_emitter").EventEmitter,h=L("./range").Range,u=L("./anchor").Anchor,t=function(e){this.$lines=[""],e.length===0?this.$lines=[""]:Array.isArray(e)?this.insertMergedLines({row:0,column:0},e):this.insert({row:0,column:0},e)};(function(){E.implement(this,p),this.setValue=function(e){var i=this.getLength()-1;this.remove(new h(0,0,i,this.getLine(i).length)),this.insert({row:0,column:0},e)},this.getValue=function(){return this.getAllLines().join(this.getNewLineCharacter())},this.createAnchor=function(e,i){return new u(this,e,i)},"aaa".split(/a/).length===0?this.$split=function(e){return e.replace(/\r\n|\r/g,`
+`+L+C,selection:[1,R.length,1,R.length]}}else m.clearMaybeInsertedClosing()}),this.add("braces","deletion",function(S,b,c,l,g){var u=l.doc.getTextRange(g);if(!g.isMultiLine()&&u=="{"){n(c);var o=l.doc.getLine(g.start.row),f=o.substring(g.end.column,g.end.column+1);if(f=="}")return g.end.column++,g;e.maybeInsertedBrackets--}}),this.add("parens","insertion",function(S,b,c,l,g){if(g=="("){n(c);var u=c.getSelectionRange(),o=l.doc.getTextRange(u);if(o!==""&&c.getWrapBehavioursEnabled())return a(u,o,"(",")");if(m.isSaneInsertion(c,l))return m.recordAutoInsert(c,l,")"),{text:"()",selection:[1,1]}}else if(g==")"){n(c);var f=c.getCursorPosition(),s=l.doc.getLine(f.row),v=s.substring(f.column,f.column+1);if(v==")"){var $=l.$findOpeningBracket(")",{column:f.column+1,row:f.row});if($!==null&&m.isAutoInsertedClosing(f,s,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}}),this.add("parens","deletion",function(S,b,c,l,g){var u=l.doc.getTextRange(g);if(!g.isMultiLine()&&u=="("){n(c);var o=l.doc.getLine(g.start.row),f=o.substring(g.start.column+1,g.start.column+2);if(f==")")return g.end.column++,g}}),this.add("brackets","insertion",function(S,b,c,l,g){if(g=="["){n(c);var u=c.getSelectionRange(),o=l.doc.getTextRange(u);if(o!==""&&c.getWrapBehavioursEnabled())return a(u,o,"[","]");if(m.isSaneInsertion(c,l))return m.recordAutoInsert(c,l,"]"),{text:"[]",selection:[1,1]}}else if(g=="]"){n(c);var f=c.getCursorPosition(),s=l.doc.getLine(f.row),v=s.substring(f.column,f.column+1);if(v=="]"){var $=l.$findOpeningBracket("]",{column:f.column+1,row:f.row});if($!==null&&m.isAutoInsertedClosing(f,s,g))return m.popAutoInsertedClosing(),{text:"",selection:[1,1]}}}}),this.add("brackets","deletion",function(S,b,c,l,g){var u=l.doc.getTextRange(g);if(!g.isMultiLine()&&u=="["){n(c);var o=l.doc.getLine(g.start.row),f=o.substring(g.start.column+1,g.start.column+2);if(f=="]")return g.end.column++,g}}),this.add("string_dquotes","insertion",function(S,b,c,l,g){var u=l.$mode.$quotes||r;if(g.length==1&&u[g]){if(this.lineCommentStart&&this.lineCommentStart.indexOf(g)!=-1)return;n(c);var o=g,f=c.getSelectionRange(),s=l.doc.getTextRange(f);if(s!==""&&(s.length!=1||!u[s])&&c.getWrapBehavioursEnabled())return a(f,s,o,o);if(!s){var v=c.getCursorPosition(),$=l.doc.getLine(v.row),C=$.substring(v.column-1,v.column),x=$.substring(v.column,v.column+1),L=l.getTokenAt(v.row,v.column),R=l.getTokenAt(v.row,v.column+1);if(C=="\\"&&L&&/escape/.test(L.type))return null;var T=L&&/string|escape/.test(L.type),_=!R||/string|escape/.test(R.type),F;if(x==o)F=T!==_,F&&/string\.end/.test(R.type)&&(F=!1);else{if(T&&!_||T&&_)return null;var O=l.$mode.tokenRe;O.lastIndex=0;var M=O.test(C);O.lastIndex=0;var P=O.test(C);if(M||P||x&&!/[\s;,.})\]\\]/.test(x))return null;var I=$[v.column-2];if(C==o&&(I==o||O.test(I)))return null;F=!0}return{text:F?o+o:"",selection:[1,1]}}}}),this.add("string_dquotes","deletion",function(S,b,c,l,g){var u=l.$mode.$quotes||r,o=l.doc.getTextRange(g);if(!g.isMultiLine()&&u.hasOwnProperty(o)){n(c);var f=l.doc.getLine(g.start.row),s=f.substring(g.start.column+1,g.start.column+2);if(s==o)return g.end.column++,g}})};m.isSaneInsertion=function(w,S){var b=w.getCursorPosition(),c=new p(S,b.row,b.column);if(!this.$matchTokenType(c.getCurrentToken()||"text",d)){if(/[)}\]]/.test(w.session.getLine(b.row)[b.column]))return!0;var l=new p(S,b.row,b.column+1);if(!this.$matchTokenType(l.getCurrentToken()||"text",d))return!1}return c.stepForward(),c.getCurrentTokenRow()!==b.row||this.$matchTokenType(c.getCurrentToken()||"text",t)},m.$matchTokenType=function(w,S){return S.indexOf(w.type||w)>-1},m.recordAutoInsert=function(w,S,b){var c=w.getCursorPosition(),l=S.doc.getLine(c.row);this.isAutoInsertedClosing(c,l,e.autoInsertedLineEnd[0])||(e.autoInsertedBrackets=0),e.autoInsertedRow=c.row,e.autoInsertedLineEnd=b+l.substr(c.column),e.autoInsertedBrackets++},m.recordMaybeInsert=function(w,S,b){var c=w.getCursorPosition(),l=S.doc.getLine(c.row);this.isMaybeInsertedClosing(c,l)||(e.maybeInsertedBrackets=0),e.maybeInsertedRow=c.row,e.maybeInsertedLineStart=l.substr(0,c.column)+b,e.maybeInsertedLineEnd=l.substr(c.column),e.maybeInsertedBrackets++},m.isAutoInsertedClosing=function(w,S,b){return e.autoInsertedBrackets>0&&w.row===e.autoInsertedRow&&b===e.autoInsertedLineEnd[0]&&S.substr(w.column)===e.autoInsertedLineEnd},m.isMaybeInsertedClosing=function(w,S){return e.maybeInsertedBrackets>0&&w.row===e.maybeInsertedRow&&S.substr(w.column)===e.maybeInsertedLineEnd&&S.substr(0,w.column)==e.maybeInsertedLineStart},m.popAutoInsertedClosing=function(){e.autoInsertedLineEnd=e.autoInsertedLineEnd.substr(1),e.autoInsertedBrackets--},m.clearMaybeInsertedClosing=function(){e&&(e.maybeInsertedBrackets=0,e.maybeInsertedRow=-1)},E.inherits(m,A),y.CstyleBehaviour=m}),define("ace/unicode",["require","exports","module"],function(k,y,B){"use strict";for(var E=[48,9,8,25,5,0,2,25,48,0,11,0,5,0,6,22,2,30,2,457,5,11,15,4,8,0,2,0,18,116,2,1,3,3,9,0,2,2,2,0,2,19,2,82,2,138,2,4,3,155,12,37,3,0,8,38,10,44,2,0,2,1,2,1,2,0,9,26,6,2,30,10,7,61,2,9,5,101,2,7,3,9,2,18,3,0,17,58,3,100,15,53,5,0,6,45,211,57,3,18,2,5,3,11,3,9,2,1,7,6,2,2,2,7,3,1,3,21,2,6,2,0,4,3,3,8,3,1,3,3,9,0,5,1,2,4,3,11,16,2,2,5,5,1,3,21,2,6,2,1,2,1,2,1,3,0,2,4,5,1,3,2,4,0,8,3,2,0,8,15,12,2,2,8,2,2,2,21,2,6,2,1,2,4,3,9,2,2,2,2,3,0,16,3,3,9,18,2,2,7,3,1,3,21,2,6,2,1,2,4,3,8,3,1,3,2,9,1,5,1,2,4,3,9,2,0,17,1,2,5,4,2,2,3,4,1,2,0,2,1,4,1,4,2,4,11,5,4,4,2,2,3,3,0,7,0,15,9,18,2,2,7,2,2,2,22,2,9,2,4,4,7,2,2,2,3,8,1,2,1,7,3,3,9,19,1,2,7,2,2,2,22,2,9,2,4,3,8,2,2,2,3,8,1,8,0,2,3,3,9,19,1,2,7,2,2,2,22,2,15,4,7,2,2,2,3,10,0,9,3,3,9,11,5,3,1,2,17,4,23,2,8,2,0,3,6,4,0,5,5,2,0,2,7,19,1,14,57,6,14,2,9,40,1,2,0,3,1,2,0,3,0,7,3,2,6,2,2,2,0,2,0,3,1,2,12,2,2,3,4,2,0,2,5,3,9,3,1,35,0,24,1,7,9,12,0,2,0,2,0,5,9,2,35,5,19,2,5,5,7,2,35,10,0,58,73,7,77,3,37,11,42,2,0,4,328,2,3,3,6,2,0,2,3,3,40,2,3,3,32,2,3,3,6,2,0,2,3,3,14,2,56,2,3,3,66,5,0,33,15,17,84,13,619,3,16,2,25,6,74,22,12,2,6,12,20,12,19,13,12,2,2,2,1,13,51,3,29,4,0,5,1,3,9,34,2,3,9,7,87,9,42,6,69,11,28,4,11,5,11,11,39,3,4,12,43,5,25,7,10,38,27,5,62,2,28,3,10,7,9,14,0,89,75,5,9,18,8,13,42,4,11,71,55,9,9,4,48,83,2,2,30,14,230,23,280,3,5,3,37,3,5,3,7,2,0,2,0,2,0,2,30,3,52,2,6,2,0,4,2,2,6,4,3,3,5,5,12,6,2,2,6,67,1,20,0,29,0,14,0,17,4,60,12,5,0,4,11,18,0,5,0,3,9,2,0,4,4,7,0,2,0,2,0,2,3,2,10,3,3,6,4,5,0,53,1,2684,46,2,46,2,132,7,6,15,37,11,53,10,0,17,22,10,6,2,6,2,6,2,6,2,6,2,6,2,6,2,6,2,31,48,0,470,1,36,5,2,4,6,1,5,85,3,1,3,2,2,89,2,3,6,40,4,93,18,23,57,15,513,6581,75,20939,53,1164,68,45,3,268,4,27,21,31,3,13,13,1,2,24,9,69,11,1,38,8,3,102,3,1,111,44,25,51,13,68,12,9,7,23,4,0,5,45,3,35,13,28,4,64,15,10,39,54,10,13,3,9,7,22,4,1,5,66,25,2,227,42,2,1,3,9,7,11171,13,22,5,48,8453,301,3,61,3,105,39,6,13,4,6,11,2,12,2,4,2,0,2,1,2,1,2,107,34,362,19,63,3,53,41,11,5,15,17,6,13,1,25,2,33,4,2,134,20,9,8,25,5,0,2,25,12,88,4,5,3,5,3,5,3,2],A=0,p=[],h=0;h<E.length;h+=2)p.push(A+=E[h]),E[h+1]&&p.push(45,A+=E[h+1]);y.wordChars=String.fromCharCode.apply(null,p)}),define("ace/mode/text",["require","exports","module","ace/config","ace/tokenizer","ace/mode/text_highlight_rules","ace/mode/behaviour/cstyle","ace/unicode","ace/lib/lang","ace/token_iterator","ace/range"],function(k,y,B){"use strict";var E=k("../config"),A=k("../tokenizer").Tokenizer,p=k("./text_highlight_rules").TextHighlightRules,h=k("./behaviour/cstyle").CstyleBehaviour,d=k("../unicode"),t=k("../lib/lang"),e=k("../token_iterator").TokenIterator,i=k("../range").Range,r=function(){this.HighlightRules=p};(function(){this.$defaultBehaviour=new h,this.tokenRe=new RegExp("^["+d.wordChars+"\\$_]+","g"),this.nonTokenRe=new RegExp("^(?:[^"+d.wordChars+"\\$_]|\\s])+","g"),this.getTokenizer=function(){return this.$tokenizer||(this.$highlightRules=this.$highlightRules||new this.HighlightRules(this.$highlightRuleConfig),this.$tokenizer=new A(this.$highlightRules.getRules())),this.$tokenizer},this.lineCommentStart="",this.blockComment="",this.toggleCommentLines=function(n,a,m,w){var S=a.doc,b=!0,c=!0,l=1/0,g=a.getTabSize(),u=!1;if(this.lineCommentStart){if(Array.isArray(this.lineCommentStart))var s=this.lineCommentStart.map(t.escapeRegExp).join("|"),o=this.lineCommentStart[0];else var s=t.escapeRegExp(this.lineCommentStart),o=this.lineCommentStart;s=new RegExp("^(\\s*)(?:"+s+") ?"),u=a.getUseSoftTabs();var C=function(P,I){var D=P.match(s);if(D){var N=D[1].length,V=D[0].length;!R(P,N,V)&&D[0][V-1]==" "&&V--,S.removeInLine(I,N,V)}},L=o+" ",$=function(P,I){(!b||/\S/.test(P))&&(R(P,l,l)?S.insertInLine({row:I,column:l},L):S.insertInLine({row:I,column:l},o))},x=function(P,I){return s.test(P)},R=function(P,I,D){for(var N=0;I--&&P.charAt(I)==" ";)N++;if(N%g!=0)return!1;for(var N=0;P.charAt(D++)==" ";)N++;return g>2?N%g!=g-1:N%g==0}}else{if(!this.blockComment)return!1;var o=this.blockComment.start,f=this.blockComment.end,s=new RegExp("^(\\s*)(?:"+t.escapeRegExp(o)+")"),v=new RegExp("(?:"+t.escapeRegExp(f)+")\\s*$"),$=function(F,O){x(F,O)||(!b||/\S/.test(F))&&(S.insertInLine({row:O,column:F.length},f),S.insertInLine({row:O,column:l},o))},C=function(F,O){var M;(M=F.match(v))&&S.removeInLine(O,F.length-M[0].length,F.length),(M=F.match(s))&&S.removeInLine(O,M[1].length,M[0].length)},x=function(F,O){if(s.test(F))return!0;for(var M=a.getTokens(O),P=0;P<M.length;P++)if(M[P].type==="comment")return!0}}function T(F){for(var O=m;O<=w;O++)F(S.getLine(O),O)}var _=1/0;T(function(F,O){var M=F.search(/\S/);M!==-1?(M<l&&(l=M),c&&!x(F,O)&&(c=!1)):_>F.length&&(_=F.length)}),l==1/0&&(l=_,b=!1,c=!1),u&&l%g!=0&&(l=Math.floor(l/g)*g),T(c?C:$)},this.toggleBlockComment=function(n,a,m,w){var S=this.blockComment;if(S){!S.start&&S[0]&&(S=S[0]);var b=new e(a,w.row,w.column),c=b.getCurrentToken(),l=a.selection,g=a.selection.toOrientedRange(),u,o;if(c&&/comment/.test(c.type)){for(var f,s;c&&/comment/.test(c.type);){var v=c.value.indexOf(S.start);if(v!=-1){var $=b.getCurrentTokenRow(),C=b.getCurrentTokenColumn()+v;f=new i($,C,$,C+S.start.length);break}c=b.stepBackward()}for(var b=new e(a,w.row,w.column),c=b.getCurrentToken();c&&/comment/.test(c.type);){var v=c.value.indexOf(S.end);if(v!=-1){var $=b.getCurrentTokenRow(),C=b.getCurrentTokenColumn()+v;s=new i($,C,$,C+S.end.length);break}c=b.stepForward()}s&&a.remove(s),f&&(a.remove(f),u=f.start.row,o=-S.start.length)}else o=S.start.length,u=m.start.row,a.insert(m.end,S.end),a.insert(m.start,S.start);g.start.row==u&&(g.start.column+=o),g.end.row==u&&(g.end.column+=o),a.selection.fromOrientedRange(g)}},this.getNextLineIndent=function(n,a,m){return this.$getIndent(a)},this.checkOutdent=function(n,a,m){return!1},this.autoOutdent=function(n,a,m){},this.$getIndent=function(n){return n.match(/^\s*/)[0]},this.createWorker=function(n){return null},this.createModeDelegates=function(n){this.$embeds=[],this.$modes={};for(var a in n)if(n[a]){var m=n[a],w=m.prototype.$id,S=E.$modes[w];S||(E.$modes[w]=S=new m),E.$modes[a]||(E.$modes[a]=S),this.$embeds.push(a),this.$modes[a]=S}for(var b=["toggleBlockComment","toggleCommentLines","getNextLineIndent","checkOutdent","autoOutdent","transformAction","getCompletions"],a=0;a<b.length;a++)(function(l){var g=b[a],u=l[g];l[b[a]]=function(){return this.$delegator(g,arguments,u)}})(this)},this.$delegator=function(n,a,m){var w=a[0]||"start";if(typeof w!="string"){if(Array.isArray(w[2])){var S=w[2][w[2].length-1],b=this.$modes[S];if(b)return b[n].apply(b,[w[1]].concat([].slice.call(a,1)))}w=w[0]||"start"}for(var c=0;c<this.$embeds.length;c++)if(this.$modes[this.$embeds[c]]){var l=w.split(this.$embeds[c]);if(!l[0]&&l[1]){a[0]=l[1];var b=this.$modes[this.$embeds[c]];return b[n].apply(b,a)}}var g=m.apply(this,a);return m?g:void 0},this.transformAction=function(n,a,m,w,S){if(this.$behaviour){var b=this.$behaviour.getBehaviours();for(var c in b)if(b[c][a]){var l=b[c][a].apply(this,arguments);if(l)return l}}},this.getKeywords=function(n){if(!this.completionKeywords){var a=this.$tokenizer.rules,m=[];for(var w in a)for(var S=a[w],b=0,c=S.length;b<c;b++)if(typeof S[b].token=="string")/keyword|support|storage/.test(S[b].token)&&m.push(S[b].regex);else if(typeof S[b].token=="object"){for(var l=0,g=S[b].token.length;l<g;l++)if(/keyword|support|storage/.test(S[b].token[l])){var w=S[b].regex.match(/\(.+?\)/g)[l];m.push(w.substr(1,w.length-2))}}this.completionKeywords=m}return n?m.concat(this.$keywordList||[]):this.$keywordList},this.$createKeywordList=function(){return this.$highlightRules||this.getTokenizer(),this.$keywordList=this.$highlightRules.$keywordList||[]},this.getCompletions=function(n,a,m,w){var S=this.$keywordList||this.$createKeywordList();return S.map(function(b){return{name:b,value:b,score:0,meta:"keyword"}})},this.$id="ace/mode/text"}).call(r.prototype),y.Mode=r}),define("ace/apply_delta",["require","exports","module"],function(k,y,B){"use strict";function E(h,d){throw console.log("Invalid Delta:",h),"Invalid Delta: "+d}function A(h,d){return d.row>=0&&d.row<h.length&&d.column>=0&&d.column<=h[d.row].length}function p(h,d){d.action!="insert"&&d.action!="remove"&&E(d,"delta.action must be 'insert' or 'remove'"),d.lines instanceof Array||E(d,"delta.lines must be an Array"),(!d.start||!d.end)&&E(d,"delta.start/end must be an present");var t=d.start;A(h,d.start)||E(d,"delta.start must be contained in document");var e=d.end;d.action=="remove"&&!A(h,e)&&E(d,"delta.end must contained in document for 'remove' actions");var i=e.row-t.row,r=e.column-(i==0?t.column:0);(i!=d.lines.length-1||d.lines[i].length!=r)&&E(d,"delta.range must match delta lines")}y.applyDelta=function(h,d,t){var e=d.start.row,i=d.start.column,r=h[e]||"";switch(d.action){case"insert":var n=d.lines;if(n.length===1)h[e]=r.substring(0,i)+d.lines[0]+r.substring(i);else{var a=[e,1].concat(d.lines);h.splice.apply(h,a),h[e]=r.substring(0,i)+h[e],h[e+d.lines.length-1]+=r.substring(i)}break;case"remove":var m=d.end.column,w=d.end.row;e===w?h[e]=r.substring(0,i)+r.substring(m):h.splice(e,w-e+1,r.substring(0,i)+h[w].substring(m));break}}}),define("ace/anchor",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/event_emitter").EventEmitter,p=y.Anchor=function(h,d,t){this.$onChange=this.onChange.bind(this),this.attach(h),typeof t>"u"?this.setPosition(d.row,d.column):this.setPosition(d,t)};(function(){E.implement(this,A),this.getPosition=function(){return this.$clipPositionToDocument(this.row,this.column)},this.getDocument=function(){return this.document},this.$insertRight=!1,this.onChange=function(t){if(!(t.start.row==t.end.row&&t.start.row!=this.row)&&!(t.start.row>this.row)){var e=d(t,{row:this.row,column:this.column},this.$insertRight);this.setPosition(e.row,e.column,!0)}};function h(t,e,i){var r=i?t.column<=e.column:t.column<e.column;return t.row<e.row||t.row==e.row&&r}function d(t,e,i){var r=t.action=="insert",n=(r?1:-1)*(t.end.row-t.start.row),a=(r?1:-1)*(t.end.column-t.start.column),m=t.start,w=r?m:t.end;return h(e,m,i)?{row:e.row,column:e.column}:h(w,e,!i)?{row:e.row+n,column:e.column+(e.row==w.row?a:0)}:{row:m.row,column:m.column}}this.setPosition=function(t,e,i){var r;if(i?r={row:t,column:e}:r=this.$clipPositionToDocument(t,e),!(this.row==r.row&&this.column==r.column)){var n={row:this.row,column:this.column};this.row=r.row,this.column=r.column,this._signal("change",{old:n,value:r})}},this.detach=function(){this.document.off("change",this.$onChange)},this.attach=function(t){this.document=t||this.document,this.document.on("change",this.$onChange)},this.$clipPositionToDocument=function(t,e){var i={};return t>=this.document.getLength()?(i.row=Math.max(0,this.document.getLength()-1),i.column=this.document.getLine(i.row).length):t<0?(i.row=0,i.column=0):(i.row=t,i.column=Math.min(this.document.getLine(i.row).length,Math.max(0,e))),e<0&&(i.column=0),i}}).call(p.prototype)}),define("ace/document",["require","exports","module","ace/lib/oop","ace/apply_delta","ace/lib/event_emitter","ace/range","ace/anchor"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./apply_delta").applyDelta,p=k("./lib/event_emitter").EventEmitter,h=k("./range").Range,d=k("./anchor").Anchor,t=function(e){this.$lines=[""],e.length===0?this.$lines=[""]:Array.isArray(e)?this.insertMergedLines({row:0,column:0},e):this.insert({row:0,column:0},e)};(function(){E.implement(this,p),this.setValue=function(e){var i=this.getLength()-1;this.remove(new h(0,0,i,this.getLine(i).length)),this.insert({row:0,column:0},e)},this.getValue=function(){return this.getAllLines().join(this.getNewLineCharacter())},this.createAnchor=function(e,i){return new d(this,e,i)},"aaa".split(/a/).length===0?this.$split=function(e){return e.replace(/\r\n|\r/g,`
 `).split(`
 `)}:this.$split=function(e){return e.split(/\r\n|\r|\n/)},this.$detectNewLine=function(e){var i=e.match(/^.*?(\r\n|\r|\n)/m);this.$autoNewLine=i?i[1]:`
 `,this._signal("changeNewLineMode")},this.getNewLineCharacter=function(){switch(this.$newLineMode){case"windows":return`\r
@@ -23,33 +23,33 @@
 `;default:return this.$autoNewLine||`
 `}},this.$autoNewLine="",this.$newLineMode="auto",this.setNewLineMode=function(e){this.$newLineMode!==e&&(this.$newLineMode=e,this._signal("changeNewLineMode"))},this.getNewLineMode=function(){return this.$newLineMode},this.isNewLine=function(e){return e==`\r
 `||e=="\r"||e==`
-`},this.getLine=function(e){return this.$lines[e]||""},this.getLines=function(e,i){return this.$lines.slice(e,i+1)},this.getAllLines=function(){return this.getLines(0,this.getLength())},this.getLength=function(){return this.$lines.length},this.getTextRange=function(e){return this.getLinesForRange(e).join(this.getNewLineCharacter())},this.getLinesForRange=function(e){var i;if(e.start.row===e.end.row)i=[this.getLine(e.start.row).substring(e.start.column,e.end.column)];else{i=this.getLines(e.start.row,e.end.row),i[0]=(i[0]||"").substring(e.start.column);var r=i.length-1;e.end.row-e.start.row==r&&(i[r]=i[r].substring(0,e.end.column))}return i},this.insertLines=function(e,i){return console.warn("Use of document.insertLines is deprecated. Use the insertFullLines method instead."),this.insertFullLines(e,i)},this.removeLines=function(e,i){return console.warn("Use of document.removeLines is deprecated. Use the removeFullLines method instead."),this.removeFullLines(e,i)},this.insertNewLine=function(e){return console.warn("Use of document.insertNewLine is deprecated. Use insertMergedLines(position, ['', '']) instead."),this.insertMergedLines(e,["",""])},this.insert=function(e,i){return this.getLength()<=1&&this.$detectNewLine(i),this.insertMergedLines(e,this.$split(i))},this.insertInLine=function(e,i){var r=this.clippedPos(e.row,e.column),n=this.pos(e.row,e.column+i.length);return this.applyDelta({start:r,end:n,action:"insert",lines:[i]},!0),this.clonePos(n)},this.clippedPos=function(e,i){var r=this.getLength();e===void 0?e=r:e<0?e=0:e>=r&&(e=r-1,i=void 0);var n=this.getLine(e);return i==null&&(i=n.length),i=Math.min(Math.max(i,0),n.length),{row:e,column:i}},this.clonePos=function(e){return{row:e.row,column:e.column}},this.pos=function(e,i){return{row:e,column:i}},this.$clipPosition=function(e){var i=this.getLength();return e.row>=i?(e.row=Math.max(0,i-1),e.column=this.getLine(i-1).length):(e.row=Math.max(0,e.row),e.column=Math.min(Math.max(e.column,0),this.getLine(e.row).length)),e},this.insertFullLines=function(e,i){e=Math.min(Math.max(e,0),this.getLength());var r=0;e<this.getLength()?(i=i.concat([""]),r=0):(i=[""].concat(i),e--,r=this.$lines[e].length),this.insertMergedLines({row:e,column:r},i)},this.insertMergedLines=function(e,i){var r=this.clippedPos(e.row,e.column),n={row:r.row+i.length-1,column:(i.length==1?r.column:0)+i[i.length-1].length};return this.applyDelta({start:r,end:n,action:"insert",lines:i}),this.clonePos(n)},this.remove=function(e){var i=this.clippedPos(e.start.row,e.start.column),r=this.clippedPos(e.end.row,e.end.column);return this.applyDelta({start:i,end:r,action:"remove",lines:this.getLinesForRange({start:i,end:r})}),this.clonePos(i)},this.removeInLine=function(e,i,r){var n=this.clippedPos(e,i),o=this.clippedPos(e,r);return this.applyDelta({start:n,end:o,action:"remove",lines:this.getLinesForRange({start:n,end:o})},!0),this.clonePos(n)},this.removeFullLines=function(e,i){e=Math.min(Math.max(0,e),this.getLength()-1),i=Math.min(Math.max(0,i),this.getLength()-1);var r=i==this.getLength()-1&&e>0,n=i<this.getLength()-1,o=r?e-1:e,m=r?this.getLine(o).length:0,w=n?i+1:i,S=n?0:this.getLine(w).length,y=new h(o,m,w,S),c=this.$lines.slice(e,i+1);return this.applyDelta({start:y.start,end:y.end,action:"remove",lines:this.getLinesForRange(y)}),c},this.removeNewLine=function(e){e<this.getLength()-1&&e>=0&&this.applyDelta({start:this.pos(e,this.getLine(e).length),end:this.pos(e+1,0),action:"remove",lines:["",""]})},this.replace=function(e,i){if(e instanceof h||(e=h.fromPoints(e.start,e.end)),i.length===0&&e.isEmpty())return e.start;if(i==this.getTextRange(e))return e.end;this.remove(e);var r;return i?r=this.insert(e.start,i):r=e.start,r},this.applyDeltas=function(e){for(var i=0;i<e.length;i++)this.applyDelta(e[i])},this.revertDeltas=function(e){for(var i=e.length-1;i>=0;i--)this.revertDelta(e[i])},this.applyDelta=function(e,i){var r=e.action=="insert";(r?e.lines.length<=1&&!e.lines[0]:!h.comparePoints(e.start,e.end))||(r&&e.lines.length>2e4?this.$splitAndapplyLargeDelta(e,2e4):(A(this.$lines,e,i),this._signal("change",e)))},this.$safeApplyDelta=function(e){var i=this.$lines.length;(e.action=="remove"&&e.start.row<i&&e.end.row<i||e.action=="insert"&&e.start.row<=i)&&this.applyDelta(e)},this.$splitAndapplyLargeDelta=function(e,i){for(var r=e.lines,n=r.length-i+1,o=e.start.row,m=e.start.column,w=0,S=0;w<n;w=S){S+=i-1;var y=r.slice(w,S);y.push(""),this.applyDelta({start:this.pos(o+w,m),end:this.pos(o+S,m=0),action:e.action,lines:y},!0)}e.lines=r.slice(w),e.start.row=o+w,e.start.column=m,this.applyDelta(e,!0)},this.revertDelta=function(e){this.$safeApplyDelta({start:this.clonePos(e.start),end:this.clonePos(e.end),action:e.action=="insert"?"remove":"insert",lines:e.lines.slice()})},this.indexToPosition=function(e,i){for(var r=this.$lines||this.getAllLines(),n=this.getNewLineCharacter().length,o=i||0,m=r.length;o<m;o++)if(e-=r[o].length+n,e<0)return{row:o,column:e+r[o].length+n};return{row:m-1,column:e+r[m-1].length+n}},this.positionToIndex=function(e,i){for(var r=this.$lines||this.getAllLines(),n=this.getNewLineCharacter().length,o=0,m=Math.min(e.row,r.length),w=i||0;w<m;++w)o+=r[w].length+n;return o+e.column}}).call(t.prototype),b.Document=t}),define("ace/background_tokenizer",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/event_emitter").EventEmitter,p=function(h,u){this.running=!1,this.lines=[],this.states=[],this.currentLine=0,this.tokenizer=h;var t=this;this.$worker=function(){if(t.running){for(var e=new Date,i=t.currentLine,r=-1,n=t.doc,o=i;t.lines[i];)i++;var m=n.getLength(),w=0;for(t.running=!1;i<m;){t.$tokenizeRow(i),r=i;do i++;while(t.lines[i]);if(w++,w%5===0&&new Date-e>20){t.running=setTimeout(t.$worker,20);break}}t.currentLine=i,r==-1&&(r=i),o<=r&&t.fireUpdateEvent(o,r)}}};(function(){E.implement(this,A),this.setTokenizer=function(h){this.tokenizer=h,this.lines=[],this.states=[],this.start(0)},this.setDocument=function(h){this.doc=h,this.lines=[],this.states=[],this.stop()},this.fireUpdateEvent=function(h,u){var t={first:h,last:u};this._signal("update",{data:t})},this.start=function(h){this.currentLine=Math.min(h||0,this.currentLine,this.doc.getLength()),this.lines.splice(this.currentLine,this.lines.length),this.states.splice(this.currentLine,this.states.length),this.stop(),this.running=setTimeout(this.$worker,700)},this.scheduleStart=function(){this.running||(this.running=setTimeout(this.$worker,700))},this.$updateOnChange=function(h){var u=h.start.row,t=h.end.row-u;if(t===0)this.lines[u]=null;else if(h.action=="remove")this.lines.splice(u,t+1,null),this.states.splice(u,t+1,null);else{var e=Array(t+1);e.unshift(u,1),this.lines.splice.apply(this.lines,e),this.states.splice.apply(this.states,e)}this.currentLine=Math.min(u,this.currentLine,this.doc.getLength()),this.stop()},this.stop=function(){this.running&&clearTimeout(this.running),this.running=!1},this.getTokens=function(h){return this.lines[h]||this.$tokenizeRow(h)},this.getState=function(h){return this.currentLine==h&&this.$tokenizeRow(h),this.states[h]||"start"},this.$tokenizeRow=function(h){var u=this.doc.getLine(h),t=this.states[h-1],e=this.tokenizer.getLineTokens(u,t,h);return this.states[h]+""!=e.state+""?(this.states[h]=e.state,this.lines[h+1]=null,this.currentLine>h+1&&(this.currentLine=h+1)):this.currentLine==h&&(this.currentLine=h+1),this.lines[h]=e.tokens}}).call(p.prototype),b.BackgroundTokenizer=p}),define("ace/search_highlight",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/range"],function(L,b,B){"use strict";var E=L("./lib/lang"),A=L("./lib/oop"),p=L("./range").Range,h=function(u,t,e){this.setRegexp(u),this.clazz=t,this.type=e||"text"};(function(){this.MAX_RANGES=500,this.setRegexp=function(u){this.regExp+""!=u+""&&(this.regExp=u,this.cache=[])},this.update=function(u,t,e,i){if(this.regExp)for(var r=i.firstRow,n=i.lastRow,o=r;o<=n;o++){var m=this.cache[o];m==null&&(m=E.getMatchOffsets(e.getLine(o),this.regExp),m.length>this.MAX_RANGES&&(m=m.slice(0,this.MAX_RANGES)),m=m.map(function(S){return new p(o,S.offset,o,S.offset+S.length)}),this.cache[o]=m.length?m:"");for(var w=m.length;w--;)t.drawSingleLineMarker(u,m[w].toScreenRange(e),this.clazz,i)}}}).call(h.prototype),b.SearchHighlight=h}),define("ace/edit_session/fold_line",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=L("../range").Range;function A(p,h){this.foldData=p,Array.isArray(h)?this.folds=h:h=this.folds=[h];var u=h[h.length-1];this.range=new E(h[0].start.row,h[0].start.column,u.end.row,u.end.column),this.start=this.range.start,this.end=this.range.end,this.folds.forEach(function(t){t.setFoldLine(this)},this)}(function(){this.shiftRow=function(p){this.start.row+=p,this.end.row+=p,this.folds.forEach(function(h){h.start.row+=p,h.end.row+=p})},this.addFold=function(p){if(p.sameRow){if(p.start.row<this.startRow||p.endRow>this.endRow)throw new Error("Can't add a fold to this FoldLine as it has no connection");this.folds.push(p),this.folds.sort(function(h,u){return-h.range.compareEnd(u.start.row,u.start.column)}),this.range.compareEnd(p.start.row,p.start.column)>0?(this.end.row=p.end.row,this.end.column=p.end.column):this.range.compareStart(p.end.row,p.end.column)<0&&(this.start.row=p.start.row,this.start.column=p.start.column)}else if(p.start.row==this.end.row)this.folds.push(p),this.end.row=p.end.row,this.end.column=p.end.column;else if(p.end.row==this.start.row)this.folds.unshift(p),this.start.row=p.start.row,this.start.column=p.start.column;else throw new Error("Trying to add fold to FoldRow that doesn't have a matching row");p.foldLine=this},this.containsRow=function(p){return p>=this.start.row&&p<=this.end.row},this.walk=function(p,h,u){var t=0,e=this.folds,i,r,n,o=!0;h==null&&(h=this.end.row,u=this.end.column);for(var m=0;m<e.length;m++){if(i=e[m],r=i.range.compareStart(h,u),r==-1){p(null,h,u,t,o);return}if(n=p(null,i.start.row,i.start.column,t,o),n=!n&&p(i.placeholder,i.start.row,i.start.column,t),n||r===0)return;o=!i.sameRow,t=i.end.column}p(null,h,u,t,o)},this.getNextFoldTo=function(p,h){for(var u,t,e=0;e<this.folds.length;e++){if(u=this.folds[e],t=u.range.compareEnd(p,h),t==-1)return{fold:u,kind:"after"};if(t===0)return{fold:u,kind:"inside"}}return null},this.addRemoveChars=function(p,h,u){var t=this.getNextFoldTo(p,h),e,i;if(t){if(e=t.fold,t.kind=="inside"&&e.start.column!=h&&e.start.row!=p)window.console&&window.console.log(p,h,e);else if(e.start.row==p){i=this.folds;var r=i.indexOf(e);for(r===0&&(this.start.column+=u),r;r<i.length;r++){if(e=i[r],e.start.column+=u,!e.sameRow)return;e.end.column+=u}this.end.column+=u}}},this.split=function(p,h){var u=this.getNextFoldTo(p,h);if(!u||u.kind=="inside")return null;var t=u.fold,e=this.folds,i=this.foldData,r=e.indexOf(t),n=e[r-1];this.end.row=n.end.row,this.end.column=n.end.column,e=e.splice(r,e.length-r);var o=new A(i,e);return i.splice(i.indexOf(this)+1,0,o),o},this.merge=function(p){for(var h=p.folds,u=0;u<h.length;u++)this.addFold(h[u]);var t=this.foldData;t.splice(t.indexOf(p),1)},this.toString=function(){var p=[this.range.toString()+": ["];return this.folds.forEach(function(h){p.push("  "+h.toString())}),p.push("]"),p.join(`
-`)},this.idxToPosition=function(p){for(var h=0,u=0;u<this.folds.length;u++){var t=this.folds[u];if(p-=t.start.column-h,p<0)return{row:t.start.row,column:t.start.column+p};if(p-=t.placeholder.length,p<0)return t.start;h=t.end.column}return{row:this.end.row,column:this.end.column+p}}}).call(A.prototype),b.FoldLine=A}),define("ace/range_list",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=L("./range").Range,A=E.comparePoints,p=function(){this.ranges=[],this.$bias=1};(function(){this.comparePoints=A,this.pointIndex=function(h,u,t){for(var e=this.ranges,i=t||0;i<e.length;i++){var r=e[i],n=A(h,r.end);if(!(n>0)){var o=A(h,r.start);return n===0?u&&o!==0?-i-2:i:o>0||o===0&&!u?i:-i-1}}return-i-1},this.add=function(h){var u=!h.isEmpty(),t=this.pointIndex(h.start,u);t<0&&(t=-t-1);var e=this.pointIndex(h.end,u,t);return e<0?e=-e-1:e++,this.ranges.splice(t,e-t,h)},this.addList=function(h){for(var u=[],t=h.length;t--;)u.push.apply(u,this.add(h[t]));return u},this.substractPoint=function(h){var u=this.pointIndex(h);if(u>=0)return this.ranges.splice(u,1)},this.merge=function(){var h=[],u=this.ranges;u=u.sort(function(n,o){return A(n.start,o.start)});for(var t=u[0],e,i=1;i<u.length;i++){e=t,t=u[i];var r=A(e.end,t.start);r<0||r==0&&!e.isEmpty()&&!t.isEmpty()||(A(e.end,t.end)<0&&(e.end.row=t.end.row,e.end.column=t.end.column),u.splice(i,1),h.push(t),t=e,i--)}return this.ranges=u,h},this.contains=function(h,u){return this.pointIndex({row:h,column:u})>=0},this.containsPoint=function(h){return this.pointIndex(h)>=0},this.rangeAtPoint=function(h){var u=this.pointIndex(h);if(u>=0)return this.ranges[u]},this.clipRows=function(h,u){var t=this.ranges;if(t[0].start.row>u||t[t.length-1].start.row<h)return[];var e=this.pointIndex({row:h,column:0});e<0&&(e=-e-1);var i=this.pointIndex({row:u,column:0},e);i<0&&(i=-i-1);for(var r=[],n=e;n<i;n++)r.push(t[n]);return r},this.removeAll=function(){return this.ranges.splice(0,this.ranges.length)},this.attach=function(h){this.session&&this.detach(),this.session=h,this.onChange=this.$onChange.bind(this),this.session.on("change",this.onChange)},this.detach=function(){this.session&&(this.session.removeListener("change",this.onChange),this.session=null)},this.$onChange=function(h){for(var u=h.start,t=h.end,e=u.row,i=t.row,r=this.ranges,n=0,o=r.length;n<o;n++){var m=r[n];if(m.end.row>=e)break}if(h.action=="insert")for(var w=i-e,S=-u.column+t.column;n<o;n++){var m=r[n];if(m.start.row>e)break;if(m.start.row==e&&m.start.column>=u.column&&(m.start.column==u.column&&this.$bias<=0||(m.start.column+=S,m.start.row+=w)),m.end.row==e&&m.end.column>=u.column){if(m.end.column==u.column&&this.$bias<0)continue;m.end.column==u.column&&S>0&&n<o-1&&m.end.column>m.start.column&&m.end.column==r[n+1].start.column&&(m.end.column-=S),m.end.column+=S,m.end.row+=w}}else for(var w=e-i,S=u.column-t.column;n<o;n++){var m=r[n];if(m.start.row>i)break;m.end.row<i&&(e<m.end.row||e==m.end.row&&u.column<m.end.column)?(m.end.row=e,m.end.column=u.column):m.end.row==i?m.end.column<=t.column?(w||m.end.column>u.column)&&(m.end.column=u.column,m.end.row=u.row):(m.end.column+=S,m.end.row+=w):m.end.row>i&&(m.end.row+=w),m.start.row<i&&(e<m.start.row||e==m.start.row&&u.column<m.start.column)?(m.start.row=e,m.start.column=u.column):m.start.row==i?m.start.column<=t.column?(w||m.start.column>u.column)&&(m.start.column=u.column,m.start.row=u.row):(m.start.column+=S,m.start.row+=w):m.start.row>i&&(m.start.row+=w)}if(w!=0&&n<o)for(;n<o;n++){var m=r[n];m.start.row+=w,m.end.row+=w}}}).call(p.prototype),b.RangeList=p}),define("ace/edit_session/fold",["require","exports","module","ace/range_list","ace/lib/oop"],function(L,b,B){"use strict";var E=L("../range_list").RangeList,A=L("../lib/oop"),p=b.Fold=function(i,r){this.foldLine=null,this.placeholder=r,this.range=i,this.start=i.start,this.end=i.end,this.sameRow=i.start.row==i.end.row,this.subFolds=this.ranges=[]};A.inherits(p,E),function(){this.toString=function(){return'"'+this.placeholder+'" '+this.range.toString()},this.setFoldLine=function(i){this.foldLine=i,this.subFolds.forEach(function(r){r.setFoldLine(i)})},this.clone=function(){var i=this.range.clone(),r=new p(i,this.placeholder);return this.subFolds.forEach(function(n){r.subFolds.push(n.clone())}),r.collapseChildren=this.collapseChildren,r},this.addSubFold=function(i){if(!this.range.isEqual(i)){u(i,this.start);for(var w=i.start.row,S=i.start.column,r=0,n=-1;r<this.subFolds.length&&(n=this.subFolds[r].range.compare(w,S),n==1);r++);var o=this.subFolds[r],m=0;if(n==0){if(o.range.containsRange(i))return o.addSubFold(i);m=1}for(var w=i.range.end.row,S=i.range.end.column,y=r,n=-1;y<this.subFolds.length&&(n=this.subFolds[y].range.compare(w,S),n==1);y++);n==0&&y++;for(var c=this.subFolds.splice(r,y-r,i),l=n==0?c.length-1:c.length,g=m;g<l;g++)i.addSubFold(c[g]);return i.setFoldLine(this.foldLine),i}},this.restoreRange=function(i){return e(i,this.start)}}.call(p.prototype);function h(i,r){i.row-=r.row,i.row==0&&(i.column-=r.column)}function u(i,r){h(i.start,r),h(i.end,r)}function t(i,r){i.row==0&&(i.column+=r.column),i.row+=r.row}function e(i,r){t(i.start,r),t(i.end,r)}}),define("ace/edit_session/folding",["require","exports","module","ace/range","ace/edit_session/fold_line","ace/edit_session/fold","ace/token_iterator"],function(L,b,B){"use strict";var E=L("../range").Range,A=L("./fold_line").FoldLine,p=L("./fold").Fold,h=L("../token_iterator").TokenIterator;function u(){this.getFoldAt=function(t,e,i){var r=this.getFoldLine(t);if(!r)return null;for(var n=r.folds,o=0;o<n.length;o++){var m=n[o].range;if(m.contains(t,e)){if(i==1&&m.isEnd(t,e)&&!m.isEmpty())continue;if(i==-1&&m.isStart(t,e)&&!m.isEmpty())continue;return n[o]}}},this.getFoldsInRange=function(t){var e=t.start,i=t.end,r=this.$foldData,n=[];e.column+=1,i.column-=1;for(var o=0;o<r.length;o++){var m=r[o].range.compareRange(t);if(m!=2){if(m==-2)break;for(var w=r[o].folds,S=0;S<w.length;S++){var y=w[S];if(m=y.range.compareRange(t),m==-2)break;if(m==2)continue;if(m==42)break;n.push(y)}}}return e.column-=1,i.column+=1,n},this.getFoldsInRangeList=function(t){if(Array.isArray(t)){var e=[];t.forEach(function(i){e=e.concat(this.getFoldsInRange(i))},this)}else var e=this.getFoldsInRange(t);return e},this.getAllFolds=function(){for(var t=[],e=this.$foldData,i=0;i<e.length;i++)for(var r=0;r<e[i].folds.length;r++)t.push(e[i].folds[r]);return t},this.getFoldStringAt=function(t,e,i,r){if(r=r||this.getFoldLine(t),!r)return null;for(var n={end:{column:0}},o,m,w=0;w<r.folds.length;w++){m=r.folds[w];var S=m.range.compareEnd(t,e);if(S==-1){o=this.getLine(m.start.row).substring(n.end.column,m.start.column);break}else if(S===0)return null;n=m}return o||(o=this.getLine(m.start.row).substring(n.end.column)),i==-1?o.substring(0,e-n.end.column):i==1?o.substring(e-n.end.column):o},this.getFoldLine=function(t,e){var i=this.$foldData,r=0;for(e&&(r=i.indexOf(e)),r==-1&&(r=0),r;r<i.length;r++){var n=i[r];if(n.start.row<=t&&n.end.row>=t)return n;if(n.end.row>t)return null}return null},this.getNextFoldLine=function(t,e){var i=this.$foldData,r=0;for(e&&(r=i.indexOf(e)),r==-1&&(r=0),r;r<i.length;r++){var n=i[r];if(n.end.row>=t)return n}return null},this.getFoldedRowCount=function(t,e){for(var i=this.$foldData,r=e-t+1,n=0;n<i.length;n++){var o=i[n],m=o.end.row,w=o.start.row;if(m>=e){w<e&&(w>=t?r-=e-w:r=0);break}else m>=t&&(w>=t?r-=m-w:r-=m-t+1)}return r},this.$addFoldLine=function(t){return this.$foldData.push(t),this.$foldData.sort(function(e,i){return e.start.row-i.start.row}),t},this.addFold=function(t,e){var i=this.$foldData,r=!1,n;t instanceof p?n=t:(n=new p(e,t),n.collapseChildren=e.collapseChildren),this.$clipRangeToDocument(n.range);var o=n.start.row,m=n.start.column,w=n.end.row,S=n.end.column,y=this.getFoldAt(o,m,1),c=this.getFoldAt(w,S,-1);if(y&&c==y)return y.addSubFold(n);y&&!y.range.isStart(o,m)&&this.removeFold(y),c&&!c.range.isEnd(w,S)&&this.removeFold(c);var l=this.getFoldsInRange(n.range);l.length>0&&(this.removeFolds(l),n.collapseChildren||l.forEach(function(f){n.addSubFold(f)}));for(var g=0;g<i.length;g++){var d=i[g];if(w==d.start.row){d.addFold(n),r=!0;break}else if(o==d.end.row){if(d.addFold(n),r=!0,!n.sameRow){var a=i[g+1];if(a&&a.start.row==w){d.merge(a);break}}break}else if(w<=d.start.row)break}return r||(d=this.$addFoldLine(new A(this.$foldData,n))),this.$useWrapMode?this.$updateWrapData(d.start.row,d.start.row):this.$updateRowLengthCache(d.start.row,d.start.row),this.$modified=!0,this._signal("changeFold",{data:n,action:"add"}),n},this.addFolds=function(t){t.forEach(function(e){this.addFold(e)},this)},this.removeFold=function(t){var e=t.foldLine,i=e.start.row,r=e.end.row,n=this.$foldData,o=e.folds;if(o.length==1)n.splice(n.indexOf(e),1);else if(e.range.isEnd(t.end.row,t.end.column))o.pop(),e.end.row=o[o.length-1].end.row,e.end.column=o[o.length-1].end.column;else if(e.range.isStart(t.start.row,t.start.column))o.shift(),e.start.row=o[0].start.row,e.start.column=o[0].start.column;else if(t.sameRow)o.splice(o.indexOf(t),1);else{var m=e.split(t.start.row,t.start.column);o=m.folds,o.shift(),m.start.row=o[0].start.row,m.start.column=o[0].start.column}this.$updating||(this.$useWrapMode?this.$updateWrapData(i,r):this.$updateRowLengthCache(i,r)),this.$modified=!0,this._signal("changeFold",{data:t,action:"remove"})},this.removeFolds=function(t){for(var e=[],i=0;i<t.length;i++)e.push(t[i]);e.forEach(function(r){this.removeFold(r)},this),this.$modified=!0},this.expandFold=function(t){this.removeFold(t),t.subFolds.forEach(function(e){t.restoreRange(e),this.addFold(e)},this),t.collapseChildren>0&&this.foldAll(t.start.row+1,t.end.row,t.collapseChildren-1),t.subFolds=[]},this.expandFolds=function(t){t.forEach(function(e){this.expandFold(e)},this)},this.unfold=function(t,e){var i,r;if(t==null?(i=new E(0,0,this.getLength(),0),e==null&&(e=!0)):typeof t=="number"?i=new E(t,0,t,this.getLine(t).length):"row"in t?i=E.fromPoints(t,t):i=t,r=this.getFoldsInRangeList(i),e!=!1?this.removeFolds(r):this.expandFolds(r),r.length)return r},this.isRowFolded=function(t,e){return!!this.getFoldLine(t,e)},this.getRowFoldEnd=function(t,e){var i=this.getFoldLine(t,e);return i?i.end.row:t},this.getRowFoldStart=function(t,e){var i=this.getFoldLine(t,e);return i?i.start.row:t},this.getFoldDisplayLine=function(t,e,i,r,n){r==null&&(r=t.start.row),n==null&&(n=0),e==null&&(e=t.end.row),i==null&&(i=this.getLine(e).length);var o=this.doc,m="";return t.walk(function(w,S,y,c){if(!(S<r)){if(S==r){if(y<n)return;c=Math.max(n,c)}w!=null?m+=w:m+=o.getLine(S).substring(c,y)}},e,i),m},this.getDisplayLine=function(t,e,i,r){var n=this.getFoldLine(t);if(n)return this.getFoldDisplayLine(n,t,e,i,r);var o;return o=this.doc.getLine(t),o.substring(r||0,e||o.length)},this.$cloneFoldData=function(){var t=[];return t=this.$foldData.map(function(e){var i=e.folds.map(function(r){return r.clone()});return new A(t,i)}),t},this.toggleFold=function(t){var e=this.selection,i=e.getRange(),r,n;if(i.isEmpty()){var o=i.start;if(r=this.getFoldAt(o.row,o.column),r){this.expandFold(r);return}else(n=this.findMatchingBracket(o))?i.comparePoint(n)==1?i.end=n:(i.start=n,i.start.column++,i.end.column--):(n=this.findMatchingBracket({row:o.row,column:o.column+1}))?(i.comparePoint(n)==1?i.end=n:i.start=n,i.start.column++):i=this.getCommentFoldRange(o.row,o.column)||i}else{var m=this.getFoldsInRange(i);if(t&&m.length){this.expandFolds(m);return}else m.length==1&&(r=m[0])}if(r||(r=this.getFoldAt(i.start.row,i.start.column)),r&&r.range.toString()==i.toString()){this.expandFold(r);return}var w="...";if(!i.isMultiLine()){if(w=this.getTextRange(i),w.length<4)return;w=w.trim().substring(0,2)+".."}this.addFold(w,i)},this.getCommentFoldRange=function(t,e,i){var r=new h(this,t,e),n=r.getCurrentToken(),o=n&&n.type;if(n&&/^comment|string/.test(o)){o=o.match(/comment|string/)[0],o=="comment"&&(o+="|doc-start");var m=new RegExp(o),w=new E;if(i!=1){do n=r.stepBackward();while(n&&m.test(n.type));r.stepForward()}if(w.start.row=r.getCurrentTokenRow(),w.start.column=r.getCurrentTokenColumn()+2,r=new h(this,t,e),i!=-1){var S=-1;do if(n=r.stepForward(),S==-1){var y=this.getState(r.$row);m.test(y)||(S=r.$row)}else if(r.$row>S)break;while(n&&m.test(n.type));n=r.stepBackward()}else n=r.getCurrentToken();return w.end.row=r.getCurrentTokenRow(),w.end.column=r.getCurrentTokenColumn()+n.value.length-2,w}},this.foldAll=function(t,e,i,r){i==null&&(i=1e5);var n=this.foldWidgets;if(n){e=e||this.getLength(),t=t||0;for(var o=t;o<e;o++)if(n[o]==null&&(n[o]=this.getFoldWidget(o)),n[o]=="start"&&!(r&&!r(o))){var m=this.getFoldWidgetRange(o);m&&m.isMultiLine()&&m.end.row<=e&&m.start.row>=t&&(o=m.end.row,m.collapseChildren=i,this.addFold("...",m))}}},this.foldToLevel=function(t){for(this.foldAll();t-- >0;)this.unfold(null,!1)},this.foldAllComments=function(){var t=this;this.foldAll(null,null,null,function(e){for(var i=t.getTokens(e),r=0;r<i.length;r++){var n=i[r];if(!(n.type=="text"&&/^\s+$/.test(n.value)))return!!/comment/.test(n.type)}})},this.$foldStyles={manual:1,markbegin:1,markbeginend:1},this.$foldStyle="markbegin",this.setFoldStyle=function(t){if(!this.$foldStyles[t])throw new Error("invalid fold style: "+t+"["+Object.keys(this.$foldStyles).join(", ")+"]");if(this.$foldStyle!=t){this.$foldStyle=t,t=="manual"&&this.unfold();var e=this.$foldMode;this.$setFolding(null),this.$setFolding(e)}},this.$setFolding=function(t){if(this.$foldMode!=t){if(this.$foldMode=t,this.off("change",this.$updateFoldWidgets),this.off("tokenizerUpdate",this.$tokenizerUpdateFoldWidgets),this._signal("changeAnnotation"),!t||this.$foldStyle=="manual"){this.foldWidgets=null;return}this.foldWidgets=[],this.getFoldWidget=t.getFoldWidget.bind(t,this,this.$foldStyle),this.getFoldWidgetRange=t.getFoldWidgetRange.bind(t,this,this.$foldStyle),this.$updateFoldWidgets=this.updateFoldWidgets.bind(this),this.$tokenizerUpdateFoldWidgets=this.tokenizerUpdateFoldWidgets.bind(this),this.on("change",this.$updateFoldWidgets),this.on("tokenizerUpdate",this.$tokenizerUpdateFoldWidgets)}},this.getParentFoldRangeData=function(t,e){var i=this.foldWidgets;if(!i||e&&i[t])return{};for(var r=t-1,n;r>=0;){var o=i[r];if(o==null&&(o=i[r]=this.getFoldWidget(r)),o=="start"){var m=this.getFoldWidgetRange(r);if(n||(n=m),m&&m.end.row>=t)break}r--}return{range:r!==-1&&m,firstRange:n}},this.onFoldWidgetClick=function(t,e){e=e.domEvent;var i={children:e.shiftKey,all:e.ctrlKey||e.metaKey,siblings:e.altKey},r=this.$toggleFoldWidget(t,i);if(!r){var n=e.target||e.srcElement;n&&/ace_fold-widget/.test(n.className)&&(n.className+=" ace_invalid")}},this.$toggleFoldWidget=function(t,e){if(this.getFoldWidget){var i=this.getFoldWidget(t),r=this.getLine(t),n=i==="end"?-1:1,o=this.getFoldAt(t,n===-1?0:r.length,n);if(o)return e.children||e.all?this.removeFold(o):this.expandFold(o),o;var m=this.getFoldWidgetRange(t,!0);if(m&&!m.isMultiLine()&&(o=this.getFoldAt(m.start.row,m.start.column,1),o&&m.isEqual(o.range)))return this.removeFold(o),o;if(e.siblings){var w=this.getParentFoldRangeData(t);if(w.range)var S=w.range.start.row+1,y=w.range.end.row;this.foldAll(S,y,e.all?1e4:0)}else e.children?(y=m?m.end.row:this.getLength(),this.foldAll(t+1,y,e.all?1e4:0)):m&&(e.all&&(m.collapseChildren=1e4),this.addFold("...",m));return m}},this.toggleFoldWidget=function(t){var e=this.selection.getCursor().row;e=this.getRowFoldStart(e);var i=this.$toggleFoldWidget(e,{});if(!i){var r=this.getParentFoldRangeData(e,!0);if(i=r.range||r.firstRange,i){e=i.start.row;var n=this.getFoldAt(e,this.getLine(e).length,1);n?this.removeFold(n):this.addFold("...",i)}}},this.updateFoldWidgets=function(t){var e=t.start.row,i=t.end.row-e;if(i===0)this.foldWidgets[e]=null;else if(t.action=="remove")this.foldWidgets.splice(e,i+1,null);else{var r=Array(i+1);r.unshift(e,1),this.foldWidgets.splice.apply(this.foldWidgets,r)}},this.tokenizerUpdateFoldWidgets=function(t){var e=t.data;e.first!=e.last&&this.foldWidgets.length>e.first&&this.foldWidgets.splice(e.first,this.foldWidgets.length)}}b.Folding=u}),define("ace/edit_session/bracket_match",["require","exports","module","ace/token_iterator","ace/range"],function(L,b,B){"use strict";var E=L("../token_iterator").TokenIterator,A=L("../range").Range;function p(){this.findMatchingBracket=function(h,u){if(h.column==0)return null;var t=u||this.getLine(h.row).charAt(h.column-1);if(t=="")return null;var e=t.match(/([\(\[\{])|([\)\]\}])/);return e?e[1]?this.$findClosingBracket(e[1],h):this.$findOpeningBracket(e[2],h):null},this.getBracketRange=function(h){var u=this.getLine(h.row),t=!0,e,i=u.charAt(h.column-1),r=i&&i.match(/([\(\[\{])|([\)\]\}])/);if(r||(i=u.charAt(h.column),h={row:h.row,column:h.column+1},r=i&&i.match(/([\(\[\{])|([\)\]\}])/),t=!1),!r)return null;if(r[1]){var n=this.$findClosingBracket(r[1],h);if(!n)return null;e=A.fromPoints(h,n),t||(e.end.column++,e.start.column--),e.cursor=e.end}else{var n=this.$findOpeningBracket(r[2],h);if(!n)return null;e=A.fromPoints(n,h),t||(e.start.column++,e.end.column--),e.cursor=e.start}return e},this.getMatchingBracketRanges=function(h){var u=this.getLine(h.row),t=u.charAt(h.column-1),e=t&&t.match(/([\(\[\{])|([\)\]\}])/);if(e||(t=u.charAt(h.column),h={row:h.row,column:h.column+1},e=t&&t.match(/([\(\[\{])|([\)\]\}])/)),!e)return null;var i=new A(h.row,h.column-1,h.row,h.column),r=e[1]?this.$findClosingBracket(e[1],h):this.$findOpeningBracket(e[2],h);if(!r)return[i];var n=new A(r.row,r.column,r.row,r.column+1);return[i,n]},this.$brackets={")":"(","(":")","]":"[","[":"]","{":"}","}":"{","<":">",">":"<"},this.$findOpeningBracket=function(h,u,t){var e=this.$brackets[h],i=1,r=new E(this,u.row,u.column),n=r.getCurrentToken();if(n||(n=r.stepForward()),!!n){t||(t=new RegExp("(\\.?"+n.type.replace(".","\\.").replace("rparen",".paren").replace(/\b(?:end)\b/,"(?:start|begin|end)")+")+"));for(var o=u.column-r.getCurrentTokenColumn()-2,m=n.value;;){for(;o>=0;){var w=m.charAt(o);if(w==e){if(i-=1,i==0)return{row:r.getCurrentTokenRow(),column:o+r.getCurrentTokenColumn()}}else w==h&&(i+=1);o-=1}do n=r.stepBackward();while(n&&!t.test(n.type));if(n==null)break;m=n.value,o=m.length-1}return null}},this.$findClosingBracket=function(h,u,t){var e=this.$brackets[h],i=1,r=new E(this,u.row,u.column),n=r.getCurrentToken();if(n||(n=r.stepForward()),!!n){t||(t=new RegExp("(\\.?"+n.type.replace(".","\\.").replace("lparen",".paren").replace(/\b(?:start|begin)\b/,"(?:start|begin|end)")+")+"));for(var o=u.column-r.getCurrentTokenColumn();;){for(var m=n.value,w=m.length;o<w;){var S=m.charAt(o);if(S==e){if(i-=1,i==0)return{row:r.getCurrentTokenRow(),column:o+r.getCurrentTokenColumn()}}else S==h&&(i+=1);o+=1}do n=r.stepForward();while(n&&!t.test(n.type));if(n==null)break;o=0}return null}}}b.BracketMatch=p}),define("ace/edit_session",["require","exports","module","ace/lib/oop","ace/lib/lang","ace/bidihandler","ace/config","ace/lib/event_emitter","ace/selection","ace/mode/text","ace/range","ace/document","ace/background_tokenizer","ace/search_highlight","ace/edit_session/folding","ace/edit_session/bracket_match"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/lang"),p=L("./bidihandler").BidiHandler,h=L("./config"),u=L("./lib/event_emitter").EventEmitter,t=L("./selection").Selection,e=L("./mode/text").Mode,i=L("./range").Range,r=L("./document").Document,n=L("./background_tokenizer").BackgroundTokenizer,o=L("./search_highlight").SearchHighlight,m=function(w,S){this.$breakpoints=[],this.$decorations=[],this.$frontMarkers={},this.$backMarkers={},this.$markerId=1,this.$undoSelect=!0,this.$foldData=[],this.id="session"+ ++m.$uid,this.$foldData.toString=function(){return this.join(`
-`)},this.on("changeFold",this.onChangeFold.bind(this)),this.$onChange=this.onChange.bind(this),(typeof w!="object"||!w.getLine)&&(w=new r(w)),this.setDocument(w),this.selection=new t(this),this.$bidiHandler=new p(this),h.resetOptions(this),this.setMode(S),h._signal("session",this)};m.$uid=0,function(){E.implement(this,u),this.setDocument=function(s){this.doc&&this.doc.removeListener("change",this.$onChange),this.doc=s,s.on("change",this.$onChange),this.bgTokenizer&&this.bgTokenizer.setDocument(this.getDocument()),this.resetCaches()},this.getDocument=function(){return this.doc},this.$resetRowCache=function(s){if(!s){this.$docRowCache=[],this.$screenRowCache=[];return}var v=this.$docRowCache.length,$=this.$getRowCacheIndex(this.$docRowCache,s)+1;v>$&&(this.$docRowCache.splice($,v),this.$screenRowCache.splice($,v))},this.$getRowCacheIndex=function(s,v){for(var $=0,x=s.length-1;$<=x;){var C=$+x>>1,k=s[C];if(v>k)$=C+1;else if(v<k)x=C-1;else return C}return $-1},this.resetCaches=function(){this.$modified=!0,this.$wrapData=[],this.$rowLengthCache=[],this.$resetRowCache(0),this.bgTokenizer&&this.bgTokenizer.start(0)},this.onChangeFold=function(s){var v=s.data;this.$resetRowCache(v.start.row)},this.onChange=function(s){this.$modified=!0,this.$bidiHandler.onChange(s),this.$resetRowCache(s.start.row);var v=this.$updateInternalDataOnChange(s);!this.$fromUndo&&this.$undoManager&&(v&&v.length&&(this.$undoManager.add({action:"removeFolds",folds:v},this.mergeUndoDeltas),this.mergeUndoDeltas=!0),this.$undoManager.add(s,this.mergeUndoDeltas),this.mergeUndoDeltas=!0,this.$informUndoManager.schedule()),this.bgTokenizer&&this.bgTokenizer.$updateOnChange(s),this._signal("change",s)},this.setValue=function(s){this.doc.setValue(s),this.selection.moveTo(0,0),this.$resetRowCache(0),this.setUndoManager(this.$undoManager),this.getUndoManager().reset()},this.getValue=this.toString=function(){return this.doc.getValue()},this.getSelection=function(){return this.selection},this.getState=function(s){return this.bgTokenizer.getState(s)},this.getTokens=function(s){return this.bgTokenizer.getTokens(s)},this.getTokenAt=function(s,v){var $=this.bgTokenizer.getTokens(s),x,C=0;if(v==null){var k=$.length-1;C=this.getLine(s).length}else for(var k=0;k<$.length&&(C+=$[k].value.length,!(C>=v));k++);return x=$[k],x?(x.index=k,x.start=C-x.value.length,x):null},this.setUndoManager=function(s){if(this.$undoManager=s,this.$informUndoManager&&this.$informUndoManager.cancel(),s){var v=this;s.addSession(this),this.$syncInformUndoManager=function(){v.$informUndoManager.cancel(),v.mergeUndoDeltas=!1},this.$informUndoManager=A.delayedCall(this.$syncInformUndoManager)}else this.$syncInformUndoManager=function(){}},this.markUndoGroup=function(){this.$syncInformUndoManager&&this.$syncInformUndoManager()},this.$defaultUndoManager={undo:function(){},redo:function(){},hasUndo:function(){},hasRedo:function(){},reset:function(){},add:function(){},addSelection:function(){},startNewGroup:function(){},addSession:function(){}},this.getUndoManager=function(){return this.$undoManager||this.$defaultUndoManager},this.getTabString=function(){return this.getUseSoftTabs()?A.stringRepeat(" ",this.getTabSize()):"	"},this.setUseSoftTabs=function(s){this.setOption("useSoftTabs",s)},this.getUseSoftTabs=function(){return this.$useSoftTabs&&!this.$mode.$indentWithTabs},this.setTabSize=function(s){this.setOption("tabSize",s)},this.getTabSize=function(){return this.$tabSize},this.isTabStop=function(s){return this.$useSoftTabs&&s.column%this.$tabSize===0},this.setNavigateWithinSoftTabs=function(s){this.setOption("navigateWithinSoftTabs",s)},this.getNavigateWithinSoftTabs=function(){return this.$navigateWithinSoftTabs},this.$overwrite=!1,this.setOverwrite=function(s){this.setOption("overwrite",s)},this.getOverwrite=function(){return this.$overwrite},this.toggleOverwrite=function(){this.setOverwrite(!this.$overwrite)},this.addGutterDecoration=function(s,v){this.$decorations[s]||(this.$decorations[s]=""),this.$decorations[s]+=" "+v,this._signal("changeBreakpoint",{})},this.removeGutterDecoration=function(s,v){this.$decorations[s]=(this.$decorations[s]||"").replace(" "+v,""),this._signal("changeBreakpoint",{})},this.getBreakpoints=function(){return this.$breakpoints},this.setBreakpoints=function(s){this.$breakpoints=[];for(var v=0;v<s.length;v++)this.$breakpoints[s[v]]="ace_breakpoint";this._signal("changeBreakpoint",{})},this.clearBreakpoints=function(){this.$breakpoints=[],this._signal("changeBreakpoint",{})},this.setBreakpoint=function(s,v){v===void 0&&(v="ace_breakpoint"),v?this.$breakpoints[s]=v:delete this.$breakpoints[s],this._signal("changeBreakpoint",{})},this.clearBreakpoint=function(s){delete this.$breakpoints[s],this._signal("changeBreakpoint",{})},this.addMarker=function(s,v,$,x){var C=this.$markerId++,k={range:s,type:$||"line",renderer:typeof $=="function"?$:null,clazz:v,inFront:!!x,id:C};return x?(this.$frontMarkers[C]=k,this._signal("changeFrontMarker")):(this.$backMarkers[C]=k,this._signal("changeBackMarker")),C},this.addDynamicMarker=function(s,v){if(s.update){var $=this.$markerId++;return s.id=$,s.inFront=!!v,v?(this.$frontMarkers[$]=s,this._signal("changeFrontMarker")):(this.$backMarkers[$]=s,this._signal("changeBackMarker")),s}},this.removeMarker=function(s){var v=this.$frontMarkers[s]||this.$backMarkers[s];if(v){var $=v.inFront?this.$frontMarkers:this.$backMarkers;delete $[s],this._signal(v.inFront?"changeFrontMarker":"changeBackMarker")}},this.getMarkers=function(s){return s?this.$frontMarkers:this.$backMarkers},this.highlight=function(s){if(!this.$searchHighlight){var v=new o(null,"ace_selected-word","text");this.$searchHighlight=this.addDynamicMarker(v)}this.$searchHighlight.setRegexp(s)},this.highlightLines=function(s,v,$,x){typeof v!="number"&&($=v,v=s),$||($="ace_step");var C=new i(s,0,v,1/0);return C.id=this.addMarker(C,$,"fullLine",x),C},this.setAnnotations=function(s){this.$annotations=s,this._signal("changeAnnotation",{})},this.getAnnotations=function(){return this.$annotations||[]},this.clearAnnotations=function(){this.setAnnotations([])},this.$detectNewLine=function(s){var v=s.match(/^.*?(\r?\n)/m);v?this.$autoNewLine=v[1]:this.$autoNewLine=`
-`},this.getWordRange=function(s,v){var $=this.getLine(s),x=!1;if(v>0&&(x=!!$.charAt(v-1).match(this.tokenRe)),x||(x=!!$.charAt(v).match(this.tokenRe)),x)var C=this.tokenRe;else if(/^\s+$/.test($.slice(v-1,v+1)))var C=/\s/;else var C=this.nonTokenRe;var k=v;if(k>0){do k--;while(k>=0&&$.charAt(k).match(C));k++}for(var R=v;R<$.length&&$.charAt(R).match(C);)R++;return new i(s,k,s,R)},this.getAWordRange=function(s,v){for(var $=this.getWordRange(s,v),x=this.getLine($.end.row);x.charAt($.end.column).match(/[ \t]/);)$.end.column+=1;return $},this.setNewLineMode=function(s){this.doc.setNewLineMode(s)},this.getNewLineMode=function(){return this.doc.getNewLineMode()},this.setUseWorker=function(s){this.setOption("useWorker",s)},this.getUseWorker=function(){return this.$useWorker},this.onReloadTokenizer=function(s){var v=s.data;this.bgTokenizer.start(v.first),this._signal("tokenizerUpdate",s)},this.$modes=h.$modes,this.$mode=null,this.$modeId=null,this.setMode=function(s,v){if(s&&typeof s=="object"){if(s.getTokenizer)return this.$onChangeMode(s);var $=s,x=$.path}else x=s||"ace/mode/text";if(this.$modes["ace/mode/text"]||(this.$modes["ace/mode/text"]=new e),this.$modes[x]&&!$){this.$onChangeMode(this.$modes[x]),v&&v();return}this.$modeId=x,h.loadModule(["mode",x],function(C){if(this.$modeId!==x)return v&&v();this.$modes[x]&&!$?this.$onChangeMode(this.$modes[x]):C&&C.Mode&&(C=new C.Mode($),$||(this.$modes[x]=C,C.$id=x),this.$onChangeMode(C)),v&&v()}.bind(this)),this.$mode||this.$onChangeMode(this.$modes["ace/mode/text"],!0)},this.$onChangeMode=function(s,v){if(v||(this.$modeId=s.$id),this.$mode!==s){var $=this.$mode;this.$mode=s,this.$stopWorker(),this.$useWorker&&this.$startWorker();var x=s.getTokenizer();if(x.on!==void 0){var C=this.onReloadTokenizer.bind(this);x.on("update",C)}if(this.bgTokenizer)this.bgTokenizer.setTokenizer(x);else{this.bgTokenizer=new n(x);var k=this;this.bgTokenizer.on("update",function(R){k._signal("tokenizerUpdate",R)})}this.bgTokenizer.setDocument(this.getDocument()),this.tokenRe=s.tokenRe,this.nonTokenRe=s.nonTokenRe,v||(s.attachToSession&&s.attachToSession(this),this.$options.wrapMethod.set.call(this,this.$wrapMethod),this.$setFolding(s.foldingRules),this.bgTokenizer.start(0),this._emit("changeMode",{oldMode:$,mode:s}))}},this.$stopWorker=function(){this.$worker&&(this.$worker.terminate(),this.$worker=null)},this.$startWorker=function(){try{this.$worker=this.$mode.createWorker(this)}catch(s){h.warn("Could not load worker",s),this.$worker=null}},this.getMode=function(){return this.$mode},this.$scrollTop=0,this.setScrollTop=function(s){this.$scrollTop===s||isNaN(s)||(this.$scrollTop=s,this._signal("changeScrollTop",s))},this.getScrollTop=function(){return this.$scrollTop},this.$scrollLeft=0,this.setScrollLeft=function(s){this.$scrollLeft===s||isNaN(s)||(this.$scrollLeft=s,this._signal("changeScrollLeft",s))},this.getScrollLeft=function(){return this.$scrollLeft},this.getScreenWidth=function(){return this.$computeWidth(),this.lineWidgets?Math.max(this.getLineWidgetMaxWidth(),this.screenWidth):this.screenWidth},this.getLineWidgetMaxWidth=function(){if(this.lineWidgetsWidth!=null)return this.lineWidgetsWidth;var s=0;return this.lineWidgets.forEach(function(v){v&&v.screenWidth>s&&(s=v.screenWidth)}),this.lineWidgetWidth=s},this.$computeWidth=function(s){if(this.$modified||s){if(this.$modified=!1,this.$useWrapMode)return this.screenWidth=this.$wrapLimit;for(var v=this.doc.getAllLines(),$=this.$rowLengthCache,x=0,C=0,k=this.$foldData[C],R=k?k.start.row:1/0,_=v.length,O=0;O<_;O++){if(O>R){if(O=k.end.row+1,O>=_)break;k=this.$foldData[C++],R=k?k.start.row:1/0}$[O]==null&&($[O]=this.$getStringScreenWidth(v[O])[0]),$[O]>x&&(x=$[O])}this.screenWidth=x}},this.getLine=function(s){return this.doc.getLine(s)},this.getLines=function(s,v){return this.doc.getLines(s,v)},this.getLength=function(){return this.doc.getLength()},this.getTextRange=function(s){return this.doc.getTextRange(s||this.selection.getRange())},this.insert=function(s,v){return this.doc.insert(s,v)},this.remove=function(s){return this.doc.remove(s)},this.removeFullLines=function(s,v){return this.doc.removeFullLines(s,v)},this.undoChanges=function(s,v){if(s.length){this.$fromUndo=!0;for(var $=s.length-1;$!=-1;$--){var x=s[$];x.action=="insert"||x.action=="remove"?this.doc.revertDelta(x):x.folds&&this.addFolds(x.folds)}!v&&this.$undoSelect&&(s.selectionBefore?this.selection.fromJSON(s.selectionBefore):this.selection.setRange(this.$getUndoSelection(s,!0))),this.$fromUndo=!1}},this.redoChanges=function(s,v){if(s.length){this.$fromUndo=!0;for(var $=0;$<s.length;$++){var x=s[$];(x.action=="insert"||x.action=="remove")&&this.doc.$safeApplyDelta(x)}!v&&this.$undoSelect&&(s.selectionAfter?this.selection.fromJSON(s.selectionAfter):this.selection.setRange(this.$getUndoSelection(s,!1))),this.$fromUndo=!1}},this.setUndoSelect=function(s){this.$undoSelect=s},this.$getUndoSelection=function(s,v){function $(_){return v?_.action!=="insert":_.action==="insert"}for(var x,C,k=0;k<s.length;k++){var R=s[k];if(R.start){if(!x){$(R)?x=i.fromPoints(R.start,R.end):x=i.fromPoints(R.start,R.start);continue}$(R)?(C=R.start,x.compare(C.row,C.column)==-1&&x.setStart(C),C=R.end,x.compare(C.row,C.column)==1&&x.setEnd(C)):(C=R.start,x.compare(C.row,C.column)==-1&&(x=i.fromPoints(R.start,R.start)))}}return x},this.replace=function(s,v){return this.doc.replace(s,v)},this.moveText=function(s,v,$){var x=this.getTextRange(s),C=this.getFoldsInRange(s),k=i.fromPoints(v,v);if(!$){this.remove(s);var R=s.start.row-s.end.row,_=R?-s.end.column:s.start.column-s.end.column;_&&(k.start.row==s.end.row&&k.start.column>s.end.column&&(k.start.column+=_),k.end.row==s.end.row&&k.end.column>s.end.column&&(k.end.column+=_)),R&&k.start.row>=s.end.row&&(k.start.row+=R,k.end.row+=R)}if(k.end=this.insert(k.start,x),C.length){var O=s.start,I=k.start,R=I.row-O.row,_=I.column-O.column;this.addFolds(C.map(function(P){return P=P.clone(),P.start.row==O.row&&(P.start.column+=_),P.end.row==O.row&&(P.end.column+=_),P.start.row+=R,P.end.row+=R,P}))}return k},this.indentRows=function(s,v,$){$=$.replace(/\t/g,this.getTabString());for(var x=s;x<=v;x++)this.doc.insertInLine({row:x,column:0},$)},this.outdentRows=function(s){for(var v=s.collapseRows(),$=new i(0,0,0,0),x=this.getTabSize(),C=v.start.row;C<=v.end.row;++C){var k=this.getLine(C);$.start.row=C,$.end.row=C;for(var R=0;R<x&&k.charAt(R)==" ";++R);R<x&&k.charAt(R)=="	"?($.start.column=R,$.end.column=R+1):($.start.column=0,$.end.column=R),this.remove($)}},this.$moveLines=function(s,v,$){if(s=this.getRowFoldStart(s),v=this.getRowFoldEnd(v),$<0){var x=this.getRowFoldStart(s+$);if(x<0)return 0;var C=x-s}else if($>0){var x=this.getRowFoldEnd(v+$);if(x>this.doc.getLength()-1)return 0;var C=x-v}else{s=this.$clipRowToDocument(s),v=this.$clipRowToDocument(v);var C=v-s+1}var k=new i(s,0,v,Number.MAX_VALUE),R=this.getFoldsInRange(k).map(function(O){return O=O.clone(),O.start.row+=C,O.end.row+=C,O}),_=$==0?this.doc.getLines(s,v):this.doc.removeFullLines(s,v);return this.doc.insertFullLines(s+C,_),R.length&&this.addFolds(R),C},this.moveLinesUp=function(s,v){return this.$moveLines(s,v,-1)},this.moveLinesDown=function(s,v){return this.$moveLines(s,v,1)},this.duplicateLines=function(s,v){return this.$moveLines(s,v,0)},this.$clipRowToDocument=function(s){return Math.max(0,Math.min(s,this.doc.getLength()-1))},this.$clipColumnToRow=function(s,v){return v<0?0:Math.min(this.doc.getLine(s).length,v)},this.$clipPositionToDocument=function(s,v){if(v=Math.max(0,v),s<0)s=0,v=0;else{var $=this.doc.getLength();s>=$?(s=$-1,v=this.doc.getLine($-1).length):v=Math.min(this.doc.getLine(s).length,v)}return{row:s,column:v}},this.$clipRangeToDocument=function(s){s.start.row<0?(s.start.row=0,s.start.column=0):s.start.column=this.$clipColumnToRow(s.start.row,s.start.column);var v=this.doc.getLength()-1;return s.end.row>v?(s.end.row=v,s.end.column=this.doc.getLine(v).length):s.end.column=this.$clipColumnToRow(s.end.row,s.end.column),s},this.$wrapLimit=80,this.$useWrapMode=!1,this.$wrapLimitRange={min:null,max:null},this.setUseWrapMode=function(s){if(s!=this.$useWrapMode){if(this.$useWrapMode=s,this.$modified=!0,this.$resetRowCache(0),s){var v=this.getLength();this.$wrapData=Array(v),this.$updateWrapData(0,v-1)}this._signal("changeWrapMode")}},this.getUseWrapMode=function(){return this.$useWrapMode},this.setWrapLimitRange=function(s,v){(this.$wrapLimitRange.min!==s||this.$wrapLimitRange.max!==v)&&(this.$wrapLimitRange={min:s,max:v},this.$modified=!0,this.$bidiHandler.markAsDirty(),this.$useWrapMode&&this._signal("changeWrapMode"))},this.adjustWrapLimit=function(s,v){var $=this.$wrapLimitRange;$.max<0&&($={min:v,max:v});var x=this.$constrainWrapLimit(s,$.min,$.max);return x!=this.$wrapLimit&&x>1?(this.$wrapLimit=x,this.$modified=!0,this.$useWrapMode&&(this.$updateWrapData(0,this.getLength()-1),this.$resetRowCache(0),this._signal("changeWrapLimit")),!0):!1},this.$constrainWrapLimit=function(s,v,$){return v&&(s=Math.max(v,s)),$&&(s=Math.min($,s)),s},this.getWrapLimit=function(){return this.$wrapLimit},this.setWrapLimit=function(s){this.setWrapLimitRange(s,s)},this.getWrapLimitRange=function(){return{min:this.$wrapLimitRange.min,max:this.$wrapLimitRange.max}},this.$updateInternalDataOnChange=function(s){var v=this.$useWrapMode,$=s.action,x=s.start,C=s.end,k=x.row,R=C.row,_=R-k,O=null;if(this.$updating=!0,_!=0)if($==="remove"){this[v?"$wrapData":"$rowLengthCache"].splice(k,_);var I=this.$foldData;O=this.getFoldsInRange(s),this.removeFolds(O);var D=this.getFoldLine(C.row),T=0;if(D){D.addRemoveChars(C.row,C.column,x.column-C.column),D.shiftRow(-_);var P=this.getFoldLine(k);P&&P!==D&&(P.merge(D),D=P),T=I.indexOf(D)+1}for(T;T<I.length;T++){var D=I[T];D.start.row>=C.row&&D.shiftRow(-_)}R=k}else{var M=Array(_);M.unshift(k,0);var W=v?this.$wrapData:this.$rowLengthCache;W.splice.apply(W,M);var I=this.$foldData,D=this.getFoldLine(k),T=0;if(D){var N=D.range.compareInside(x.row,x.column);N==0?(D=D.split(x.row,x.column),D&&(D.shiftRow(_),D.addRemoveChars(R,0,C.column-x.column))):N==-1&&(D.addRemoveChars(k,0,C.column-x.column),D.shiftRow(_)),T=I.indexOf(D)+1}for(T;T<I.length;T++){var D=I[T];D.start.row>=k&&D.shiftRow(_)}}else{_=Math.abs(s.start.column-s.end.column),$==="remove"&&(O=this.getFoldsInRange(s),this.removeFolds(O),_=-_);var D=this.getFoldLine(k);D&&D.addRemoveChars(k,x.column,_)}return v&&this.$wrapData.length!=this.doc.getLength()&&console.error("doc.getLength() and $wrapData.length have to be the same!"),this.$updating=!1,v?this.$updateWrapData(k,R):this.$updateRowLengthCache(k,R),O},this.$updateRowLengthCache=function(s,v,$){this.$rowLengthCache[s]=null,this.$rowLengthCache[v]=null},this.$updateWrapData=function(s,v){var $=this.doc.getAllLines(),x=this.getTabSize(),C=this.$wrapData,k=this.$wrapLimit,R,_,O=s;for(v=Math.min(v,$.length-1);O<=v;)_=this.getFoldLine(O,_),_?(R=[],_.walk(function(I,D,T,P){var M;if(I!=null){M=this.$getDisplayTokens(I,R.length),M[0]=y;for(var W=1;W<M.length;W++)M[W]=c}else M=this.$getDisplayTokens($[D].substring(P,T),R.length);R=R.concat(M)}.bind(this),_.end.row,$[_.end.row].length+1),C[_.start.row]=this.$computeWrapSplits(R,k,x),O=_.end.row+1):(R=this.$getDisplayTokens($[O]),C[O]=this.$computeWrapSplits(R,k,x),O++)};var w=1,S=2,y=3,c=4,l=9,g=10,d=11,a=12;this.$computeWrapSplits=function(s,v,$){if(s.length==0)return[];var x=[],C=s.length,k=0,R=0,_=this.$wrapAsCode,O=this.$indentedSoftWrap,I=v<=Math.max(2*$,8)||O===!1?0:Math.floor(v/2);function D(){var N=0;if(I===0)return N;if(O)for(var G=0;G<s.length;G++){var z=s[G];if(z==g)N+=1;else if(z==d)N+=$;else{if(z==a)continue;break}}return _&&O!==!1&&(N+=$),Math.min(N,I)}function T(N){for(var G=N-k,z=k;z<N;z++){var J=s[z];(J===12||J===2)&&(G-=1)}x.length||(P=D(),x.indent=P),R+=G,x.push(R),k=N}for(var P=0;C-k>v-P;){var M=k+v-P;if(s[M-1]>=g&&s[M]>=g){T(M);continue}if(s[M]==y||s[M]==c){for(M;M!=k-1&&s[M]!=y;M--);if(M>k){T(M);continue}for(M=k+v,M;M<s.length&&s[M]==c;M++);if(M==s.length)break;T(M);continue}for(var W=Math.max(M-(v-(v>>2)),k-1);M>W&&s[M]<y;)M--;if(_){for(;M>W&&s[M]<y;)M--;for(;M>W&&s[M]==l;)M--}else for(;M>W&&s[M]<g;)M--;if(M>W){T(++M);continue}M=k+v,s[M]==S&&M--,T(M-P)}return x},this.$getDisplayTokens=function(s,v){var $=[],x;v=v||0;for(var C=0;C<s.length;C++){var k=s.charCodeAt(C);if(k==9){x=this.getScreenTabSize($.length+v),$.push(d);for(var R=1;R<x;R++)$.push(a)}else k==32?$.push(g):k>39&&k<48||k>57&&k<64?$.push(l):k>=4352&&f(k)?$.push(w,S):$.push(w)}return $},this.$getStringScreenWidth=function(s,v,$){if(v==0)return[0,0];v==null&&(v=1/0),$=$||0;var x,C;for(C=0;C<s.length&&(x=s.charCodeAt(C),x==9?$+=this.getScreenTabSize($):x>=4352&&f(x)?$+=2:$+=1,!($>v));C++);return[$,C]},this.lineWidgets=null,this.getRowLength=function(s){var v=1;return this.lineWidgets&&(v+=this.lineWidgets[s]&&this.lineWidgets[s].rowCount||0),!this.$useWrapMode||!this.$wrapData[s]?v:this.$wrapData[s].length+v},this.getRowLineCount=function(s){return!this.$useWrapMode||!this.$wrapData[s]?1:this.$wrapData[s].length+1},this.getRowWrapIndent=function(s){if(this.$useWrapMode){var v=this.screenToDocumentPosition(s,Number.MAX_VALUE),$=this.$wrapData[v.row];return $.length&&$[0]<v.column?$.indent:0}else return 0},this.getScreenLastRowColumn=function(s){var v=this.screenToDocumentPosition(s,Number.MAX_VALUE);return this.documentToScreenColumn(v.row,v.column)},this.getDocumentLastRowColumn=function(s,v){var $=this.documentToScreenRow(s,v);return this.getScreenLastRowColumn($)},this.getDocumentLastRowColumnPosition=function(s,v){var $=this.documentToScreenRow(s,v);return this.screenToDocumentPosition($,Number.MAX_VALUE/10)},this.getRowSplitData=function(s){if(this.$useWrapMode)return this.$wrapData[s]},this.getScreenTabSize=function(s){return this.$tabSize-(s%this.$tabSize|0)},this.screenToDocumentRow=function(s,v){return this.screenToDocumentPosition(s,v).row},this.screenToDocumentColumn=function(s,v){return this.screenToDocumentPosition(s,v).column},this.screenToDocumentPosition=function(s,v,$){if(s<0)return{row:0,column:0};var x,C=0,k=0,R,_=0,O=0,I=this.$screenRowCache,D=this.$getRowCacheIndex(I,s),T=I.length;if(T&&D>=0)var _=I[D],C=this.$docRowCache[D],P=s>I[T-1];else var P=!T;for(var M=this.getLength()-1,W=this.getNextFoldLine(C),N=W?W.start.row:1/0;_<=s&&(O=this.getRowLength(C),!(_+O>s||C>=M));)_+=O,C++,C>N&&(C=W.end.row+1,W=this.getNextFoldLine(C,W),N=W?W.start.row:1/0),P&&(this.$docRowCache.push(C),this.$screenRowCache.push(_));if(W&&W.start.row<=C)x=this.getFoldDisplayLine(W),C=W.start.row;else{if(_+O<=s||C>M)return{row:M,column:this.getLine(M).length};x=this.getLine(C),W=null}var G=0,z=Math.floor(s-_);if(this.$useWrapMode){var J=this.$wrapData[C];J&&(R=J[z],z>0&&J.length&&(G=J.indent,k=J[z-1]||J[J.length-1],x=x.substring(k)))}return $!==void 0&&this.$bidiHandler.isBidiRow(_+z,C,z)&&(v=this.$bidiHandler.offsetToCol($)),k+=this.$getStringScreenWidth(x,v-G)[1],this.$useWrapMode&&k>=R&&(k=R-1),W?W.idxToPosition(k):{row:C,column:k}},this.documentToScreenPosition=function(s,v){if(typeof v>"u")var $=this.$clipPositionToDocument(s.row,s.column);else $=this.$clipPositionToDocument(s,v);s=$.row,v=$.column;var x=0,C=null,k=null;k=this.getFoldAt(s,v,1),k&&(s=k.start.row,v=k.start.column);var R,_=0,O=this.$docRowCache,I=this.$getRowCacheIndex(O,s),D=O.length;if(D&&I>=0)var _=O[I],x=this.$screenRowCache[I],T=s>O[D-1];else var T=!D;for(var P=this.getNextFoldLine(_),M=P?P.start.row:1/0;_<s;){if(_>=M){if(R=P.end.row+1,R>s)break;P=this.getNextFoldLine(R,P),M=P?P.start.row:1/0}else R=_+1;x+=this.getRowLength(_),_=R,T&&(this.$docRowCache.push(_),this.$screenRowCache.push(x))}var W="";P&&_>=M?(W=this.getFoldDisplayLine(P,s,v),C=P.start.row):(W=this.getLine(s).substring(0,v),C=s);var N=0;if(this.$useWrapMode){var G=this.$wrapData[C];if(G){for(var z=0;W.length>=G[z];)x++,z++;W=W.substring(G[z-1]||0,W.length),N=z>0?G.indent:0}}return this.lineWidgets&&this.lineWidgets[_]&&this.lineWidgets[_].rowsAbove&&(x+=this.lineWidgets[_].rowsAbove),{row:x,column:N+this.$getStringScreenWidth(W)[0]}},this.documentToScreenColumn=function(s,v){return this.documentToScreenPosition(s,v).column},this.documentToScreenRow=function(s,v){return this.documentToScreenPosition(s,v).row},this.getScreenLength=function(){var s=0,v=null;if(this.$useWrapMode)for(var C=this.$wrapData.length,k=0,x=0,v=this.$foldData[x++],R=v?v.start.row:1/0;k<C;){var _=this.$wrapData[k];s+=_?_.length+1:1,k++,k>R&&(k=v.end.row+1,v=this.$foldData[x++],R=v?v.start.row:1/0)}else{s=this.getLength();for(var $=this.$foldData,x=0;x<$.length;x++)v=$[x],s-=v.end.row-v.start.row}return this.lineWidgets&&(s+=this.$getWidgetScreenLength()),s},this.$setFontMetrics=function(s){this.$enableVarChar&&(this.$getStringScreenWidth=function(v,$,x){if($===0)return[0,0];$||($=1/0),x=x||0;var C,k;for(k=0;k<v.length&&(C=v.charAt(k),C==="	"?x+=this.getScreenTabSize(x):x+=s.getCharacterWidth(C),!(x>$));k++);return[x,k]})},this.destroy=function(){this.bgTokenizer&&(this.bgTokenizer.setDocument(null),this.bgTokenizer=null),this.$stopWorker(),this.removeAllListeners(),this.selection.detach()},this.isFullWidth=f;function f(s){return s<4352?!1:s>=4352&&s<=4447||s>=4515&&s<=4519||s>=4602&&s<=4607||s>=9001&&s<=9002||s>=11904&&s<=11929||s>=11931&&s<=12019||s>=12032&&s<=12245||s>=12272&&s<=12283||s>=12288&&s<=12350||s>=12353&&s<=12438||s>=12441&&s<=12543||s>=12549&&s<=12589||s>=12593&&s<=12686||s>=12688&&s<=12730||s>=12736&&s<=12771||s>=12784&&s<=12830||s>=12832&&s<=12871||s>=12880&&s<=13054||s>=13056&&s<=19903||s>=19968&&s<=42124||s>=42128&&s<=42182||s>=43360&&s<=43388||s>=44032&&s<=55203||s>=55216&&s<=55238||s>=55243&&s<=55291||s>=63744&&s<=64255||s>=65040&&s<=65049||s>=65072&&s<=65106||s>=65108&&s<=65126||s>=65128&&s<=65131||s>=65281&&s<=65376||s>=65504&&s<=65510}}.call(m.prototype),L("./edit_session/folding").Folding.call(m.prototype),L("./edit_session/bracket_match").BracketMatch.call(m.prototype),h.defineOptions(m.prototype,"session",{wrap:{set:function(w){if(!w||w=="off"?w=!1:w=="free"?w=!0:w=="printMargin"?w=-1:typeof w=="string"&&(w=parseInt(w,10)||!1),this.$wrap!=w)if(this.$wrap=w,!w)this.setUseWrapMode(!1);else{var S=typeof w=="number"?w:null;this.setWrapLimitRange(S,S),this.setUseWrapMode(!0)}},get:function(){return this.getUseWrapMode()?this.$wrap==-1?"printMargin":this.getWrapLimitRange().min?this.$wrap:"free":"off"},handlesSet:!0},wrapMethod:{set:function(w){w=w=="auto"?this.$mode.type!="text":w!="text",w!=this.$wrapAsCode&&(this.$wrapAsCode=w,this.$useWrapMode&&(this.$useWrapMode=!1,this.setUseWrapMode(!0)))},initialValue:"auto"},indentedSoftWrap:{set:function(){this.$useWrapMode&&(this.$useWrapMode=!1,this.setUseWrapMode(!0))},initialValue:!0},firstLineNumber:{set:function(){this._signal("changeBreakpoint")},initialValue:1},useWorker:{set:function(w){this.$useWorker=w,this.$stopWorker(),w&&this.$startWorker()},initialValue:!0},useSoftTabs:{initialValue:!0},tabSize:{set:function(w){w=parseInt(w),w>0&&this.$tabSize!==w&&(this.$modified=!0,this.$rowLengthCache=[],this.$tabSize=w,this._signal("changeTabSize"))},initialValue:4,handlesSet:!0},navigateWithinSoftTabs:{initialValue:!1},foldStyle:{set:function(w){this.setFoldStyle(w)},handlesSet:!0},overwrite:{set:function(w){this._signal("changeOverwrite")},initialValue:!1},newLineMode:{set:function(w){this.doc.setNewLineMode(w)},get:function(){return this.doc.getNewLineMode()},handlesSet:!0},mode:{set:function(w){this.setMode(w)},get:function(){return this.$modeId},handlesSet:!0}}),b.EditSession=m}),define("ace/search",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/range"],function(L,b,B){"use strict";var E=L("./lib/lang"),A=L("./lib/oop"),p=L("./range").Range,h=function(){this.$options={}};(function(){this.set=function(t){return A.mixin(this.$options,t),this},this.getOptions=function(){return E.copyObject(this.$options)},this.setOptions=function(t){this.$options=t},this.find=function(t){var e=this.$options,i=this.$matchIterator(t,e);if(!i)return!1;var r=null;return i.forEach(function(n,o,m,w){return r=new p(n,o,m,w),o==w&&e.start&&e.start.start&&e.skipCurrent!=!1&&r.isEqual(e.start)?(r=null,!1):!0}),r},this.findAll=function(t){var e=this.$options;if(!e.needle)return[];this.$assembleRegExp(e);var i=e.range,r=i?t.getLines(i.start.row,i.end.row):t.doc.getAllLines(),n=[],o=e.re;if(e.$isMultiLine){var m=o.length,w=r.length-m,S;e:for(var y=o.offset||0;y<=w;y++){for(var c=0;c<m;c++)if(r[y+c].search(o[c])==-1)continue e;var l=r[y],g=r[y+m-1],d=l.length-l.match(o[0])[0].length,a=g.match(o[m-1])[0].length;S&&S.end.row===y&&S.end.column>d||(n.push(S=new p(y,d,y+m-1,a)),m>2&&(y=y+m-2))}}else for(var f=0;f<r.length;f++)for(var s=E.getMatchOffsets(r[f],o),c=0;c<s.length;c++){var v=s[c];n.push(new p(f,v.offset,f,v.offset+v.length))}if(i){for(var $=i.start.column,x=i.start.column,f=0,c=n.length-1;f<c&&n[f].start.column<$&&n[f].start.row==i.start.row;)f++;for(;f<c&&n[c].end.column>x&&n[c].end.row==i.end.row;)c--;for(n=n.slice(f,c+1),f=0,c=n.length;f<c;f++)n[f].start.row+=i.start.row,n[f].end.row+=i.start.row}return n},this.replace=function(t,e){var i=this.$options,r=this.$assembleRegExp(i);if(i.$isMultiLine)return e;if(r){var n=r.exec(t);if(!n||n[0].length!=t.length)return null;if(e=t.replace(r,e),i.preserveCase){e=e.split("");for(var o=Math.min(t.length,t.length);o--;){var m=t[o];m&&m.toLowerCase()!=m?e[o]=e[o].toUpperCase():e[o]=e[o].toLowerCase()}e=e.join("")}return e}},this.$assembleRegExp=function(t,e){if(t.needle instanceof RegExp)return t.re=t.needle;var i=t.needle;if(!t.needle)return t.re=!1;t.regExp||(i=E.escapeRegExp(i)),t.wholeWord&&(i=u(i,t));var r=t.caseSensitive?"gm":"gmi";if(t.$isMultiLine=!e&&/[\n\r]/.test(i),t.$isMultiLine)return t.re=this.$assembleMultilineRegExp(i,r);try{var n=new RegExp(i,r)}catch{n=!1}return t.re=n},this.$assembleMultilineRegExp=function(t,e){for(var i=t.replace(/\r\n|\r|\n/g,`$
+`},this.getLine=function(e){return this.$lines[e]||""},this.getLines=function(e,i){return this.$lines.slice(e,i+1)},this.getAllLines=function(){return this.getLines(0,this.getLength())},this.getLength=function(){return this.$lines.length},this.getTextRange=function(e){return this.getLinesForRange(e).join(this.getNewLineCharacter())},this.getLinesForRange=function(e){var i;if(e.start.row===e.end.row)i=[this.getLine(e.start.row).substring(e.start.column,e.end.column)];else{i=this.getLines(e.start.row,e.end.row),i[0]=(i[0]||"").substring(e.start.column);var r=i.length-1;e.end.row-e.start.row==r&&(i[r]=i[r].substring(0,e.end.column))}return i},this.insertLines=function(e,i){return console.warn("Use of document.insertLines is deprecated. Use the insertFullLines method instead."),this.insertFullLines(e,i)},this.removeLines=function(e,i){return console.warn("Use of document.removeLines is deprecated. Use the removeFullLines method instead."),this.removeFullLines(e,i)},this.insertNewLine=function(e){return console.warn("Use of document.insertNewLine is deprecated. Use insertMergedLines(position, ['', '']) instead."),this.insertMergedLines(e,["",""])},this.insert=function(e,i){return this.getLength()<=1&&this.$detectNewLine(i),this.insertMergedLines(e,this.$split(i))},this.insertInLine=function(e,i){var r=this.clippedPos(e.row,e.column),n=this.pos(e.row,e.column+i.length);return this.applyDelta({start:r,end:n,action:"insert",lines:[i]},!0),this.clonePos(n)},this.clippedPos=function(e,i){var r=this.getLength();e===void 0?e=r:e<0?e=0:e>=r&&(e=r-1,i=void 0);var n=this.getLine(e);return i==null&&(i=n.length),i=Math.min(Math.max(i,0),n.length),{row:e,column:i}},this.clonePos=function(e){return{row:e.row,column:e.column}},this.pos=function(e,i){return{row:e,column:i}},this.$clipPosition=function(e){var i=this.getLength();return e.row>=i?(e.row=Math.max(0,i-1),e.column=this.getLine(i-1).length):(e.row=Math.max(0,e.row),e.column=Math.min(Math.max(e.column,0),this.getLine(e.row).length)),e},this.insertFullLines=function(e,i){e=Math.min(Math.max(e,0),this.getLength());var r=0;e<this.getLength()?(i=i.concat([""]),r=0):(i=[""].concat(i),e--,r=this.$lines[e].length),this.insertMergedLines({row:e,column:r},i)},this.insertMergedLines=function(e,i){var r=this.clippedPos(e.row,e.column),n={row:r.row+i.length-1,column:(i.length==1?r.column:0)+i[i.length-1].length};return this.applyDelta({start:r,end:n,action:"insert",lines:i}),this.clonePos(n)},this.remove=function(e){var i=this.clippedPos(e.start.row,e.start.column),r=this.clippedPos(e.end.row,e.end.column);return this.applyDelta({start:i,end:r,action:"remove",lines:this.getLinesForRange({start:i,end:r})}),this.clonePos(i)},this.removeInLine=function(e,i,r){var n=this.clippedPos(e,i),a=this.clippedPos(e,r);return this.applyDelta({start:n,end:a,action:"remove",lines:this.getLinesForRange({start:n,end:a})},!0),this.clonePos(n)},this.removeFullLines=function(e,i){e=Math.min(Math.max(0,e),this.getLength()-1),i=Math.min(Math.max(0,i),this.getLength()-1);var r=i==this.getLength()-1&&e>0,n=i<this.getLength()-1,a=r?e-1:e,m=r?this.getLine(a).length:0,w=n?i+1:i,S=n?0:this.getLine(w).length,b=new h(a,m,w,S),c=this.$lines.slice(e,i+1);return this.applyDelta({start:b.start,end:b.end,action:"remove",lines:this.getLinesForRange(b)}),c},this.removeNewLine=function(e){e<this.getLength()-1&&e>=0&&this.applyDelta({start:this.pos(e,this.getLine(e).length),end:this.pos(e+1,0),action:"remove",lines:["",""]})},this.replace=function(e,i){if(e instanceof h||(e=h.fromPoints(e.start,e.end)),i.length===0&&e.isEmpty())return e.start;if(i==this.getTextRange(e))return e.end;this.remove(e);var r;return i?r=this.insert(e.start,i):r=e.start,r},this.applyDeltas=function(e){for(var i=0;i<e.length;i++)this.applyDelta(e[i])},this.revertDeltas=function(e){for(var i=e.length-1;i>=0;i--)this.revertDelta(e[i])},this.applyDelta=function(e,i){var r=e.action=="insert";(r?e.lines.length<=1&&!e.lines[0]:!h.comparePoints(e.start,e.end))||(r&&e.lines.length>2e4?this.$splitAndapplyLargeDelta(e,2e4):(A(this.$lines,e,i),this._signal("change",e)))},this.$safeApplyDelta=function(e){var i=this.$lines.length;(e.action=="remove"&&e.start.row<i&&e.end.row<i||e.action=="insert"&&e.start.row<=i)&&this.applyDelta(e)},this.$splitAndapplyLargeDelta=function(e,i){for(var r=e.lines,n=r.length-i+1,a=e.start.row,m=e.start.column,w=0,S=0;w<n;w=S){S+=i-1;var b=r.slice(w,S);b.push(""),this.applyDelta({start:this.pos(a+w,m),end:this.pos(a+S,m=0),action:e.action,lines:b},!0)}e.lines=r.slice(w),e.start.row=a+w,e.start.column=m,this.applyDelta(e,!0)},this.revertDelta=function(e){this.$safeApplyDelta({start:this.clonePos(e.start),end:this.clonePos(e.end),action:e.action=="insert"?"remove":"insert",lines:e.lines.slice()})},this.indexToPosition=function(e,i){for(var r=this.$lines||this.getAllLines(),n=this.getNewLineCharacter().length,a=i||0,m=r.length;a<m;a++)if(e-=r[a].length+n,e<0)return{row:a,column:e+r[a].length+n};return{row:m-1,column:e+r[m-1].length+n}},this.positionToIndex=function(e,i){for(var r=this.$lines||this.getAllLines(),n=this.getNewLineCharacter().length,a=0,m=Math.min(e.row,r.length),w=i||0;w<m;++w)a+=r[w].length+n;return a+e.column}}).call(t.prototype),y.Document=t}),define("ace/background_tokenizer",["require","exports","module","ace/lib/oop","ace/lib/event_emitter"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/event_emitter").EventEmitter,p=function(h,d){this.running=!1,this.lines=[],this.states=[],this.currentLine=0,this.tokenizer=h;var t=this;this.$worker=function(){if(t.running){for(var e=new Date,i=t.currentLine,r=-1,n=t.doc,a=i;t.lines[i];)i++;var m=n.getLength(),w=0;for(t.running=!1;i<m;){t.$tokenizeRow(i),r=i;do i++;while(t.lines[i]);if(w++,w%5===0&&new Date-e>20){t.running=setTimeout(t.$worker,20);break}}t.currentLine=i,r==-1&&(r=i),a<=r&&t.fireUpdateEvent(a,r)}}};(function(){E.implement(this,A),this.setTokenizer=function(h){this.tokenizer=h,this.lines=[],this.states=[],this.start(0)},this.setDocument=function(h){this.doc=h,this.lines=[],this.states=[],this.stop()},this.fireUpdateEvent=function(h,d){var t={first:h,last:d};this._signal("update",{data:t})},this.start=function(h){this.currentLine=Math.min(h||0,this.currentLine,this.doc.getLength()),this.lines.splice(this.currentLine,this.lines.length),this.states.splice(this.currentLine,this.states.length),this.stop(),this.running=setTimeout(this.$worker,700)},this.scheduleStart=function(){this.running||(this.running=setTimeout(this.$worker,700))},this.$updateOnChange=function(h){var d=h.start.row,t=h.end.row-d;if(t===0)this.lines[d]=null;else if(h.action=="remove")this.lines.splice(d,t+1,null),this.states.splice(d,t+1,null);else{var e=Array(t+1);e.unshift(d,1),this.lines.splice.apply(this.lines,e),this.states.splice.apply(this.states,e)}this.currentLine=Math.min(d,this.currentLine,this.doc.getLength()),this.stop()},this.stop=function(){this.running&&clearTimeout(this.running),this.running=!1},this.getTokens=function(h){return this.lines[h]||this.$tokenizeRow(h)},this.getState=function(h){return this.currentLine==h&&this.$tokenizeRow(h),this.states[h]||"start"},this.$tokenizeRow=function(h){var d=this.doc.getLine(h),t=this.states[h-1],e=this.tokenizer.getLineTokens(d,t,h);return this.states[h]+""!=e.state+""?(this.states[h]=e.state,this.lines[h+1]=null,this.currentLine>h+1&&(this.currentLine=h+1)):this.currentLine==h&&(this.currentLine=h+1),this.lines[h]=e.tokens}}).call(p.prototype),y.BackgroundTokenizer=p}),define("ace/search_highlight",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/range"],function(k,y,B){"use strict";var E=k("./lib/lang"),A=k("./lib/oop"),p=k("./range").Range,h=function(d,t,e){this.setRegexp(d),this.clazz=t,this.type=e||"text"};(function(){this.MAX_RANGES=500,this.setRegexp=function(d){this.regExp+""!=d+""&&(this.regExp=d,this.cache=[])},this.update=function(d,t,e,i){if(this.regExp)for(var r=i.firstRow,n=i.lastRow,a=r;a<=n;a++){var m=this.cache[a];m==null&&(m=E.getMatchOffsets(e.getLine(a),this.regExp),m.length>this.MAX_RANGES&&(m=m.slice(0,this.MAX_RANGES)),m=m.map(function(S){return new p(a,S.offset,a,S.offset+S.length)}),this.cache[a]=m.length?m:"");for(var w=m.length;w--;)t.drawSingleLineMarker(d,m[w].toScreenRange(e),this.clazz,i)}}}).call(h.prototype),y.SearchHighlight=h}),define("ace/edit_session/fold_line",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=k("../range").Range;function A(p,h){this.foldData=p,Array.isArray(h)?this.folds=h:h=this.folds=[h];var d=h[h.length-1];this.range=new E(h[0].start.row,h[0].start.column,d.end.row,d.end.column),this.start=this.range.start,this.end=this.range.end,this.folds.forEach(function(t){t.setFoldLine(this)},this)}(function(){this.shiftRow=function(p){this.start.row+=p,this.end.row+=p,this.folds.forEach(function(h){h.start.row+=p,h.end.row+=p})},this.addFold=function(p){if(p.sameRow){if(p.start.row<this.startRow||p.endRow>this.endRow)throw new Error("Can't add a fold to this FoldLine as it has no connection");this.folds.push(p),this.folds.sort(function(h,d){return-h.range.compareEnd(d.start.row,d.start.column)}),this.range.compareEnd(p.start.row,p.start.column)>0?(this.end.row=p.end.row,this.end.column=p.end.column):this.range.compareStart(p.end.row,p.end.column)<0&&(this.start.row=p.start.row,this.start.column=p.start.column)}else if(p.start.row==this.end.row)this.folds.push(p),this.end.row=p.end.row,this.end.column=p.end.column;else if(p.end.row==this.start.row)this.folds.unshift(p),this.start.row=p.start.row,this.start.column=p.start.column;else throw new Error("Trying to add fold to FoldRow that doesn't have a matching row");p.foldLine=this},this.containsRow=function(p){return p>=this.start.row&&p<=this.end.row},this.walk=function(p,h,d){var t=0,e=this.folds,i,r,n,a=!0;h==null&&(h=this.end.row,d=this.end.column);for(var m=0;m<e.length;m++){if(i=e[m],r=i.range.compareStart(h,d),r==-1){p(null,h,d,t,a);return}if(n=p(null,i.start.row,i.start.column,t,a),n=!n&&p(i.placeholder,i.start.row,i.start.column,t),n||r===0)return;a=!i.sameRow,t=i.end.column}p(null,h,d,t,a)},this.getNextFoldTo=function(p,h){for(var d,t,e=0;e<this.folds.length;e++){if(d=this.folds[e],t=d.range.compareEnd(p,h),t==-1)return{fold:d,kind:"after"};if(t===0)return{fold:d,kind:"inside"}}return null},this.addRemoveChars=function(p,h,d){var t=this.getNextFoldTo(p,h),e,i;if(t){if(e=t.fold,t.kind=="inside"&&e.start.column!=h&&e.start.row!=p)window.console&&window.console.log(p,h,e);else if(e.start.row==p){i=this.folds;var r=i.indexOf(e);for(r===0&&(this.start.column+=d),r;r<i.length;r++){if(e=i[r],e.start.column+=d,!e.sameRow)return;e.end.column+=d}this.end.column+=d}}},this.split=function(p,h){var d=this.getNextFoldTo(p,h);if(!d||d.kind=="inside")return null;var t=d.fold,e=this.folds,i=this.foldData,r=e.indexOf(t),n=e[r-1];this.end.row=n.end.row,this.end.column=n.end.column,e=e.splice(r,e.length-r);var a=new A(i,e);return i.splice(i.indexOf(this)+1,0,a),a},this.merge=function(p){for(var h=p.folds,d=0;d<h.length;d++)this.addFold(h[d]);var t=this.foldData;t.splice(t.indexOf(p),1)},this.toString=function(){var p=[this.range.toString()+": ["];return this.folds.forEach(function(h){p.push("  "+h.toString())}),p.push("]"),p.join(`
+`)},this.idxToPosition=function(p){for(var h=0,d=0;d<this.folds.length;d++){var t=this.folds[d];if(p-=t.start.column-h,p<0)return{row:t.start.row,column:t.start.column+p};if(p-=t.placeholder.length,p<0)return t.start;h=t.end.column}return{row:this.end.row,column:this.end.column+p}}}).call(A.prototype),y.FoldLine=A}),define("ace/range_list",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=k("./range").Range,A=E.comparePoints,p=function(){this.ranges=[],this.$bias=1};(function(){this.comparePoints=A,this.pointIndex=function(h,d,t){for(var e=this.ranges,i=t||0;i<e.length;i++){var r=e[i],n=A(h,r.end);if(!(n>0)){var a=A(h,r.start);return n===0?d&&a!==0?-i-2:i:a>0||a===0&&!d?i:-i-1}}return-i-1},this.add=function(h){var d=!h.isEmpty(),t=this.pointIndex(h.start,d);t<0&&(t=-t-1);var e=this.pointIndex(h.end,d,t);return e<0?e=-e-1:e++,this.ranges.splice(t,e-t,h)},this.addList=function(h){for(var d=[],t=h.length;t--;)d.push.apply(d,this.add(h[t]));return d},this.substractPoint=function(h){var d=this.pointIndex(h);if(d>=0)return this.ranges.splice(d,1)},this.merge=function(){var h=[],d=this.ranges;d=d.sort(function(n,a){return A(n.start,a.start)});for(var t=d[0],e,i=1;i<d.length;i++){e=t,t=d[i];var r=A(e.end,t.start);r<0||r==0&&!e.isEmpty()&&!t.isEmpty()||(A(e.end,t.end)<0&&(e.end.row=t.end.row,e.end.column=t.end.column),d.splice(i,1),h.push(t),t=e,i--)}return this.ranges=d,h},this.contains=function(h,d){return this.pointIndex({row:h,column:d})>=0},this.containsPoint=function(h){return this.pointIndex(h)>=0},this.rangeAtPoint=function(h){var d=this.pointIndex(h);if(d>=0)return this.ranges[d]},this.clipRows=function(h,d){var t=this.ranges;if(t[0].start.row>d||t[t.length-1].start.row<h)return[];var e=this.pointIndex({row:h,column:0});e<0&&(e=-e-1);var i=this.pointIndex({row:d,column:0},e);i<0&&(i=-i-1);for(var r=[],n=e;n<i;n++)r.push(t[n]);return r},this.removeAll=function(){return this.ranges.splice(0,this.ranges.length)},this.attach=function(h){this.session&&this.detach(),this.session=h,this.onChange=this.$onChange.bind(this),this.session.on("change",this.onChange)},this.detach=function(){this.session&&(this.session.removeListener("change",this.onChange),this.session=null)},this.$onChange=function(h){for(var d=h.start,t=h.end,e=d.row,i=t.row,r=this.ranges,n=0,a=r.length;n<a;n++){var m=r[n];if(m.end.row>=e)break}if(h.action=="insert")for(var w=i-e,S=-d.column+t.column;n<a;n++){var m=r[n];if(m.start.row>e)break;if(m.start.row==e&&m.start.column>=d.column&&(m.start.column==d.column&&this.$bias<=0||(m.start.column+=S,m.start.row+=w)),m.end.row==e&&m.end.column>=d.column){if(m.end.column==d.column&&this.$bias<0)continue;m.end.column==d.column&&S>0&&n<a-1&&m.end.column>m.start.column&&m.end.column==r[n+1].start.column&&(m.end.column-=S),m.end.column+=S,m.end.row+=w}}else for(var w=e-i,S=d.column-t.column;n<a;n++){var m=r[n];if(m.start.row>i)break;m.end.row<i&&(e<m.end.row||e==m.end.row&&d.column<m.end.column)?(m.end.row=e,m.end.column=d.column):m.end.row==i?m.end.column<=t.column?(w||m.end.column>d.column)&&(m.end.column=d.column,m.end.row=d.row):(m.end.column+=S,m.end.row+=w):m.end.row>i&&(m.end.row+=w),m.start.row<i&&(e<m.start.row||e==m.start.row&&d.column<m.start.column)?(m.start.row=e,m.start.column=d.column):m.start.row==i?m.start.column<=t.column?(w||m.start.column>d.column)&&(m.start.column=d.column,m.start.row=d.row):(m.start.column+=S,m.start.row+=w):m.start.row>i&&(m.start.row+=w)}if(w!=0&&n<a)for(;n<a;n++){var m=r[n];m.start.row+=w,m.end.row+=w}}}).call(p.prototype),y.RangeList=p}),define("ace/edit_session/fold",["require","exports","module","ace/range_list","ace/lib/oop"],function(k,y,B){"use strict";var E=k("../range_list").RangeList,A=k("../lib/oop"),p=y.Fold=function(i,r){this.foldLine=null,this.placeholder=r,this.range=i,this.start=i.start,this.end=i.end,this.sameRow=i.start.row==i.end.row,this.subFolds=this.ranges=[]};A.inherits(p,E),function(){this.toString=function(){return'"'+this.placeholder+'" '+this.range.toString()},this.setFoldLine=function(i){this.foldLine=i,this.subFolds.forEach(function(r){r.setFoldLine(i)})},this.clone=function(){var i=this.range.clone(),r=new p(i,this.placeholder);return this.subFolds.forEach(function(n){r.subFolds.push(n.clone())}),r.collapseChildren=this.collapseChildren,r},this.addSubFold=function(i){if(!this.range.isEqual(i)){d(i,this.start);for(var w=i.start.row,S=i.start.column,r=0,n=-1;r<this.subFolds.length&&(n=this.subFolds[r].range.compare(w,S),n==1);r++);var a=this.subFolds[r],m=0;if(n==0){if(a.range.containsRange(i))return a.addSubFold(i);m=1}for(var w=i.range.end.row,S=i.range.end.column,b=r,n=-1;b<this.subFolds.length&&(n=this.subFolds[b].range.compare(w,S),n==1);b++);n==0&&b++;for(var c=this.subFolds.splice(r,b-r,i),l=n==0?c.length-1:c.length,g=m;g<l;g++)i.addSubFold(c[g]);return i.setFoldLine(this.foldLine),i}},this.restoreRange=function(i){return e(i,this.start)}}.call(p.prototype);function h(i,r){i.row-=r.row,i.row==0&&(i.column-=r.column)}function d(i,r){h(i.start,r),h(i.end,r)}function t(i,r){i.row==0&&(i.column+=r.column),i.row+=r.row}function e(i,r){t(i.start,r),t(i.end,r)}}),define("ace/edit_session/folding",["require","exports","module","ace/range","ace/edit_session/fold_line","ace/edit_session/fold","ace/token_iterator"],function(k,y,B){"use strict";var E=k("../range").Range,A=k("./fold_line").FoldLine,p=k("./fold").Fold,h=k("../token_iterator").TokenIterator;function d(){this.getFoldAt=function(t,e,i){var r=this.getFoldLine(t);if(!r)return null;for(var n=r.folds,a=0;a<n.length;a++){var m=n[a].range;if(m.contains(t,e)){if(i==1&&m.isEnd(t,e)&&!m.isEmpty())continue;if(i==-1&&m.isStart(t,e)&&!m.isEmpty())continue;return n[a]}}},this.getFoldsInRange=function(t){var e=t.start,i=t.end,r=this.$foldData,n=[];e.column+=1,i.column-=1;for(var a=0;a<r.length;a++){var m=r[a].range.compareRange(t);if(m!=2){if(m==-2)break;for(var w=r[a].folds,S=0;S<w.length;S++){var b=w[S];if(m=b.range.compareRange(t),m==-2)break;if(m==2)continue;if(m==42)break;n.push(b)}}}return e.column-=1,i.column+=1,n},this.getFoldsInRangeList=function(t){if(Array.isArray(t)){var e=[];t.forEach(function(i){e=e.concat(this.getFoldsInRange(i))},this)}else var e=this.getFoldsInRange(t);return e},this.getAllFolds=function(){for(var t=[],e=this.$foldData,i=0;i<e.length;i++)for(var r=0;r<e[i].folds.length;r++)t.push(e[i].folds[r]);return t},this.getFoldStringAt=function(t,e,i,r){if(r=r||this.getFoldLine(t),!r)return null;for(var n={end:{column:0}},a,m,w=0;w<r.folds.length;w++){m=r.folds[w];var S=m.range.compareEnd(t,e);if(S==-1){a=this.getLine(m.start.row).substring(n.end.column,m.start.column);break}else if(S===0)return null;n=m}return a||(a=this.getLine(m.start.row).substring(n.end.column)),i==-1?a.substring(0,e-n.end.column):i==1?a.substring(e-n.end.column):a},this.getFoldLine=function(t,e){var i=this.$foldData,r=0;for(e&&(r=i.indexOf(e)),r==-1&&(r=0),r;r<i.length;r++){var n=i[r];if(n.start.row<=t&&n.end.row>=t)return n;if(n.end.row>t)return null}return null},this.getNextFoldLine=function(t,e){var i=this.$foldData,r=0;for(e&&(r=i.indexOf(e)),r==-1&&(r=0),r;r<i.length;r++){var n=i[r];if(n.end.row>=t)return n}return null},this.getFoldedRowCount=function(t,e){for(var i=this.$foldData,r=e-t+1,n=0;n<i.length;n++){var a=i[n],m=a.end.row,w=a.start.row;if(m>=e){w<e&&(w>=t?r-=e-w:r=0);break}else m>=t&&(w>=t?r-=m-w:r-=m-t+1)}return r},this.$addFoldLine=function(t){return this.$foldData.push(t),this.$foldData.sort(function(e,i){return e.start.row-i.start.row}),t},this.addFold=function(t,e){var i=this.$foldData,r=!1,n;t instanceof p?n=t:(n=new p(e,t),n.collapseChildren=e.collapseChildren),this.$clipRangeToDocument(n.range);var a=n.start.row,m=n.start.column,w=n.end.row,S=n.end.column,b=this.getFoldAt(a,m,1),c=this.getFoldAt(w,S,-1);if(b&&c==b)return b.addSubFold(n);b&&!b.range.isStart(a,m)&&this.removeFold(b),c&&!c.range.isEnd(w,S)&&this.removeFold(c);var l=this.getFoldsInRange(n.range);l.length>0&&(this.removeFolds(l),n.collapseChildren||l.forEach(function(f){n.addSubFold(f)}));for(var g=0;g<i.length;g++){var u=i[g];if(w==u.start.row){u.addFold(n),r=!0;break}else if(a==u.end.row){if(u.addFold(n),r=!0,!n.sameRow){var o=i[g+1];if(o&&o.start.row==w){u.merge(o);break}}break}else if(w<=u.start.row)break}return r||(u=this.$addFoldLine(new A(this.$foldData,n))),this.$useWrapMode?this.$updateWrapData(u.start.row,u.start.row):this.$updateRowLengthCache(u.start.row,u.start.row),this.$modified=!0,this._signal("changeFold",{data:n,action:"add"}),n},this.addFolds=function(t){t.forEach(function(e){this.addFold(e)},this)},this.removeFold=function(t){var e=t.foldLine,i=e.start.row,r=e.end.row,n=this.$foldData,a=e.folds;if(a.length==1)n.splice(n.indexOf(e),1);else if(e.range.isEnd(t.end.row,t.end.column))a.pop(),e.end.row=a[a.length-1].end.row,e.end.column=a[a.length-1].end.column;else if(e.range.isStart(t.start.row,t.start.column))a.shift(),e.start.row=a[0].start.row,e.start.column=a[0].start.column;else if(t.sameRow)a.splice(a.indexOf(t),1);else{var m=e.split(t.start.row,t.start.column);a=m.folds,a.shift(),m.start.row=a[0].start.row,m.start.column=a[0].start.column}this.$updating||(this.$useWrapMode?this.$updateWrapData(i,r):this.$updateRowLengthCache(i,r)),this.$modified=!0,this._signal("changeFold",{data:t,action:"remove"})},this.removeFolds=function(t){for(var e=[],i=0;i<t.length;i++)e.push(t[i]);e.forEach(function(r){this.removeFold(r)},this),this.$modified=!0},this.expandFold=function(t){this.removeFold(t),t.subFolds.forEach(function(e){t.restoreRange(e),this.addFold(e)},this),t.collapseChildren>0&&this.foldAll(t.start.row+1,t.end.row,t.collapseChildren-1),t.subFolds=[]},this.expandFolds=function(t){t.forEach(function(e){this.expandFold(e)},this)},this.unfold=function(t,e){var i,r;if(t==null?(i=new E(0,0,this.getLength(),0),e==null&&(e=!0)):typeof t=="number"?i=new E(t,0,t,this.getLine(t).length):"row"in t?i=E.fromPoints(t,t):i=t,r=this.getFoldsInRangeList(i),e!=!1?this.removeFolds(r):this.expandFolds(r),r.length)return r},this.isRowFolded=function(t,e){return!!this.getFoldLine(t,e)},this.getRowFoldEnd=function(t,e){var i=this.getFoldLine(t,e);return i?i.end.row:t},this.getRowFoldStart=function(t,e){var i=this.getFoldLine(t,e);return i?i.start.row:t},this.getFoldDisplayLine=function(t,e,i,r,n){r==null&&(r=t.start.row),n==null&&(n=0),e==null&&(e=t.end.row),i==null&&(i=this.getLine(e).length);var a=this.doc,m="";return t.walk(function(w,S,b,c){if(!(S<r)){if(S==r){if(b<n)return;c=Math.max(n,c)}w!=null?m+=w:m+=a.getLine(S).substring(c,b)}},e,i),m},this.getDisplayLine=function(t,e,i,r){var n=this.getFoldLine(t);if(n)return this.getFoldDisplayLine(n,t,e,i,r);var a;return a=this.doc.getLine(t),a.substring(r||0,e||a.length)},this.$cloneFoldData=function(){var t=[];return t=this.$foldData.map(function(e){var i=e.folds.map(function(r){return r.clone()});return new A(t,i)}),t},this.toggleFold=function(t){var e=this.selection,i=e.getRange(),r,n;if(i.isEmpty()){var a=i.start;if(r=this.getFoldAt(a.row,a.column),r){this.expandFold(r);return}else(n=this.findMatchingBracket(a))?i.comparePoint(n)==1?i.end=n:(i.start=n,i.start.column++,i.end.column--):(n=this.findMatchingBracket({row:a.row,column:a.column+1}))?(i.comparePoint(n)==1?i.end=n:i.start=n,i.start.column++):i=this.getCommentFoldRange(a.row,a.column)||i}else{var m=this.getFoldsInRange(i);if(t&&m.length){this.expandFolds(m);return}else m.length==1&&(r=m[0])}if(r||(r=this.getFoldAt(i.start.row,i.start.column)),r&&r.range.toString()==i.toString()){this.expandFold(r);return}var w="...";if(!i.isMultiLine()){if(w=this.getTextRange(i),w.length<4)return;w=w.trim().substring(0,2)+".."}this.addFold(w,i)},this.getCommentFoldRange=function(t,e,i){var r=new h(this,t,e),n=r.getCurrentToken(),a=n&&n.type;if(n&&/^comment|string/.test(a)){a=a.match(/comment|string/)[0],a=="comment"&&(a+="|doc-start");var m=new RegExp(a),w=new E;if(i!=1){do n=r.stepBackward();while(n&&m.test(n.type));r.stepForward()}if(w.start.row=r.getCurrentTokenRow(),w.start.column=r.getCurrentTokenColumn()+2,r=new h(this,t,e),i!=-1){var S=-1;do if(n=r.stepForward(),S==-1){var b=this.getState(r.$row);m.test(b)||(S=r.$row)}else if(r.$row>S)break;while(n&&m.test(n.type));n=r.stepBackward()}else n=r.getCurrentToken();return w.end.row=r.getCurrentTokenRow(),w.end.column=r.getCurrentTokenColumn()+n.value.length-2,w}},this.foldAll=function(t,e,i,r){i==null&&(i=1e5);var n=this.foldWidgets;if(n){e=e||this.getLength(),t=t||0;for(var a=t;a<e;a++)if(n[a]==null&&(n[a]=this.getFoldWidget(a)),n[a]=="start"&&!(r&&!r(a))){var m=this.getFoldWidgetRange(a);m&&m.isMultiLine()&&m.end.row<=e&&m.start.row>=t&&(a=m.end.row,m.collapseChildren=i,this.addFold("...",m))}}},this.foldToLevel=function(t){for(this.foldAll();t-- >0;)this.unfold(null,!1)},this.foldAllComments=function(){var t=this;this.foldAll(null,null,null,function(e){for(var i=t.getTokens(e),r=0;r<i.length;r++){var n=i[r];if(!(n.type=="text"&&/^\s+$/.test(n.value)))return!!/comment/.test(n.type)}})},this.$foldStyles={manual:1,markbegin:1,markbeginend:1},this.$foldStyle="markbegin",this.setFoldStyle=function(t){if(!this.$foldStyles[t])throw new Error("invalid fold style: "+t+"["+Object.keys(this.$foldStyles).join(", ")+"]");if(this.$foldStyle!=t){this.$foldStyle=t,t=="manual"&&this.unfold();var e=this.$foldMode;this.$setFolding(null),this.$setFolding(e)}},this.$setFolding=function(t){if(this.$foldMode!=t){if(this.$foldMode=t,this.off("change",this.$updateFoldWidgets),this.off("tokenizerUpdate",this.$tokenizerUpdateFoldWidgets),this._signal("changeAnnotation"),!t||this.$foldStyle=="manual"){this.foldWidgets=null;return}this.foldWidgets=[],this.getFoldWidget=t.getFoldWidget.bind(t,this,this.$foldStyle),this.getFoldWidgetRange=t.getFoldWidgetRange.bind(t,this,this.$foldStyle),this.$updateFoldWidgets=this.updateFoldWidgets.bind(this),this.$tokenizerUpdateFoldWidgets=this.tokenizerUpdateFoldWidgets.bind(this),this.on("change",this.$updateFoldWidgets),this.on("tokenizerUpdate",this.$tokenizerUpdateFoldWidgets)}},this.getParentFoldRangeData=function(t,e){var i=this.foldWidgets;if(!i||e&&i[t])return{};for(var r=t-1,n;r>=0;){var a=i[r];if(a==null&&(a=i[r]=this.getFoldWidget(r)),a=="start"){var m=this.getFoldWidgetRange(r);if(n||(n=m),m&&m.end.row>=t)break}r--}return{range:r!==-1&&m,firstRange:n}},this.onFoldWidgetClick=function(t,e){e=e.domEvent;var i={children:e.shiftKey,all:e.ctrlKey||e.metaKey,siblings:e.altKey},r=this.$toggleFoldWidget(t,i);if(!r){var n=e.target||e.srcElement;n&&/ace_fold-widget/.test(n.className)&&(n.className+=" ace_invalid")}},this.$toggleFoldWidget=function(t,e){if(this.getFoldWidget){var i=this.getFoldWidget(t),r=this.getLine(t),n=i==="end"?-1:1,a=this.getFoldAt(t,n===-1?0:r.length,n);if(a)return e.children||e.all?this.removeFold(a):this.expandFold(a),a;var m=this.getFoldWidgetRange(t,!0);if(m&&!m.isMultiLine()&&(a=this.getFoldAt(m.start.row,m.start.column,1),a&&m.isEqual(a.range)))return this.removeFold(a),a;if(e.siblings){var w=this.getParentFoldRangeData(t);if(w.range)var S=w.range.start.row+1,b=w.range.end.row;this.foldAll(S,b,e.all?1e4:0)}else e.children?(b=m?m.end.row:this.getLength(),this.foldAll(t+1,b,e.all?1e4:0)):m&&(e.all&&(m.collapseChildren=1e4),this.addFold("...",m));return m}},this.toggleFoldWidget=function(t){var e=this.selection.getCursor().row;e=this.getRowFoldStart(e);var i=this.$toggleFoldWidget(e,{});if(!i){var r=this.getParentFoldRangeData(e,!0);if(i=r.range||r.firstRange,i){e=i.start.row;var n=this.getFoldAt(e,this.getLine(e).length,1);n?this.removeFold(n):this.addFold("...",i)}}},this.updateFoldWidgets=function(t){var e=t.start.row,i=t.end.row-e;if(i===0)this.foldWidgets[e]=null;else if(t.action=="remove")this.foldWidgets.splice(e,i+1,null);else{var r=Array(i+1);r.unshift(e,1),this.foldWidgets.splice.apply(this.foldWidgets,r)}},this.tokenizerUpdateFoldWidgets=function(t){var e=t.data;e.first!=e.last&&this.foldWidgets.length>e.first&&this.foldWidgets.splice(e.first,this.foldWidgets.length)}}y.Folding=d}),define("ace/edit_session/bracket_match",["require","exports","module","ace/token_iterator","ace/range"],function(k,y,B){"use strict";var E=k("../token_iterator").TokenIterator,A=k("../range").Range;function p(){this.findMatchingBracket=function(h,d){if(h.column==0)return null;var t=d||this.getLine(h.row).charAt(h.column-1);if(t=="")return null;var e=t.match(/([\(\[\{])|([\)\]\}])/);return e?e[1]?this.$findClosingBracket(e[1],h):this.$findOpeningBracket(e[2],h):null},this.getBracketRange=function(h){var d=this.getLine(h.row),t=!0,e,i=d.charAt(h.column-1),r=i&&i.match(/([\(\[\{])|([\)\]\}])/);if(r||(i=d.charAt(h.column),h={row:h.row,column:h.column+1},r=i&&i.match(/([\(\[\{])|([\)\]\}])/),t=!1),!r)return null;if(r[1]){var n=this.$findClosingBracket(r[1],h);if(!n)return null;e=A.fromPoints(h,n),t||(e.end.column++,e.start.column--),e.cursor=e.end}else{var n=this.$findOpeningBracket(r[2],h);if(!n)return null;e=A.fromPoints(n,h),t||(e.start.column++,e.end.column--),e.cursor=e.start}return e},this.getMatchingBracketRanges=function(h){var d=this.getLine(h.row),t=d.charAt(h.column-1),e=t&&t.match(/([\(\[\{])|([\)\]\}])/);if(e||(t=d.charAt(h.column),h={row:h.row,column:h.column+1},e=t&&t.match(/([\(\[\{])|([\)\]\}])/)),!e)return null;var i=new A(h.row,h.column-1,h.row,h.column),r=e[1]?this.$findClosingBracket(e[1],h):this.$findOpeningBracket(e[2],h);if(!r)return[i];var n=new A(r.row,r.column,r.row,r.column+1);return[i,n]},this.$brackets={")":"(","(":")","]":"[","[":"]","{":"}","}":"{","<":">",">":"<"},this.$findOpeningBracket=function(h,d,t){var e=this.$brackets[h],i=1,r=new E(this,d.row,d.column),n=r.getCurrentToken();if(n||(n=r.stepForward()),!!n){t||(t=new RegExp("(\\.?"+n.type.replace(".","\\.").replace("rparen",".paren").replace(/\b(?:end)\b/,"(?:start|begin|end)")+")+"));for(var a=d.column-r.getCurrentTokenColumn()-2,m=n.value;;){for(;a>=0;){var w=m.charAt(a);if(w==e){if(i-=1,i==0)return{row:r.getCurrentTokenRow(),column:a+r.getCurrentTokenColumn()}}else w==h&&(i+=1);a-=1}do n=r.stepBackward();while(n&&!t.test(n.type));if(n==null)break;m=n.value,a=m.length-1}return null}},this.$findClosingBracket=function(h,d,t){var e=this.$brackets[h],i=1,r=new E(this,d.row,d.column),n=r.getCurrentToken();if(n||(n=r.stepForward()),!!n){t||(t=new RegExp("(\\.?"+n.type.replace(".","\\.").replace("lparen",".paren").replace(/\b(?:start|begin)\b/,"(?:start|begin|end)")+")+"));for(var a=d.column-r.getCurrentTokenColumn();;){for(var m=n.value,w=m.length;a<w;){var S=m.charAt(a);if(S==e){if(i-=1,i==0)return{row:r.getCurrentTokenRow(),column:a+r.getCurrentTokenColumn()}}else S==h&&(i+=1);a+=1}do n=r.stepForward();while(n&&!t.test(n.type));if(n==null)break;a=0}return null}}}y.BracketMatch=p}),define("ace/edit_session",["require","exports","module","ace/lib/oop","ace/lib/lang","ace/bidihandler","ace/config","ace/lib/event_emitter","ace/selection","ace/mode/text","ace/range","ace/document","ace/background_tokenizer","ace/search_highlight","ace/edit_session/folding","ace/edit_session/bracket_match"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/lang"),p=k("./bidihandler").BidiHandler,h=k("./config"),d=k("./lib/event_emitter").EventEmitter,t=k("./selection").Selection,e=k("./mode/text").Mode,i=k("./range").Range,r=k("./document").Document,n=k("./background_tokenizer").BackgroundTokenizer,a=k("./search_highlight").SearchHighlight,m=function(w,S){this.$breakpoints=[],this.$decorations=[],this.$frontMarkers={},this.$backMarkers={},this.$markerId=1,this.$undoSelect=!0,this.$foldData=[],this.id="session"+ ++m.$uid,this.$foldData.toString=function(){return this.join(`
+`)},this.on("changeFold",this.onChangeFold.bind(this)),this.$onChange=this.onChange.bind(this),(typeof w!="object"||!w.getLine)&&(w=new r(w)),this.setDocument(w),this.selection=new t(this),this.$bidiHandler=new p(this),h.resetOptions(this),this.setMode(S),h._signal("session",this)};m.$uid=0,function(){E.implement(this,d),this.setDocument=function(s){this.doc&&this.doc.removeListener("change",this.$onChange),this.doc=s,s.on("change",this.$onChange),this.bgTokenizer&&this.bgTokenizer.setDocument(this.getDocument()),this.resetCaches()},this.getDocument=function(){return this.doc},this.$resetRowCache=function(s){if(!s){this.$docRowCache=[],this.$screenRowCache=[];return}var v=this.$docRowCache.length,$=this.$getRowCacheIndex(this.$docRowCache,s)+1;v>$&&(this.$docRowCache.splice($,v),this.$screenRowCache.splice($,v))},this.$getRowCacheIndex=function(s,v){for(var $=0,C=s.length-1;$<=C;){var x=$+C>>1,L=s[x];if(v>L)$=x+1;else if(v<L)C=x-1;else return x}return $-1},this.resetCaches=function(){this.$modified=!0,this.$wrapData=[],this.$rowLengthCache=[],this.$resetRowCache(0),this.bgTokenizer&&this.bgTokenizer.start(0)},this.onChangeFold=function(s){var v=s.data;this.$resetRowCache(v.start.row)},this.onChange=function(s){this.$modified=!0,this.$bidiHandler.onChange(s),this.$resetRowCache(s.start.row);var v=this.$updateInternalDataOnChange(s);!this.$fromUndo&&this.$undoManager&&(v&&v.length&&(this.$undoManager.add({action:"removeFolds",folds:v},this.mergeUndoDeltas),this.mergeUndoDeltas=!0),this.$undoManager.add(s,this.mergeUndoDeltas),this.mergeUndoDeltas=!0,this.$informUndoManager.schedule()),this.bgTokenizer&&this.bgTokenizer.$updateOnChange(s),this._signal("change",s)},this.setValue=function(s){this.doc.setValue(s),this.selection.moveTo(0,0),this.$resetRowCache(0),this.setUndoManager(this.$undoManager),this.getUndoManager().reset()},this.getValue=this.toString=function(){return this.doc.getValue()},this.getSelection=function(){return this.selection},this.getState=function(s){return this.bgTokenizer.getState(s)},this.getTokens=function(s){return this.bgTokenizer.getTokens(s)},this.getTokenAt=function(s,v){var $=this.bgTokenizer.getTokens(s),C,x=0;if(v==null){var L=$.length-1;x=this.getLine(s).length}else for(var L=0;L<$.length&&(x+=$[L].value.length,!(x>=v));L++);return C=$[L],C?(C.index=L,C.start=x-C.value.length,C):null},this.setUndoManager=function(s){if(this.$undoManager=s,this.$informUndoManager&&this.$informUndoManager.cancel(),s){var v=this;s.addSession(this),this.$syncInformUndoManager=function(){v.$informUndoManager.cancel(),v.mergeUndoDeltas=!1},this.$informUndoManager=A.delayedCall(this.$syncInformUndoManager)}else this.$syncInformUndoManager=function(){}},this.markUndoGroup=function(){this.$syncInformUndoManager&&this.$syncInformUndoManager()},this.$defaultUndoManager={undo:function(){},redo:function(){},hasUndo:function(){},hasRedo:function(){},reset:function(){},add:function(){},addSelection:function(){},startNewGroup:function(){},addSession:function(){}},this.getUndoManager=function(){return this.$undoManager||this.$defaultUndoManager},this.getTabString=function(){return this.getUseSoftTabs()?A.stringRepeat(" ",this.getTabSize()):"	"},this.setUseSoftTabs=function(s){this.setOption("useSoftTabs",s)},this.getUseSoftTabs=function(){return this.$useSoftTabs&&!this.$mode.$indentWithTabs},this.setTabSize=function(s){this.setOption("tabSize",s)},this.getTabSize=function(){return this.$tabSize},this.isTabStop=function(s){return this.$useSoftTabs&&s.column%this.$tabSize===0},this.setNavigateWithinSoftTabs=function(s){this.setOption("navigateWithinSoftTabs",s)},this.getNavigateWithinSoftTabs=function(){return this.$navigateWithinSoftTabs},this.$overwrite=!1,this.setOverwrite=function(s){this.setOption("overwrite",s)},this.getOverwrite=function(){return this.$overwrite},this.toggleOverwrite=function(){this.setOverwrite(!this.$overwrite)},this.addGutterDecoration=function(s,v){this.$decorations[s]||(this.$decorations[s]=""),this.$decorations[s]+=" "+v,this._signal("changeBreakpoint",{})},this.removeGutterDecoration=function(s,v){this.$decorations[s]=(this.$decorations[s]||"").replace(" "+v,""),this._signal("changeBreakpoint",{})},this.getBreakpoints=function(){return this.$breakpoints},this.setBreakpoints=function(s){this.$breakpoints=[];for(var v=0;v<s.length;v++)this.$breakpoints[s[v]]="ace_breakpoint";this._signal("changeBreakpoint",{})},this.clearBreakpoints=function(){this.$breakpoints=[],this._signal("changeBreakpoint",{})},this.setBreakpoint=function(s,v){v===void 0&&(v="ace_breakpoint"),v?this.$breakpoints[s]=v:delete this.$breakpoints[s],this._signal("changeBreakpoint",{})},this.clearBreakpoint=function(s){delete this.$breakpoints[s],this._signal("changeBreakpoint",{})},this.addMarker=function(s,v,$,C){var x=this.$markerId++,L={range:s,type:$||"line",renderer:typeof $=="function"?$:null,clazz:v,inFront:!!C,id:x};return C?(this.$frontMarkers[x]=L,this._signal("changeFrontMarker")):(this.$backMarkers[x]=L,this._signal("changeBackMarker")),x},this.addDynamicMarker=function(s,v){if(s.update){var $=this.$markerId++;return s.id=$,s.inFront=!!v,v?(this.$frontMarkers[$]=s,this._signal("changeFrontMarker")):(this.$backMarkers[$]=s,this._signal("changeBackMarker")),s}},this.removeMarker=function(s){var v=this.$frontMarkers[s]||this.$backMarkers[s];if(v){var $=v.inFront?this.$frontMarkers:this.$backMarkers;delete $[s],this._signal(v.inFront?"changeFrontMarker":"changeBackMarker")}},this.getMarkers=function(s){return s?this.$frontMarkers:this.$backMarkers},this.highlight=function(s){if(!this.$searchHighlight){var v=new a(null,"ace_selected-word","text");this.$searchHighlight=this.addDynamicMarker(v)}this.$searchHighlight.setRegexp(s)},this.highlightLines=function(s,v,$,C){typeof v!="number"&&($=v,v=s),$||($="ace_step");var x=new i(s,0,v,1/0);return x.id=this.addMarker(x,$,"fullLine",C),x},this.setAnnotations=function(s){this.$annotations=s,this._signal("changeAnnotation",{})},this.getAnnotations=function(){return this.$annotations||[]},this.clearAnnotations=function(){this.setAnnotations([])},this.$detectNewLine=function(s){var v=s.match(/^.*?(\r?\n)/m);v?this.$autoNewLine=v[1]:this.$autoNewLine=`
+`},this.getWordRange=function(s,v){var $=this.getLine(s),C=!1;if(v>0&&(C=!!$.charAt(v-1).match(this.tokenRe)),C||(C=!!$.charAt(v).match(this.tokenRe)),C)var x=this.tokenRe;else if(/^\s+$/.test($.slice(v-1,v+1)))var x=/\s/;else var x=this.nonTokenRe;var L=v;if(L>0){do L--;while(L>=0&&$.charAt(L).match(x));L++}for(var R=v;R<$.length&&$.charAt(R).match(x);)R++;return new i(s,L,s,R)},this.getAWordRange=function(s,v){for(var $=this.getWordRange(s,v),C=this.getLine($.end.row);C.charAt($.end.column).match(/[ \t]/);)$.end.column+=1;return $},this.setNewLineMode=function(s){this.doc.setNewLineMode(s)},this.getNewLineMode=function(){return this.doc.getNewLineMode()},this.setUseWorker=function(s){this.setOption("useWorker",s)},this.getUseWorker=function(){return this.$useWorker},this.onReloadTokenizer=function(s){var v=s.data;this.bgTokenizer.start(v.first),this._signal("tokenizerUpdate",s)},this.$modes=h.$modes,this.$mode=null,this.$modeId=null,this.setMode=function(s,v){if(s&&typeof s=="object"){if(s.getTokenizer)return this.$onChangeMode(s);var $=s,C=$.path}else C=s||"ace/mode/text";if(this.$modes["ace/mode/text"]||(this.$modes["ace/mode/text"]=new e),this.$modes[C]&&!$){this.$onChangeMode(this.$modes[C]),v&&v();return}this.$modeId=C,h.loadModule(["mode",C],function(x){if(this.$modeId!==C)return v&&v();this.$modes[C]&&!$?this.$onChangeMode(this.$modes[C]):x&&x.Mode&&(x=new x.Mode($),$||(this.$modes[C]=x,x.$id=C),this.$onChangeMode(x)),v&&v()}.bind(this)),this.$mode||this.$onChangeMode(this.$modes["ace/mode/text"],!0)},this.$onChangeMode=function(s,v){if(v||(this.$modeId=s.$id),this.$mode!==s){var $=this.$mode;this.$mode=s,this.$stopWorker(),this.$useWorker&&this.$startWorker();var C=s.getTokenizer();if(C.on!==void 0){var x=this.onReloadTokenizer.bind(this);C.on("update",x)}if(this.bgTokenizer)this.bgTokenizer.setTokenizer(C);else{this.bgTokenizer=new n(C);var L=this;this.bgTokenizer.on("update",function(R){L._signal("tokenizerUpdate",R)})}this.bgTokenizer.setDocument(this.getDocument()),this.tokenRe=s.tokenRe,this.nonTokenRe=s.nonTokenRe,v||(s.attachToSession&&s.attachToSession(this),this.$options.wrapMethod.set.call(this,this.$wrapMethod),this.$setFolding(s.foldingRules),this.bgTokenizer.start(0),this._emit("changeMode",{oldMode:$,mode:s}))}},this.$stopWorker=function(){this.$worker&&(this.$worker.terminate(),this.$worker=null)},this.$startWorker=function(){try{this.$worker=this.$mode.createWorker(this)}catch(s){h.warn("Could not load worker",s),this.$worker=null}},this.getMode=function(){return this.$mode},this.$scrollTop=0,this.setScrollTop=function(s){this.$scrollTop===s||isNaN(s)||(this.$scrollTop=s,this._signal("changeScrollTop",s))},this.getScrollTop=function(){return this.$scrollTop},this.$scrollLeft=0,this.setScrollLeft=function(s){this.$scrollLeft===s||isNaN(s)||(this.$scrollLeft=s,this._signal("changeScrollLeft",s))},this.getScrollLeft=function(){return this.$scrollLeft},this.getScreenWidth=function(){return this.$computeWidth(),this.lineWidgets?Math.max(this.getLineWidgetMaxWidth(),this.screenWidth):this.screenWidth},this.getLineWidgetMaxWidth=function(){if(this.lineWidgetsWidth!=null)return this.lineWidgetsWidth;var s=0;return this.lineWidgets.forEach(function(v){v&&v.screenWidth>s&&(s=v.screenWidth)}),this.lineWidgetWidth=s},this.$computeWidth=function(s){if(this.$modified||s){if(this.$modified=!1,this.$useWrapMode)return this.screenWidth=this.$wrapLimit;for(var v=this.doc.getAllLines(),$=this.$rowLengthCache,C=0,x=0,L=this.$foldData[x],R=L?L.start.row:1/0,T=v.length,_=0;_<T;_++){if(_>R){if(_=L.end.row+1,_>=T)break;L=this.$foldData[x++],R=L?L.start.row:1/0}$[_]==null&&($[_]=this.$getStringScreenWidth(v[_])[0]),$[_]>C&&(C=$[_])}this.screenWidth=C}},this.getLine=function(s){return this.doc.getLine(s)},this.getLines=function(s,v){return this.doc.getLines(s,v)},this.getLength=function(){return this.doc.getLength()},this.getTextRange=function(s){return this.doc.getTextRange(s||this.selection.getRange())},this.insert=function(s,v){return this.doc.insert(s,v)},this.remove=function(s){return this.doc.remove(s)},this.removeFullLines=function(s,v){return this.doc.removeFullLines(s,v)},this.undoChanges=function(s,v){if(s.length){this.$fromUndo=!0;for(var $=s.length-1;$!=-1;$--){var C=s[$];C.action=="insert"||C.action=="remove"?this.doc.revertDelta(C):C.folds&&this.addFolds(C.folds)}!v&&this.$undoSelect&&(s.selectionBefore?this.selection.fromJSON(s.selectionBefore):this.selection.setRange(this.$getUndoSelection(s,!0))),this.$fromUndo=!1}},this.redoChanges=function(s,v){if(s.length){this.$fromUndo=!0;for(var $=0;$<s.length;$++){var C=s[$];(C.action=="insert"||C.action=="remove")&&this.doc.$safeApplyDelta(C)}!v&&this.$undoSelect&&(s.selectionAfter?this.selection.fromJSON(s.selectionAfter):this.selection.setRange(this.$getUndoSelection(s,!1))),this.$fromUndo=!1}},this.setUndoSelect=function(s){this.$undoSelect=s},this.$getUndoSelection=function(s,v){function $(T){return v?T.action!=="insert":T.action==="insert"}for(var C,x,L=0;L<s.length;L++){var R=s[L];if(R.start){if(!C){$(R)?C=i.fromPoints(R.start,R.end):C=i.fromPoints(R.start,R.start);continue}$(R)?(x=R.start,C.compare(x.row,x.column)==-1&&C.setStart(x),x=R.end,C.compare(x.row,x.column)==1&&C.setEnd(x)):(x=R.start,C.compare(x.row,x.column)==-1&&(C=i.fromPoints(R.start,R.start)))}}return C},this.replace=function(s,v){return this.doc.replace(s,v)},this.moveText=function(s,v,$){var C=this.getTextRange(s),x=this.getFoldsInRange(s),L=i.fromPoints(v,v);if(!$){this.remove(s);var R=s.start.row-s.end.row,T=R?-s.end.column:s.start.column-s.end.column;T&&(L.start.row==s.end.row&&L.start.column>s.end.column&&(L.start.column+=T),L.end.row==s.end.row&&L.end.column>s.end.column&&(L.end.column+=T)),R&&L.start.row>=s.end.row&&(L.start.row+=R,L.end.row+=R)}if(L.end=this.insert(L.start,C),x.length){var _=s.start,F=L.start,R=F.row-_.row,T=F.column-_.column;this.addFolds(x.map(function(P){return P=P.clone(),P.start.row==_.row&&(P.start.column+=T),P.end.row==_.row&&(P.end.column+=T),P.start.row+=R,P.end.row+=R,P}))}return L},this.indentRows=function(s,v,$){$=$.replace(/\t/g,this.getTabString());for(var C=s;C<=v;C++)this.doc.insertInLine({row:C,column:0},$)},this.outdentRows=function(s){for(var v=s.collapseRows(),$=new i(0,0,0,0),C=this.getTabSize(),x=v.start.row;x<=v.end.row;++x){var L=this.getLine(x);$.start.row=x,$.end.row=x;for(var R=0;R<C&&L.charAt(R)==" ";++R);R<C&&L.charAt(R)=="	"?($.start.column=R,$.end.column=R+1):($.start.column=0,$.end.column=R),this.remove($)}},this.$moveLines=function(s,v,$){if(s=this.getRowFoldStart(s),v=this.getRowFoldEnd(v),$<0){var C=this.getRowFoldStart(s+$);if(C<0)return 0;var x=C-s}else if($>0){var C=this.getRowFoldEnd(v+$);if(C>this.doc.getLength()-1)return 0;var x=C-v}else{s=this.$clipRowToDocument(s),v=this.$clipRowToDocument(v);var x=v-s+1}var L=new i(s,0,v,Number.MAX_VALUE),R=this.getFoldsInRange(L).map(function(_){return _=_.clone(),_.start.row+=x,_.end.row+=x,_}),T=$==0?this.doc.getLines(s,v):this.doc.removeFullLines(s,v);return this.doc.insertFullLines(s+x,T),R.length&&this.addFolds(R),x},this.moveLinesUp=function(s,v){return this.$moveLines(s,v,-1)},this.moveLinesDown=function(s,v){return this.$moveLines(s,v,1)},this.duplicateLines=function(s,v){return this.$moveLines(s,v,0)},this.$clipRowToDocument=function(s){return Math.max(0,Math.min(s,this.doc.getLength()-1))},this.$clipColumnToRow=function(s,v){return v<0?0:Math.min(this.doc.getLine(s).length,v)},this.$clipPositionToDocument=function(s,v){if(v=Math.max(0,v),s<0)s=0,v=0;else{var $=this.doc.getLength();s>=$?(s=$-1,v=this.doc.getLine($-1).length):v=Math.min(this.doc.getLine(s).length,v)}return{row:s,column:v}},this.$clipRangeToDocument=function(s){s.start.row<0?(s.start.row=0,s.start.column=0):s.start.column=this.$clipColumnToRow(s.start.row,s.start.column);var v=this.doc.getLength()-1;return s.end.row>v?(s.end.row=v,s.end.column=this.doc.getLine(v).length):s.end.column=this.$clipColumnToRow(s.end.row,s.end.column),s},this.$wrapLimit=80,this.$useWrapMode=!1,this.$wrapLimitRange={min:null,max:null},this.setUseWrapMode=function(s){if(s!=this.$useWrapMode){if(this.$useWrapMode=s,this.$modified=!0,this.$resetRowCache(0),s){var v=this.getLength();this.$wrapData=Array(v),this.$updateWrapData(0,v-1)}this._signal("changeWrapMode")}},this.getUseWrapMode=function(){return this.$useWrapMode},this.setWrapLimitRange=function(s,v){(this.$wrapLimitRange.min!==s||this.$wrapLimitRange.max!==v)&&(this.$wrapLimitRange={min:s,max:v},this.$modified=!0,this.$bidiHandler.markAsDirty(),this.$useWrapMode&&this._signal("changeWrapMode"))},this.adjustWrapLimit=function(s,v){var $=this.$wrapLimitRange;$.max<0&&($={min:v,max:v});var C=this.$constrainWrapLimit(s,$.min,$.max);return C!=this.$wrapLimit&&C>1?(this.$wrapLimit=C,this.$modified=!0,this.$useWrapMode&&(this.$updateWrapData(0,this.getLength()-1),this.$resetRowCache(0),this._signal("changeWrapLimit")),!0):!1},this.$constrainWrapLimit=function(s,v,$){return v&&(s=Math.max(v,s)),$&&(s=Math.min($,s)),s},this.getWrapLimit=function(){return this.$wrapLimit},this.setWrapLimit=function(s){this.setWrapLimitRange(s,s)},this.getWrapLimitRange=function(){return{min:this.$wrapLimitRange.min,max:this.$wrapLimitRange.max}},this.$updateInternalDataOnChange=function(s){var v=this.$useWrapMode,$=s.action,C=s.start,x=s.end,L=C.row,R=x.row,T=R-L,_=null;if(this.$updating=!0,T!=0)if($==="remove"){this[v?"$wrapData":"$rowLengthCache"].splice(L,T);var F=this.$foldData;_=this.getFoldsInRange(s),this.removeFolds(_);var O=this.getFoldLine(x.row),M=0;if(O){O.addRemoveChars(x.row,x.column,C.column-x.column),O.shiftRow(-T);var P=this.getFoldLine(L);P&&P!==O&&(P.merge(O),O=P),M=F.indexOf(O)+1}for(M;M<F.length;M++){var O=F[M];O.start.row>=x.row&&O.shiftRow(-T)}R=L}else{var I=Array(T);I.unshift(L,0);var D=v?this.$wrapData:this.$rowLengthCache;D.splice.apply(D,I);var F=this.$foldData,O=this.getFoldLine(L),M=0;if(O){var N=O.range.compareInside(C.row,C.column);N==0?(O=O.split(C.row,C.column),O&&(O.shiftRow(T),O.addRemoveChars(R,0,x.column-C.column))):N==-1&&(O.addRemoveChars(L,0,x.column-C.column),O.shiftRow(T)),M=F.indexOf(O)+1}for(M;M<F.length;M++){var O=F[M];O.start.row>=L&&O.shiftRow(T)}}else{T=Math.abs(s.start.column-s.end.column),$==="remove"&&(_=this.getFoldsInRange(s),this.removeFolds(_),T=-T);var O=this.getFoldLine(L);O&&O.addRemoveChars(L,C.column,T)}return v&&this.$wrapData.length!=this.doc.getLength()&&console.error("doc.getLength() and $wrapData.length have to be the same!"),this.$updating=!1,v?this.$updateWrapData(L,R):this.$updateRowLengthCache(L,R),_},this.$updateRowLengthCache=function(s,v,$){this.$rowLengthCache[s]=null,this.$rowLengthCache[v]=null},this.$updateWrapData=function(s,v){var $=this.doc.getAllLines(),C=this.getTabSize(),x=this.$wrapData,L=this.$wrapLimit,R,T,_=s;for(v=Math.min(v,$.length-1);_<=v;)T=this.getFoldLine(_,T),T?(R=[],T.walk(function(F,O,M,P){var I;if(F!=null){I=this.$getDisplayTokens(F,R.length),I[0]=b;for(var D=1;D<I.length;D++)I[D]=c}else I=this.$getDisplayTokens($[O].substring(P,M),R.length);R=R.concat(I)}.bind(this),T.end.row,$[T.end.row].length+1),x[T.start.row]=this.$computeWrapSplits(R,L,C),_=T.end.row+1):(R=this.$getDisplayTokens($[_]),x[_]=this.$computeWrapSplits(R,L,C),_++)};var w=1,S=2,b=3,c=4,l=9,g=10,u=11,o=12;this.$computeWrapSplits=function(s,v,$){if(s.length==0)return[];var C=[],x=s.length,L=0,R=0,T=this.$wrapAsCode,_=this.$indentedSoftWrap,F=v<=Math.max(2*$,8)||_===!1?0:Math.floor(v/2);function O(){var N=0;if(F===0)return N;if(_)for(var V=0;V<s.length;V++){var z=s[V];if(z==g)N+=1;else if(z==u)N+=$;else{if(z==o)continue;break}}return T&&_!==!1&&(N+=$),Math.min(N,F)}function M(N){for(var V=N-L,z=L;z<N;z++){var Q=s[z];(Q===12||Q===2)&&(V-=1)}C.length||(P=O(),C.indent=P),R+=V,C.push(R),L=N}for(var P=0;x-L>v-P;){var I=L+v-P;if(s[I-1]>=g&&s[I]>=g){M(I);continue}if(s[I]==b||s[I]==c){for(I;I!=L-1&&s[I]!=b;I--);if(I>L){M(I);continue}for(I=L+v,I;I<s.length&&s[I]==c;I++);if(I==s.length)break;M(I);continue}for(var D=Math.max(I-(v-(v>>2)),L-1);I>D&&s[I]<b;)I--;if(T){for(;I>D&&s[I]<b;)I--;for(;I>D&&s[I]==l;)I--}else for(;I>D&&s[I]<g;)I--;if(I>D){M(++I);continue}I=L+v,s[I]==S&&I--,M(I-P)}return C},this.$getDisplayTokens=function(s,v){var $=[],C;v=v||0;for(var x=0;x<s.length;x++){var L=s.charCodeAt(x);if(L==9){C=this.getScreenTabSize($.length+v),$.push(u);for(var R=1;R<C;R++)$.push(o)}else L==32?$.push(g):L>39&&L<48||L>57&&L<64?$.push(l):L>=4352&&f(L)?$.push(w,S):$.push(w)}return $},this.$getStringScreenWidth=function(s,v,$){if(v==0)return[0,0];v==null&&(v=1/0),$=$||0;var C,x;for(x=0;x<s.length&&(C=s.charCodeAt(x),C==9?$+=this.getScreenTabSize($):C>=4352&&f(C)?$+=2:$+=1,!($>v));x++);return[$,x]},this.lineWidgets=null,this.getRowLength=function(s){var v=1;return this.lineWidgets&&(v+=this.lineWidgets[s]&&this.lineWidgets[s].rowCount||0),!this.$useWrapMode||!this.$wrapData[s]?v:this.$wrapData[s].length+v},this.getRowLineCount=function(s){return!this.$useWrapMode||!this.$wrapData[s]?1:this.$wrapData[s].length+1},this.getRowWrapIndent=function(s){if(this.$useWrapMode){var v=this.screenToDocumentPosition(s,Number.MAX_VALUE),$=this.$wrapData[v.row];return $.length&&$[0]<v.column?$.indent:0}else return 0},this.getScreenLastRowColumn=function(s){var v=this.screenToDocumentPosition(s,Number.MAX_VALUE);return this.documentToScreenColumn(v.row,v.column)},this.getDocumentLastRowColumn=function(s,v){var $=this.documentToScreenRow(s,v);return this.getScreenLastRowColumn($)},this.getDocumentLastRowColumnPosition=function(s,v){var $=this.documentToScreenRow(s,v);return this.screenToDocumentPosition($,Number.MAX_VALUE/10)},this.getRowSplitData=function(s){if(this.$useWrapMode)return this.$wrapData[s]},this.getScreenTabSize=function(s){return this.$tabSize-(s%this.$tabSize|0)},this.screenToDocumentRow=function(s,v){return this.screenToDocumentPosition(s,v).row},this.screenToDocumentColumn=function(s,v){return this.screenToDocumentPosition(s,v).column},this.screenToDocumentPosition=function(s,v,$){if(s<0)return{row:0,column:0};var C,x=0,L=0,R,T=0,_=0,F=this.$screenRowCache,O=this.$getRowCacheIndex(F,s),M=F.length;if(M&&O>=0)var T=F[O],x=this.$docRowCache[O],P=s>F[M-1];else var P=!M;for(var I=this.getLength()-1,D=this.getNextFoldLine(x),N=D?D.start.row:1/0;T<=s&&(_=this.getRowLength(x),!(T+_>s||x>=I));)T+=_,x++,x>N&&(x=D.end.row+1,D=this.getNextFoldLine(x,D),N=D?D.start.row:1/0),P&&(this.$docRowCache.push(x),this.$screenRowCache.push(T));if(D&&D.start.row<=x)C=this.getFoldDisplayLine(D),x=D.start.row;else{if(T+_<=s||x>I)return{row:I,column:this.getLine(I).length};C=this.getLine(x),D=null}var V=0,z=Math.floor(s-T);if(this.$useWrapMode){var Q=this.$wrapData[x];Q&&(R=Q[z],z>0&&Q.length&&(V=Q.indent,L=Q[z-1]||Q[Q.length-1],C=C.substring(L)))}return $!==void 0&&this.$bidiHandler.isBidiRow(T+z,x,z)&&(v=this.$bidiHandler.offsetToCol($)),L+=this.$getStringScreenWidth(C,v-V)[1],this.$useWrapMode&&L>=R&&(L=R-1),D?D.idxToPosition(L):{row:x,column:L}},this.documentToScreenPosition=function(s,v){if(typeof v>"u")var $=this.$clipPositionToDocument(s.row,s.column);else $=this.$clipPositionToDocument(s,v);s=$.row,v=$.column;var C=0,x=null,L=null;L=this.getFoldAt(s,v,1),L&&(s=L.start.row,v=L.start.column);var R,T=0,_=this.$docRowCache,F=this.$getRowCacheIndex(_,s),O=_.length;if(O&&F>=0)var T=_[F],C=this.$screenRowCache[F],M=s>_[O-1];else var M=!O;for(var P=this.getNextFoldLine(T),I=P?P.start.row:1/0;T<s;){if(T>=I){if(R=P.end.row+1,R>s)break;P=this.getNextFoldLine(R,P),I=P?P.start.row:1/0}else R=T+1;C+=this.getRowLength(T),T=R,M&&(this.$docRowCache.push(T),this.$screenRowCache.push(C))}var D="";P&&T>=I?(D=this.getFoldDisplayLine(P,s,v),x=P.start.row):(D=this.getLine(s).substring(0,v),x=s);var N=0;if(this.$useWrapMode){var V=this.$wrapData[x];if(V){for(var z=0;D.length>=V[z];)C++,z++;D=D.substring(V[z-1]||0,D.length),N=z>0?V.indent:0}}return this.lineWidgets&&this.lineWidgets[T]&&this.lineWidgets[T].rowsAbove&&(C+=this.lineWidgets[T].rowsAbove),{row:C,column:N+this.$getStringScreenWidth(D)[0]}},this.documentToScreenColumn=function(s,v){return this.documentToScreenPosition(s,v).column},this.documentToScreenRow=function(s,v){return this.documentToScreenPosition(s,v).row},this.getScreenLength=function(){var s=0,v=null;if(this.$useWrapMode)for(var x=this.$wrapData.length,L=0,C=0,v=this.$foldData[C++],R=v?v.start.row:1/0;L<x;){var T=this.$wrapData[L];s+=T?T.length+1:1,L++,L>R&&(L=v.end.row+1,v=this.$foldData[C++],R=v?v.start.row:1/0)}else{s=this.getLength();for(var $=this.$foldData,C=0;C<$.length;C++)v=$[C],s-=v.end.row-v.start.row}return this.lineWidgets&&(s+=this.$getWidgetScreenLength()),s},this.$setFontMetrics=function(s){this.$enableVarChar&&(this.$getStringScreenWidth=function(v,$,C){if($===0)return[0,0];$||($=1/0),C=C||0;var x,L;for(L=0;L<v.length&&(x=v.charAt(L),x==="	"?C+=this.getScreenTabSize(C):C+=s.getCharacterWidth(x),!(C>$));L++);return[C,L]})},this.destroy=function(){this.bgTokenizer&&(this.bgTokenizer.setDocument(null),this.bgTokenizer=null),this.$stopWorker(),this.removeAllListeners(),this.selection.detach()},this.isFullWidth=f;function f(s){return s<4352?!1:s>=4352&&s<=4447||s>=4515&&s<=4519||s>=4602&&s<=4607||s>=9001&&s<=9002||s>=11904&&s<=11929||s>=11931&&s<=12019||s>=12032&&s<=12245||s>=12272&&s<=12283||s>=12288&&s<=12350||s>=12353&&s<=12438||s>=12441&&s<=12543||s>=12549&&s<=12589||s>=12593&&s<=12686||s>=12688&&s<=12730||s>=12736&&s<=12771||s>=12784&&s<=12830||s>=12832&&s<=12871||s>=12880&&s<=13054||s>=13056&&s<=19903||s>=19968&&s<=42124||s>=42128&&s<=42182||s>=43360&&s<=43388||s>=44032&&s<=55203||s>=55216&&s<=55238||s>=55243&&s<=55291||s>=63744&&s<=64255||s>=65040&&s<=65049||s>=65072&&s<=65106||s>=65108&&s<=65126||s>=65128&&s<=65131||s>=65281&&s<=65376||s>=65504&&s<=65510}}.call(m.prototype),k("./edit_session/folding").Folding.call(m.prototype),k("./edit_session/bracket_match").BracketMatch.call(m.prototype),h.defineOptions(m.prototype,"session",{wrap:{set:function(w){if(!w||w=="off"?w=!1:w=="free"?w=!0:w=="printMargin"?w=-1:typeof w=="string"&&(w=parseInt(w,10)||!1),this.$wrap!=w)if(this.$wrap=w,!w)this.setUseWrapMode(!1);else{var S=typeof w=="number"?w:null;this.setWrapLimitRange(S,S),this.setUseWrapMode(!0)}},get:function(){return this.getUseWrapMode()?this.$wrap==-1?"printMargin":this.getWrapLimitRange().min?this.$wrap:"free":"off"},handlesSet:!0},wrapMethod:{set:function(w){w=w=="auto"?this.$mode.type!="text":w!="text",w!=this.$wrapAsCode&&(this.$wrapAsCode=w,this.$useWrapMode&&(this.$useWrapMode=!1,this.setUseWrapMode(!0)))},initialValue:"auto"},indentedSoftWrap:{set:function(){this.$useWrapMode&&(this.$useWrapMode=!1,this.setUseWrapMode(!0))},initialValue:!0},firstLineNumber:{set:function(){this._signal("changeBreakpoint")},initialValue:1},useWorker:{set:function(w){this.$useWorker=w,this.$stopWorker(),w&&this.$startWorker()},initialValue:!0},useSoftTabs:{initialValue:!0},tabSize:{set:function(w){w=parseInt(w),w>0&&this.$tabSize!==w&&(this.$modified=!0,this.$rowLengthCache=[],this.$tabSize=w,this._signal("changeTabSize"))},initialValue:4,handlesSet:!0},navigateWithinSoftTabs:{initialValue:!1},foldStyle:{set:function(w){this.setFoldStyle(w)},handlesSet:!0},overwrite:{set:function(w){this._signal("changeOverwrite")},initialValue:!1},newLineMode:{set:function(w){this.doc.setNewLineMode(w)},get:function(){return this.doc.getNewLineMode()},handlesSet:!0},mode:{set:function(w){this.setMode(w)},get:function(){return this.$modeId},handlesSet:!0}}),y.EditSession=m}),define("ace/search",["require","exports","module","ace/lib/lang","ace/lib/oop","ace/range"],function(k,y,B){"use strict";var E=k("./lib/lang"),A=k("./lib/oop"),p=k("./range").Range,h=function(){this.$options={}};(function(){this.set=function(t){return A.mixin(this.$options,t),this},this.getOptions=function(){return E.copyObject(this.$options)},this.setOptions=function(t){this.$options=t},this.find=function(t){var e=this.$options,i=this.$matchIterator(t,e);if(!i)return!1;var r=null;return i.forEach(function(n,a,m,w){return r=new p(n,a,m,w),a==w&&e.start&&e.start.start&&e.skipCurrent!=!1&&r.isEqual(e.start)?(r=null,!1):!0}),r},this.findAll=function(t){var e=this.$options;if(!e.needle)return[];this.$assembleRegExp(e);var i=e.range,r=i?t.getLines(i.start.row,i.end.row):t.doc.getAllLines(),n=[],a=e.re;if(e.$isMultiLine){var m=a.length,w=r.length-m,S;e:for(var b=a.offset||0;b<=w;b++){for(var c=0;c<m;c++)if(r[b+c].search(a[c])==-1)continue e;var l=r[b],g=r[b+m-1],u=l.length-l.match(a[0])[0].length,o=g.match(a[m-1])[0].length;S&&S.end.row===b&&S.end.column>u||(n.push(S=new p(b,u,b+m-1,o)),m>2&&(b=b+m-2))}}else for(var f=0;f<r.length;f++)for(var s=E.getMatchOffsets(r[f],a),c=0;c<s.length;c++){var v=s[c];n.push(new p(f,v.offset,f,v.offset+v.length))}if(i){for(var $=i.start.column,C=i.start.column,f=0,c=n.length-1;f<c&&n[f].start.column<$&&n[f].start.row==i.start.row;)f++;for(;f<c&&n[c].end.column>C&&n[c].end.row==i.end.row;)c--;for(n=n.slice(f,c+1),f=0,c=n.length;f<c;f++)n[f].start.row+=i.start.row,n[f].end.row+=i.start.row}return n},this.replace=function(t,e){var i=this.$options,r=this.$assembleRegExp(i);if(i.$isMultiLine)return e;if(r){var n=r.exec(t);if(!n||n[0].length!=t.length)return null;if(e=t.replace(r,e),i.preserveCase){e=e.split("");for(var a=Math.min(t.length,t.length);a--;){var m=t[a];m&&m.toLowerCase()!=m?e[a]=e[a].toUpperCase():e[a]=e[a].toLowerCase()}e=e.join("")}return e}},this.$assembleRegExp=function(t,e){if(t.needle instanceof RegExp)return t.re=t.needle;var i=t.needle;if(!t.needle)return t.re=!1;t.regExp||(i=E.escapeRegExp(i)),t.wholeWord&&(i=d(i,t));var r=t.caseSensitive?"gm":"gmi";if(t.$isMultiLine=!e&&/[\n\r]/.test(i),t.$isMultiLine)return t.re=this.$assembleMultilineRegExp(i,r);try{var n=new RegExp(i,r)}catch{n=!1}return t.re=n},this.$assembleMultilineRegExp=function(t,e){for(var i=t.replace(/\r\n|\r|\n/g,`$
 ^`).split(`
-`),r=[],n=0;n<i.length;n++)try{r.push(new RegExp(i[n],e))}catch{return!1}return r},this.$matchIterator=function(t,e){var i=this.$assembleRegExp(e);if(!i)return!1;var r=e.backwards==!0,n=e.skipCurrent!=!1,o=e.range,m=e.start;m||(m=o?o[r?"end":"start"]:t.selection.getRange()),m.start&&(m=m[n!=r?"end":"start"]);var w=o?o.start.row:0,S=o?o.end.row:t.getLength()-1;if(r)var y=function(g){var d=m.row;if(!l(d,m.column,g)){for(d--;d>=w;d--)if(l(d,Number.MAX_VALUE,g))return;if(e.wrap!=!1){for(d=S,w=m.row;d>=w;d--)if(l(d,Number.MAX_VALUE,g))return}}};else var y=function(d){var a=m.row;if(!l(a,m.column,d)){for(a=a+1;a<=S;a++)if(l(a,0,d))return;if(e.wrap!=!1){for(a=w,S=m.row;a<=S;a++)if(l(a,0,d))return}}};if(e.$isMultiLine)var c=i.length,l=function(g,d,a){var f=r?g-c+1:g;if(!(f<0)){var s=t.getLine(f),v=s.search(i[0]);if(!(!r&&v<d||v===-1)){for(var $=1;$<c;$++)if(s=t.getLine(f+$),s.search(i[$])==-1)return;var x=s.match(i[c-1])[0].length;if(!(r&&x>d)&&a(f,v,f+c-1,x))return!0}}};else if(r)var l=function(d,a,f){var s=t.getLine(d),v=[],$,x=0;for(i.lastIndex=0;$=i.exec(s);){var C=$[0].length;if(x=$.index,!C){if(x>=s.length)break;i.lastIndex=x+=1}if($.index+C>a)break;v.push($.index,C)}for(var k=v.length-1;k>=0;k-=2){var R=v[k-1],C=v[k];if(f(d,R,d,R+C))return!0}};else var l=function(d,a,f){var s=t.getLine(d),v,$;for(i.lastIndex=a;$=i.exec(s);){var x=$[0].length;if(v=$.index,f(d,v,d,v+x))return!0;if(!x&&(i.lastIndex=v+=1,v>=s.length))return!1}};return{forEach:y}}}).call(h.prototype);function u(t,e){function i(r){return/\w/.test(r)||e.regExp?"\\b":""}return i(t[0])+t+i(t[t.length-1])}b.Search=h}),define("ace/keyboard/hash_handler",["require","exports","module","ace/lib/keys","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("../lib/keys"),A=L("../lib/useragent"),p=E.KEY_MODS;function h(t,e){this.platform=e||(A.isMac?"mac":"win"),this.commands={},this.commandKeyBinding={},this.addCommands(t),this.$singleCommand=!0}function u(t,e){h.call(this,t,e),this.$singleCommand=!1}u.prototype=h.prototype,function(){this.addCommand=function(e){this.commands[e.name]&&this.removeCommand(e),this.commands[e.name]=e,e.bindKey&&this._buildKeyHash(e)},this.removeCommand=function(e,i){var r=e&&(typeof e=="string"?e:e.name);e=this.commands[r],i||delete this.commands[r];var n=this.commandKeyBinding;for(var o in n){var m=n[o];if(m==e)delete n[o];else if(Array.isArray(m)){var w=m.indexOf(e);w!=-1&&(m.splice(w,1),m.length==1&&(n[o]=m[0]))}}},this.bindKey=function(e,i,r){if(typeof e=="object"&&e&&(r==null&&(r=e.position),e=e[this.platform]),!!e){if(typeof i=="function")return this.addCommand({exec:i,bindKey:e,name:i.name||e});e.split("|").forEach(function(n){var o="";if(n.indexOf(" ")!=-1){var m=n.split(/\s+/);n=m.pop(),m.forEach(function(y){var c=this.parseKeys(y),l=p[c.hashId]+c.key;o+=(o?" ":"")+l,this._addCommandToBinding(o,"chainKeys")},this),o+=" "}var w=this.parseKeys(n),S=p[w.hashId]+w.key;this._addCommandToBinding(o+S,i,r)},this)}};function t(e){return typeof e=="object"&&e.bindKey&&e.bindKey.position||(e.isDefault?-100:0)}this._addCommandToBinding=function(e,i,r){var n=this.commandKeyBinding,o;if(!i)delete n[e];else if(!n[e]||this.$singleCommand)n[e]=i;else{Array.isArray(n[e])?(o=n[e].indexOf(i))!=-1&&n[e].splice(o,1):n[e]=[n[e]],typeof r!="number"&&(r=t(i));var m=n[e];for(o=0;o<m.length;o++){var w=m[o],S=t(w);if(S>r)break}m.splice(o,0,i)}},this.addCommands=function(e){e&&Object.keys(e).forEach(function(i){var r=e[i];if(r){if(typeof r=="string")return this.bindKey(r,i);typeof r=="function"&&(r={exec:r}),typeof r=="object"&&(r.name||(r.name=i),this.addCommand(r))}},this)},this.removeCommands=function(e){Object.keys(e).forEach(function(i){this.removeCommand(e[i])},this)},this.bindKeys=function(e){Object.keys(e).forEach(function(i){this.bindKey(i,e[i])},this)},this._buildKeyHash=function(e){this.bindKey(e.bindKey,e)},this.parseKeys=function(e){var i=e.toLowerCase().split(/[\-\+]([\-\+])?/).filter(function(S){return S}),r=i.pop(),n=E[r];if(E.FUNCTION_KEYS[n])r=E.FUNCTION_KEYS[n].toLowerCase();else if(i.length){if(i.length==1&&i[0]=="shift")return{key:r.toUpperCase(),hashId:-1}}else return{key:r,hashId:-1};for(var o=0,m=i.length;m--;){var w=E.KEY_MODS[i[m]];if(w==null)return typeof console<"u"&&console.error("invalid modifier "+i[m]+" in "+e),!1;o|=w}return{key:r,hashId:o}},this.findKeyCommand=function(i,r){var n=p[i]+r;return this.commandKeyBinding[n]},this.handleKeyboard=function(e,i,r,n){if(!(n<0)){var o=p[i]+r,m=this.commandKeyBinding[o];return e.$keyChain&&(e.$keyChain+=" "+o,m=this.commandKeyBinding[e.$keyChain]||m),m&&(m=="chainKeys"||m[m.length-1]=="chainKeys")?(e.$keyChain=e.$keyChain||o,{command:"null"}):(e.$keyChain&&((!i||i==4)&&r.length==1?e.$keyChain=e.$keyChain.slice(0,-o.length-1):(i==-1||n>0)&&(e.$keyChain="")),{command:m})}},this.getStatusText=function(e,i){return i.$keyChain||""}}.call(h.prototype),b.HashHandler=h,b.MultiHashHandler=u}),define("ace/commands/command_manager",["require","exports","module","ace/lib/oop","ace/keyboard/hash_handler","ace/lib/event_emitter"],function(L,b,B){"use strict";var E=L("../lib/oop"),A=L("../keyboard/hash_handler").MultiHashHandler,p=L("../lib/event_emitter").EventEmitter,h=function(u,t){A.call(this,t,u),this.byName=this.commands,this.setDefaultHandler("exec",function(e){return e.command.exec(e.editor,e.args||{})})};E.inherits(h,A),function(){E.implement(this,p),this.exec=function(u,t,e){if(Array.isArray(u)){for(var i=u.length;i--;)if(this.exec(u[i],t,e))return!0;return!1}if(typeof u=="string"&&(u=this.commands[u]),!u||t&&t.$readOnly&&!u.readOnly||this.$checkCommandState!=!1&&u.isAvailable&&!u.isAvailable(t))return!1;var r={editor:t,command:u,args:e};return r.returnValue=this._emit("exec",r),this._signal("afterExec",r),r.returnValue!==!1},this.toggleRecording=function(u){if(!this.$inReplay)return u&&u._emit("changeStatus"),this.recording?(this.macro.pop(),this.off("exec",this.$addCommandToMacro),this.macro.length||(this.macro=this.oldMacro),this.recording=!1):(this.$addCommandToMacro||(this.$addCommandToMacro=function(t){this.macro.push([t.command,t.args])}.bind(this)),this.oldMacro=this.macro,this.macro=[],this.on("exec",this.$addCommandToMacro),this.recording=!0)},this.replay=function(u){if(!(this.$inReplay||!this.macro)){if(this.recording)return this.toggleRecording(u);try{this.$inReplay=!0,this.macro.forEach(function(t){typeof t=="string"?this.exec(t,u):this.exec(t[0],u,t[1])},this)}finally{this.$inReplay=!1}}},this.trimMacro=function(u){return u.map(function(t){return typeof t[0]!="string"&&(t[0]=t[0].name),t[1]||(t=t[0]),t})}}.call(h.prototype),b.CommandManager=h}),define("ace/commands/default_commands",["require","exports","module","ace/lib/lang","ace/config","ace/range"],function(L,b,B){"use strict";var E=L("../lib/lang"),A=L("../config"),p=L("../range").Range;function h(t,e){return{win:t,mac:e}}b.commands=[{name:"showSettingsMenu",bindKey:h("Ctrl-,","Command-,"),exec:function(t){A.loadModule("ace/ext/settings_menu",function(e){e.init(t),t.showSettingsMenu()})},readOnly:!0},{name:"goToNextError",bindKey:h("Alt-E","F4"),exec:function(t){A.loadModule("./ext/error_marker",function(e){e.showErrorMarker(t,1)})},scrollIntoView:"animate",readOnly:!0},{name:"goToPreviousError",bindKey:h("Alt-Shift-E","Shift-F4"),exec:function(t){A.loadModule("./ext/error_marker",function(e){e.showErrorMarker(t,-1)})},scrollIntoView:"animate",readOnly:!0},{name:"selectall",description:"Select all",bindKey:h("Ctrl-A","Command-A"),exec:function(t){t.selectAll()},readOnly:!0},{name:"centerselection",description:"Center selection",bindKey:h(null,"Ctrl-L"),exec:function(t){t.centerSelection()},readOnly:!0},{name:"gotoline",description:"Go to line...",bindKey:h("Ctrl-L","Command-L"),exec:function(t,e){typeof e=="number"&&!isNaN(e)&&t.gotoLine(e),t.prompt({$type:"gotoLine"})},readOnly:!0},{name:"fold",bindKey:h("Alt-L|Ctrl-F1","Command-Alt-L|Command-F1"),exec:function(t){t.session.toggleFold(!1)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"unfold",bindKey:h("Alt-Shift-L|Ctrl-Shift-F1","Command-Alt-Shift-L|Command-Shift-F1"),exec:function(t){t.session.toggleFold(!0)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"toggleFoldWidget",bindKey:h("F2","F2"),exec:function(t){t.session.toggleFoldWidget()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"toggleParentFoldWidget",bindKey:h("Alt-F2","Alt-F2"),exec:function(t){t.session.toggleFoldWidget(!0)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"foldall",description:"Fold all",bindKey:h(null,"Ctrl-Command-Option-0"),exec:function(t){t.session.foldAll()},scrollIntoView:"center",readOnly:!0},{name:"foldAllComments",description:"Fold all comments",bindKey:h(null,"Ctrl-Command-Option-0"),exec:function(t){t.session.foldAllComments()},scrollIntoView:"center",readOnly:!0},{name:"foldOther",description:"Fold other",bindKey:h("Alt-0","Command-Option-0"),exec:function(t){t.session.foldAll(),t.session.unfold(t.selection.getAllRanges())},scrollIntoView:"center",readOnly:!0},{name:"unfoldall",description:"Unfold all",bindKey:h("Alt-Shift-0","Command-Option-Shift-0"),exec:function(t){t.session.unfold()},scrollIntoView:"center",readOnly:!0},{name:"findnext",description:"Find next",bindKey:h("Ctrl-K","Command-G"),exec:function(t){t.findNext()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"findprevious",description:"Find previous",bindKey:h("Ctrl-Shift-K","Command-Shift-G"),exec:function(t){t.findPrevious()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"selectOrFindNext",description:"Select or find next",bindKey:h("Alt-K","Ctrl-G"),exec:function(t){t.selection.isEmpty()?t.selection.selectWord():t.findNext()},readOnly:!0},{name:"selectOrFindPrevious",description:"Select or find previous",bindKey:h("Alt-Shift-K","Ctrl-Shift-G"),exec:function(t){t.selection.isEmpty()?t.selection.selectWord():t.findPrevious()},readOnly:!0},{name:"find",description:"Find",bindKey:h("Ctrl-F","Command-F"),exec:function(t){A.loadModule("ace/ext/searchbox",function(e){e.Search(t)})},readOnly:!0},{name:"overwrite",description:"Overwrite",bindKey:"Insert",exec:function(t){t.toggleOverwrite()},readOnly:!0},{name:"selecttostart",description:"Select to start",bindKey:h("Ctrl-Shift-Home","Command-Shift-Home|Command-Shift-Up"),exec:function(t){t.getSelection().selectFileStart()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"gotostart",description:"Go to start",bindKey:h("Ctrl-Home","Command-Home|Command-Up"),exec:function(t){t.navigateFileStart()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"selectup",description:"Select up",bindKey:h("Shift-Up","Shift-Up|Ctrl-Shift-P"),exec:function(t){t.getSelection().selectUp()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"golineup",description:"Go line up",bindKey:h("Up","Up|Ctrl-P"),exec:function(t,e){t.navigateUp(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttoend",description:"Select to end",bindKey:h("Ctrl-Shift-End","Command-Shift-End|Command-Shift-Down"),exec:function(t){t.getSelection().selectFileEnd()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"gotoend",description:"Go to end",bindKey:h("Ctrl-End","Command-End|Command-Down"),exec:function(t){t.navigateFileEnd()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"selectdown",description:"Select down",bindKey:h("Shift-Down","Shift-Down|Ctrl-Shift-N"),exec:function(t){t.getSelection().selectDown()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"golinedown",description:"Go line down",bindKey:h("Down","Down|Ctrl-N"),exec:function(t,e){t.navigateDown(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectwordleft",description:"Select word left",bindKey:h("Ctrl-Shift-Left","Option-Shift-Left"),exec:function(t){t.getSelection().selectWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotowordleft",description:"Go to word left",bindKey:h("Ctrl-Left","Option-Left"),exec:function(t){t.navigateWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttolinestart",description:"Select to line start",bindKey:h("Alt-Shift-Left","Command-Shift-Left|Ctrl-Shift-A"),exec:function(t){t.getSelection().selectLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotolinestart",description:"Go to line start",bindKey:h("Alt-Left|Home","Command-Left|Home|Ctrl-A"),exec:function(t){t.navigateLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectleft",description:"Select left",bindKey:h("Shift-Left","Shift-Left|Ctrl-Shift-B"),exec:function(t){t.getSelection().selectLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotoleft",description:"Go to left",bindKey:h("Left","Left|Ctrl-B"),exec:function(t,e){t.navigateLeft(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectwordright",description:"Select word right",bindKey:h("Ctrl-Shift-Right","Option-Shift-Right"),exec:function(t){t.getSelection().selectWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotowordright",description:"Go to word right",bindKey:h("Ctrl-Right","Option-Right"),exec:function(t){t.navigateWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttolineend",description:"Select to line end",bindKey:h("Alt-Shift-Right","Command-Shift-Right|Shift-End|Ctrl-Shift-E"),exec:function(t){t.getSelection().selectLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotolineend",description:"Go to line end",bindKey:h("Alt-Right|End","Command-Right|End|Ctrl-E"),exec:function(t){t.navigateLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectright",description:"Select right",bindKey:h("Shift-Right","Shift-Right"),exec:function(t){t.getSelection().selectRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotoright",description:"Go to right",bindKey:h("Right","Right|Ctrl-F"),exec:function(t,e){t.navigateRight(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectpagedown",description:"Select page down",bindKey:"Shift-PageDown",exec:function(t){t.selectPageDown()},readOnly:!0},{name:"pagedown",description:"Page down",bindKey:h(null,"Option-PageDown"),exec:function(t){t.scrollPageDown()},readOnly:!0},{name:"gotopagedown",description:"Go to page down",bindKey:h("PageDown","PageDown|Ctrl-V"),exec:function(t){t.gotoPageDown()},readOnly:!0},{name:"selectpageup",description:"Select page up",bindKey:"Shift-PageUp",exec:function(t){t.selectPageUp()},readOnly:!0},{name:"pageup",description:"Page up",bindKey:h(null,"Option-PageUp"),exec:function(t){t.scrollPageUp()},readOnly:!0},{name:"gotopageup",description:"Go to page up",bindKey:"PageUp",exec:function(t){t.gotoPageUp()},readOnly:!0},{name:"scrollup",description:"Scroll up",bindKey:h("Ctrl-Up",null),exec:function(t){t.renderer.scrollBy(0,-2*t.renderer.layerConfig.lineHeight)},readOnly:!0},{name:"scrolldown",description:"Scroll down",bindKey:h("Ctrl-Down",null),exec:function(t){t.renderer.scrollBy(0,2*t.renderer.layerConfig.lineHeight)},readOnly:!0},{name:"selectlinestart",description:"Select line start",bindKey:"Shift-Home",exec:function(t){t.getSelection().selectLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectlineend",description:"Select line end",bindKey:"Shift-End",exec:function(t){t.getSelection().selectLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"togglerecording",description:"Toggle recording",bindKey:h("Ctrl-Alt-E","Command-Option-E"),exec:function(t){t.commands.toggleRecording(t)},readOnly:!0},{name:"replaymacro",description:"Replay macro",bindKey:h("Ctrl-Shift-E","Command-Shift-E"),exec:function(t){t.commands.replay(t)},readOnly:!0},{name:"jumptomatching",description:"Jump to matching",bindKey:h("Ctrl-\\|Ctrl-P","Command-\\"),exec:function(t){t.jumpToMatching()},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"selecttomatching",description:"Select to matching",bindKey:h("Ctrl-Shift-\\|Ctrl-Shift-P","Command-Shift-\\"),exec:function(t){t.jumpToMatching(!0)},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"expandToMatching",description:"Expand to matching",bindKey:h("Ctrl-Shift-M","Ctrl-Shift-M"),exec:function(t){t.jumpToMatching(!0,!0)},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"passKeysToBrowser",description:"Pass keys to browser",bindKey:h(null,null),exec:function(){},passEvent:!0,readOnly:!0},{name:"copy",description:"Copy",exec:function(t){},readOnly:!0},{name:"cut",description:"Cut",exec:function(t){var e=t.$copyWithEmptySelection&&t.selection.isEmpty(),i=e?t.selection.getLineRange():t.selection.getRange();t._emit("cut",i),i.isEmpty()||t.session.remove(i),t.clearSelection()},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"paste",description:"Paste",exec:function(t,e){t.$handlePaste(e)},scrollIntoView:"cursor"},{name:"removeline",description:"Remove line",bindKey:h("Ctrl-D","Command-D"),exec:function(t){t.removeLines()},scrollIntoView:"cursor",multiSelectAction:"forEachLine"},{name:"duplicateSelection",description:"Duplicate selection",bindKey:h("Ctrl-Shift-D","Command-Shift-D"),exec:function(t){t.duplicateSelection()},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"sortlines",description:"Sort lines",bindKey:h("Ctrl-Alt-S","Command-Alt-S"),exec:function(t){t.sortLines()},scrollIntoView:"selection",multiSelectAction:"forEachLine"},{name:"togglecomment",description:"Toggle comment",bindKey:h("Ctrl-/","Command-/"),exec:function(t){t.toggleCommentLines()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"toggleBlockComment",description:"Toggle block comment",bindKey:h("Ctrl-Shift-/","Command-Shift-/"),exec:function(t){t.toggleBlockComment()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"modifyNumberUp",description:"Modify number up",bindKey:h("Ctrl-Shift-Up","Alt-Shift-Up"),exec:function(t){t.modifyNumber(1)},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"modifyNumberDown",description:"Modify number down",bindKey:h("Ctrl-Shift-Down","Alt-Shift-Down"),exec:function(t){t.modifyNumber(-1)},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"replace",description:"Replace",bindKey:h("Ctrl-H","Command-Option-F"),exec:function(t){A.loadModule("ace/ext/searchbox",function(e){e.Search(t,!0)})}},{name:"undo",description:"Undo",bindKey:h("Ctrl-Z","Command-Z"),exec:function(t){t.undo()}},{name:"redo",description:"Redo",bindKey:h("Ctrl-Shift-Z|Ctrl-Y","Command-Shift-Z|Command-Y"),exec:function(t){t.redo()}},{name:"copylinesup",description:"Copy lines up",bindKey:h("Alt-Shift-Up","Command-Option-Up"),exec:function(t){t.copyLinesUp()},scrollIntoView:"cursor"},{name:"movelinesup",description:"Move lines up",bindKey:h("Alt-Up","Option-Up"),exec:function(t){t.moveLinesUp()},scrollIntoView:"cursor"},{name:"copylinesdown",description:"Copy lines down",bindKey:h("Alt-Shift-Down","Command-Option-Down"),exec:function(t){t.copyLinesDown()},scrollIntoView:"cursor"},{name:"movelinesdown",description:"Move lines down",bindKey:h("Alt-Down","Option-Down"),exec:function(t){t.moveLinesDown()},scrollIntoView:"cursor"},{name:"del",description:"Delete",bindKey:h("Delete","Delete|Ctrl-D|Shift-Delete"),exec:function(t){t.remove("right")},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"backspace",description:"Backspace",bindKey:h("Shift-Backspace|Backspace","Ctrl-Backspace|Shift-Backspace|Backspace|Ctrl-H"),exec:function(t){t.remove("left")},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"cut_or_delete",description:"Cut or delete",bindKey:h("Shift-Delete",null),exec:function(t){if(t.selection.isEmpty())t.remove("left");else return!1},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolinestart",description:"Remove to line start",bindKey:h("Alt-Backspace","Command-Backspace"),exec:function(t){t.removeToLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolineend",description:"Remove to line end",bindKey:h("Alt-Delete","Ctrl-K|Command-Delete"),exec:function(t){t.removeToLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolinestarthard",description:"Remove to line start hard",bindKey:h("Ctrl-Shift-Backspace",null),exec:function(t){var e=t.selection.getRange();e.start.column=0,t.session.remove(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolineendhard",description:"Remove to line end hard",bindKey:h("Ctrl-Shift-Delete",null),exec:function(t){var e=t.selection.getRange();e.end.column=Number.MAX_VALUE,t.session.remove(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removewordleft",description:"Remove word left",bindKey:h("Ctrl-Backspace","Alt-Backspace|Ctrl-Alt-Backspace"),exec:function(t){t.removeWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removewordright",description:"Remove word right",bindKey:h("Ctrl-Delete","Alt-Delete"),exec:function(t){t.removeWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"outdent",description:"Outdent",bindKey:h("Shift-Tab","Shift-Tab"),exec:function(t){t.blockOutdent()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"indent",description:"Indent",bindKey:h("Tab","Tab"),exec:function(t){t.indent()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"blockoutdent",description:"Block outdent",bindKey:h("Ctrl-[","Ctrl-["),exec:function(t){t.blockOutdent()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"blockindent",description:"Block indent",bindKey:h("Ctrl-]","Ctrl-]"),exec:function(t){t.blockIndent()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"insertstring",description:"Insert string",exec:function(t,e){t.insert(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"inserttext",description:"Insert text",exec:function(t,e){t.insert(E.stringRepeat(e.text||"",e.times||1))},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"splitline",description:"Split line",bindKey:h(null,"Ctrl-O"),exec:function(t){t.splitLine()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"transposeletters",description:"Transpose letters",bindKey:h("Alt-Shift-X","Ctrl-T"),exec:function(t){t.transposeLetters()},multiSelectAction:function(t){t.transposeSelections(1)},scrollIntoView:"cursor"},{name:"touppercase",description:"To uppercase",bindKey:h("Ctrl-U","Ctrl-U"),exec:function(t){t.toUpperCase()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"tolowercase",description:"To lowercase",bindKey:h("Ctrl-Shift-U","Ctrl-Shift-U"),exec:function(t){t.toLowerCase()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"autoindent",description:"Auto Indent",bindKey:h(null,null),exec:function(t){t.autoIndent()},multiSelectAction:"forEachLine",scrollIntoView:"animate"},{name:"expandtoline",description:"Expand to line",bindKey:h("Ctrl-Shift-L","Command-Shift-L"),exec:function(t){var e=t.selection.getRange();e.start.column=e.end.column=0,e.end.row++,t.selection.setRange(e,!1)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"joinlines",description:"Join lines",bindKey:h(null,null),exec:function(t){for(var e=t.selection.isBackwards(),i=e?t.selection.getSelectionLead():t.selection.getSelectionAnchor(),r=e?t.selection.getSelectionAnchor():t.selection.getSelectionLead(),n=t.session.doc.getLine(i.row).length,o=t.session.doc.getTextRange(t.selection.getRange()),m=o.replace(/\n\s*/," ").length,w=t.session.doc.getLine(i.row),S=i.row+1;S<=r.row+1;S++){var y=E.stringTrimLeft(E.stringTrimRight(t.session.doc.getLine(S)));y.length!==0&&(y=" "+y),w+=y}r.row+1<t.session.doc.getLength()-1&&(w+=t.session.doc.getNewLineCharacter()),t.clearSelection(),t.session.doc.replace(new p(i.row,0,r.row+2,0),w),m>0?(t.selection.moveCursorTo(i.row,i.column),t.selection.selectTo(i.row,i.column+m)):(n=t.session.doc.getLine(i.row).length>n?n+1:n,t.selection.moveCursorTo(i.row,n))},multiSelectAction:"forEach",readOnly:!0},{name:"invertSelection",description:"Invert selection",bindKey:h(null,null),exec:function(t){var e=t.session.doc.getLength()-1,i=t.session.doc.getLine(e).length,r=t.selection.rangeList.ranges,n=[];r.length<1&&(r=[t.selection.getRange()]);for(var o=0;o<r.length;o++)o==r.length-1&&(r[o].end.row===e&&r[o].end.column===i||n.push(new p(r[o].end.row,r[o].end.column,e,i))),o===0?r[o].start.row===0&&r[o].start.column===0||n.push(new p(0,0,r[o].start.row,r[o].start.column)):n.push(new p(r[o-1].end.row,r[o-1].end.column,r[o].start.row,r[o].start.column));t.exitMultiSelectMode(),t.clearSelection();for(var o=0;o<n.length;o++)t.selection.addRange(n[o],!1)},readOnly:!0,scrollIntoView:"none"},{name:"addLineAfter",exec:function(t){t.selection.clearSelection(),t.navigateLineEnd(),t.insert(`
+`),r=[],n=0;n<i.length;n++)try{r.push(new RegExp(i[n],e))}catch{return!1}return r},this.$matchIterator=function(t,e){var i=this.$assembleRegExp(e);if(!i)return!1;var r=e.backwards==!0,n=e.skipCurrent!=!1,a=e.range,m=e.start;m||(m=a?a[r?"end":"start"]:t.selection.getRange()),m.start&&(m=m[n!=r?"end":"start"]);var w=a?a.start.row:0,S=a?a.end.row:t.getLength()-1;if(r)var b=function(g){var u=m.row;if(!l(u,m.column,g)){for(u--;u>=w;u--)if(l(u,Number.MAX_VALUE,g))return;if(e.wrap!=!1){for(u=S,w=m.row;u>=w;u--)if(l(u,Number.MAX_VALUE,g))return}}};else var b=function(u){var o=m.row;if(!l(o,m.column,u)){for(o=o+1;o<=S;o++)if(l(o,0,u))return;if(e.wrap!=!1){for(o=w,S=m.row;o<=S;o++)if(l(o,0,u))return}}};if(e.$isMultiLine)var c=i.length,l=function(g,u,o){var f=r?g-c+1:g;if(!(f<0)){var s=t.getLine(f),v=s.search(i[0]);if(!(!r&&v<u||v===-1)){for(var $=1;$<c;$++)if(s=t.getLine(f+$),s.search(i[$])==-1)return;var C=s.match(i[c-1])[0].length;if(!(r&&C>u)&&o(f,v,f+c-1,C))return!0}}};else if(r)var l=function(u,o,f){var s=t.getLine(u),v=[],$,C=0;for(i.lastIndex=0;$=i.exec(s);){var x=$[0].length;if(C=$.index,!x){if(C>=s.length)break;i.lastIndex=C+=1}if($.index+x>o)break;v.push($.index,x)}for(var L=v.length-1;L>=0;L-=2){var R=v[L-1],x=v[L];if(f(u,R,u,R+x))return!0}};else var l=function(u,o,f){var s=t.getLine(u),v,$;for(i.lastIndex=o;$=i.exec(s);){var C=$[0].length;if(v=$.index,f(u,v,u,v+C))return!0;if(!C&&(i.lastIndex=v+=1,v>=s.length))return!1}};return{forEach:b}}}).call(h.prototype);function d(t,e){function i(r){return/\w/.test(r)||e.regExp?"\\b":""}return i(t[0])+t+i(t[t.length-1])}y.Search=h}),define("ace/keyboard/hash_handler",["require","exports","module","ace/lib/keys","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("../lib/keys"),A=k("../lib/useragent"),p=E.KEY_MODS;function h(t,e){this.platform=e||(A.isMac?"mac":"win"),this.commands={},this.commandKeyBinding={},this.addCommands(t),this.$singleCommand=!0}function d(t,e){h.call(this,t,e),this.$singleCommand=!1}d.prototype=h.prototype,function(){this.addCommand=function(e){this.commands[e.name]&&this.removeCommand(e),this.commands[e.name]=e,e.bindKey&&this._buildKeyHash(e)},this.removeCommand=function(e,i){var r=e&&(typeof e=="string"?e:e.name);e=this.commands[r],i||delete this.commands[r];var n=this.commandKeyBinding;for(var a in n){var m=n[a];if(m==e)delete n[a];else if(Array.isArray(m)){var w=m.indexOf(e);w!=-1&&(m.splice(w,1),m.length==1&&(n[a]=m[0]))}}},this.bindKey=function(e,i,r){if(typeof e=="object"&&e&&(r==null&&(r=e.position),e=e[this.platform]),!!e){if(typeof i=="function")return this.addCommand({exec:i,bindKey:e,name:i.name||e});e.split("|").forEach(function(n){var a="";if(n.indexOf(" ")!=-1){var m=n.split(/\s+/);n=m.pop(),m.forEach(function(b){var c=this.parseKeys(b),l=p[c.hashId]+c.key;a+=(a?" ":"")+l,this._addCommandToBinding(a,"chainKeys")},this),a+=" "}var w=this.parseKeys(n),S=p[w.hashId]+w.key;this._addCommandToBinding(a+S,i,r)},this)}};function t(e){return typeof e=="object"&&e.bindKey&&e.bindKey.position||(e.isDefault?-100:0)}this._addCommandToBinding=function(e,i,r){var n=this.commandKeyBinding,a;if(!i)delete n[e];else if(!n[e]||this.$singleCommand)n[e]=i;else{Array.isArray(n[e])?(a=n[e].indexOf(i))!=-1&&n[e].splice(a,1):n[e]=[n[e]],typeof r!="number"&&(r=t(i));var m=n[e];for(a=0;a<m.length;a++){var w=m[a],S=t(w);if(S>r)break}m.splice(a,0,i)}},this.addCommands=function(e){e&&Object.keys(e).forEach(function(i){var r=e[i];if(r){if(typeof r=="string")return this.bindKey(r,i);typeof r=="function"&&(r={exec:r}),typeof r=="object"&&(r.name||(r.name=i),this.addCommand(r))}},this)},this.removeCommands=function(e){Object.keys(e).forEach(function(i){this.removeCommand(e[i])},this)},this.bindKeys=function(e){Object.keys(e).forEach(function(i){this.bindKey(i,e[i])},this)},this._buildKeyHash=function(e){this.bindKey(e.bindKey,e)},this.parseKeys=function(e){var i=e.toLowerCase().split(/[\-\+]([\-\+])?/).filter(function(S){return S}),r=i.pop(),n=E[r];if(E.FUNCTION_KEYS[n])r=E.FUNCTION_KEYS[n].toLowerCase();else if(i.length){if(i.length==1&&i[0]=="shift")return{key:r.toUpperCase(),hashId:-1}}else return{key:r,hashId:-1};for(var a=0,m=i.length;m--;){var w=E.KEY_MODS[i[m]];if(w==null)return typeof console<"u"&&console.error("invalid modifier "+i[m]+" in "+e),!1;a|=w}return{key:r,hashId:a}},this.findKeyCommand=function(i,r){var n=p[i]+r;return this.commandKeyBinding[n]},this.handleKeyboard=function(e,i,r,n){if(!(n<0)){var a=p[i]+r,m=this.commandKeyBinding[a];return e.$keyChain&&(e.$keyChain+=" "+a,m=this.commandKeyBinding[e.$keyChain]||m),m&&(m=="chainKeys"||m[m.length-1]=="chainKeys")?(e.$keyChain=e.$keyChain||a,{command:"null"}):(e.$keyChain&&((!i||i==4)&&r.length==1?e.$keyChain=e.$keyChain.slice(0,-a.length-1):(i==-1||n>0)&&(e.$keyChain="")),{command:m})}},this.getStatusText=function(e,i){return i.$keyChain||""}}.call(h.prototype),y.HashHandler=h,y.MultiHashHandler=d}),define("ace/commands/command_manager",["require","exports","module","ace/lib/oop","ace/keyboard/hash_handler","ace/lib/event_emitter"],function(k,y,B){"use strict";var E=k("../lib/oop"),A=k("../keyboard/hash_handler").MultiHashHandler,p=k("../lib/event_emitter").EventEmitter,h=function(d,t){A.call(this,t,d),this.byName=this.commands,this.setDefaultHandler("exec",function(e){return e.command.exec(e.editor,e.args||{})})};E.inherits(h,A),function(){E.implement(this,p),this.exec=function(d,t,e){if(Array.isArray(d)){for(var i=d.length;i--;)if(this.exec(d[i],t,e))return!0;return!1}if(typeof d=="string"&&(d=this.commands[d]),!d||t&&t.$readOnly&&!d.readOnly||this.$checkCommandState!=!1&&d.isAvailable&&!d.isAvailable(t))return!1;var r={editor:t,command:d,args:e};return r.returnValue=this._emit("exec",r),this._signal("afterExec",r),r.returnValue!==!1},this.toggleRecording=function(d){if(!this.$inReplay)return d&&d._emit("changeStatus"),this.recording?(this.macro.pop(),this.off("exec",this.$addCommandToMacro),this.macro.length||(this.macro=this.oldMacro),this.recording=!1):(this.$addCommandToMacro||(this.$addCommandToMacro=function(t){this.macro.push([t.command,t.args])}.bind(this)),this.oldMacro=this.macro,this.macro=[],this.on("exec",this.$addCommandToMacro),this.recording=!0)},this.replay=function(d){if(!(this.$inReplay||!this.macro)){if(this.recording)return this.toggleRecording(d);try{this.$inReplay=!0,this.macro.forEach(function(t){typeof t=="string"?this.exec(t,d):this.exec(t[0],d,t[1])},this)}finally{this.$inReplay=!1}}},this.trimMacro=function(d){return d.map(function(t){return typeof t[0]!="string"&&(t[0]=t[0].name),t[1]||(t=t[0]),t})}}.call(h.prototype),y.CommandManager=h}),define("ace/commands/default_commands",["require","exports","module","ace/lib/lang","ace/config","ace/range"],function(k,y,B){"use strict";var E=k("../lib/lang"),A=k("../config"),p=k("../range").Range;function h(t,e){return{win:t,mac:e}}y.commands=[{name:"showSettingsMenu",bindKey:h("Ctrl-,","Command-,"),exec:function(t){A.loadModule("ace/ext/settings_menu",function(e){e.init(t),t.showSettingsMenu()})},readOnly:!0},{name:"goToNextError",bindKey:h("Alt-E","F4"),exec:function(t){A.loadModule("./ext/error_marker",function(e){e.showErrorMarker(t,1)})},scrollIntoView:"animate",readOnly:!0},{name:"goToPreviousError",bindKey:h("Alt-Shift-E","Shift-F4"),exec:function(t){A.loadModule("./ext/error_marker",function(e){e.showErrorMarker(t,-1)})},scrollIntoView:"animate",readOnly:!0},{name:"selectall",description:"Select all",bindKey:h("Ctrl-A","Command-A"),exec:function(t){t.selectAll()},readOnly:!0},{name:"centerselection",description:"Center selection",bindKey:h(null,"Ctrl-L"),exec:function(t){t.centerSelection()},readOnly:!0},{name:"gotoline",description:"Go to line...",bindKey:h("Ctrl-L","Command-L"),exec:function(t,e){typeof e=="number"&&!isNaN(e)&&t.gotoLine(e),t.prompt({$type:"gotoLine"})},readOnly:!0},{name:"fold",bindKey:h("Alt-L|Ctrl-F1","Command-Alt-L|Command-F1"),exec:function(t){t.session.toggleFold(!1)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"unfold",bindKey:h("Alt-Shift-L|Ctrl-Shift-F1","Command-Alt-Shift-L|Command-Shift-F1"),exec:function(t){t.session.toggleFold(!0)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"toggleFoldWidget",bindKey:h("F2","F2"),exec:function(t){t.session.toggleFoldWidget()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"toggleParentFoldWidget",bindKey:h("Alt-F2","Alt-F2"),exec:function(t){t.session.toggleFoldWidget(!0)},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"foldall",description:"Fold all",bindKey:h(null,"Ctrl-Command-Option-0"),exec:function(t){t.session.foldAll()},scrollIntoView:"center",readOnly:!0},{name:"foldAllComments",description:"Fold all comments",bindKey:h(null,"Ctrl-Command-Option-0"),exec:function(t){t.session.foldAllComments()},scrollIntoView:"center",readOnly:!0},{name:"foldOther",description:"Fold other",bindKey:h("Alt-0","Command-Option-0"),exec:function(t){t.session.foldAll(),t.session.unfold(t.selection.getAllRanges())},scrollIntoView:"center",readOnly:!0},{name:"unfoldall",description:"Unfold all",bindKey:h("Alt-Shift-0","Command-Option-Shift-0"),exec:function(t){t.session.unfold()},scrollIntoView:"center",readOnly:!0},{name:"findnext",description:"Find next",bindKey:h("Ctrl-K","Command-G"),exec:function(t){t.findNext()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"findprevious",description:"Find previous",bindKey:h("Ctrl-Shift-K","Command-Shift-G"),exec:function(t){t.findPrevious()},multiSelectAction:"forEach",scrollIntoView:"center",readOnly:!0},{name:"selectOrFindNext",description:"Select or find next",bindKey:h("Alt-K","Ctrl-G"),exec:function(t){t.selection.isEmpty()?t.selection.selectWord():t.findNext()},readOnly:!0},{name:"selectOrFindPrevious",description:"Select or find previous",bindKey:h("Alt-Shift-K","Ctrl-Shift-G"),exec:function(t){t.selection.isEmpty()?t.selection.selectWord():t.findPrevious()},readOnly:!0},{name:"find",description:"Find",bindKey:h("Ctrl-F","Command-F"),exec:function(t){A.loadModule("ace/ext/searchbox",function(e){e.Search(t)})},readOnly:!0},{name:"overwrite",description:"Overwrite",bindKey:"Insert",exec:function(t){t.toggleOverwrite()},readOnly:!0},{name:"selecttostart",description:"Select to start",bindKey:h("Ctrl-Shift-Home","Command-Shift-Home|Command-Shift-Up"),exec:function(t){t.getSelection().selectFileStart()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"gotostart",description:"Go to start",bindKey:h("Ctrl-Home","Command-Home|Command-Up"),exec:function(t){t.navigateFileStart()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"selectup",description:"Select up",bindKey:h("Shift-Up","Shift-Up|Ctrl-Shift-P"),exec:function(t){t.getSelection().selectUp()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"golineup",description:"Go line up",bindKey:h("Up","Up|Ctrl-P"),exec:function(t,e){t.navigateUp(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttoend",description:"Select to end",bindKey:h("Ctrl-Shift-End","Command-Shift-End|Command-Shift-Down"),exec:function(t){t.getSelection().selectFileEnd()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"gotoend",description:"Go to end",bindKey:h("Ctrl-End","Command-End|Command-Down"),exec:function(t){t.navigateFileEnd()},multiSelectAction:"forEach",readOnly:!0,scrollIntoView:"animate",aceCommandGroup:"fileJump"},{name:"selectdown",description:"Select down",bindKey:h("Shift-Down","Shift-Down|Ctrl-Shift-N"),exec:function(t){t.getSelection().selectDown()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"golinedown",description:"Go line down",bindKey:h("Down","Down|Ctrl-N"),exec:function(t,e){t.navigateDown(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectwordleft",description:"Select word left",bindKey:h("Ctrl-Shift-Left","Option-Shift-Left"),exec:function(t){t.getSelection().selectWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotowordleft",description:"Go to word left",bindKey:h("Ctrl-Left","Option-Left"),exec:function(t){t.navigateWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttolinestart",description:"Select to line start",bindKey:h("Alt-Shift-Left","Command-Shift-Left|Ctrl-Shift-A"),exec:function(t){t.getSelection().selectLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotolinestart",description:"Go to line start",bindKey:h("Alt-Left|Home","Command-Left|Home|Ctrl-A"),exec:function(t){t.navigateLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectleft",description:"Select left",bindKey:h("Shift-Left","Shift-Left|Ctrl-Shift-B"),exec:function(t){t.getSelection().selectLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotoleft",description:"Go to left",bindKey:h("Left","Left|Ctrl-B"),exec:function(t,e){t.navigateLeft(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectwordright",description:"Select word right",bindKey:h("Ctrl-Shift-Right","Option-Shift-Right"),exec:function(t){t.getSelection().selectWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotowordright",description:"Go to word right",bindKey:h("Ctrl-Right","Option-Right"),exec:function(t){t.navigateWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selecttolineend",description:"Select to line end",bindKey:h("Alt-Shift-Right","Command-Shift-Right|Shift-End|Ctrl-Shift-E"),exec:function(t){t.getSelection().selectLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotolineend",description:"Go to line end",bindKey:h("Alt-Right|End","Command-Right|End|Ctrl-E"),exec:function(t){t.navigateLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectright",description:"Select right",bindKey:h("Shift-Right","Shift-Right"),exec:function(t){t.getSelection().selectRight()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"gotoright",description:"Go to right",bindKey:h("Right","Right|Ctrl-F"),exec:function(t,e){t.navigateRight(e.times)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectpagedown",description:"Select page down",bindKey:"Shift-PageDown",exec:function(t){t.selectPageDown()},readOnly:!0},{name:"pagedown",description:"Page down",bindKey:h(null,"Option-PageDown"),exec:function(t){t.scrollPageDown()},readOnly:!0},{name:"gotopagedown",description:"Go to page down",bindKey:h("PageDown","PageDown|Ctrl-V"),exec:function(t){t.gotoPageDown()},readOnly:!0},{name:"selectpageup",description:"Select page up",bindKey:"Shift-PageUp",exec:function(t){t.selectPageUp()},readOnly:!0},{name:"pageup",description:"Page up",bindKey:h(null,"Option-PageUp"),exec:function(t){t.scrollPageUp()},readOnly:!0},{name:"gotopageup",description:"Go to page up",bindKey:"PageUp",exec:function(t){t.gotoPageUp()},readOnly:!0},{name:"scrollup",description:"Scroll up",bindKey:h("Ctrl-Up",null),exec:function(t){t.renderer.scrollBy(0,-2*t.renderer.layerConfig.lineHeight)},readOnly:!0},{name:"scrolldown",description:"Scroll down",bindKey:h("Ctrl-Down",null),exec:function(t){t.renderer.scrollBy(0,2*t.renderer.layerConfig.lineHeight)},readOnly:!0},{name:"selectlinestart",description:"Select line start",bindKey:"Shift-Home",exec:function(t){t.getSelection().selectLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"selectlineend",description:"Select line end",bindKey:"Shift-End",exec:function(t){t.getSelection().selectLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"togglerecording",description:"Toggle recording",bindKey:h("Ctrl-Alt-E","Command-Option-E"),exec:function(t){t.commands.toggleRecording(t)},readOnly:!0},{name:"replaymacro",description:"Replay macro",bindKey:h("Ctrl-Shift-E","Command-Shift-E"),exec:function(t){t.commands.replay(t)},readOnly:!0},{name:"jumptomatching",description:"Jump to matching",bindKey:h("Ctrl-\\|Ctrl-P","Command-\\"),exec:function(t){t.jumpToMatching()},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"selecttomatching",description:"Select to matching",bindKey:h("Ctrl-Shift-\\|Ctrl-Shift-P","Command-Shift-\\"),exec:function(t){t.jumpToMatching(!0)},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"expandToMatching",description:"Expand to matching",bindKey:h("Ctrl-Shift-M","Ctrl-Shift-M"),exec:function(t){t.jumpToMatching(!0,!0)},multiSelectAction:"forEach",scrollIntoView:"animate",readOnly:!0},{name:"passKeysToBrowser",description:"Pass keys to browser",bindKey:h(null,null),exec:function(){},passEvent:!0,readOnly:!0},{name:"copy",description:"Copy",exec:function(t){},readOnly:!0},{name:"cut",description:"Cut",exec:function(t){var e=t.$copyWithEmptySelection&&t.selection.isEmpty(),i=e?t.selection.getLineRange():t.selection.getRange();t._emit("cut",i),i.isEmpty()||t.session.remove(i),t.clearSelection()},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"paste",description:"Paste",exec:function(t,e){t.$handlePaste(e)},scrollIntoView:"cursor"},{name:"removeline",description:"Remove line",bindKey:h("Ctrl-D","Command-D"),exec:function(t){t.removeLines()},scrollIntoView:"cursor",multiSelectAction:"forEachLine"},{name:"duplicateSelection",description:"Duplicate selection",bindKey:h("Ctrl-Shift-D","Command-Shift-D"),exec:function(t){t.duplicateSelection()},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"sortlines",description:"Sort lines",bindKey:h("Ctrl-Alt-S","Command-Alt-S"),exec:function(t){t.sortLines()},scrollIntoView:"selection",multiSelectAction:"forEachLine"},{name:"togglecomment",description:"Toggle comment",bindKey:h("Ctrl-/","Command-/"),exec:function(t){t.toggleCommentLines()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"toggleBlockComment",description:"Toggle block comment",bindKey:h("Ctrl-Shift-/","Command-Shift-/"),exec:function(t){t.toggleBlockComment()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"modifyNumberUp",description:"Modify number up",bindKey:h("Ctrl-Shift-Up","Alt-Shift-Up"),exec:function(t){t.modifyNumber(1)},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"modifyNumberDown",description:"Modify number down",bindKey:h("Ctrl-Shift-Down","Alt-Shift-Down"),exec:function(t){t.modifyNumber(-1)},scrollIntoView:"cursor",multiSelectAction:"forEach"},{name:"replace",description:"Replace",bindKey:h("Ctrl-H","Command-Option-F"),exec:function(t){A.loadModule("ace/ext/searchbox",function(e){e.Search(t,!0)})}},{name:"undo",description:"Undo",bindKey:h("Ctrl-Z","Command-Z"),exec:function(t){t.undo()}},{name:"redo",description:"Redo",bindKey:h("Ctrl-Shift-Z|Ctrl-Y","Command-Shift-Z|Command-Y"),exec:function(t){t.redo()}},{name:"copylinesup",description:"Copy lines up",bindKey:h("Alt-Shift-Up","Command-Option-Up"),exec:function(t){t.copyLinesUp()},scrollIntoView:"cursor"},{name:"movelinesup",description:"Move lines up",bindKey:h("Alt-Up","Option-Up"),exec:function(t){t.moveLinesUp()},scrollIntoView:"cursor"},{name:"copylinesdown",description:"Copy lines down",bindKey:h("Alt-Shift-Down","Command-Option-Down"),exec:function(t){t.copyLinesDown()},scrollIntoView:"cursor"},{name:"movelinesdown",description:"Move lines down",bindKey:h("Alt-Down","Option-Down"),exec:function(t){t.moveLinesDown()},scrollIntoView:"cursor"},{name:"del",description:"Delete",bindKey:h("Delete","Delete|Ctrl-D|Shift-Delete"),exec:function(t){t.remove("right")},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"backspace",description:"Backspace",bindKey:h("Shift-Backspace|Backspace","Ctrl-Backspace|Shift-Backspace|Backspace|Ctrl-H"),exec:function(t){t.remove("left")},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"cut_or_delete",description:"Cut or delete",bindKey:h("Shift-Delete",null),exec:function(t){if(t.selection.isEmpty())t.remove("left");else return!1},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolinestart",description:"Remove to line start",bindKey:h("Alt-Backspace","Command-Backspace"),exec:function(t){t.removeToLineStart()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolineend",description:"Remove to line end",bindKey:h("Alt-Delete","Ctrl-K|Command-Delete"),exec:function(t){t.removeToLineEnd()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolinestarthard",description:"Remove to line start hard",bindKey:h("Ctrl-Shift-Backspace",null),exec:function(t){var e=t.selection.getRange();e.start.column=0,t.session.remove(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removetolineendhard",description:"Remove to line end hard",bindKey:h("Ctrl-Shift-Delete",null),exec:function(t){var e=t.selection.getRange();e.end.column=Number.MAX_VALUE,t.session.remove(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removewordleft",description:"Remove word left",bindKey:h("Ctrl-Backspace","Alt-Backspace|Ctrl-Alt-Backspace"),exec:function(t){t.removeWordLeft()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"removewordright",description:"Remove word right",bindKey:h("Ctrl-Delete","Alt-Delete"),exec:function(t){t.removeWordRight()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"outdent",description:"Outdent",bindKey:h("Shift-Tab","Shift-Tab"),exec:function(t){t.blockOutdent()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"indent",description:"Indent",bindKey:h("Tab","Tab"),exec:function(t){t.indent()},multiSelectAction:"forEach",scrollIntoView:"selectionPart"},{name:"blockoutdent",description:"Block outdent",bindKey:h("Ctrl-[","Ctrl-["),exec:function(t){t.blockOutdent()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"blockindent",description:"Block indent",bindKey:h("Ctrl-]","Ctrl-]"),exec:function(t){t.blockIndent()},multiSelectAction:"forEachLine",scrollIntoView:"selectionPart"},{name:"insertstring",description:"Insert string",exec:function(t,e){t.insert(e)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"inserttext",description:"Insert text",exec:function(t,e){t.insert(E.stringRepeat(e.text||"",e.times||1))},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"splitline",description:"Split line",bindKey:h(null,"Ctrl-O"),exec:function(t){t.splitLine()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"transposeletters",description:"Transpose letters",bindKey:h("Alt-Shift-X","Ctrl-T"),exec:function(t){t.transposeLetters()},multiSelectAction:function(t){t.transposeSelections(1)},scrollIntoView:"cursor"},{name:"touppercase",description:"To uppercase",bindKey:h("Ctrl-U","Ctrl-U"),exec:function(t){t.toUpperCase()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"tolowercase",description:"To lowercase",bindKey:h("Ctrl-Shift-U","Ctrl-Shift-U"),exec:function(t){t.toLowerCase()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"autoindent",description:"Auto Indent",bindKey:h(null,null),exec:function(t){t.autoIndent()},multiSelectAction:"forEachLine",scrollIntoView:"animate"},{name:"expandtoline",description:"Expand to line",bindKey:h("Ctrl-Shift-L","Command-Shift-L"),exec:function(t){var e=t.selection.getRange();e.start.column=e.end.column=0,e.end.row++,t.selection.setRange(e,!1)},multiSelectAction:"forEach",scrollIntoView:"cursor",readOnly:!0},{name:"joinlines",description:"Join lines",bindKey:h(null,null),exec:function(t){for(var e=t.selection.isBackwards(),i=e?t.selection.getSelectionLead():t.selection.getSelectionAnchor(),r=e?t.selection.getSelectionAnchor():t.selection.getSelectionLead(),n=t.session.doc.getLine(i.row).length,a=t.session.doc.getTextRange(t.selection.getRange()),m=a.replace(/\n\s*/," ").length,w=t.session.doc.getLine(i.row),S=i.row+1;S<=r.row+1;S++){var b=E.stringTrimLeft(E.stringTrimRight(t.session.doc.getLine(S)));b.length!==0&&(b=" "+b),w+=b}r.row+1<t.session.doc.getLength()-1&&(w+=t.session.doc.getNewLineCharacter()),t.clearSelection(),t.session.doc.replace(new p(i.row,0,r.row+2,0),w),m>0?(t.selection.moveCursorTo(i.row,i.column),t.selection.selectTo(i.row,i.column+m)):(n=t.session.doc.getLine(i.row).length>n?n+1:n,t.selection.moveCursorTo(i.row,n))},multiSelectAction:"forEach",readOnly:!0},{name:"invertSelection",description:"Invert selection",bindKey:h(null,null),exec:function(t){var e=t.session.doc.getLength()-1,i=t.session.doc.getLine(e).length,r=t.selection.rangeList.ranges,n=[];r.length<1&&(r=[t.selection.getRange()]);for(var a=0;a<r.length;a++)a==r.length-1&&(r[a].end.row===e&&r[a].end.column===i||n.push(new p(r[a].end.row,r[a].end.column,e,i))),a===0?r[a].start.row===0&&r[a].start.column===0||n.push(new p(0,0,r[a].start.row,r[a].start.column)):n.push(new p(r[a-1].end.row,r[a-1].end.column,r[a].start.row,r[a].start.column));t.exitMultiSelectMode(),t.clearSelection();for(var a=0;a<n.length;a++)t.selection.addRange(n[a],!1)},readOnly:!0,scrollIntoView:"none"},{name:"addLineAfter",exec:function(t){t.selection.clearSelection(),t.navigateLineEnd(),t.insert(`
 `)},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"addLineBefore",exec:function(t){t.selection.clearSelection();var e=t.getCursorPosition();t.selection.moveTo(e.row-1,Number.MAX_VALUE),t.insert(`
-`),e.row===0&&t.navigateUp()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"openCommandPallete",description:"Open command pallete",bindKey:h("F1","F1"),exec:function(t){t.prompt({$type:"commands"})},readOnly:!0},{name:"modeSelect",description:"Change language mode...",bindKey:h(null,null),exec:function(t){t.prompt({$type:"modes"})},readOnly:!0}];for(var u=1;u<9;u++)b.commands.push({name:"foldToLevel"+u,description:"Fold To Level "+u,level:u,exec:function(t){t.session.foldToLevel(this.level)},scrollIntoView:"center",readOnly:!0})}),define("ace/editor",["require","exports","module","ace/lib/fixoldbrowsers","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/lib/useragent","ace/keyboard/textinput","ace/mouse/mouse_handler","ace/mouse/fold_handler","ace/keyboard/keybinding","ace/edit_session","ace/search","ace/range","ace/lib/event_emitter","ace/commands/command_manager","ace/commands/default_commands","ace/config","ace/token_iterator","ace/clipboard"],function(L,b,B){"use strict";L("./lib/fixoldbrowsers");var E=L("./lib/oop"),A=L("./lib/dom"),p=L("./lib/lang"),h=L("./lib/useragent"),u=L("./keyboard/textinput").TextInput,t=L("./mouse/mouse_handler").MouseHandler,e=L("./mouse/fold_handler").FoldHandler,i=L("./keyboard/keybinding").KeyBinding,r=L("./edit_session").EditSession,n=L("./search").Search,o=L("./range").Range,m=L("./lib/event_emitter").EventEmitter,w=L("./commands/command_manager").CommandManager,S=L("./commands/default_commands").commands,y=L("./config"),c=L("./token_iterator").TokenIterator,l=L("./clipboard"),g=function(a,f,s){this.$toDestroy=[];var v=a.getContainerElement();this.container=v,this.renderer=a,this.id="editor"+ ++g.$uid,this.commands=new w(h.isMac?"mac":"win",S),typeof document=="object"&&(this.textInput=new u(a.getTextAreaContainer(),this),this.renderer.textarea=this.textInput.getElement(),this.$mouseHandler=new t(this),new e(this)),this.keyBinding=new i(this),this.$search=new n().set({wrap:!0}),this.$historyTracker=this.$historyTracker.bind(this),this.commands.on("exec",this.$historyTracker),this.$initOperationListeners(),this._$emitInputEvent=p.delayedCall(function(){this._signal("input",{}),this.session&&this.session.bgTokenizer&&this.session.bgTokenizer.scheduleStart()}.bind(this)),this.on("change",function($,x){x._$emitInputEvent.schedule(31)}),this.setSession(f||s&&s.session||new r("")),y.resetOptions(this),s&&this.setOptions(s),y._signal("editor",this)};g.$uid=0,function(){E.implement(this,m),this.$initOperationListeners=function(){this.commands.on("exec",this.startOperation.bind(this),!0),this.commands.on("afterExec",this.endOperation.bind(this),!0),this.$opResetTimer=p.delayedCall(this.endOperation.bind(this,!0)),this.on("change",function(){this.curOp||(this.startOperation(),this.curOp.selectionBefore=this.$lastSel),this.curOp.docChanged=!0}.bind(this),!0),this.on("changeSelection",function(){this.curOp||(this.startOperation(),this.curOp.selectionBefore=this.$lastSel),this.curOp.selectionChanged=!0}.bind(this),!0)},this.curOp=null,this.prevOp={},this.startOperation=function(a){if(this.curOp){if(!a||this.curOp.command)return;this.prevOp=this.curOp}a||(this.previousCommand=null,a={}),this.$opResetTimer.schedule(),this.curOp=this.session.curOp={command:a.command||{},args:a.args,scrollTop:this.renderer.scrollTop},this.curOp.selectionBefore=this.selection.toJSON()},this.endOperation=function(a){if(this.curOp&&this.session){if(a&&a.returnValue===!1||!this.session)return this.curOp=null;if(a==!0&&this.curOp.command&&this.curOp.command.name=="mouse"||(this._signal("beforeEndOperation"),!this.curOp))return;var f=this.curOp.command,s=f&&f.scrollIntoView;if(s){switch(s){case"center-animate":s="animate";case"center":this.renderer.scrollCursorIntoView(null,.5);break;case"animate":case"cursor":this.renderer.scrollCursorIntoView();break;case"selectionPart":var v=this.selection.getRange(),$=this.renderer.layerConfig;(v.start.row>=$.lastRow||v.end.row<=$.firstRow)&&this.renderer.scrollSelectionIntoView(this.selection.anchor,this.selection.lead);break;default:break}s=="animate"&&this.renderer.animateScrolling(this.curOp.scrollTop)}var x=this.selection.toJSON();this.curOp.selectionAfter=x,this.$lastSel=this.selection.toJSON(),this.session.getUndoManager().addSelection(x),this.prevOp=this.curOp,this.curOp=null}},this.$mergeableCommands=["backspace","del","insertstring"],this.$historyTracker=function(a){if(this.$mergeUndoDeltas){var f=this.prevOp,s=this.$mergeableCommands,v=f.command&&a.command.name==f.command.name;if(a.command.name=="insertstring"){var $=a.args;this.mergeNextCommand===void 0&&(this.mergeNextCommand=!0),v=v&&this.mergeNextCommand&&(!/\s/.test($)||/\s/.test(f.args)),this.mergeNextCommand=!0}else v=v&&s.indexOf(a.command.name)!==-1;this.$mergeUndoDeltas!="always"&&Date.now()-this.sequenceStartTime>2e3&&(v=!1),v?this.session.mergeUndoDeltas=!0:s.indexOf(a.command.name)!==-1&&(this.sequenceStartTime=Date.now())}},this.setKeyboardHandler=function(a,f){if(a&&typeof a=="string"&&a!="ace"){this.$keybindingId=a;var s=this;y.loadModule(["keybinding",a],function(v){s.$keybindingId==a&&s.keyBinding.setKeyboardHandler(v&&v.handler),f&&f()})}else this.$keybindingId=null,this.keyBinding.setKeyboardHandler(a),f&&f()},this.getKeyboardHandler=function(){return this.keyBinding.getKeyboardHandler()},this.setSession=function(a){if(this.session!=a){this.curOp&&this.endOperation(),this.curOp={};var f=this.session;if(f){this.session.off("change",this.$onDocumentChange),this.session.off("changeMode",this.$onChangeMode),this.session.off("tokenizerUpdate",this.$onTokenizerUpdate),this.session.off("changeTabSize",this.$onChangeTabSize),this.session.off("changeWrapLimit",this.$onChangeWrapLimit),this.session.off("changeWrapMode",this.$onChangeWrapMode),this.session.off("changeFold",this.$onChangeFold),this.session.off("changeFrontMarker",this.$onChangeFrontMarker),this.session.off("changeBackMarker",this.$onChangeBackMarker),this.session.off("changeBreakpoint",this.$onChangeBreakpoint),this.session.off("changeAnnotation",this.$onChangeAnnotation),this.session.off("changeOverwrite",this.$onCursorChange),this.session.off("changeScrollTop",this.$onScrollTopChange),this.session.off("changeScrollLeft",this.$onScrollLeftChange);var s=this.session.getSelection();s.off("changeCursor",this.$onCursorChange),s.off("changeSelection",this.$onSelectionChange)}this.session=a,a?(this.$onDocumentChange=this.onDocumentChange.bind(this),a.on("change",this.$onDocumentChange),this.renderer.setSession(a),this.$onChangeMode=this.onChangeMode.bind(this),a.on("changeMode",this.$onChangeMode),this.$onTokenizerUpdate=this.onTokenizerUpdate.bind(this),a.on("tokenizerUpdate",this.$onTokenizerUpdate),this.$onChangeTabSize=this.renderer.onChangeTabSize.bind(this.renderer),a.on("changeTabSize",this.$onChangeTabSize),this.$onChangeWrapLimit=this.onChangeWrapLimit.bind(this),a.on("changeWrapLimit",this.$onChangeWrapLimit),this.$onChangeWrapMode=this.onChangeWrapMode.bind(this),a.on("changeWrapMode",this.$onChangeWrapMode),this.$onChangeFold=this.onChangeFold.bind(this),a.on("changeFold",this.$onChangeFold),this.$onChangeFrontMarker=this.onChangeFrontMarker.bind(this),this.session.on("changeFrontMarker",this.$onChangeFrontMarker),this.$onChangeBackMarker=this.onChangeBackMarker.bind(this),this.session.on("changeBackMarker",this.$onChangeBackMarker),this.$onChangeBreakpoint=this.onChangeBreakpoint.bind(this),this.session.on("changeBreakpoint",this.$onChangeBreakpoint),this.$onChangeAnnotation=this.onChangeAnnotation.bind(this),this.session.on("changeAnnotation",this.$onChangeAnnotation),this.$onCursorChange=this.onCursorChange.bind(this),this.session.on("changeOverwrite",this.$onCursorChange),this.$onScrollTopChange=this.onScrollTopChange.bind(this),this.session.on("changeScrollTop",this.$onScrollTopChange),this.$onScrollLeftChange=this.onScrollLeftChange.bind(this),this.session.on("changeScrollLeft",this.$onScrollLeftChange),this.selection=a.getSelection(),this.selection.on("changeCursor",this.$onCursorChange),this.$onSelectionChange=this.onSelectionChange.bind(this),this.selection.on("changeSelection",this.$onSelectionChange),this.onChangeMode(),this.onCursorChange(),this.onScrollTopChange(),this.onScrollLeftChange(),this.onSelectionChange(),this.onChangeFrontMarker(),this.onChangeBackMarker(),this.onChangeBreakpoint(),this.onChangeAnnotation(),this.session.getUseWrapMode()&&this.renderer.adjustWrapLimit(),this.renderer.updateFull()):(this.selection=null,this.renderer.setSession(a)),this._signal("changeSession",{session:a,oldSession:f}),this.curOp=null,f&&f._signal("changeEditor",{oldEditor:this}),a&&a._signal("changeEditor",{editor:this}),a&&a.bgTokenizer&&a.bgTokenizer.scheduleStart()}},this.getSession=function(){return this.session},this.setValue=function(a,f){return this.session.doc.setValue(a),f?f==1?this.navigateFileEnd():f==-1&&this.navigateFileStart():this.selectAll(),a},this.getValue=function(){return this.session.getValue()},this.getSelection=function(){return this.selection},this.resize=function(a){this.renderer.onResize(a)},this.setTheme=function(a,f){this.renderer.setTheme(a,f)},this.getTheme=function(){return this.renderer.getTheme()},this.setStyle=function(a){this.renderer.setStyle(a)},this.unsetStyle=function(a){this.renderer.unsetStyle(a)},this.getFontSize=function(){return this.getOption("fontSize")||A.computedStyle(this.container).fontSize},this.setFontSize=function(a){this.setOption("fontSize",a)},this.$highlightBrackets=function(){if(!this.$highlightPending){var a=this;this.$highlightPending=!0,setTimeout(function(){a.$highlightPending=!1;var f=a.session;if(!(!f||!f.bgTokenizer)){f.$bracketHighlight&&(f.$bracketHighlight.markerIds.forEach(function($){f.removeMarker($)}),f.$bracketHighlight=null);var s=f.getMatchingBracketRanges(a.getCursorPosition());if(!s&&f.$mode.getMatching&&(s=f.$mode.getMatching(a.session)),!!s){var v="ace_bracket";Array.isArray(s)?s.length==1&&(v="ace_error_bracket"):s=[s],s.length==2&&(o.comparePoints(s[0].end,s[1].start)==0?s=[o.fromPoints(s[0].start,s[1].end)]:o.comparePoints(s[0].start,s[1].end)==0&&(s=[o.fromPoints(s[1].start,s[0].end)])),f.$bracketHighlight={ranges:s,markerIds:s.map(function($){return f.addMarker($,v,"text")})}}}},50)}},this.$highlightTags=function(){if(!this.$highlightTagPending){var a=this;this.$highlightTagPending=!0,setTimeout(function(){a.$highlightTagPending=!1;var f=a.session;if(!(!f||!f.bgTokenizer)){var s=a.getCursorPosition(),v=new c(a.session,s.row,s.column),$=v.getCurrentToken();if(!$||!/\b(?:tag-open|tag-name)/.test($.type)){f.removeMarker(f.$tagHighlight),f.$tagHighlight=null;return}if(!($.type.indexOf("tag-open")!==-1&&($=v.stepForward(),!$))){var x=$.value,C=$.value,k=0,R=v.stepBackward();if(R.value==="<")do R=$,$=v.stepForward(),$&&($.type.indexOf("tag-name")!==-1?(C=$.value,x===C&&(R.value==="<"?k++:R.value==="</"&&k--)):x===C&&$.value==="/>"&&k--);while($&&k>=0);else{do if($=R,R=v.stepBackward(),$){if($.type.indexOf("tag-name")!==-1)x===$.value&&(R.value==="<"?k++:R.value==="</"&&k--);else if($.value==="/>"){for(var _=0,O=R;O;){if(O.type.indexOf("tag-name")!==-1&&O.value===x){k--;break}else if(O.value==="<")break;O=v.stepBackward(),_++}for(var I=0;I<_;I++)v.stepForward()}}while(R&&k<=0);v.stepForward()}if(!$){f.removeMarker(f.$tagHighlight),f.$tagHighlight=null;return}var D=v.getCurrentTokenRow(),T=v.getCurrentTokenColumn(),P=new o(D,T,D,T+$.value.length),M=f.$backMarkers[f.$tagHighlight];f.$tagHighlight&&M!=null&&P.compareRange(M.range)!==0&&(f.removeMarker(f.$tagHighlight),f.$tagHighlight=null),f.$tagHighlight||(f.$tagHighlight=f.addMarker(P,"ace_bracket","text"))}}},50)}},this.focus=function(){var a=this;setTimeout(function(){a.isFocused()||a.textInput.focus()}),this.textInput.focus()},this.isFocused=function(){return this.textInput.isFocused()},this.blur=function(){this.textInput.blur()},this.onFocus=function(a){this.$isFocused||(this.$isFocused=!0,this.renderer.showCursor(),this.renderer.visualizeFocus(),this._emit("focus",a))},this.onBlur=function(a){this.$isFocused&&(this.$isFocused=!1,this.renderer.hideCursor(),this.renderer.visualizeBlur(),this._emit("blur",a))},this.$cursorChange=function(){this.renderer.updateCursor(),this.$highlightBrackets(),this.$highlightTags(),this.$updateHighlightActiveLine()},this.onDocumentChange=function(a){var f=this.session.$useWrapMode,s=a.start.row==a.end.row?a.end.row:1/0;this.renderer.updateLines(a.start.row,s,f),this._signal("change",a),this.$cursorChange()},this.onTokenizerUpdate=function(a){var f=a.data;this.renderer.updateLines(f.first,f.last)},this.onScrollTopChange=function(){this.renderer.scrollToY(this.session.getScrollTop())},this.onScrollLeftChange=function(){this.renderer.scrollToX(this.session.getScrollLeft())},this.onCursorChange=function(){this.$cursorChange(),this._signal("changeSelection")},this.$updateHighlightActiveLine=function(){var a=this.getSession(),f;if(this.$highlightActiveLine&&((this.$selectionStyle!="line"||!this.selection.isMultiLine())&&(f=this.getCursorPosition()),this.renderer.theme&&this.renderer.theme.$selectionColorConflict&&!this.selection.isEmpty()&&(f=!1),this.renderer.$maxLines&&this.session.getLength()===1&&!(this.renderer.$minLines>1)&&(f=!1)),a.$highlightLineMarker&&!f)a.removeMarker(a.$highlightLineMarker.id),a.$highlightLineMarker=null;else if(!a.$highlightLineMarker&&f){var s=new o(f.row,f.column,f.row,1/0);s.id=a.addMarker(s,"ace_active-line","screenLine"),a.$highlightLineMarker=s}else f&&(a.$highlightLineMarker.start.row=f.row,a.$highlightLineMarker.end.row=f.row,a.$highlightLineMarker.start.column=f.column,a._signal("changeBackMarker"))},this.onSelectionChange=function(a){var f=this.session;if(f.$selectionMarker&&f.removeMarker(f.$selectionMarker),f.$selectionMarker=null,this.selection.isEmpty())this.$updateHighlightActiveLine();else{var s=this.selection.getRange(),v=this.getSelectionStyle();f.$selectionMarker=f.addMarker(s,"ace_selection",v)}var $=this.$highlightSelectedWord&&this.$getSelectionHighLightRegexp();this.session.highlight($),this._signal("changeSelection")},this.$getSelectionHighLightRegexp=function(){var a=this.session,f=this.getSelectionRange();if(!(f.isEmpty()||f.isMultiLine())){var s=f.start.column,v=f.end.column,$=a.getLine(f.start.row),x=$.substring(s,v);if(!(x.length>5e3||!/[\w\d]/.test(x))){var C=this.$search.$assembleRegExp({wholeWord:!0,caseSensitive:!0,needle:x}),k=$.substring(s-1,v+1);if(C.test(k))return C}}},this.onChangeFrontMarker=function(){this.renderer.updateFrontMarkers()},this.onChangeBackMarker=function(){this.renderer.updateBackMarkers()},this.onChangeBreakpoint=function(){this.renderer.updateBreakpoints()},this.onChangeAnnotation=function(){this.renderer.setAnnotations(this.session.getAnnotations())},this.onChangeMode=function(a){this.renderer.updateText(),this._emit("changeMode",a)},this.onChangeWrapLimit=function(){this.renderer.updateFull()},this.onChangeWrapMode=function(){this.renderer.onResize(!0)},this.onChangeFold=function(){this.$updateHighlightActiveLine(),this.renderer.updateFull()},this.getSelectedText=function(){return this.session.getTextRange(this.getSelectionRange())},this.getCopyText=function(){var a=this.getSelectedText(),f=this.session.doc.getNewLineCharacter(),s=!1;if(!a&&this.$copyWithEmptySelection){s=!0;for(var v=this.selection.getAllRanges(),$=0;$<v.length;$++){var x=v[$];$&&v[$-1].start.row==x.start.row||(a+=this.session.getLine(x.start.row)+f)}}var C={text:a};return this._signal("copy",C),l.lineMode=s?C.text:"",C.text},this.onCopy=function(){this.commands.exec("copy",this)},this.onCut=function(){this.commands.exec("cut",this)},this.onPaste=function(a,f){var s={text:a,event:f};this.commands.exec("paste",this,s)},this.$handlePaste=function(a){typeof a=="string"&&(a={text:a}),this._signal("paste",a);var f=a.text,s=f==l.lineMode,v=this.session;if(!this.inMultiSelectMode||this.inVirtualSelectionMode)s?v.insert({row:this.selection.lead.row,column:0},f):this.insert(f);else if(s)this.selection.rangeList.ranges.forEach(function(_){v.insert({row:_.start.row,column:0},f)});else{var $=f.split(/\r\n|\r|\n/),x=this.selection.rangeList.ranges,C=$.length==2&&(!$[0]||!$[1]);if($.length!=x.length||C)return this.commands.exec("insertstring",this,f);for(var k=x.length;k--;){var R=x[k];R.isEmpty()||v.remove(R),v.insert(R.start,$[k])}}},this.execCommand=function(a,f){return this.commands.exec(a,this,f)},this.insert=function(a,f){var s=this.session,v=s.getMode(),$=this.getCursorPosition();if(this.getBehavioursEnabled()&&!f){var x=v.transformAction(s.getState($.row),"insertion",this,s,a);x&&(a!==x.text&&(this.inVirtualSelectionMode||(this.session.mergeUndoDeltas=!1,this.mergeNextCommand=!1)),a=x.text)}if(a=="	"&&(a=this.session.getTabString()),this.selection.isEmpty()){if(this.session.getOverwrite()&&a.indexOf(`
-`)==-1){var C=new o.fromPoints($,$);C.end.column+=a.length,this.session.remove(C)}}else{var C=this.getSelectionRange();$=this.session.remove(C),this.clearSelection()}if(a==`
-`||a==`\r
-`){var O=s.getLine($.row);if($.column>O.search(/\S|$/)){var k=O.substr($.column).search(/\S|$/);s.doc.removeInLine($.row,$.column,$.column+k)}}this.clearSelection();var R=$.column,_=s.getState($.row),O=s.getLine($.row),I=v.checkOutdent(_,O,a);if(s.insert($,a),x&&x.selection&&(x.selection.length==2?this.selection.setSelectionRange(new o($.row,R+x.selection[0],$.row,R+x.selection[1])):this.selection.setSelectionRange(new o($.row+x.selection[0],x.selection[1],$.row+x.selection[2],x.selection[3]))),this.$enableAutoIndent){if(s.getDocument().isNewLine(a)){var D=v.getNextLineIndent(_,O.slice(0,$.column),s.getTabString());s.insert({row:$.row+1,column:0},D)}I&&v.autoOutdent(_,s,$.row)}},this.autoIndent=function(){var a=this.session,f=a.getMode(),s,v;if(this.selection.isEmpty())s=0,v=a.doc.getLength()-1;else{var $=this.getSelectionRange();s=$.start.row,v=$.end.row}for(var x="",C="",k="",R,_,O,I=a.getTabString(),D=s;D<=v;D++)D>0&&(x=a.getState(D-1),C=a.getLine(D-1),k=f.getNextLineIndent(x,C,I)),R=a.getLine(D),_=f.$getIndent(R),k!==_&&(_.length>0&&(O=new o(D,0,D,_.length),a.remove(O)),k.length>0&&a.insert({row:D,column:0},k)),f.autoOutdent(x,a,D)},this.onTextInput=function(a,f){if(!f)return this.keyBinding.onTextInput(a);this.startOperation({command:{name:"insertstring"}});var s=this.applyComposition.bind(this,a,f);this.selection.rangeCount?this.forEachSelection(s):s(),this.endOperation()},this.applyComposition=function(a,f){if(f.extendLeft||f.extendRight){var s=this.selection.getRange();s.start.column-=f.extendLeft,s.end.column+=f.extendRight,s.start.column<0&&(s.start.row--,s.start.column+=this.session.getLine(s.start.row).length+1),this.selection.setRange(s),!a&&!s.isEmpty()&&this.remove()}if((a||!this.selection.isEmpty())&&this.insert(a,!0),f.restoreStart||f.restoreEnd){var s=this.selection.getRange();s.start.column-=f.restoreStart,s.end.column-=f.restoreEnd,this.selection.setRange(s)}},this.onCommandKey=function(a,f,s){return this.keyBinding.onCommandKey(a,f,s)},this.setOverwrite=function(a){this.session.setOverwrite(a)},this.getOverwrite=function(){return this.session.getOverwrite()},this.toggleOverwrite=function(){this.session.toggleOverwrite()},this.setScrollSpeed=function(a){this.setOption("scrollSpeed",a)},this.getScrollSpeed=function(){return this.getOption("scrollSpeed")},this.setDragDelay=function(a){this.setOption("dragDelay",a)},this.getDragDelay=function(){return this.getOption("dragDelay")},this.setSelectionStyle=function(a){this.setOption("selectionStyle",a)},this.getSelectionStyle=function(){return this.getOption("selectionStyle")},this.setHighlightActiveLine=function(a){this.setOption("highlightActiveLine",a)},this.getHighlightActiveLine=function(){return this.getOption("highlightActiveLine")},this.setHighlightGutterLine=function(a){this.setOption("highlightGutterLine",a)},this.getHighlightGutterLine=function(){return this.getOption("highlightGutterLine")},this.setHighlightSelectedWord=function(a){this.setOption("highlightSelectedWord",a)},this.getHighlightSelectedWord=function(){return this.$highlightSelectedWord},this.setAnimatedScroll=function(a){this.renderer.setAnimatedScroll(a)},this.getAnimatedScroll=function(){return this.renderer.getAnimatedScroll()},this.setShowInvisibles=function(a){this.renderer.setShowInvisibles(a)},this.getShowInvisibles=function(){return this.renderer.getShowInvisibles()},this.setDisplayIndentGuides=function(a){this.renderer.setDisplayIndentGuides(a)},this.getDisplayIndentGuides=function(){return this.renderer.getDisplayIndentGuides()},this.setShowPrintMargin=function(a){this.renderer.setShowPrintMargin(a)},this.getShowPrintMargin=function(){return this.renderer.getShowPrintMargin()},this.setPrintMarginColumn=function(a){this.renderer.setPrintMarginColumn(a)},this.getPrintMarginColumn=function(){return this.renderer.getPrintMarginColumn()},this.setReadOnly=function(a){this.setOption("readOnly",a)},this.getReadOnly=function(){return this.getOption("readOnly")},this.setBehavioursEnabled=function(a){this.setOption("behavioursEnabled",a)},this.getBehavioursEnabled=function(){return this.getOption("behavioursEnabled")},this.setWrapBehavioursEnabled=function(a){this.setOption("wrapBehavioursEnabled",a)},this.getWrapBehavioursEnabled=function(){return this.getOption("wrapBehavioursEnabled")},this.setShowFoldWidgets=function(a){this.setOption("showFoldWidgets",a)},this.getShowFoldWidgets=function(){return this.getOption("showFoldWidgets")},this.setFadeFoldWidgets=function(a){this.setOption("fadeFoldWidgets",a)},this.getFadeFoldWidgets=function(){return this.getOption("fadeFoldWidgets")},this.remove=function(a){this.selection.isEmpty()&&(a=="left"?this.selection.selectLeft():this.selection.selectRight());var f=this.getSelectionRange();if(this.getBehavioursEnabled()){var s=this.session,v=s.getState(f.start.row),$=s.getMode().transformAction(v,"deletion",this,s,f);if(f.end.column===0){var x=s.getTextRange(f);if(x[x.length-1]==`
-`){var C=s.getLine(f.end.row);/^\s+$/.test(C)&&(f.end.column=C.length)}}$&&(f=$)}this.session.remove(f),this.clearSelection()},this.removeWordRight=function(){this.selection.isEmpty()&&this.selection.selectWordRight(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeWordLeft=function(){this.selection.isEmpty()&&this.selection.selectWordLeft(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeToLineStart=function(){this.selection.isEmpty()&&this.selection.selectLineStart(),this.selection.isEmpty()&&this.selection.selectLeft(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeToLineEnd=function(){this.selection.isEmpty()&&this.selection.selectLineEnd();var a=this.getSelectionRange();a.start.column==a.end.column&&a.start.row==a.end.row&&(a.end.column=0,a.end.row++),this.session.remove(a),this.clearSelection()},this.splitLine=function(){this.selection.isEmpty()||(this.session.remove(this.getSelectionRange()),this.clearSelection());var a=this.getCursorPosition();this.insert(`
-`),this.moveCursorToPosition(a)},this.transposeLetters=function(){if(this.selection.isEmpty()){var a=this.getCursorPosition(),f=a.column;if(f!==0){var s=this.session.getLine(a.row),v,$;f<s.length?(v=s.charAt(f)+s.charAt(f-1),$=new o(a.row,f-1,a.row,f+1)):(v=s.charAt(f-1)+s.charAt(f-2),$=new o(a.row,f-2,a.row,f)),this.session.replace($,v),this.session.selection.moveToPosition($.end)}}},this.toLowerCase=function(){var a=this.getSelectionRange();this.selection.isEmpty()&&this.selection.selectWord();var f=this.getSelectionRange(),s=this.session.getTextRange(f);this.session.replace(f,s.toLowerCase()),this.selection.setSelectionRange(a)},this.toUpperCase=function(){var a=this.getSelectionRange();this.selection.isEmpty()&&this.selection.selectWord();var f=this.getSelectionRange(),s=this.session.getTextRange(f);this.session.replace(f,s.toUpperCase()),this.selection.setSelectionRange(a)},this.indent=function(){var a=this.session,f=this.getSelectionRange();if(f.start.row<f.end.row){var s=this.$getSelectedRows();a.indentRows(s.first,s.last,"	");return}else if(f.start.column<f.end.column){var v=a.getTextRange(f);if(!/^\s+$/.test(v)){var s=this.$getSelectedRows();a.indentRows(s.first,s.last,"	");return}}var $=a.getLine(f.start.row),x=f.start,C=a.getTabSize(),k=a.documentToScreenColumn(x.row,x.column);if(this.session.getUseSoftTabs())var R=C-k%C,_=p.stringRepeat(" ",R);else{for(var R=k%C;$[f.start.column-1]==" "&&R;)f.start.column--,R--;this.selection.setSelectionRange(f),_="	"}return this.insert(_)},this.blockIndent=function(){var a=this.$getSelectedRows();this.session.indentRows(a.first,a.last,"	")},this.blockOutdent=function(){var a=this.session.getSelection();this.session.outdentRows(a.getRange())},this.sortLines=function(){for(var a=this.$getSelectedRows(),f=this.session,s=[],v=a.first;v<=a.last;v++)s.push(f.getLine(v));s.sort(function(C,k){return C.toLowerCase()<k.toLowerCase()?-1:C.toLowerCase()>k.toLowerCase()?1:0});for(var $=new o(0,0,0,0),v=a.first;v<=a.last;v++){var x=f.getLine(v);$.start.row=v,$.end.row=v,$.end.column=x.length,f.replace($,s[v-a.first])}},this.toggleCommentLines=function(){var a=this.session.getState(this.getCursorPosition().row),f=this.$getSelectedRows();this.session.getMode().toggleCommentLines(a,this.session,f.first,f.last)},this.toggleBlockComment=function(){var a=this.getCursorPosition(),f=this.session.getState(a.row),s=this.getSelectionRange();this.session.getMode().toggleBlockComment(f,this.session,s,a)},this.getNumberAt=function(a,f){var s=/[\-]?[0-9]+(?:\.[0-9]+)?/g;s.lastIndex=0;for(var v=this.session.getLine(a);s.lastIndex<f;){var $=s.exec(v);if($.index<=f&&$.index+$[0].length>=f){var x={value:$[0],start:$.index,end:$.index+$[0].length};return x}}return null},this.modifyNumber=function(a){var f=this.selection.getCursor().row,s=this.selection.getCursor().column,v=new o(f,s-1,f,s),$=this.session.getTextRange(v);if(!isNaN(parseFloat($))&&isFinite($)){var x=this.getNumberAt(f,s);if(x){var C=x.value.indexOf(".")>=0?x.start+x.value.indexOf(".")+1:x.end,k=x.start+x.value.length-C,R=parseFloat(x.value);R*=Math.pow(10,k),C!==x.end&&s<C?a*=Math.pow(10,x.end-s-1):a*=Math.pow(10,x.end-s),R+=a,R/=Math.pow(10,k);var _=R.toFixed(k),O=new o(f,x.start,f,x.end);this.session.replace(O,_),this.moveCursorTo(f,Math.max(x.start+1,s+_.length-x.value.length))}}else this.toggleWord()},this.$toggleWordPairs=[["first","last"],["true","false"],["yes","no"],["width","height"],["top","bottom"],["right","left"],["on","off"],["x","y"],["get","set"],["max","min"],["horizontal","vertical"],["show","hide"],["add","remove"],["up","down"],["before","after"],["even","odd"],["in","out"],["inside","outside"],["next","previous"],["increase","decrease"],["attach","detach"],["&&","||"],["==","!="]],this.toggleWord=function(){var a=this.selection.getCursor().row,f=this.selection.getCursor().column;this.selection.selectWord();var s=this.getSelectedText(),v=this.selection.getWordRange().start.column,$=s.replace(/([a-z]+|[A-Z]+)(?=[A-Z_]|$)/g,"$1 ").split(/\s/),x=f-v-1;x<0&&(x=0);var C=0,k=0,R=this;s.match(/[A-Za-z0-9_]+/)&&$.forEach(function(N,G){k=C+N.length,x>=C&&x<=k&&(s=N,R.selection.clearSelection(),R.moveCursorTo(a,C+v),R.selection.selectTo(a,k+v)),C=k});for(var _=this.$toggleWordPairs,O,I=0;I<_.length;I++)for(var D=_[I],T=0;T<=1;T++){var P=+!T,M=s.match(new RegExp("^\\s?_?("+p.escapeRegExp(D[T])+")\\s?$","i"));if(M){var W=s.match(new RegExp("([_]|^|\\s)("+p.escapeRegExp(M[1])+")($|\\s)","g"));W&&(O=s.replace(new RegExp(p.escapeRegExp(D[T]),"i"),function(N){var G=D[P];return N.toUpperCase()==N?G=G.toUpperCase():N.charAt(0).toUpperCase()==N.charAt(0)&&(G=G.substr(0,0)+D[P].charAt(0).toUpperCase()+G.substr(1)),G}),this.insert(O),O="")}}},this.removeLines=function(){var a=this.$getSelectedRows();this.session.removeFullLines(a.first,a.last),this.clearSelection()},this.duplicateSelection=function(){var a=this.selection,f=this.session,s=a.getRange(),v=a.isBackwards();if(s.isEmpty()){var $=s.start.row;f.duplicateLines($,$)}else{var x=v?s.start:s.end,C=f.insert(x,f.getTextRange(s),!1);s.start=x,s.end=C,a.setSelectionRange(s,v)}},this.moveLinesDown=function(){this.$moveLines(1,!1)},this.moveLinesUp=function(){this.$moveLines(-1,!1)},this.moveText=function(a,f,s){return this.session.moveText(a,f,s)},this.copyLinesUp=function(){this.$moveLines(-1,!0)},this.copyLinesDown=function(){this.$moveLines(1,!0)},this.$moveLines=function(a,f){var s,v,$=this.selection;if(!$.inMultiSelectMode||this.inVirtualSelectionMode){var x=$.toOrientedRange();s=this.$getSelectedRows(x),v=this.session.$moveLines(s.first,s.last,f?0:a),f&&a==-1&&(v=0),x.moveBy(v,0),$.fromOrientedRange(x)}else{var C=$.rangeList.ranges;$.rangeList.detach(this.session),this.inVirtualSelectionMode=!0;for(var k=0,R=0,_=C.length,O=0;O<_;O++){var I=O;C[O].moveBy(k,0),s=this.$getSelectedRows(C[O]);for(var D=s.first,T=s.last;++O<_;){R&&C[O].moveBy(R,0);var P=this.$getSelectedRows(C[O]);if(f&&P.first!=T)break;if(!f&&P.first>T+1)break;T=P.last}for(O--,k=this.session.$moveLines(D,T,f?0:a),f&&a==-1&&(I=O+1);I<=O;)C[I].moveBy(k,0),I++;f||(k=0),R+=k}$.fromOrientedRange($.ranges[0]),$.rangeList.attach(this.session),this.inVirtualSelectionMode=!1}},this.$getSelectedRows=function(a){return a=(a||this.getSelectionRange()).collapseRows(),{first:this.session.getRowFoldStart(a.start.row),last:this.session.getRowFoldEnd(a.end.row)}},this.onCompositionStart=function(a){this.renderer.showComposition(a)},this.onCompositionUpdate=function(a){this.renderer.setCompositionText(a)},this.onCompositionEnd=function(){this.renderer.hideComposition()},this.getFirstVisibleRow=function(){return this.renderer.getFirstVisibleRow()},this.getLastVisibleRow=function(){return this.renderer.getLastVisibleRow()},this.isRowVisible=function(a){return a>=this.getFirstVisibleRow()&&a<=this.getLastVisibleRow()},this.isRowFullyVisible=function(a){return a>=this.renderer.getFirstFullyVisibleRow()&&a<=this.renderer.getLastFullyVisibleRow()},this.$getVisibleRowCount=function(){return this.renderer.getScrollBottomRow()-this.renderer.getScrollTopRow()+1},this.$moveByPage=function(a,f){var s=this.renderer,v=this.renderer.layerConfig,$=a*Math.floor(v.height/v.lineHeight);f===!0?this.selection.$moveSelection(function(){this.moveCursorBy($,0)}):f===!1&&(this.selection.moveCursorBy($,0),this.selection.clearSelection());var x=s.scrollTop;s.scrollBy(0,$*v.lineHeight),f!=null&&s.scrollCursorIntoView(null,.5),s.animateScrolling(x)},this.selectPageDown=function(){this.$moveByPage(1,!0)},this.selectPageUp=function(){this.$moveByPage(-1,!0)},this.gotoPageDown=function(){this.$moveByPage(1,!1)},this.gotoPageUp=function(){this.$moveByPage(-1,!1)},this.scrollPageDown=function(){this.$moveByPage(1)},this.scrollPageUp=function(){this.$moveByPage(-1)},this.scrollToRow=function(a){this.renderer.scrollToRow(a)},this.scrollToLine=function(a,f,s,v){this.renderer.scrollToLine(a,f,s,v)},this.centerSelection=function(){var a=this.getSelectionRange(),f={row:Math.floor(a.start.row+(a.end.row-a.start.row)/2),column:Math.floor(a.start.column+(a.end.column-a.start.column)/2)};this.renderer.alignCursor(f,.5)},this.getCursorPosition=function(){return this.selection.getCursor()},this.getCursorPositionScreen=function(){return this.session.documentToScreenPosition(this.getCursorPosition())},this.getSelectionRange=function(){return this.selection.getRange()},this.selectAll=function(){this.selection.selectAll()},this.clearSelection=function(){this.selection.clearSelection()},this.moveCursorTo=function(a,f){this.selection.moveCursorTo(a,f)},this.moveCursorToPosition=function(a){this.selection.moveCursorToPosition(a)},this.jumpToMatching=function(a,f){var s=this.getCursorPosition(),v=new c(this.session,s.row,s.column),$=v.getCurrentToken(),x=$||v.stepForward();if(x){var C,k=!1,R={},_=s.column-x.start,O,I={")":"(","(":"(","]":"[","[":"[","{":"{","}":"{"};do{if(x.value.match(/[{}()\[\]]/g)){for(;_<x.value.length&&!k;_++)if(I[x.value[_]])switch(O=I[x.value[_]]+"."+x.type.replace("rparen","lparen"),isNaN(R[O])&&(R[O]=0),x.value[_]){case"(":case"[":case"{":R[O]++;break;case")":case"]":case"}":R[O]--,R[O]===-1&&(C="bracket",k=!0);break}}else x.type.indexOf("tag-name")!==-1&&(isNaN(R[x.value])&&(R[x.value]=0),$.value==="<"?R[x.value]++:$.value==="</"&&R[x.value]--,R[x.value]===-1&&(C="tag",k=!0));k||($=x,x=v.stepForward(),_=0)}while(x&&!k);if(C){var D,T;if(C==="bracket")D=this.session.getBracketRange(s),D||(D=new o(v.getCurrentTokenRow(),v.getCurrentTokenColumn()+_-1,v.getCurrentTokenRow(),v.getCurrentTokenColumn()+_-1),T=D.start,(f||T.row===s.row&&Math.abs(T.column-s.column)<2)&&(D=this.session.getBracketRange(T)));else if(C==="tag"){if(x&&x.type.indexOf("tag-name")!==-1)var P=x.value;else return;if(D=new o(v.getCurrentTokenRow(),v.getCurrentTokenColumn()-2,v.getCurrentTokenRow(),v.getCurrentTokenColumn()-2),D.compare(s.row,s.column)===0){k=!1;do x=$,$=v.stepBackward(),$&&($.type.indexOf("tag-close")!==-1&&D.setEnd(v.getCurrentTokenRow(),v.getCurrentTokenColumn()+1),x.value===P&&x.type.indexOf("tag-name")!==-1&&($.value==="<"?R[P]++:$.value==="</"&&R[P]--,R[P]===0&&(k=!0)));while($&&!k)}x&&x.type.indexOf("tag-name")&&(T=D.start,T.row==s.row&&Math.abs(T.column-s.column)<2&&(T=D.end))}T=D&&D.cursor||T,T&&(a?D&&f?this.selection.setRange(D):D&&D.isEqual(this.getSelectionRange())?this.clearSelection():this.selection.selectTo(T.row,T.column):this.selection.moveTo(T.row,T.column))}}},this.gotoLine=function(a,f,s){this.selection.clearSelection(),this.session.unfold({row:a-1,column:f||0}),this.exitMultiSelectMode&&this.exitMultiSelectMode(),this.moveCursorTo(a-1,f||0),this.isRowFullyVisible(a-1)||this.scrollToLine(a-1,!0,s)},this.navigateTo=function(a,f){this.selection.moveTo(a,f)},this.navigateUp=function(a){if(this.selection.isMultiLine()&&!this.selection.isBackwards()){var f=this.selection.anchor.getPosition();return this.moveCursorToPosition(f)}this.selection.clearSelection(),this.selection.moveCursorBy(-a||-1,0)},this.navigateDown=function(a){if(this.selection.isMultiLine()&&this.selection.isBackwards()){var f=this.selection.anchor.getPosition();return this.moveCursorToPosition(f)}this.selection.clearSelection(),this.selection.moveCursorBy(a||1,0)},this.navigateLeft=function(a){if(this.selection.isEmpty())for(a=a||1;a--;)this.selection.moveCursorLeft();else{var f=this.getSelectionRange().start;this.moveCursorToPosition(f)}this.clearSelection()},this.navigateRight=function(a){if(this.selection.isEmpty())for(a=a||1;a--;)this.selection.moveCursorRight();else{var f=this.getSelectionRange().end;this.moveCursorToPosition(f)}this.clearSelection()},this.navigateLineStart=function(){this.selection.moveCursorLineStart(),this.clearSelection()},this.navigateLineEnd=function(){this.selection.moveCursorLineEnd(),this.clearSelection()},this.navigateFileEnd=function(){this.selection.moveCursorFileEnd(),this.clearSelection()},this.navigateFileStart=function(){this.selection.moveCursorFileStart(),this.clearSelection()},this.navigateWordRight=function(){this.selection.moveCursorWordRight(),this.clearSelection()},this.navigateWordLeft=function(){this.selection.moveCursorWordLeft(),this.clearSelection()},this.replace=function(a,f){f&&this.$search.set(f);var s=this.$search.find(this.session),v=0;return s&&(this.$tryReplace(s,a)&&(v=1),this.selection.setSelectionRange(s),this.renderer.scrollSelectionIntoView(s.start,s.end)),v},this.replaceAll=function(a,f){f&&this.$search.set(f);var s=this.$search.findAll(this.session),v=0;if(!s.length)return v;var $=this.getSelectionRange();this.selection.moveTo(0,0);for(var x=s.length-1;x>=0;--x)this.$tryReplace(s[x],a)&&v++;return this.selection.setSelectionRange($),v},this.$tryReplace=function(a,f){var s=this.session.getTextRange(a);return f=this.$search.replace(s,f),f!==null?(a.end=this.session.replace(a,f),a):null},this.getLastSearchOptions=function(){return this.$search.getOptions()},this.find=function(a,f,s){f||(f={}),typeof a=="string"||a instanceof RegExp?f.needle=a:typeof a=="object"&&E.mixin(f,a);var v=this.selection.getRange();f.needle==null&&(a=this.session.getTextRange(v)||this.$search.$options.needle,a||(v=this.session.getWordRange(v.start.row,v.start.column),a=this.session.getTextRange(v)),this.$search.set({needle:a})),this.$search.set(f),f.start||this.$search.set({start:v});var $=this.$search.find(this.session);if(f.preventScroll)return $;if($)return this.revealRange($,s),$;f.backwards?v.start=v.end:v.end=v.start,this.selection.setRange(v)},this.findNext=function(a,f){this.find({skipCurrent:!0,backwards:!1},a,f)},this.findPrevious=function(a,f){this.find(a,{skipCurrent:!0,backwards:!0},f)},this.revealRange=function(a,f){this.session.unfold(a),this.selection.setSelectionRange(a);var s=this.renderer.scrollTop;this.renderer.scrollSelectionIntoView(a.start,a.end,.5),f!==!1&&this.renderer.animateScrolling(s)},this.undo=function(){this.session.getUndoManager().undo(this.session),this.renderer.scrollCursorIntoView(null,.5)},this.redo=function(){this.session.getUndoManager().redo(this.session),this.renderer.scrollCursorIntoView(null,.5)},this.destroy=function(){this.$toDestroy&&(this.$toDestroy.forEach(function(a){a.destroy()}),this.$toDestroy=null),this.$mouseHandler&&this.$mouseHandler.destroy(),this.renderer.destroy(),this._signal("destroy",this),this.session&&this.session.destroy(),this._$emitInputEvent&&this._$emitInputEvent.cancel(),this.removeAllListeners()},this.setAutoScrollEditorIntoView=function(a){if(a){var f,s=this,v=!1;this.$scrollAnchor||(this.$scrollAnchor=document.createElement("div"));var $=this.$scrollAnchor;$.style.cssText="position:absolute",this.container.insertBefore($,this.container.firstChild);var x=this.on("changeSelection",function(){v=!0}),C=this.renderer.on("beforeRender",function(){v&&(f=s.renderer.container.getBoundingClientRect())}),k=this.renderer.on("afterRender",function(){if(v&&f&&(s.isFocused()||s.searchBox&&s.searchBox.isFocused())){var R=s.renderer,_=R.$cursorLayer.$pixelPos,O=R.layerConfig,I=_.top-O.offset;_.top>=0&&I+f.top<0?v=!0:_.top<O.height&&_.top+f.top+O.lineHeight>window.innerHeight?v=!1:v=null,v!=null&&($.style.top=I+"px",$.style.left=_.left+"px",$.style.height=O.lineHeight+"px",$.scrollIntoView(v)),v=f=null}});this.setAutoScrollEditorIntoView=function(R){R||(delete this.setAutoScrollEditorIntoView,this.off("changeSelection",x),this.renderer.off("afterRender",k),this.renderer.off("beforeRender",C))}}},this.$resetCursorStyle=function(){var a=this.$cursorStyle||"ace",f=this.renderer.$cursorLayer;f&&(f.setSmoothBlinking(/smooth/.test(a)),f.isBlinking=!this.$readOnly&&a!="wide",A.setCssClass(f.element,"ace_slim-cursors",/slim/.test(a)))},this.prompt=function(a,f,s){var v=this;y.loadModule("./ext/prompt",function($){$.prompt(v,a,f,s)})}}.call(g.prototype),y.defineOptions(g.prototype,"editor",{selectionStyle:{set:function(a){this.onSelectionChange(),this._signal("changeSelectionStyle",{data:a})},initialValue:"line"},highlightActiveLine:{set:function(){this.$updateHighlightActiveLine()},initialValue:!0},highlightSelectedWord:{set:function(a){this.$onSelectionChange()},initialValue:!0},readOnly:{set:function(a){this.textInput.setReadOnly(a),this.$resetCursorStyle()},initialValue:!1},copyWithEmptySelection:{set:function(a){this.textInput.setCopyWithEmptySelection(a)},initialValue:!1},cursorStyle:{set:function(a){this.$resetCursorStyle()},values:["ace","slim","smooth","wide"],initialValue:"ace"},mergeUndoDeltas:{values:[!1,!0,"always"],initialValue:!0},behavioursEnabled:{initialValue:!0},wrapBehavioursEnabled:{initialValue:!0},enableAutoIndent:{initialValue:!0},autoScrollEditorIntoView:{set:function(a){this.setAutoScrollEditorIntoView(a)}},keyboardHandler:{set:function(a){this.setKeyboardHandler(a)},get:function(){return this.$keybindingId},handlesSet:!0},value:{set:function(a){this.session.setValue(a)},get:function(){return this.getValue()},handlesSet:!0,hidden:!0},session:{set:function(a){this.setSession(a)},get:function(){return this.session},handlesSet:!0,hidden:!0},showLineNumbers:{set:function(a){this.renderer.$gutterLayer.setShowLineNumbers(a),this.renderer.$loop.schedule(this.renderer.CHANGE_GUTTER),a&&this.$relativeLineNumbers?d.attach(this):d.detach(this)},initialValue:!0},relativeLineNumbers:{set:function(a){this.$showLineNumbers&&a?d.attach(this):d.detach(this)}},placeholder:{set:function(a){this.$updatePlaceholder||(this.$updatePlaceholder=function(){var f=this.session&&(this.renderer.$composition||this.getValue());if(f&&this.renderer.placeholderNode)this.renderer.off("afterRender",this.$updatePlaceholder),A.removeCssClass(this.container,"ace_hasPlaceholder"),this.renderer.placeholderNode.remove(),this.renderer.placeholderNode=null;else if(!f&&!this.renderer.placeholderNode){this.renderer.on("afterRender",this.$updatePlaceholder),A.addCssClass(this.container,"ace_hasPlaceholder");var s=A.createElement("div");s.className="ace_placeholder",s.textContent=this.$placeholder||"",this.renderer.placeholderNode=s,this.renderer.content.appendChild(this.renderer.placeholderNode)}else!f&&this.renderer.placeholderNode&&(this.renderer.placeholderNode.textContent=this.$placeholder||"")}.bind(this),this.on("input",this.$updatePlaceholder)),this.$updatePlaceholder()}},hScrollBarAlwaysVisible:"renderer",vScrollBarAlwaysVisible:"renderer",highlightGutterLine:"renderer",animatedScroll:"renderer",showInvisibles:"renderer",showPrintMargin:"renderer",printMarginColumn:"renderer",printMargin:"renderer",fadeFoldWidgets:"renderer",showFoldWidgets:"renderer",displayIndentGuides:"renderer",showGutter:"renderer",fontSize:"renderer",fontFamily:"renderer",maxLines:"renderer",minLines:"renderer",scrollPastEnd:"renderer",fixedWidthGutter:"renderer",theme:"renderer",hasCssTransforms:"renderer",maxPixelHeight:"renderer",useTextareaForIME:"renderer",scrollSpeed:"$mouseHandler",dragDelay:"$mouseHandler",dragEnabled:"$mouseHandler",focusTimeout:"$mouseHandler",tooltipFollowsMouse:"$mouseHandler",firstLineNumber:"session",overwrite:"session",newLineMode:"session",useWorker:"session",useSoftTabs:"session",navigateWithinSoftTabs:"session",tabSize:"session",wrap:"session",indentedSoftWrap:"session",foldStyle:"session",mode:"session"});var d={getText:function(a,f){return(Math.abs(a.selection.lead.row-f)||f+1+(f<9?"\xB7":""))+""},getWidth:function(a,f,s){return Math.max(f.toString().length,(s.lastRow+1).toString().length,2)*s.characterWidth},update:function(a,f){f.renderer.$loop.schedule(f.renderer.CHANGE_GUTTER)},attach:function(a){a.renderer.$gutterLayer.$renderer=this,a.on("changeSelection",this.update),this.update(null,a)},detach:function(a){a.renderer.$gutterLayer.$renderer==this&&(a.renderer.$gutterLayer.$renderer=null),a.off("changeSelection",this.update),this.update(null,a)}};b.Editor=g}),define("ace/undomanager",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=function(){this.$maxRev=0,this.$fromUndo=!1,this.reset()};(function(){this.addSession=function(d){this.$session=d},this.add=function(d,a,f){this.$fromUndo||d!=this.$lastDelta&&(this.$keepRedoStack||(this.$redoStack.length=0),(a===!1||!this.lastDeltas)&&(this.lastDeltas=[],this.$undoStack.push(this.lastDeltas),d.id=this.$rev=++this.$maxRev),(d.action=="remove"||d.action=="insert")&&(this.$lastDelta=d),this.lastDeltas.push(d))},this.addSelection=function(d,a){this.selections.push({value:d,rev:a||this.$rev})},this.startNewGroup=function(){return this.lastDeltas=null,this.$rev},this.markIgnored=function(d,a){a==null&&(a=this.$rev+1);for(var f=this.$undoStack,s=f.length;s--;){var v=f[s][0];if(v.id<=d)break;v.id<a&&(v.ignore=!0)}this.lastDeltas=null},this.getSelection=function(d,a){for(var f=this.selections,s=f.length;s--;){var v=f[s];if(v.rev<d)return a&&(v=f[s+1]),v}},this.getRevision=function(){return this.$rev},this.getDeltas=function(d,a){a==null&&(a=this.$rev+1);for(var f=this.$undoStack,s=null,v=0,$=f.length;$--;){var x=f[$][0];if(x.id<a&&!s&&(s=$+1),x.id<=d){v=$+1;break}}return f.slice(v,s)},this.getChangedRanges=function(d,a){a==null&&(a=this.$rev+1)},this.getChangedLines=function(d,a){a==null&&(a=this.$rev+1)},this.undo=function(d,a){this.lastDeltas=null;var f=this.$undoStack;if(A(f,f.length)){d||(d=this.$session),this.$redoStackBaseRev!==this.$rev&&this.$redoStack.length&&(this.$redoStack=[]),this.$fromUndo=!0;var s=f.pop(),v=null;return s&&(v=d.undoChanges(s,a),this.$redoStack.push(s),this.$syncRev()),this.$fromUndo=!1,v}},this.redo=function(d,a){if(this.lastDeltas=null,d||(d=this.$session),this.$fromUndo=!0,this.$redoStackBaseRev!=this.$rev){var f=this.getDeltas(this.$redoStackBaseRev,this.$rev+1);g(this.$redoStack,f),this.$redoStackBaseRev=this.$rev,this.$redoStack.forEach(function($){$[0].id=++this.$maxRev},this)}var s=this.$redoStack.pop(),v=null;return s&&(v=d.redoChanges(s,a),this.$undoStack.push(s),this.$syncRev()),this.$fromUndo=!1,v},this.$syncRev=function(){var d=this.$undoStack,a=d[d.length-1],f=a&&a[0].id||0;this.$redoStackBaseRev=f,this.$rev=f},this.reset=function(){this.lastDeltas=null,this.$lastDelta=null,this.$undoStack=[],this.$redoStack=[],this.$rev=0,this.mark=0,this.$redoStackBaseRev=this.$rev,this.selections=[]},this.canUndo=function(){return this.$undoStack.length>0},this.canRedo=function(){return this.$redoStack.length>0},this.bookmark=function(d){d==null&&(d=this.$rev),this.mark=d},this.isAtBookmark=function(){return this.$rev===this.mark},this.toJSON=function(){},this.fromJSON=function(){},this.hasUndo=this.canUndo,this.hasRedo=this.canRedo,this.isClean=this.isAtBookmark,this.markClean=this.bookmark,this.$prettyPrint=function(d){return d?r(d):r(this.$undoStack)+`
+`),e.row===0&&t.navigateUp()},multiSelectAction:"forEach",scrollIntoView:"cursor"},{name:"openCommandPallete",description:"Open command pallete",bindKey:h("F1","F1"),exec:function(t){t.prompt({$type:"commands"})},readOnly:!0},{name:"modeSelect",description:"Change language mode...",bindKey:h(null,null),exec:function(t){t.prompt({$type:"modes"})},readOnly:!0}];for(var d=1;d<9;d++)y.commands.push({name:"foldToLevel"+d,description:"Fold To Level "+d,level:d,exec:function(t){t.session.foldToLevel(this.level)},scrollIntoView:"center",readOnly:!0})}),define("ace/editor",["require","exports","module","ace/lib/fixoldbrowsers","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/lib/useragent","ace/keyboard/textinput","ace/mouse/mouse_handler","ace/mouse/fold_handler","ace/keyboard/keybinding","ace/edit_session","ace/search","ace/range","ace/lib/event_emitter","ace/commands/command_manager","ace/commands/default_commands","ace/config","ace/token_iterator","ace/clipboard"],function(k,y,B){"use strict";k("./lib/fixoldbrowsers");var E=k("./lib/oop"),A=k("./lib/dom"),p=k("./lib/lang"),h=k("./lib/useragent"),d=k("./keyboard/textinput").TextInput,t=k("./mouse/mouse_handler").MouseHandler,e=k("./mouse/fold_handler").FoldHandler,i=k("./keyboard/keybinding").KeyBinding,r=k("./edit_session").EditSession,n=k("./search").Search,a=k("./range").Range,m=k("./lib/event_emitter").EventEmitter,w=k("./commands/command_manager").CommandManager,S=k("./commands/default_commands").commands,b=k("./config"),c=k("./token_iterator").TokenIterator,l=k("./clipboard"),g=function(o,f,s){this.$toDestroy=[];var v=o.getContainerElement();this.container=v,this.renderer=o,this.id="editor"+ ++g.$uid,this.commands=new w(h.isMac?"mac":"win",S),typeof document=="object"&&(this.textInput=new d(o.getTextAreaContainer(),this),this.renderer.textarea=this.textInput.getElement(),this.$mouseHandler=new t(this),new e(this)),this.keyBinding=new i(this),this.$search=new n().set({wrap:!0}),this.$historyTracker=this.$historyTracker.bind(this),this.commands.on("exec",this.$historyTracker),this.$initOperationListeners(),this._$emitInputEvent=p.delayedCall(function(){this._signal("input",{}),this.session&&this.session.bgTokenizer&&this.session.bgTokenizer.scheduleStart()}.bind(this)),this.on("change",function($,C){C._$emitInputEvent.schedule(31)}),this.setSession(f||s&&s.session||new r("")),b.resetOptions(this),s&&this.setOptions(s),b._signal("editor",this)};g.$uid=0,function(){E.implement(this,m),this.$initOperationListeners=function(){this.commands.on("exec",this.startOperation.bind(this),!0),this.commands.on("afterExec",this.endOperation.bind(this),!0),this.$opResetTimer=p.delayedCall(this.endOperation.bind(this,!0)),this.on("change",function(){this.curOp||(this.startOperation(),this.curOp.selectionBefore=this.$lastSel),this.curOp.docChanged=!0}.bind(this),!0),this.on("changeSelection",function(){this.curOp||(this.startOperation(),this.curOp.selectionBefore=this.$lastSel),this.curOp.selectionChanged=!0}.bind(this),!0)},this.curOp=null,this.prevOp={},this.startOperation=function(o){if(this.curOp){if(!o||this.curOp.command)return;this.prevOp=this.curOp}o||(this.previousCommand=null,o={}),this.$opResetTimer.schedule(),this.curOp=this.session.curOp={command:o.command||{},args:o.args,scrollTop:this.renderer.scrollTop},this.curOp.selectionBefore=this.selection.toJSON()},this.endOperation=function(o){if(this.curOp&&this.session){if(o&&o.returnValue===!1||!this.session)return this.curOp=null;if(o==!0&&this.curOp.command&&this.curOp.command.name=="mouse"||(this._signal("beforeEndOperation"),!this.curOp))return;var f=this.curOp.command,s=f&&f.scrollIntoView;if(s){switch(s){case"center-animate":s="animate";case"center":this.renderer.scrollCursorIntoView(null,.5);break;case"animate":case"cursor":this.renderer.scrollCursorIntoView();break;case"selectionPart":var v=this.selection.getRange(),$=this.renderer.layerConfig;(v.start.row>=$.lastRow||v.end.row<=$.firstRow)&&this.renderer.scrollSelectionIntoView(this.selection.anchor,this.selection.lead);break;default:break}s=="animate"&&this.renderer.animateScrolling(this.curOp.scrollTop)}var C=this.selection.toJSON();this.curOp.selectionAfter=C,this.$lastSel=this.selection.toJSON(),this.session.getUndoManager().addSelection(C),this.prevOp=this.curOp,this.curOp=null}},this.$mergeableCommands=["backspace","del","insertstring"],this.$historyTracker=function(o){if(this.$mergeUndoDeltas){var f=this.prevOp,s=this.$mergeableCommands,v=f.command&&o.command.name==f.command.name;if(o.command.name=="insertstring"){var $=o.args;this.mergeNextCommand===void 0&&(this.mergeNextCommand=!0),v=v&&this.mergeNextCommand&&(!/\s/.test($)||/\s/.test(f.args)),this.mergeNextCommand=!0}else v=v&&s.indexOf(o.command.name)!==-1;this.$mergeUndoDeltas!="always"&&Date.now()-this.sequenceStartTime>2e3&&(v=!1),v?this.session.mergeUndoDeltas=!0:s.indexOf(o.command.name)!==-1&&(this.sequenceStartTime=Date.now())}},this.setKeyboardHandler=function(o,f){if(o&&typeof o=="string"&&o!="ace"){this.$keybindingId=o;var s=this;b.loadModule(["keybinding",o],function(v){s.$keybindingId==o&&s.keyBinding.setKeyboardHandler(v&&v.handler),f&&f()})}else this.$keybindingId=null,this.keyBinding.setKeyboardHandler(o),f&&f()},this.getKeyboardHandler=function(){return this.keyBinding.getKeyboardHandler()},this.setSession=function(o){if(this.session!=o){this.curOp&&this.endOperation(),this.curOp={};var f=this.session;if(f){this.session.off("change",this.$onDocumentChange),this.session.off("changeMode",this.$onChangeMode),this.session.off("tokenizerUpdate",this.$onTokenizerUpdate),this.session.off("changeTabSize",this.$onChangeTabSize),this.session.off("changeWrapLimit",this.$onChangeWrapLimit),this.session.off("changeWrapMode",this.$onChangeWrapMode),this.session.off("changeFold",this.$onChangeFold),this.session.off("changeFrontMarker",this.$onChangeFrontMarker),this.session.off("changeBackMarker",this.$onChangeBackMarker),this.session.off("changeBreakpoint",this.$onChangeBreakpoint),this.session.off("changeAnnotation",this.$onChangeAnnotation),this.session.off("changeOverwrite",this.$onCursorChange),this.session.off("changeScrollTop",this.$onScrollTopChange),this.session.off("changeScrollLeft",this.$onScrollLeftChange);var s=this.session.getSelection();s.off("changeCursor",this.$onCursorChange),s.off("changeSelection",this.$onSelectionChange)}this.session=o,o?(this.$onDocumentChange=this.onDocumentChange.bind(this),o.on("change",this.$onDocumentChange),this.renderer.setSession(o),this.$onChangeMode=this.onChangeMode.bind(this),o.on("changeMode",this.$onChangeMode),this.$onTokenizerUpdate=this.onTokenizerUpdate.bind(this),o.on("tokenizerUpdate",this.$onTokenizerUpdate),this.$onChangeTabSize=this.renderer.onChangeTabSize.bind(this.renderer),o.on("changeTabSize",this.$onChangeTabSize),this.$onChangeWrapLimit=this.onChangeWrapLimit.bind(this),o.on("changeWrapLimit",this.$onChangeWrapLimit),this.$onChangeWrapMode=this.onChangeWrapMode.bind(this),o.on("changeWrapMode",this.$onChangeWrapMode),this.$onChangeFold=this.onChangeFold.bind(this),o.on("changeFold",this.$onChangeFold),this.$onChangeFrontMarker=this.onChangeFrontMarker.bind(this),this.session.on("changeFrontMarker",this.$onChangeFrontMarker),this.$onChangeBackMarker=this.onChangeBackMarker.bind(this),this.session.on("changeBackMarker",this.$onChangeBackMarker),this.$onChangeBreakpoint=this.onChangeBreakpoint.bind(this),this.session.on("changeBreakpoint",this.$onChangeBreakpoint),this.$onChangeAnnotation=this.onChangeAnnotation.bind(this),this.session.on("changeAnnotation",this.$onChangeAnnotation),this.$onCursorChange=this.onCursorChange.bind(this),this.session.on("changeOverwrite",this.$onCursorChange),this.$onScrollTopChange=this.onScrollTopChange.bind(this),this.session.on("changeScrollTop",this.$onScrollTopChange),this.$onScrollLeftChange=this.onScrollLeftChange.bind(this),this.session.on("changeScrollLeft",this.$onScrollLeftChange),this.selection=o.getSelection(),this.selection.on("changeCursor",this.$onCursorChange),this.$onSelectionChange=this.onSelectionChange.bind(this),this.selection.on("changeSelection",this.$onSelectionChange),this.onChangeMode(),this.onCursorChange(),this.onScrollTopChange(),this.onScrollLeftChange(),this.onSelectionChange(),this.onChangeFrontMarker(),this.onChangeBackMarker(),this.onChangeBreakpoint(),this.onChangeAnnotation(),this.session.getUseWrapMode()&&this.renderer.adjustWrapLimit(),this.renderer.updateFull()):(this.selection=null,this.renderer.setSession(o)),this._signal("changeSession",{session:o,oldSession:f}),this.curOp=null,f&&f._signal("changeEditor",{oldEditor:this}),o&&o._signal("changeEditor",{editor:this}),o&&o.bgTokenizer&&o.bgTokenizer.scheduleStart()}},this.getSession=function(){return this.session},this.setValue=function(o,f){return this.session.doc.setValue(o),f?f==1?this.navigateFileEnd():f==-1&&this.navigateFileStart():this.selectAll(),o},this.getValue=function(){return this.session.getValue()},this.getSelection=function(){return this.selection},this.resize=function(o){this.renderer.onResize(o)},this.setTheme=function(o,f){this.renderer.setTheme(o,f)},this.getTheme=function(){return this.renderer.getTheme()},this.setStyle=function(o){this.renderer.setStyle(o)},this.unsetStyle=function(o){this.renderer.unsetStyle(o)},this.getFontSize=function(){return this.getOption("fontSize")||A.computedStyle(this.container).fontSize},this.setFontSize=function(o){this.setOption("fontSize",o)},this.$highlightBrackets=function(){if(!this.$highlightPending){var o=this;this.$highlightPending=!0,setTimeout(function(){o.$highlightPending=!1;var f=o.session;if(!(!f||!f.bgTokenizer)){f.$bracketHighlight&&(f.$bracketHighlight.markerIds.forEach(function($){f.removeMarker($)}),f.$bracketHighlight=null);var s=f.getMatchingBracketRanges(o.getCursorPosition());if(!s&&f.$mode.getMatching&&(s=f.$mode.getMatching(o.session)),!!s){var v="ace_bracket";Array.isArray(s)?s.length==1&&(v="ace_error_bracket"):s=[s],s.length==2&&(a.comparePoints(s[0].end,s[1].start)==0?s=[a.fromPoints(s[0].start,s[1].end)]:a.comparePoints(s[0].start,s[1].end)==0&&(s=[a.fromPoints(s[1].start,s[0].end)])),f.$bracketHighlight={ranges:s,markerIds:s.map(function($){return f.addMarker($,v,"text")})}}}},50)}},this.$highlightTags=function(){if(!this.$highlightTagPending){var o=this;this.$highlightTagPending=!0,setTimeout(function(){o.$highlightTagPending=!1;var f=o.session;if(!(!f||!f.bgTokenizer)){var s=o.getCursorPosition(),v=new c(o.session,s.row,s.column),$=v.getCurrentToken();if(!$||!/\b(?:tag-open|tag-name)/.test($.type)){f.removeMarker(f.$tagHighlight),f.$tagHighlight=null;return}if(!($.type.indexOf("tag-open")!==-1&&($=v.stepForward(),!$))){var C=$.value,x=$.value,L=0,R=v.stepBackward();if(R.value==="<")do R=$,$=v.stepForward(),$&&($.type.indexOf("tag-name")!==-1?(x=$.value,C===x&&(R.value==="<"?L++:R.value==="</"&&L--)):C===x&&$.value==="/>"&&L--);while($&&L>=0);else{do if($=R,R=v.stepBackward(),$){if($.type.indexOf("tag-name")!==-1)C===$.value&&(R.value==="<"?L++:R.value==="</"&&L--);else if($.value==="/>"){for(var T=0,_=R;_;){if(_.type.indexOf("tag-name")!==-1&&_.value===C){L--;break}else if(_.value==="<")break;_=v.stepBackward(),T++}for(var F=0;F<T;F++)v.stepForward()}}while(R&&L<=0);v.stepForward()}if(!$){f.removeMarker(f.$tagHighlight),f.$tagHighlight=null;return}var O=v.getCurrentTokenRow(),M=v.getCurrentTokenColumn(),P=new a(O,M,O,M+$.value.length),I=f.$backMarkers[f.$tagHighlight];f.$tagHighlight&&I!=null&&P.compareRange(I.range)!==0&&(f.removeMarker(f.$tagHighlight),f.$tagHighlight=null),f.$tagHighlight||(f.$tagHighlight=f.addMarker(P,"ace_bracket","text"))}}},50)}},this.focus=function(){var o=this;setTimeout(function(){o.isFocused()||o.textInput.focus()}),this.textInput.focus()},this.isFocused=function(){return this.textInput.isFocused()},this.blur=function(){this.textInput.blur()},this.onFocus=function(o){this.$isFocused||(this.$isFocused=!0,this.renderer.showCursor(),this.renderer.visualizeFocus(),this._emit("focus",o))},this.onBlur=function(o){this.$isFocused&&(this.$isFocused=!1,this.renderer.hideCursor(),this.renderer.visualizeBlur(),this._emit("blur",o))},this.$cursorChange=function(){this.renderer.updateCursor(),this.$highlightBrackets(),this.$highlightTags(),this.$updateHighlightActiveLine()},this.onDocumentChange=function(o){var f=this.session.$useWrapMode,s=o.start.row==o.end.row?o.end.row:1/0;this.renderer.updateLines(o.start.row,s,f),this._signal("change",o),this.$cursorChange()},this.onTokenizerUpdate=function(o){var f=o.data;this.renderer.updateLines(f.first,f.last)},this.onScrollTopChange=function(){this.renderer.scrollToY(this.session.getScrollTop())},this.onScrollLeftChange=function(){this.renderer.scrollToX(this.session.getScrollLeft())},this.onCursorChange=function(){this.$cursorChange(),this._signal("changeSelection")},this.$updateHighlightActiveLine=function(){var o=this.getSession(),f;if(this.$highlightActiveLine&&((this.$selectionStyle!="line"||!this.selection.isMultiLine())&&(f=this.getCursorPosition()),this.renderer.theme&&this.renderer.theme.$selectionColorConflict&&!this.selection.isEmpty()&&(f=!1),this.renderer.$maxLines&&this.session.getLength()===1&&!(this.renderer.$minLines>1)&&(f=!1)),o.$highlightLineMarker&&!f)o.removeMarker(o.$highlightLineMarker.id),o.$highlightLineMarker=null;else if(!o.$highlightLineMarker&&f){var s=new a(f.row,f.column,f.row,1/0);s.id=o.addMarker(s,"ace_active-line","screenLine"),o.$highlightLineMarker=s}else f&&(o.$highlightLineMarker.start.row=f.row,o.$highlightLineMarker.end.row=f.row,o.$highlightLineMarker.start.column=f.column,o._signal("changeBackMarker"))},this.onSelectionChange=function(o){var f=this.session;if(f.$selectionMarker&&f.removeMarker(f.$selectionMarker),f.$selectionMarker=null,this.selection.isEmpty())this.$updateHighlightActiveLine();else{var s=this.selection.getRange(),v=this.getSelectionStyle();f.$selectionMarker=f.addMarker(s,"ace_selection",v)}var $=this.$highlightSelectedWord&&this.$getSelectionHighLightRegexp();this.session.highlight($),this._signal("changeSelection")},this.$getSelectionHighLightRegexp=function(){var o=this.session,f=this.getSelectionRange();if(!(f.isEmpty()||f.isMultiLine())){var s=f.start.column,v=f.end.column,$=o.getLine(f.start.row),C=$.substring(s,v);if(!(C.length>5e3||!/[\w\d]/.test(C))){var x=this.$search.$assembleRegExp({wholeWord:!0,caseSensitive:!0,needle:C}),L=$.substring(s-1,v+1);if(x.test(L))return x}}},this.onChangeFrontMarker=function(){this.renderer.updateFrontMarkers()},this.onChangeBackMarker=function(){this.renderer.updateBackMarkers()},this.onChangeBreakpoint=function(){this.renderer.updateBreakpoints()},this.onChangeAnnotation=function(){this.renderer.setAnnotations(this.session.getAnnotations())},this.onChangeMode=function(o){this.renderer.updateText(),this._emit("changeMode",o)},this.onChangeWrapLimit=function(){this.renderer.updateFull()},this.onChangeWrapMode=function(){this.renderer.onResize(!0)},this.onChangeFold=function(){this.$updateHighlightActiveLine(),this.renderer.updateFull()},this.getSelectedText=function(){return this.session.getTextRange(this.getSelectionRange())},this.getCopyText=function(){var o=this.getSelectedText(),f=this.session.doc.getNewLineCharacter(),s=!1;if(!o&&this.$copyWithEmptySelection){s=!0;for(var v=this.selection.getAllRanges(),$=0;$<v.length;$++){var C=v[$];$&&v[$-1].start.row==C.start.row||(o+=this.session.getLine(C.start.row)+f)}}var x={text:o};return this._signal("copy",x),l.lineMode=s?x.text:"",x.text},this.onCopy=function(){this.commands.exec("copy",this)},this.onCut=function(){this.commands.exec("cut",this)},this.onPaste=function(o,f){var s={text:o,event:f};this.commands.exec("paste",this,s)},this.$handlePaste=function(o){typeof o=="string"&&(o={text:o}),this._signal("paste",o);var f=o.text,s=f==l.lineMode,v=this.session;if(!this.inMultiSelectMode||this.inVirtualSelectionMode)s?v.insert({row:this.selection.lead.row,column:0},f):this.insert(f);else if(s)this.selection.rangeList.ranges.forEach(function(T){v.insert({row:T.start.row,column:0},f)});else{var $=f.split(/\r\n|\r|\n/),C=this.selection.rangeList.ranges,x=$.length==2&&(!$[0]||!$[1]);if($.length!=C.length||x)return this.commands.exec("insertstring",this,f);for(var L=C.length;L--;){var R=C[L];R.isEmpty()||v.remove(R),v.insert(R.start,$[L])}}},this.execCommand=function(o,f){return this.commands.exec(o,this,f)},this.insert=function(o,f){var s=this.session,v=s.getMode(),$=this.getCursorPosition();if(this.getBehavioursEnabled()&&!f){var C=v.transformAction(s.getState($.row),"insertion",this,s,o);C&&(o!==C.text&&(this.inVirtualSelectionMode||(this.session.mergeUndoDeltas=!1,this.mergeNextCommand=!1)),o=C.text)}if(o=="	"&&(o=this.session.getTabString()),this.selection.isEmpty()){if(this.session.getOverwrite()&&o.indexOf(`
+`)==-1){var x=new a.fromPoints($,$);x.end.column+=o.length,this.session.remove(x)}}else{var x=this.getSelectionRange();$=this.session.remove(x),this.clearSelection()}if(o==`
+`||o==`\r
+`){var _=s.getLine($.row);if($.column>_.search(/\S|$/)){var L=_.substr($.column).search(/\S|$/);s.doc.removeInLine($.row,$.column,$.column+L)}}this.clearSelection();var R=$.column,T=s.getState($.row),_=s.getLine($.row),F=v.checkOutdent(T,_,o);if(s.insert($,o),C&&C.selection&&(C.selection.length==2?this.selection.setSelectionRange(new a($.row,R+C.selection[0],$.row,R+C.selection[1])):this.selection.setSelectionRange(new a($.row+C.selection[0],C.selection[1],$.row+C.selection[2],C.selection[3]))),this.$enableAutoIndent){if(s.getDocument().isNewLine(o)){var O=v.getNextLineIndent(T,_.slice(0,$.column),s.getTabString());s.insert({row:$.row+1,column:0},O)}F&&v.autoOutdent(T,s,$.row)}},this.autoIndent=function(){var o=this.session,f=o.getMode(),s,v;if(this.selection.isEmpty())s=0,v=o.doc.getLength()-1;else{var $=this.getSelectionRange();s=$.start.row,v=$.end.row}for(var C="",x="",L="",R,T,_,F=o.getTabString(),O=s;O<=v;O++)O>0&&(C=o.getState(O-1),x=o.getLine(O-1),L=f.getNextLineIndent(C,x,F)),R=o.getLine(O),T=f.$getIndent(R),L!==T&&(T.length>0&&(_=new a(O,0,O,T.length),o.remove(_)),L.length>0&&o.insert({row:O,column:0},L)),f.autoOutdent(C,o,O)},this.onTextInput=function(o,f){if(!f)return this.keyBinding.onTextInput(o);this.startOperation({command:{name:"insertstring"}});var s=this.applyComposition.bind(this,o,f);this.selection.rangeCount?this.forEachSelection(s):s(),this.endOperation()},this.applyComposition=function(o,f){if(f.extendLeft||f.extendRight){var s=this.selection.getRange();s.start.column-=f.extendLeft,s.end.column+=f.extendRight,s.start.column<0&&(s.start.row--,s.start.column+=this.session.getLine(s.start.row).length+1),this.selection.setRange(s),!o&&!s.isEmpty()&&this.remove()}if((o||!this.selection.isEmpty())&&this.insert(o,!0),f.restoreStart||f.restoreEnd){var s=this.selection.getRange();s.start.column-=f.restoreStart,s.end.column-=f.restoreEnd,this.selection.setRange(s)}},this.onCommandKey=function(o,f,s){return this.keyBinding.onCommandKey(o,f,s)},this.setOverwrite=function(o){this.session.setOverwrite(o)},this.getOverwrite=function(){return this.session.getOverwrite()},this.toggleOverwrite=function(){this.session.toggleOverwrite()},this.setScrollSpeed=function(o){this.setOption("scrollSpeed",o)},this.getScrollSpeed=function(){return this.getOption("scrollSpeed")},this.setDragDelay=function(o){this.setOption("dragDelay",o)},this.getDragDelay=function(){return this.getOption("dragDelay")},this.setSelectionStyle=function(o){this.setOption("selectionStyle",o)},this.getSelectionStyle=function(){return this.getOption("selectionStyle")},this.setHighlightActiveLine=function(o){this.setOption("highlightActiveLine",o)},this.getHighlightActiveLine=function(){return this.getOption("highlightActiveLine")},this.setHighlightGutterLine=function(o){this.setOption("highlightGutterLine",o)},this.getHighlightGutterLine=function(){return this.getOption("highlightGutterLine")},this.setHighlightSelectedWord=function(o){this.setOption("highlightSelectedWord",o)},this.getHighlightSelectedWord=function(){return this.$highlightSelectedWord},this.setAnimatedScroll=function(o){this.renderer.setAnimatedScroll(o)},this.getAnimatedScroll=function(){return this.renderer.getAnimatedScroll()},this.setShowInvisibles=function(o){this.renderer.setShowInvisibles(o)},this.getShowInvisibles=function(){return this.renderer.getShowInvisibles()},this.setDisplayIndentGuides=function(o){this.renderer.setDisplayIndentGuides(o)},this.getDisplayIndentGuides=function(){return this.renderer.getDisplayIndentGuides()},this.setShowPrintMargin=function(o){this.renderer.setShowPrintMargin(o)},this.getShowPrintMargin=function(){return this.renderer.getShowPrintMargin()},this.setPrintMarginColumn=function(o){this.renderer.setPrintMarginColumn(o)},this.getPrintMarginColumn=function(){return this.renderer.getPrintMarginColumn()},this.setReadOnly=function(o){this.setOption("readOnly",o)},this.getReadOnly=function(){return this.getOption("readOnly")},this.setBehavioursEnabled=function(o){this.setOption("behavioursEnabled",o)},this.getBehavioursEnabled=function(){return this.getOption("behavioursEnabled")},this.setWrapBehavioursEnabled=function(o){this.setOption("wrapBehavioursEnabled",o)},this.getWrapBehavioursEnabled=function(){return this.getOption("wrapBehavioursEnabled")},this.setShowFoldWidgets=function(o){this.setOption("showFoldWidgets",o)},this.getShowFoldWidgets=function(){return this.getOption("showFoldWidgets")},this.setFadeFoldWidgets=function(o){this.setOption("fadeFoldWidgets",o)},this.getFadeFoldWidgets=function(){return this.getOption("fadeFoldWidgets")},this.remove=function(o){this.selection.isEmpty()&&(o=="left"?this.selection.selectLeft():this.selection.selectRight());var f=this.getSelectionRange();if(this.getBehavioursEnabled()){var s=this.session,v=s.getState(f.start.row),$=s.getMode().transformAction(v,"deletion",this,s,f);if(f.end.column===0){var C=s.getTextRange(f);if(C[C.length-1]==`
+`){var x=s.getLine(f.end.row);/^\s+$/.test(x)&&(f.end.column=x.length)}}$&&(f=$)}this.session.remove(f),this.clearSelection()},this.removeWordRight=function(){this.selection.isEmpty()&&this.selection.selectWordRight(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeWordLeft=function(){this.selection.isEmpty()&&this.selection.selectWordLeft(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeToLineStart=function(){this.selection.isEmpty()&&this.selection.selectLineStart(),this.selection.isEmpty()&&this.selection.selectLeft(),this.session.remove(this.getSelectionRange()),this.clearSelection()},this.removeToLineEnd=function(){this.selection.isEmpty()&&this.selection.selectLineEnd();var o=this.getSelectionRange();o.start.column==o.end.column&&o.start.row==o.end.row&&(o.end.column=0,o.end.row++),this.session.remove(o),this.clearSelection()},this.splitLine=function(){this.selection.isEmpty()||(this.session.remove(this.getSelectionRange()),this.clearSelection());var o=this.getCursorPosition();this.insert(`
+`),this.moveCursorToPosition(o)},this.transposeLetters=function(){if(this.selection.isEmpty()){var o=this.getCursorPosition(),f=o.column;if(f!==0){var s=this.session.getLine(o.row),v,$;f<s.length?(v=s.charAt(f)+s.charAt(f-1),$=new a(o.row,f-1,o.row,f+1)):(v=s.charAt(f-1)+s.charAt(f-2),$=new a(o.row,f-2,o.row,f)),this.session.replace($,v),this.session.selection.moveToPosition($.end)}}},this.toLowerCase=function(){var o=this.getSelectionRange();this.selection.isEmpty()&&this.selection.selectWord();var f=this.getSelectionRange(),s=this.session.getTextRange(f);this.session.replace(f,s.toLowerCase()),this.selection.setSelectionRange(o)},this.toUpperCase=function(){var o=this.getSelectionRange();this.selection.isEmpty()&&this.selection.selectWord();var f=this.getSelectionRange(),s=this.session.getTextRange(f);this.session.replace(f,s.toUpperCase()),this.selection.setSelectionRange(o)},this.indent=function(){var o=this.session,f=this.getSelectionRange();if(f.start.row<f.end.row){var s=this.$getSelectedRows();o.indentRows(s.first,s.last,"	");return}else if(f.start.column<f.end.column){var v=o.getTextRange(f);if(!/^\s+$/.test(v)){var s=this.$getSelectedRows();o.indentRows(s.first,s.last,"	");return}}var $=o.getLine(f.start.row),C=f.start,x=o.getTabSize(),L=o.documentToScreenColumn(C.row,C.column);if(this.session.getUseSoftTabs())var R=x-L%x,T=p.stringRepeat(" ",R);else{for(var R=L%x;$[f.start.column-1]==" "&&R;)f.start.column--,R--;this.selection.setSelectionRange(f),T="	"}return this.insert(T)},this.blockIndent=function(){var o=this.$getSelectedRows();this.session.indentRows(o.first,o.last,"	")},this.blockOutdent=function(){var o=this.session.getSelection();this.session.outdentRows(o.getRange())},this.sortLines=function(){for(var o=this.$getSelectedRows(),f=this.session,s=[],v=o.first;v<=o.last;v++)s.push(f.getLine(v));s.sort(function(x,L){return x.toLowerCase()<L.toLowerCase()?-1:x.toLowerCase()>L.toLowerCase()?1:0});for(var $=new a(0,0,0,0),v=o.first;v<=o.last;v++){var C=f.getLine(v);$.start.row=v,$.end.row=v,$.end.column=C.length,f.replace($,s[v-o.first])}},this.toggleCommentLines=function(){var o=this.session.getState(this.getCursorPosition().row),f=this.$getSelectedRows();this.session.getMode().toggleCommentLines(o,this.session,f.first,f.last)},this.toggleBlockComment=function(){var o=this.getCursorPosition(),f=this.session.getState(o.row),s=this.getSelectionRange();this.session.getMode().toggleBlockComment(f,this.session,s,o)},this.getNumberAt=function(o,f){var s=/[\-]?[0-9]+(?:\.[0-9]+)?/g;s.lastIndex=0;for(var v=this.session.getLine(o);s.lastIndex<f;){var $=s.exec(v);if($.index<=f&&$.index+$[0].length>=f){var C={value:$[0],start:$.index,end:$.index+$[0].length};return C}}return null},this.modifyNumber=function(o){var f=this.selection.getCursor().row,s=this.selection.getCursor().column,v=new a(f,s-1,f,s),$=this.session.getTextRange(v);if(!isNaN(parseFloat($))&&isFinite($)){var C=this.getNumberAt(f,s);if(C){var x=C.value.indexOf(".")>=0?C.start+C.value.indexOf(".")+1:C.end,L=C.start+C.value.length-x,R=parseFloat(C.value);R*=Math.pow(10,L),x!==C.end&&s<x?o*=Math.pow(10,C.end-s-1):o*=Math.pow(10,C.end-s),R+=o,R/=Math.pow(10,L);var T=R.toFixed(L),_=new a(f,C.start,f,C.end);this.session.replace(_,T),this.moveCursorTo(f,Math.max(C.start+1,s+T.length-C.value.length))}}else this.toggleWord()},this.$toggleWordPairs=[["first","last"],["true","false"],["yes","no"],["width","height"],["top","bottom"],["right","left"],["on","off"],["x","y"],["get","set"],["max","min"],["horizontal","vertical"],["show","hide"],["add","remove"],["up","down"],["before","after"],["even","odd"],["in","out"],["inside","outside"],["next","previous"],["increase","decrease"],["attach","detach"],["&&","||"],["==","!="]],this.toggleWord=function(){var o=this.selection.getCursor().row,f=this.selection.getCursor().column;this.selection.selectWord();var s=this.getSelectedText(),v=this.selection.getWordRange().start.column,$=s.replace(/([a-z]+|[A-Z]+)(?=[A-Z_]|$)/g,"$1 ").split(/\s/),C=f-v-1;C<0&&(C=0);var x=0,L=0,R=this;s.match(/[A-Za-z0-9_]+/)&&$.forEach(function(N,V){L=x+N.length,C>=x&&C<=L&&(s=N,R.selection.clearSelection(),R.moveCursorTo(o,x+v),R.selection.selectTo(o,L+v)),x=L});for(var T=this.$toggleWordPairs,_,F=0;F<T.length;F++)for(var O=T[F],M=0;M<=1;M++){var P=+!M,I=s.match(new RegExp("^\\s?_?("+p.escapeRegExp(O[M])+")\\s?$","i"));if(I){var D=s.match(new RegExp("([_]|^|\\s)("+p.escapeRegExp(I[1])+")($|\\s)","g"));D&&(_=s.replace(new RegExp(p.escapeRegExp(O[M]),"i"),function(N){var V=O[P];return N.toUpperCase()==N?V=V.toUpperCase():N.charAt(0).toUpperCase()==N.charAt(0)&&(V=V.substr(0,0)+O[P].charAt(0).toUpperCase()+V.substr(1)),V}),this.insert(_),_="")}}},this.removeLines=function(){var o=this.$getSelectedRows();this.session.removeFullLines(o.first,o.last),this.clearSelection()},this.duplicateSelection=function(){var o=this.selection,f=this.session,s=o.getRange(),v=o.isBackwards();if(s.isEmpty()){var $=s.start.row;f.duplicateLines($,$)}else{var C=v?s.start:s.end,x=f.insert(C,f.getTextRange(s),!1);s.start=C,s.end=x,o.setSelectionRange(s,v)}},this.moveLinesDown=function(){this.$moveLines(1,!1)},this.moveLinesUp=function(){this.$moveLines(-1,!1)},this.moveText=function(o,f,s){return this.session.moveText(o,f,s)},this.copyLinesUp=function(){this.$moveLines(-1,!0)},this.copyLinesDown=function(){this.$moveLines(1,!0)},this.$moveLines=function(o,f){var s,v,$=this.selection;if(!$.inMultiSelectMode||this.inVirtualSelectionMode){var C=$.toOrientedRange();s=this.$getSelectedRows(C),v=this.session.$moveLines(s.first,s.last,f?0:o),f&&o==-1&&(v=0),C.moveBy(v,0),$.fromOrientedRange(C)}else{var x=$.rangeList.ranges;$.rangeList.detach(this.session),this.inVirtualSelectionMode=!0;for(var L=0,R=0,T=x.length,_=0;_<T;_++){var F=_;x[_].moveBy(L,0),s=this.$getSelectedRows(x[_]);for(var O=s.first,M=s.last;++_<T;){R&&x[_].moveBy(R,0);var P=this.$getSelectedRows(x[_]);if(f&&P.first!=M)break;if(!f&&P.first>M+1)break;M=P.last}for(_--,L=this.session.$moveLines(O,M,f?0:o),f&&o==-1&&(F=_+1);F<=_;)x[F].moveBy(L,0),F++;f||(L=0),R+=L}$.fromOrientedRange($.ranges[0]),$.rangeList.attach(this.session),this.inVirtualSelectionMode=!1}},this.$getSelectedRows=function(o){return o=(o||this.getSelectionRange()).collapseRows(),{first:this.session.getRowFoldStart(o.start.row),last:this.session.getRowFoldEnd(o.end.row)}},this.onCompositionStart=function(o){this.renderer.showComposition(o)},this.onCompositionUpdate=function(o){this.renderer.setCompositionText(o)},this.onCompositionEnd=function(){this.renderer.hideComposition()},this.getFirstVisibleRow=function(){return this.renderer.getFirstVisibleRow()},this.getLastVisibleRow=function(){return this.renderer.getLastVisibleRow()},this.isRowVisible=function(o){return o>=this.getFirstVisibleRow()&&o<=this.getLastVisibleRow()},this.isRowFullyVisible=function(o){return o>=this.renderer.getFirstFullyVisibleRow()&&o<=this.renderer.getLastFullyVisibleRow()},this.$getVisibleRowCount=function(){return this.renderer.getScrollBottomRow()-this.renderer.getScrollTopRow()+1},this.$moveByPage=function(o,f){var s=this.renderer,v=this.renderer.layerConfig,$=o*Math.floor(v.height/v.lineHeight);f===!0?this.selection.$moveSelection(function(){this.moveCursorBy($,0)}):f===!1&&(this.selection.moveCursorBy($,0),this.selection.clearSelection());var C=s.scrollTop;s.scrollBy(0,$*v.lineHeight),f!=null&&s.scrollCursorIntoView(null,.5),s.animateScrolling(C)},this.selectPageDown=function(){this.$moveByPage(1,!0)},this.selectPageUp=function(){this.$moveByPage(-1,!0)},this.gotoPageDown=function(){this.$moveByPage(1,!1)},this.gotoPageUp=function(){this.$moveByPage(-1,!1)},this.scrollPageDown=function(){this.$moveByPage(1)},this.scrollPageUp=function(){this.$moveByPage(-1)},this.scrollToRow=function(o){this.renderer.scrollToRow(o)},this.scrollToLine=function(o,f,s,v){this.renderer.scrollToLine(o,f,s,v)},this.centerSelection=function(){var o=this.getSelectionRange(),f={row:Math.floor(o.start.row+(o.end.row-o.start.row)/2),column:Math.floor(o.start.column+(o.end.column-o.start.column)/2)};this.renderer.alignCursor(f,.5)},this.getCursorPosition=function(){return this.selection.getCursor()},this.getCursorPositionScreen=function(){return this.session.documentToScreenPosition(this.getCursorPosition())},this.getSelectionRange=function(){return this.selection.getRange()},this.selectAll=function(){this.selection.selectAll()},this.clearSelection=function(){this.selection.clearSelection()},this.moveCursorTo=function(o,f){this.selection.moveCursorTo(o,f)},this.moveCursorToPosition=function(o){this.selection.moveCursorToPosition(o)},this.jumpToMatching=function(o,f){var s=this.getCursorPosition(),v=new c(this.session,s.row,s.column),$=v.getCurrentToken(),C=$||v.stepForward();if(C){var x,L=!1,R={},T=s.column-C.start,_,F={")":"(","(":"(","]":"[","[":"[","{":"{","}":"{"};do{if(C.value.match(/[{}()\[\]]/g)){for(;T<C.value.length&&!L;T++)if(F[C.value[T]])switch(_=F[C.value[T]]+"."+C.type.replace("rparen","lparen"),isNaN(R[_])&&(R[_]=0),C.value[T]){case"(":case"[":case"{":R[_]++;break;case")":case"]":case"}":R[_]--,R[_]===-1&&(x="bracket",L=!0);break}}else C.type.indexOf("tag-name")!==-1&&(isNaN(R[C.value])&&(R[C.value]=0),$.value==="<"?R[C.value]++:$.value==="</"&&R[C.value]--,R[C.value]===-1&&(x="tag",L=!0));L||($=C,C=v.stepForward(),T=0)}while(C&&!L);if(x){var O,M;if(x==="bracket")O=this.session.getBracketRange(s),O||(O=new a(v.getCurrentTokenRow(),v.getCurrentTokenColumn()+T-1,v.getCurrentTokenRow(),v.getCurrentTokenColumn()+T-1),M=O.start,(f||M.row===s.row&&Math.abs(M.column-s.column)<2)&&(O=this.session.getBracketRange(M)));else if(x==="tag"){if(C&&C.type.indexOf("tag-name")!==-1)var P=C.value;else return;if(O=new a(v.getCurrentTokenRow(),v.getCurrentTokenColumn()-2,v.getCurrentTokenRow(),v.getCurrentTokenColumn()-2),O.compare(s.row,s.column)===0){L=!1;do C=$,$=v.stepBackward(),$&&($.type.indexOf("tag-close")!==-1&&O.setEnd(v.getCurrentTokenRow(),v.getCurrentTokenColumn()+1),C.value===P&&C.type.indexOf("tag-name")!==-1&&($.value==="<"?R[P]++:$.value==="</"&&R[P]--,R[P]===0&&(L=!0)));while($&&!L)}C&&C.type.indexOf("tag-name")&&(M=O.start,M.row==s.row&&Math.abs(M.column-s.column)<2&&(M=O.end))}M=O&&O.cursor||M,M&&(o?O&&f?this.selection.setRange(O):O&&O.isEqual(this.getSelectionRange())?this.clearSelection():this.selection.selectTo(M.row,M.column):this.selection.moveTo(M.row,M.column))}}},this.gotoLine=function(o,f,s){this.selection.clearSelection(),this.session.unfold({row:o-1,column:f||0}),this.exitMultiSelectMode&&this.exitMultiSelectMode(),this.moveCursorTo(o-1,f||0),this.isRowFullyVisible(o-1)||this.scrollToLine(o-1,!0,s)},this.navigateTo=function(o,f){this.selection.moveTo(o,f)},this.navigateUp=function(o){if(this.selection.isMultiLine()&&!this.selection.isBackwards()){var f=this.selection.anchor.getPosition();return this.moveCursorToPosition(f)}this.selection.clearSelection(),this.selection.moveCursorBy(-o||-1,0)},this.navigateDown=function(o){if(this.selection.isMultiLine()&&this.selection.isBackwards()){var f=this.selection.anchor.getPosition();return this.moveCursorToPosition(f)}this.selection.clearSelection(),this.selection.moveCursorBy(o||1,0)},this.navigateLeft=function(o){if(this.selection.isEmpty())for(o=o||1;o--;)this.selection.moveCursorLeft();else{var f=this.getSelectionRange().start;this.moveCursorToPosition(f)}this.clearSelection()},this.navigateRight=function(o){if(this.selection.isEmpty())for(o=o||1;o--;)this.selection.moveCursorRight();else{var f=this.getSelectionRange().end;this.moveCursorToPosition(f)}this.clearSelection()},this.navigateLineStart=function(){this.selection.moveCursorLineStart(),this.clearSelection()},this.navigateLineEnd=function(){this.selection.moveCursorLineEnd(),this.clearSelection()},this.navigateFileEnd=function(){this.selection.moveCursorFileEnd(),this.clearSelection()},this.navigateFileStart=function(){this.selection.moveCursorFileStart(),this.clearSelection()},this.navigateWordRight=function(){this.selection.moveCursorWordRight(),this.clearSelection()},this.navigateWordLeft=function(){this.selection.moveCursorWordLeft(),this.clearSelection()},this.replace=function(o,f){f&&this.$search.set(f);var s=this.$search.find(this.session),v=0;return s&&(this.$tryReplace(s,o)&&(v=1),this.selection.setSelectionRange(s),this.renderer.scrollSelectionIntoView(s.start,s.end)),v},this.replaceAll=function(o,f){f&&this.$search.set(f);var s=this.$search.findAll(this.session),v=0;if(!s.length)return v;var $=this.getSelectionRange();this.selection.moveTo(0,0);for(var C=s.length-1;C>=0;--C)this.$tryReplace(s[C],o)&&v++;return this.selection.setSelectionRange($),v},this.$tryReplace=function(o,f){var s=this.session.getTextRange(o);return f=this.$search.replace(s,f),f!==null?(o.end=this.session.replace(o,f),o):null},this.getLastSearchOptions=function(){return this.$search.getOptions()},this.find=function(o,f,s){f||(f={}),typeof o=="string"||o instanceof RegExp?f.needle=o:typeof o=="object"&&E.mixin(f,o);var v=this.selection.getRange();f.needle==null&&(o=this.session.getTextRange(v)||this.$search.$options.needle,o||(v=this.session.getWordRange(v.start.row,v.start.column),o=this.session.getTextRange(v)),this.$search.set({needle:o})),this.$search.set(f),f.start||this.$search.set({start:v});var $=this.$search.find(this.session);if(f.preventScroll)return $;if($)return this.revealRange($,s),$;f.backwards?v.start=v.end:v.end=v.start,this.selection.setRange(v)},this.findNext=function(o,f){this.find({skipCurrent:!0,backwards:!1},o,f)},this.findPrevious=function(o,f){this.find(o,{skipCurrent:!0,backwards:!0},f)},this.revealRange=function(o,f){this.session.unfold(o),this.selection.setSelectionRange(o);var s=this.renderer.scrollTop;this.renderer.scrollSelectionIntoView(o.start,o.end,.5),f!==!1&&this.renderer.animateScrolling(s)},this.undo=function(){this.session.getUndoManager().undo(this.session),this.renderer.scrollCursorIntoView(null,.5)},this.redo=function(){this.session.getUndoManager().redo(this.session),this.renderer.scrollCursorIntoView(null,.5)},this.destroy=function(){this.$toDestroy&&(this.$toDestroy.forEach(function(o){o.destroy()}),this.$toDestroy=null),this.$mouseHandler&&this.$mouseHandler.destroy(),this.renderer.destroy(),this._signal("destroy",this),this.session&&this.session.destroy(),this._$emitInputEvent&&this._$emitInputEvent.cancel(),this.removeAllListeners()},this.setAutoScrollEditorIntoView=function(o){if(o){var f,s=this,v=!1;this.$scrollAnchor||(this.$scrollAnchor=document.createElement("div"));var $=this.$scrollAnchor;$.style.cssText="position:absolute",this.container.insertBefore($,this.container.firstChild);var C=this.on("changeSelection",function(){v=!0}),x=this.renderer.on("beforeRender",function(){v&&(f=s.renderer.container.getBoundingClientRect())}),L=this.renderer.on("afterRender",function(){if(v&&f&&(s.isFocused()||s.searchBox&&s.searchBox.isFocused())){var R=s.renderer,T=R.$cursorLayer.$pixelPos,_=R.layerConfig,F=T.top-_.offset;T.top>=0&&F+f.top<0?v=!0:T.top<_.height&&T.top+f.top+_.lineHeight>window.innerHeight?v=!1:v=null,v!=null&&($.style.top=F+"px",$.style.left=T.left+"px",$.style.height=_.lineHeight+"px",$.scrollIntoView(v)),v=f=null}});this.setAutoScrollEditorIntoView=function(R){R||(delete this.setAutoScrollEditorIntoView,this.off("changeSelection",C),this.renderer.off("afterRender",L),this.renderer.off("beforeRender",x))}}},this.$resetCursorStyle=function(){var o=this.$cursorStyle||"ace",f=this.renderer.$cursorLayer;f&&(f.setSmoothBlinking(/smooth/.test(o)),f.isBlinking=!this.$readOnly&&o!="wide",A.setCssClass(f.element,"ace_slim-cursors",/slim/.test(o)))},this.prompt=function(o,f,s){var v=this;b.loadModule("./ext/prompt",function($){$.prompt(v,o,f,s)})}}.call(g.prototype),b.defineOptions(g.prototype,"editor",{selectionStyle:{set:function(o){this.onSelectionChange(),this._signal("changeSelectionStyle",{data:o})},initialValue:"line"},highlightActiveLine:{set:function(){this.$updateHighlightActiveLine()},initialValue:!0},highlightSelectedWord:{set:function(o){this.$onSelectionChange()},initialValue:!0},readOnly:{set:function(o){this.textInput.setReadOnly(o),this.$resetCursorStyle()},initialValue:!1},copyWithEmptySelection:{set:function(o){this.textInput.setCopyWithEmptySelection(o)},initialValue:!1},cursorStyle:{set:function(o){this.$resetCursorStyle()},values:["ace","slim","smooth","wide"],initialValue:"ace"},mergeUndoDeltas:{values:[!1,!0,"always"],initialValue:!0},behavioursEnabled:{initialValue:!0},wrapBehavioursEnabled:{initialValue:!0},enableAutoIndent:{initialValue:!0},autoScrollEditorIntoView:{set:function(o){this.setAutoScrollEditorIntoView(o)}},keyboardHandler:{set:function(o){this.setKeyboardHandler(o)},get:function(){return this.$keybindingId},handlesSet:!0},value:{set:function(o){this.session.setValue(o)},get:function(){return this.getValue()},handlesSet:!0,hidden:!0},session:{set:function(o){this.setSession(o)},get:function(){return this.session},handlesSet:!0,hidden:!0},showLineNumbers:{set:function(o){this.renderer.$gutterLayer.setShowLineNumbers(o),this.renderer.$loop.schedule(this.renderer.CHANGE_GUTTER),o&&this.$relativeLineNumbers?u.attach(this):u.detach(this)},initialValue:!0},relativeLineNumbers:{set:function(o){this.$showLineNumbers&&o?u.attach(this):u.detach(this)}},placeholder:{set:function(o){this.$updatePlaceholder||(this.$updatePlaceholder=function(){var f=this.session&&(this.renderer.$composition||this.getValue());if(f&&this.renderer.placeholderNode)this.renderer.off("afterRender",this.$updatePlaceholder),A.removeCssClass(this.container,"ace_hasPlaceholder"),this.renderer.placeholderNode.remove(),this.renderer.placeholderNode=null;else if(!f&&!this.renderer.placeholderNode){this.renderer.on("afterRender",this.$updatePlaceholder),A.addCssClass(this.container,"ace_hasPlaceholder");var s=A.createElement("div");s.className="ace_placeholder",s.textContent=this.$placeholder||"",this.renderer.placeholderNode=s,this.renderer.content.appendChild(this.renderer.placeholderNode)}else!f&&this.renderer.placeholderNode&&(this.renderer.placeholderNode.textContent=this.$placeholder||"")}.bind(this),this.on("input",this.$updatePlaceholder)),this.$updatePlaceholder()}},hScrollBarAlwaysVisible:"renderer",vScrollBarAlwaysVisible:"renderer",highlightGutterLine:"renderer",animatedScroll:"renderer",showInvisibles:"renderer",showPrintMargin:"renderer",printMarginColumn:"renderer",printMargin:"renderer",fadeFoldWidgets:"renderer",showFoldWidgets:"renderer",displayIndentGuides:"renderer",showGutter:"renderer",fontSize:"renderer",fontFamily:"renderer",maxLines:"renderer",minLines:"renderer",scrollPastEnd:"renderer",fixedWidthGutter:"renderer",theme:"renderer",hasCssTransforms:"renderer",maxPixelHeight:"renderer",useTextareaForIME:"renderer",scrollSpeed:"$mouseHandler",dragDelay:"$mouseHandler",dragEnabled:"$mouseHandler",focusTimeout:"$mouseHandler",tooltipFollowsMouse:"$mouseHandler",firstLineNumber:"session",overwrite:"session",newLineMode:"session",useWorker:"session",useSoftTabs:"session",navigateWithinSoftTabs:"session",tabSize:"session",wrap:"session",indentedSoftWrap:"session",foldStyle:"session",mode:"session"});var u={getText:function(o,f){return(Math.abs(o.selection.lead.row-f)||f+1+(f<9?"\xB7":""))+""},getWidth:function(o,f,s){return Math.max(f.toString().length,(s.lastRow+1).toString().length,2)*s.characterWidth},update:function(o,f){f.renderer.$loop.schedule(f.renderer.CHANGE_GUTTER)},attach:function(o){o.renderer.$gutterLayer.$renderer=this,o.on("changeSelection",this.update),this.update(null,o)},detach:function(o){o.renderer.$gutterLayer.$renderer==this&&(o.renderer.$gutterLayer.$renderer=null),o.off("changeSelection",this.update),this.update(null,o)}};y.Editor=g}),define("ace/undomanager",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=function(){this.$maxRev=0,this.$fromUndo=!1,this.reset()};(function(){this.addSession=function(u){this.$session=u},this.add=function(u,o,f){this.$fromUndo||u!=this.$lastDelta&&(this.$keepRedoStack||(this.$redoStack.length=0),(o===!1||!this.lastDeltas)&&(this.lastDeltas=[],this.$undoStack.push(this.lastDeltas),u.id=this.$rev=++this.$maxRev),(u.action=="remove"||u.action=="insert")&&(this.$lastDelta=u),this.lastDeltas.push(u))},this.addSelection=function(u,o){this.selections.push({value:u,rev:o||this.$rev})},this.startNewGroup=function(){return this.lastDeltas=null,this.$rev},this.markIgnored=function(u,o){o==null&&(o=this.$rev+1);for(var f=this.$undoStack,s=f.length;s--;){var v=f[s][0];if(v.id<=u)break;v.id<o&&(v.ignore=!0)}this.lastDeltas=null},this.getSelection=function(u,o){for(var f=this.selections,s=f.length;s--;){var v=f[s];if(v.rev<u)return o&&(v=f[s+1]),v}},this.getRevision=function(){return this.$rev},this.getDeltas=function(u,o){o==null&&(o=this.$rev+1);for(var f=this.$undoStack,s=null,v=0,$=f.length;$--;){var C=f[$][0];if(C.id<o&&!s&&(s=$+1),C.id<=u){v=$+1;break}}return f.slice(v,s)},this.getChangedRanges=function(u,o){o==null&&(o=this.$rev+1)},this.getChangedLines=function(u,o){o==null&&(o=this.$rev+1)},this.undo=function(u,o){this.lastDeltas=null;var f=this.$undoStack;if(A(f,f.length)){u||(u=this.$session),this.$redoStackBaseRev!==this.$rev&&this.$redoStack.length&&(this.$redoStack=[]),this.$fromUndo=!0;var s=f.pop(),v=null;return s&&(v=u.undoChanges(s,o),this.$redoStack.push(s),this.$syncRev()),this.$fromUndo=!1,v}},this.redo=function(u,o){if(this.lastDeltas=null,u||(u=this.$session),this.$fromUndo=!0,this.$redoStackBaseRev!=this.$rev){var f=this.getDeltas(this.$redoStackBaseRev,this.$rev+1);g(this.$redoStack,f),this.$redoStackBaseRev=this.$rev,this.$redoStack.forEach(function($){$[0].id=++this.$maxRev},this)}var s=this.$redoStack.pop(),v=null;return s&&(v=u.redoChanges(s,o),this.$undoStack.push(s),this.$syncRev()),this.$fromUndo=!1,v},this.$syncRev=function(){var u=this.$undoStack,o=u[u.length-1],f=o&&o[0].id||0;this.$redoStackBaseRev=f,this.$rev=f},this.reset=function(){this.lastDeltas=null,this.$lastDelta=null,this.$undoStack=[],this.$redoStack=[],this.$rev=0,this.mark=0,this.$redoStackBaseRev=this.$rev,this.selections=[]},this.canUndo=function(){return this.$undoStack.length>0},this.canRedo=function(){return this.$redoStack.length>0},this.bookmark=function(u){u==null&&(u=this.$rev),this.mark=u},this.isAtBookmark=function(){return this.$rev===this.mark},this.toJSON=function(){},this.fromJSON=function(){},this.hasUndo=this.canUndo,this.hasRedo=this.canRedo,this.isClean=this.isAtBookmark,this.markClean=this.bookmark,this.$prettyPrint=function(u){return u?r(u):r(this.$undoStack)+`
 ---
-`+r(this.$redoStack)}}).call(E.prototype);function A(d,a){for(var f=a;f--;){var s=d[f];if(s&&!s[0].ignore){for(;f<a-1;){var v=m(d[f],d[f+1]);d[f]=v[0],d[f+1]=v[1],f++}return!0}}}var p=L("./range").Range,h=p.comparePoints,u=p.comparePoints;function t(d){var a=d.action=="insert",f=d.start,s=d.end,v=(s.row-f.row)*(a?1:-1),$=(s.column-f.column)*(a?1:-1);a&&(s=f);for(var x in this.marks){var C=this.marks[x],k=u(C,f);if(!(k<0)){if(k===0&&a)if(C.bias==1)k=1;else{C.bias==-1;continue}var R=a?k:u(C,s);if(R>0){C.row+=v,C.column+=C.row==s.row?$:0;continue}!a&&R<=0&&(C.row=f.row,C.column=f.column,R===0&&(C.bias=1))}}}function e(d){return{row:d.row,column:d.column}}function i(d){return{start:e(d.start),end:e(d.end),action:d.action,lines:d.lines.slice()}}function r(d){if(d=d||this,Array.isArray(d))return d.map(r).join(`
-`);var a="";return d.action?(a=d.action=="insert"?"+":"-",a+="["+d.lines+"]"):d.value&&(Array.isArray(d.value)?a=d.value.map(n).join(`
-`):a=n(d.value)),d.start&&(a+=n(d)),(d.id||d.rev)&&(a+="	("+(d.id||d.rev)+")"),a}function n(d){return d.start.row+":"+d.start.column+"=>"+d.end.row+":"+d.end.column}function o(d,a){var f=d.action=="insert",s=a.action=="insert";if(f&&s)if(h(a.start,d.end)>=0)S(a,d,-1);else if(h(a.start,d.start)<=0)S(d,a,1);else return null;else if(f&&!s)if(h(a.start,d.end)>=0)S(a,d,-1);else if(h(a.end,d.start)<=0)S(d,a,-1);else return null;else if(!f&&s)if(h(a.start,d.start)>=0)S(a,d,1);else if(h(a.start,d.start)<=0)S(d,a,1);else return null;else if(!f&&!s)if(h(a.start,d.start)>=0)S(a,d,1);else if(h(a.end,d.start)<=0)S(d,a,-1);else return null;return[a,d]}function m(d,a){for(var f=d.length;f--;)for(var s=0;s<a.length;s++)if(!o(d[f],a[s])){for(;f<d.length;){for(;s--;)o(a[s],d[f]);s=a.length,f++}return[d,a]}return d.selectionBefore=a.selectionBefore=d.selectionAfter=a.selectionAfter=null,[a,d]}function w(d,a){var f=d.action=="insert",s=a.action=="insert";if(f&&s)h(d.start,a.start)<0?S(a,d,1):S(d,a,1);else if(f&&!s)h(d.start,a.end)>=0?S(d,a,-1):(h(d.start,a.start)<=0||S(d,p.fromPoints(a.start,d.start),-1),S(a,d,1));else if(!f&&s)h(a.start,d.end)>=0?S(a,d,-1):(h(a.start,d.start)<=0||S(a,p.fromPoints(d.start,a.start),-1),S(d,a,1));else if(!f&&!s)if(h(a.start,d.end)>=0)S(a,d,-1);else if(h(a.end,d.start)<=0)S(d,a,-1);else{var v,$;return h(d.start,a.start)<0&&(v=d,d=c(d,a.start)),h(d.end,a.end)>0&&($=c(d,a.end)),y(a.end,d.start,d.end,-1),$&&!v&&(d.lines=$.lines,d.start=$.start,d.end=$.end,$=d),[a,v,$].filter(Boolean)}return[a,d]}function S(d,a,f){y(d.start,a.start,a.end,f),y(d.end,a.start,a.end,f)}function y(d,a,f,s){d.row==(s==1?a:f).row&&(d.column+=s*(f.column-a.column)),d.row+=s*(f.row-a.row)}function c(d,a){var f=d.lines,s=d.end;d.end=e(a);var v=d.end.row-d.start.row,$=f.splice(v,f.length),x=v?a.column:a.column-d.start.column;f.push($[0].substring(0,x)),$[0]=$[0].substr(x);var C={start:e(a),end:s,lines:$,action:d.action};return C}function l(d,a){a=i(a);for(var f=d.length;f--;){for(var s=d[f],v=0;v<s.length;v++){var $=s[v],x=w($,a);a=x[0],x.length!=2&&(x[2]?(s.splice(v+1,1,x[1],x[2]),v++):x[1]||(s.splice(v,1),v--))}s.length||d.splice(f,1)}return d}function g(d,a){for(var f=0;f<a.length;f++)for(var s=a[f],v=0;v<s.length;v++)l(d,s[v])}b.UndoManager=E}),define("ace/layer/lines",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";var E=L("../lib/dom"),A=function(p,h){this.element=p,this.canvasHeight=h||5e5,this.element.style.height=this.canvasHeight*2+"px",this.cells=[],this.cellCache=[],this.$offsetCoefficient=0};(function(){this.moveContainer=function(p){E.translate(this.element,0,-(p.firstRowScreen*p.lineHeight%this.canvasHeight)-p.offset*this.$offsetCoefficient)},this.pageChanged=function(p,h){return Math.floor(p.firstRowScreen*p.lineHeight/this.canvasHeight)!==Math.floor(h.firstRowScreen*h.lineHeight/this.canvasHeight)},this.computeLineTop=function(p,h,u){var t=h.firstRowScreen*h.lineHeight,e=Math.floor(t/this.canvasHeight),i=u.documentToScreenRow(p,0)*h.lineHeight;return i-e*this.canvasHeight},this.computeLineHeight=function(p,h,u){return h.lineHeight*u.getRowLineCount(p)},this.getLength=function(){return this.cells.length},this.get=function(p){return this.cells[p]},this.shift=function(){this.$cacheCell(this.cells.shift())},this.pop=function(){this.$cacheCell(this.cells.pop())},this.push=function(p){if(Array.isArray(p)){this.cells.push.apply(this.cells,p);for(var h=E.createFragment(this.element),u=0;u<p.length;u++)h.appendChild(p[u].element);this.element.appendChild(h)}else this.cells.push(p),this.element.appendChild(p.element)},this.unshift=function(p){if(Array.isArray(p)){this.cells.unshift.apply(this.cells,p);for(var h=E.createFragment(this.element),u=0;u<p.length;u++)h.appendChild(p[u].element);this.element.firstChild?this.element.insertBefore(h,this.element.firstChild):this.element.appendChild(h)}else this.cells.unshift(p),this.element.insertAdjacentElement("afterbegin",p.element)},this.last=function(){return this.cells.length?this.cells[this.cells.length-1]:null},this.$cacheCell=function(p){p&&(p.element.remove(),this.cellCache.push(p))},this.createCell=function(p,h,u,t){var e=this.cellCache.pop();if(!e){var i=E.createElement("div");t&&t(i),this.element.appendChild(i),e={element:i,text:"",row:p}}return e.row=p,e}}).call(A.prototype),b.Lines=A}),define("ace/layer/gutter",["require","exports","module","ace/lib/dom","ace/lib/oop","ace/lib/lang","ace/lib/event_emitter","ace/layer/lines"],function(L,b,B){"use strict";var E=L("../lib/dom"),A=L("../lib/oop"),p=L("../lib/lang"),h=L("../lib/event_emitter").EventEmitter,u=L("./lines").Lines,t=function(i){this.element=E.createElement("div"),this.element.className="ace_layer ace_gutter-layer",i.appendChild(this.element),this.setShowFoldWidgets(this.$showFoldWidgets),this.gutterWidth=0,this.$annotations=[],this.$updateAnnotations=this.$updateAnnotations.bind(this),this.$lines=new u(this.element),this.$lines.$offsetCoefficient=1};(function(){A.implement(this,h),this.setSession=function(i){this.session&&this.session.off("change",this.$updateAnnotations),this.session=i,i&&i.on("change",this.$updateAnnotations)},this.addGutterDecoration=function(i,r){window.console&&console.warn&&console.warn("deprecated use session.addGutterDecoration"),this.session.addGutterDecoration(i,r)},this.removeGutterDecoration=function(i,r){window.console&&console.warn&&console.warn("deprecated use session.removeGutterDecoration"),this.session.removeGutterDecoration(i,r)},this.setAnnotations=function(i){this.$annotations=[];for(var r=0;r<i.length;r++){var n=i[r],o=n.row,m=this.$annotations[o];m||(m=this.$annotations[o]={text:[]});var w=n.text;w=w?p.escapeHTML(w):n.html||"",m.text.indexOf(w)===-1&&m.text.push(w);var S=n.type;S=="error"?m.className=" ace_error":S=="warning"&&m.className!=" ace_error"?m.className=" ace_warning":S=="info"&&!m.className&&(m.className=" ace_info")}},this.$updateAnnotations=function(i){if(this.$annotations.length){var r=i.start.row,n=i.end.row-r;if(n!==0)if(i.action=="remove")this.$annotations.splice(r,n+1,null);else{var o=new Array(n+1);o.unshift(r,1),this.$annotations.splice.apply(this.$annotations,o)}}},this.update=function(i){this.config=i;var r=this.session,n=i.firstRow,o=Math.min(i.lastRow+i.gutterOffset,r.getLength()-1);this.oldLastRow=o,this.config=i,this.$lines.moveContainer(i),this.$updateCursorRow();for(var m=r.getNextFoldLine(n),w=m?m.start.row:1/0,S=null,y=-1,c=n;;){if(c>w&&(c=m.end.row+1,m=r.getNextFoldLine(c,m),w=m?m.start.row:1/0),c>o){for(;this.$lines.getLength()>y+1;)this.$lines.pop();break}S=this.$lines.get(++y),S?S.row=c:(S=this.$lines.createCell(c,i,this.session,e),this.$lines.push(S)),this.$renderCell(S,i,m,c),c++}this._signal("afterRender"),this.$updateGutterWidth(i)},this.$updateGutterWidth=function(i){var r=this.session,n=r.gutterRenderer||this.$renderer,o=r.$firstLineNumber,m=this.$lines.last()?this.$lines.last().text:"";(this.$fixedWidth||r.$useWrapMode)&&(m=r.getLength()+o-1);var w=n?n.getWidth(r,m,i):m.toString().length*i.characterWidth,S=this.$padding||this.$computePadding();w+=S.left+S.right,w!==this.gutterWidth&&!isNaN(w)&&(this.gutterWidth=w,this.element.parentNode.style.width=this.element.style.width=Math.ceil(this.gutterWidth)+"px",this._signal("changeGutterWidth",w))},this.$updateCursorRow=function(){if(this.$highlightGutterLine){var i=this.session.selection.getCursor();this.$cursorRow!==i.row&&(this.$cursorRow=i.row)}},this.updateLineHighlight=function(){if(this.$highlightGutterLine){var i=this.session.selection.cursor.row;if(this.$cursorRow=i,!(this.$cursorCell&&this.$cursorCell.row==i)){this.$cursorCell&&(this.$cursorCell.element.className=this.$cursorCell.element.className.replace("ace_gutter-active-line ",""));var r=this.$lines.cells;this.$cursorCell=null;for(var n=0;n<r.length;n++){var o=r[n];if(o.row>=this.$cursorRow){if(o.row>this.$cursorRow){var m=this.session.getFoldLine(this.$cursorRow);if(n>0&&m&&m.start.row==r[n-1].row)o=r[n-1];else break}o.element.className="ace_gutter-active-line "+o.element.className,this.$cursorCell=o;break}}}}},this.scrollLines=function(i){var r=this.config;if(this.config=i,this.$updateCursorRow(),this.$lines.pageChanged(r,i))return this.update(i);this.$lines.moveContainer(i);var n=Math.min(i.lastRow+i.gutterOffset,this.session.getLength()-1),o=this.oldLastRow;if(this.oldLastRow=n,!r||o<i.firstRow)return this.update(i);if(n<r.firstRow)return this.update(i);if(r.firstRow<i.firstRow)for(var m=this.session.getFoldedRowCount(r.firstRow,i.firstRow-1);m>0;m--)this.$lines.shift();if(o>n)for(var m=this.session.getFoldedRowCount(n+1,o);m>0;m--)this.$lines.pop();i.firstRow<r.firstRow&&this.$lines.unshift(this.$renderLines(i,i.firstRow,r.firstRow-1)),n>o&&this.$lines.push(this.$renderLines(i,o+1,n)),this.updateLineHighlight(),this._signal("afterRender"),this.$updateGutterWidth(i)},this.$renderLines=function(i,r,n){for(var o=[],m=r,w=this.session.getNextFoldLine(m),S=w?w.start.row:1/0;m>S&&(m=w.end.row+1,w=this.session.getNextFoldLine(m,w),S=w?w.start.row:1/0),!(m>n);){var y=this.$lines.createCell(m,i,this.session,e);this.$renderCell(y,i,w,m),o.push(y),m++}return o},this.$renderCell=function(i,r,n,o){var m=i.element,w=this.session,S=m.childNodes[0],y=m.childNodes[1],c=w.$firstLineNumber,l=w.$breakpoints,g=w.$decorations,d=w.gutterRenderer||this.$renderer,a=this.$showFoldWidgets&&w.foldWidgets,f=n?n.start.row:Number.MAX_VALUE,s="ace_gutter-cell ";if(this.$highlightGutterLine&&(o==this.$cursorRow||n&&o<this.$cursorRow&&o>=f&&this.$cursorRow<=n.end.row)&&(s+="ace_gutter-active-line ",this.$cursorCell!=i&&(this.$cursorCell&&(this.$cursorCell.element.className=this.$cursorCell.element.className.replace("ace_gutter-active-line ","")),this.$cursorCell=i)),l[o]&&(s+=l[o]),g[o]&&(s+=g[o]),this.$annotations[o]&&(s+=this.$annotations[o].className),m.className!=s&&(m.className=s),a){var v=a[o];v==null&&(v=a[o]=w.getFoldWidget(o))}if(v){var s="ace_fold-widget ace_"+v;v=="start"&&o==f&&o<n.end.row?s+=" ace_closed":s+=" ace_open",y.className!=s&&(y.className=s);var $=r.lineHeight+"px";E.setStyle(y.style,"height",$),E.setStyle(y.style,"display","inline-block")}else y&&E.setStyle(y.style,"display","none");var x=(d?d.getText(w,o):o+c).toString();return x!==S.data&&(S.data=x),E.setStyle(i.element.style,"height",this.$lines.computeLineHeight(o,r,w)+"px"),E.setStyle(i.element.style,"top",this.$lines.computeLineTop(o,r,w)+"px"),i.text=x,i},this.$fixedWidth=!1,this.$highlightGutterLine=!0,this.$renderer="",this.setHighlightGutterLine=function(i){this.$highlightGutterLine=i},this.$showLineNumbers=!0,this.$renderer="",this.setShowLineNumbers=function(i){this.$renderer=!i&&{getWidth:function(){return 0},getText:function(){return""}}},this.getShowLineNumbers=function(){return this.$showLineNumbers},this.$showFoldWidgets=!0,this.setShowFoldWidgets=function(i){i?E.addCssClass(this.element,"ace_folding-enabled"):E.removeCssClass(this.element,"ace_folding-enabled"),this.$showFoldWidgets=i,this.$padding=null},this.getShowFoldWidgets=function(){return this.$showFoldWidgets},this.$computePadding=function(){if(!this.element.firstChild)return{left:0,right:0};var i=E.computedStyle(this.element.firstChild);return this.$padding={},this.$padding.left=(parseInt(i.borderLeftWidth)||0)+(parseInt(i.paddingLeft)||0)+1,this.$padding.right=(parseInt(i.borderRightWidth)||0)+(parseInt(i.paddingRight)||0),this.$padding},this.getRegion=function(i){var r=this.$padding||this.$computePadding(),n=this.element.getBoundingClientRect();if(i.x<r.left+n.left)return"markers";if(this.$showFoldWidgets&&i.x>n.right-r.right)return"foldWidgets"}}).call(t.prototype);function e(i){var r=document.createTextNode("");i.appendChild(r);var n=E.createElement("span");return i.appendChild(n),i}b.Gutter=t}),define("ace/layer/marker",["require","exports","module","ace/range","ace/lib/dom"],function(L,b,B){"use strict";var E=L("../range").Range,A=L("../lib/dom"),p=function(h){this.element=A.createElement("div"),this.element.className="ace_layer ace_marker-layer",h.appendChild(this.element)};(function(){this.$padding=0,this.setPadding=function(u){this.$padding=u},this.setSession=function(u){this.session=u},this.setMarkers=function(u){this.markers=u},this.elt=function(u,t){var e=this.i!=-1&&this.element.childNodes[this.i];e?this.i++:(e=document.createElement("div"),this.element.appendChild(e),this.i=-1),e.style.cssText=t,e.className=u},this.update=function(u){if(u){this.config=u,this.i=0;var t;for(var e in this.markers){var i=this.markers[e];if(!i.range){i.update(t,this,this.session,u);continue}var r=i.range.clipRows(u.firstRow,u.lastRow);if(!r.isEmpty())if(r=r.toScreenRange(this.session),i.renderer){var n=this.$getTop(r.start.row,u),o=this.$padding+r.start.column*u.characterWidth;i.renderer(t,r,o,n,u)}else i.type=="fullLine"?this.drawFullLineMarker(t,r,i.clazz,u):i.type=="screenLine"?this.drawScreenLineMarker(t,r,i.clazz,u):r.isMultiLine()?i.type=="text"?this.drawTextMarker(t,r,i.clazz,u):this.drawMultiLineMarker(t,r,i.clazz,u):this.drawSingleLineMarker(t,r,i.clazz+" ace_start ace_br15",u)}if(this.i!=-1)for(;this.i<this.element.childElementCount;)this.element.removeChild(this.element.lastChild)}},this.$getTop=function(u,t){return(u-t.firstRowScreen)*t.lineHeight};function h(u,t,e,i){return(u?1:0)|(t?2:0)|(e?4:0)|(i?8:0)}this.drawTextMarker=function(u,t,e,i,r){for(var n=this.session,o=t.start.row,m=t.end.row,w=o,S=0,y=0,c=n.getScreenLastRowColumn(w),l=new E(w,t.start.column,w,y);w<=m;w++)l.start.row=l.end.row=w,l.start.column=w==o?t.start.column:n.getRowWrapIndent(w),l.end.column=c,S=y,y=c,c=w+1<m?n.getScreenLastRowColumn(w+1):w==m?0:t.end.column,this.drawSingleLineMarker(u,l,e+(w==o?" ace_start":"")+" ace_br"+h(w==o||w==o+1&&t.start.column,S<y,y>c,w==m),i,w==m?0:1,r)},this.drawMultiLineMarker=function(u,t,e,i,r){var n=this.$padding,o=i.lineHeight,m=this.$getTop(t.start.row,i),w=n+t.start.column*i.characterWidth;if(r=r||"",this.session.$bidiHandler.isBidiRow(t.start.row)){var S=t.clone();S.end.row=S.start.row,S.end.column=this.session.getLine(S.start.row).length,this.drawBidiSingleLineMarker(u,S,e+" ace_br1 ace_start",i,null,r)}else this.elt(e+" ace_br1 ace_start","height:"+o+"px;right:0;top:"+m+"px;left:"+w+"px;"+(r||""));if(this.session.$bidiHandler.isBidiRow(t.end.row)){var S=t.clone();S.start.row=S.end.row,S.start.column=0,this.drawBidiSingleLineMarker(u,S,e+" ace_br12",i,null,r)}else{m=this.$getTop(t.end.row,i);var y=t.end.column*i.characterWidth;this.elt(e+" ace_br12","height:"+o+"px;width:"+y+"px;top:"+m+"px;left:"+n+"px;"+(r||""))}if(o=(t.end.row-t.start.row-1)*i.lineHeight,!(o<=0)){m=this.$getTop(t.start.row+1,i);var c=(t.start.column?1:0)|(t.end.column?0:8);this.elt(e+(c?" ace_br"+c:""),"height:"+o+"px;right:0;top:"+m+"px;left:"+n+"px;"+(r||""))}},this.drawSingleLineMarker=function(u,t,e,i,r,n){if(this.session.$bidiHandler.isBidiRow(t.start.row))return this.drawBidiSingleLineMarker(u,t,e,i,r,n);var o=i.lineHeight,m=(t.end.column+(r||0)-t.start.column)*i.characterWidth,w=this.$getTop(t.start.row,i),S=this.$padding+t.start.column*i.characterWidth;this.elt(e,"height:"+o+"px;width:"+m+"px;top:"+w+"px;left:"+S+"px;"+(n||""))},this.drawBidiSingleLineMarker=function(u,t,e,i,r,n){var o=i.lineHeight,m=this.$getTop(t.start.row,i),w=this.$padding,S=this.session.$bidiHandler.getSelections(t.start.column,t.end.column);S.forEach(function(y){this.elt(e,"height:"+o+"px;width:"+y.width+(r||0)+"px;top:"+m+"px;left:"+(w+y.left)+"px;"+(n||""))},this)},this.drawFullLineMarker=function(u,t,e,i,r){var n=this.$getTop(t.start.row,i),o=i.lineHeight;t.start.row!=t.end.row&&(o+=this.$getTop(t.end.row,i)-n),this.elt(e,"height:"+o+"px;top:"+n+"px;left:0;right:0;"+(r||""))},this.drawScreenLineMarker=function(u,t,e,i,r){var n=this.$getTop(t.start.row,i),o=i.lineHeight;this.elt(e,"height:"+o+"px;top:"+n+"px;left:0;right:0;"+(r||""))}}).call(p.prototype),b.Marker=p}),define("ace/layer/text",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/layer/lines","ace/lib/event_emitter"],function(L,b,B){"use strict";var E=L("../lib/oop"),A=L("../lib/dom"),p=L("../lib/lang"),h=L("./lines").Lines,u=L("../lib/event_emitter").EventEmitter,t=function(e){this.dom=A,this.element=this.dom.createElement("div"),this.element.className="ace_layer ace_text-layer",e.appendChild(this.element),this.$updateEolChar=this.$updateEolChar.bind(this),this.$lines=new h(this.element)};(function(){E.implement(this,u),this.EOF_CHAR="\xB6",this.EOL_CHAR_LF="\xAC",this.EOL_CHAR_CRLF="\xA4",this.EOL_CHAR=this.EOL_CHAR_LF,this.TAB_CHAR="\u2014",this.SPACE_CHAR="\xB7",this.$padding=0,this.MAX_LINE_LENGTH=1e4,this.$updateEolChar=function(){var e=this.session.doc,i=e.getNewLineCharacter()==`
-`&&e.getNewLineMode()!="windows",r=i?this.EOL_CHAR_LF:this.EOL_CHAR_CRLF;if(this.EOL_CHAR!=r)return this.EOL_CHAR=r,!0},this.setPadding=function(e){this.$padding=e,this.element.style.margin="0 "+e+"px"},this.getLineHeight=function(){return this.$fontMetrics.$characterSize.height||0},this.getCharacterWidth=function(){return this.$fontMetrics.$characterSize.width||0},this.$setFontMetrics=function(e){this.$fontMetrics=e,this.$fontMetrics.on("changeCharacterSize",function(i){this._signal("changeCharacterSize",i)}.bind(this)),this.$pollSizeChanges()},this.checkForSizeChanges=function(){this.$fontMetrics.checkForSizeChanges()},this.$pollSizeChanges=function(){return this.$pollSizeChangesTimer=this.$fontMetrics.$pollSizeChanges()},this.setSession=function(e){this.session=e,e&&this.$computeTabString()},this.showInvisibles=!1,this.showSpaces=!1,this.showTabs=!1,this.showEOL=!1,this.setShowInvisibles=function(e){return this.showInvisibles==e?!1:(this.showInvisibles=e,typeof e=="string"?(this.showSpaces=/tab/i.test(e),this.showTabs=/space/i.test(e),this.showEOL=/eol/i.test(e)):this.showSpaces=this.showTabs=this.showEOL=e,this.$computeTabString(),!0)},this.displayIndentGuides=!0,this.setDisplayIndentGuides=function(e){return this.displayIndentGuides==e?!1:(this.displayIndentGuides=e,this.$computeTabString(),!0)},this.$tabStrings=[],this.onChangeTabSize=this.$computeTabString=function(){var e=this.session.getTabSize();this.tabSize=e;for(var i=this.$tabStrings=[0],r=1;r<e+1;r++)if(this.showTabs){var n=this.dom.createElement("span");n.className="ace_invisible ace_invisible_tab",n.textContent=p.stringRepeat(this.TAB_CHAR,r),i.push(n)}else i.push(this.dom.createTextNode(p.stringRepeat(" ",r),this.element));if(this.displayIndentGuides){this.$indentGuideRe=/\s\S| \t|\t |\s$/;var o="ace_indent-guide",m=this.showSpaces?" ace_invisible ace_invisible_space":"",w=this.showSpaces?p.stringRepeat(this.SPACE_CHAR,this.tabSize):p.stringRepeat(" ",this.tabSize),S=this.showTabs?" ace_invisible ace_invisible_tab":"",y=this.showTabs?p.stringRepeat(this.TAB_CHAR,this.tabSize):w,n=this.dom.createElement("span");n.className=o+m,n.textContent=w,this.$tabStrings[" "]=n;var n=this.dom.createElement("span");n.className=o+S,n.textContent=y,this.$tabStrings["	"]=n}},this.updateLines=function(e,i,r){if(this.config.lastRow!=e.lastRow||this.config.firstRow!=e.firstRow)return this.update(e);this.config=e;for(var n=Math.max(i,e.firstRow),o=Math.min(r,e.lastRow),m=this.element.childNodes,w=0,y=e.firstRow;y<n;y++){var c=this.session.getFoldLine(y);if(c)if(c.containsRow(n)){n=c.start.row;break}else y=c.end.row;w++}for(var S=!1,y=n,c=this.session.getNextFoldLine(y),l=c?c.start.row:1/0;y>l&&(y=c.end.row+1,c=this.session.getNextFoldLine(y,c),l=c?c.start.row:1/0),!(y>o);){var g=m[w++];if(g){this.dom.removeChildren(g),this.$renderLine(g,y,y==l?c:!1),S&&(g.style.top=this.$lines.computeLineTop(y,e,this.session)+"px");var d=e.lineHeight*this.session.getRowLength(y)+"px";g.style.height!=d&&(S=!0,g.style.height=d)}y++}if(S)for(;w<this.$lines.cells.length;){var a=this.$lines.cells[w++];a.element.style.top=this.$lines.computeLineTop(a.row,e,this.session)+"px"}},this.scrollLines=function(e){var i=this.config;if(this.config=e,this.$lines.pageChanged(i,e))return this.update(e);this.$lines.moveContainer(e);var r=e.lastRow,n=i?i.lastRow:-1;if(!i||n<e.firstRow)return this.update(e);if(r<i.firstRow)return this.update(e);if(!i||i.lastRow<e.firstRow)return this.update(e);if(e.lastRow<i.firstRow)return this.update(e);if(i.firstRow<e.firstRow)for(var o=this.session.getFoldedRowCount(i.firstRow,e.firstRow-1);o>0;o--)this.$lines.shift();if(i.lastRow>e.lastRow)for(var o=this.session.getFoldedRowCount(e.lastRow+1,i.lastRow);o>0;o--)this.$lines.pop();e.firstRow<i.firstRow&&this.$lines.unshift(this.$renderLinesFragment(e,e.firstRow,i.firstRow-1)),e.lastRow>i.lastRow&&this.$lines.push(this.$renderLinesFragment(e,i.lastRow+1,e.lastRow))},this.$renderLinesFragment=function(e,i,r){for(var n=[],o=i,m=this.session.getNextFoldLine(o),w=m?m.start.row:1/0;o>w&&(o=m.end.row+1,m=this.session.getNextFoldLine(o,m),w=m?m.start.row:1/0),!(o>r);){var S=this.$lines.createCell(o,e,this.session),y=S.element;this.dom.removeChildren(y),A.setStyle(y.style,"height",this.$lines.computeLineHeight(o,e,this.session)+"px"),A.setStyle(y.style,"top",this.$lines.computeLineTop(o,e,this.session)+"px"),this.$renderLine(y,o,o==w?m:!1),this.$useLineGroups()?y.className="ace_line_group":y.className="ace_line",n.push(S),o++}return n},this.update=function(e){this.$lines.moveContainer(e),this.config=e;for(var i=e.firstRow,r=e.lastRow,n=this.$lines;n.getLength();)n.pop();n.push(this.$renderLinesFragment(e,i,r))},this.$textToken={text:!0,rparen:!0,lparen:!0},this.$renderToken=function(e,i,r,n){for(var o=this,m=/(\t)|( +)|([\x00-\x1f\x80-\xa0\xad\u1680\u180E\u2000-\u200f\u2028\u2029\u202F\u205F\uFEFF\uFFF9-\uFFFC]+)|(\u3000)|([\u1100-\u115F\u11A3-\u11A7\u11FA-\u11FF\u2329-\u232A\u2E80-\u2E99\u2E9B-\u2EF3\u2F00-\u2FD5\u2FF0-\u2FFB\u3001-\u303E\u3041-\u3096\u3099-\u30FF\u3105-\u312D\u3131-\u318E\u3190-\u31BA\u31C0-\u31E3\u31F0-\u321E\u3220-\u3247\u3250-\u32FE\u3300-\u4DBF\u4E00-\uA48C\uA490-\uA4C6\uA960-\uA97C\uAC00-\uD7A3\uD7B0-\uD7C6\uD7CB-\uD7FB\uF900-\uFAFF\uFE10-\uFE19\uFE30-\uFE52\uFE54-\uFE66\uFE68-\uFE6B\uFF01-\uFF60\uFFE0-\uFFE6]|[\uD800-\uDBFF][\uDC00-\uDFFF])/g,w=this.dom.createFragment(this.element),S,y=0;S=m.exec(n);){var c=S[1],l=S[2],g=S[3],d=S[4],a=S[5];if(!(!o.showSpaces&&l)){var f=y!=S.index?n.slice(y,S.index):"";if(y=S.index+S[0].length,f&&w.appendChild(this.dom.createTextNode(f,this.element)),c){var s=o.session.getScreenTabSize(i+S.index);w.appendChild(o.$tabStrings[s].cloneNode(!0)),i+=s-1}else if(l)if(o.showSpaces){var v=this.dom.createElement("span");v.className="ace_invisible ace_invisible_space",v.textContent=p.stringRepeat(o.SPACE_CHAR,l.length),w.appendChild(v)}else w.appendChild(this.com.createTextNode(l,this.element));else if(g){var v=this.dom.createElement("span");v.className="ace_invisible ace_invisible_space ace_invalid",v.textContent=p.stringRepeat(o.SPACE_CHAR,g.length),w.appendChild(v)}else if(d){i+=1;var v=this.dom.createElement("span");v.style.width=o.config.characterWidth*2+"px",v.className=o.showSpaces?"ace_cjk ace_invisible ace_invisible_space":"ace_cjk",v.textContent=o.showSpaces?o.SPACE_CHAR:d,w.appendChild(v)}else if(a){i+=1;var v=this.dom.createElement("span");v.style.width=o.config.characterWidth*2+"px",v.className="ace_cjk",v.textContent=a,w.appendChild(v)}}}if(w.appendChild(this.dom.createTextNode(y?n.slice(y):n,this.element)),this.$textToken[r.type])e.appendChild(w);else{var $="ace_"+r.type.replace(/\./g," ace_"),v=this.dom.createElement("span");r.type=="fold"&&(v.style.width=r.value.length*this.config.characterWidth+"px"),v.className=$,v.appendChild(w),e.appendChild(v)}return i+n.length},this.renderIndentGuide=function(e,i,r){var n=i.search(this.$indentGuideRe);if(n<=0||n>=r)return i;if(i[0]==" "){n-=n%this.tabSize;for(var o=n/this.tabSize,m=0;m<o;m++)e.appendChild(this.$tabStrings[" "].cloneNode(!0));return i.substr(n)}else if(i[0]=="	"){for(var m=0;m<n;m++)e.appendChild(this.$tabStrings["	"].cloneNode(!0));return i.substr(n)}return i},this.$createLineElement=function(e){var i=this.dom.createElement("div");return i.className="ace_line",i.style.height=this.config.lineHeight+"px",i},this.$renderWrappedLine=function(e,i,r){var n=0,o=0,m=r[0],w=0,S=this.$createLineElement();e.appendChild(S);for(var y=0;y<i.length;y++){var c=i[y],l=c.value;if(y==0&&this.displayIndentGuides){if(n=l.length,l=this.renderIndentGuide(S,l,m),!l)continue;n-=l.length}if(n+l.length<m)w=this.$renderToken(S,w,c,l),n+=l.length;else{for(;n+l.length>=m;)w=this.$renderToken(S,w,c,l.substring(0,m-n)),l=l.substring(m-n),n=m,S=this.$createLineElement(),e.appendChild(S),S.appendChild(this.dom.createTextNode(p.stringRepeat("\xA0",r.indent),this.element)),o++,w=0,m=r[o]||Number.MAX_VALUE;l.length!=0&&(n+=l.length,w=this.$renderToken(S,w,c,l))}}r[r.length-1]>this.MAX_LINE_LENGTH&&this.$renderOverflowMessage(S,w,null,"",!0)},this.$renderSimpleLine=function(e,i){var r=0,n=i[0],o=n.value;this.displayIndentGuides&&(o=this.renderIndentGuide(e,o)),o&&(r=this.$renderToken(e,r,n,o));for(var m=1;m<i.length;m++){if(n=i[m],o=n.value,r+o.length>this.MAX_LINE_LENGTH)return this.$renderOverflowMessage(e,r,n,o);r=this.$renderToken(e,r,n,o)}},this.$renderOverflowMessage=function(e,i,r,n,o){r&&this.$renderToken(e,i,r,n.slice(0,this.MAX_LINE_LENGTH-i));var m=this.dom.createElement("span");m.className="ace_inline_button ace_keyword ace_toggle_wrap",m.textContent=o?"<hide>":"<click to see more...>",e.appendChild(m)},this.$renderLine=function(e,i,r){if(!r&&r!=!1&&(r=this.session.getFoldLine(i)),r)var n=this.$getFoldLineTokens(i,r);else var n=this.session.getTokens(i);var o=e;if(n.length){var m=this.session.getRowSplitData(i);if(m&&m.length){this.$renderWrappedLine(e,n,m);var o=e.lastChild}else{var o=e;this.$useLineGroups()&&(o=this.$createLineElement(),e.appendChild(o)),this.$renderSimpleLine(o,n)}}else this.$useLineGroups()&&(o=this.$createLineElement(),e.appendChild(o));if(this.showEOL&&o){r&&(i=r.end.row);var w=this.dom.createElement("span");w.className="ace_invisible ace_invisible_eol",w.textContent=i==this.session.getLength()-1?this.EOF_CHAR:this.EOL_CHAR,o.appendChild(w)}},this.$getFoldLineTokens=function(e,i){var r=this.session,n=[];function o(w,S,y){for(var c=0,l=0;l+w[c].value.length<S;)if(l+=w[c].value.length,c++,c==w.length)return;if(l!=S){var g=w[c].value.substring(S-l);g.length>y-S&&(g=g.substring(0,y-S)),n.push({type:w[c].type,value:g}),l=S+g.length,c+=1}for(;l<y&&c<w.length;){var g=w[c].value;g.length+l>y?n.push({type:w[c].type,value:g.substring(0,y-l)}):n.push(w[c]),l+=g.length,c+=1}}var m=r.getTokens(e);return i.walk(function(w,S,y,c,l){w!=null?n.push({type:"fold",value:w}):(l&&(m=r.getTokens(S)),m.length&&o(m,c,y))},i.end.row,this.session.getLine(i.end.row).length),n},this.$useLineGroups=function(){return this.session.getUseWrapMode()},this.destroy=function(){}}).call(t.prototype),b.Text=t}),define("ace/layer/cursor",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";var E=L("../lib/dom"),A=function(p){this.element=E.createElement("div"),this.element.className="ace_layer ace_cursor-layer",p.appendChild(this.element),this.isVisible=!1,this.isBlinking=!0,this.blinkInterval=1e3,this.smoothBlinking=!1,this.cursors=[],this.cursor=this.addCursor(),E.addCssClass(this.element,"ace_hidden-cursors"),this.$updateCursors=this.$updateOpacity.bind(this)};(function(){this.$updateOpacity=function(p){for(var h=this.cursors,u=h.length;u--;)E.setStyle(h[u].style,"opacity",p?"":"0")},this.$startCssAnimation=function(){for(var p=this.cursors,h=p.length;h--;)p[h].style.animationDuration=this.blinkInterval+"ms";setTimeout(function(){E.addCssClass(this.element,"ace_animate-blinking")}.bind(this))},this.$stopCssAnimation=function(){E.removeCssClass(this.element,"ace_animate-blinking")},this.$padding=0,this.setPadding=function(p){this.$padding=p},this.setSession=function(p){this.session=p},this.setBlinking=function(p){p!=this.isBlinking&&(this.isBlinking=p,this.restartTimer())},this.setBlinkInterval=function(p){p!=this.blinkInterval&&(this.blinkInterval=p,this.restartTimer())},this.setSmoothBlinking=function(p){p!=this.smoothBlinking&&(this.smoothBlinking=p,E.setCssClass(this.element,"ace_smooth-blinking",p),this.$updateCursors(!0),this.restartTimer())},this.addCursor=function(){var p=E.createElement("div");return p.className="ace_cursor",this.element.appendChild(p),this.cursors.push(p),p},this.removeCursor=function(){if(this.cursors.length>1){var p=this.cursors.pop();return p.parentNode.removeChild(p),p}},this.hideCursor=function(){this.isVisible=!1,E.addCssClass(this.element,"ace_hidden-cursors"),this.restartTimer()},this.showCursor=function(){this.isVisible=!0,E.removeCssClass(this.element,"ace_hidden-cursors"),this.restartTimer()},this.restartTimer=function(){var p=this.$updateCursors;if(clearInterval(this.intervalId),clearTimeout(this.timeoutId),this.$stopCssAnimation(),this.smoothBlinking&&E.removeCssClass(this.element,"ace_smooth-blinking"),p(!0),!this.isBlinking||!this.blinkInterval||!this.isVisible){this.$stopCssAnimation();return}if(this.smoothBlinking&&setTimeout(function(){E.addCssClass(this.element,"ace_smooth-blinking")}.bind(this)),E.HAS_CSS_ANIMATION)this.$startCssAnimation();else{var h=function(){this.timeoutId=setTimeout(function(){p(!1)},.6*this.blinkInterval)}.bind(this);this.intervalId=setInterval(function(){p(!0),h()},this.blinkInterval),h()}},this.getPixelPosition=function(p,h){if(!this.config||!this.session)return{left:0,top:0};p||(p=this.session.selection.getCursor());var u=this.session.documentToScreenPosition(p),t=this.$padding+(this.session.$bidiHandler.isBidiRow(u.row,p.row)?this.session.$bidiHandler.getPosLeft(u.column):u.column*this.config.characterWidth),e=(u.row-(h?this.config.firstRowScreen:0))*this.config.lineHeight;return{left:t,top:e}},this.isCursorInView=function(p,h){return p.top>=0&&p.top<h.maxHeight},this.update=function(p){this.config=p;var h=this.session.$selectionMarkers,u=0,t=0;(h===void 0||h.length===0)&&(h=[{cursor:null}]);for(var u=0,e=h.length;u<e;u++){var i=this.getPixelPosition(h[u].cursor,!0);if(!((i.top>p.height+p.offset||i.top<0)&&u>1)){var r=this.cursors[t++]||this.addCursor(),n=r.style;this.drawCursor?this.drawCursor(r,i,p,h[u],this.session):this.isCursorInView(i,p)?(E.setStyle(n,"display","block"),E.translate(r,i.left,i.top),E.setStyle(n,"width",Math.round(p.characterWidth)+"px"),E.setStyle(n,"height",p.lineHeight+"px")):E.setStyle(n,"display","none")}}for(;this.cursors.length>t;)this.removeCursor();var o=this.session.getOverwrite();this.$setOverwrite(o),this.$pixelPos=i,this.restartTimer()},this.drawCursor=null,this.$setOverwrite=function(p){p!=this.overwrite&&(this.overwrite=p,p?E.addCssClass(this.element,"ace_overwrite-cursors"):E.removeCssClass(this.element,"ace_overwrite-cursors"))},this.destroy=function(){clearInterval(this.intervalId),clearTimeout(this.timeoutId)}}).call(A.prototype),b.Cursor=A}),define("ace/scrollbar",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/event","ace/lib/event_emitter"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/dom"),p=L("./lib/event"),h=L("./lib/event_emitter").EventEmitter,u=32768,t=function(r){this.element=A.createElement("div"),this.element.className="ace_scrollbar ace_scrollbar"+this.classSuffix,this.inner=A.createElement("div"),this.inner.className="ace_scrollbar-inner",this.inner.textContent="\xA0",this.element.appendChild(this.inner),r.appendChild(this.element),this.setVisible(!1),this.skipEvent=!1,p.addListener(this.element,"scroll",this.onScroll.bind(this)),p.addListener(this.element,"mousedown",p.preventDefault)};(function(){E.implement(this,h),this.setVisible=function(r){this.element.style.display=r?"":"none",this.isVisible=r,this.coeff=1}}).call(t.prototype);var e=function(r,n){t.call(this,r),this.scrollTop=0,this.scrollHeight=0,n.$scrollbarWidth=this.width=A.scrollbarWidth(r.ownerDocument),this.inner.style.width=this.element.style.width=(this.width||15)+5+"px",this.$minWidth=0};E.inherits(e,t),function(){this.classSuffix="-v",this.onScroll=function(){if(!this.skipEvent){if(this.scrollTop=this.element.scrollTop,this.coeff!=1){var r=this.element.clientHeight/this.scrollHeight;this.scrollTop=this.scrollTop*(1-r)/(this.coeff-r)}this._emit("scroll",{data:this.scrollTop})}this.skipEvent=!1},this.getWidth=function(){return Math.max(this.isVisible?this.width:0,this.$minWidth||0)},this.setHeight=function(r){this.element.style.height=r+"px"},this.setInnerHeight=this.setScrollHeight=function(r){this.scrollHeight=r,r>u?(this.coeff=u/r,r=u):this.coeff!=1&&(this.coeff=1),this.inner.style.height=r+"px"},this.setScrollTop=function(r){this.scrollTop!=r&&(this.skipEvent=!0,this.scrollTop=r,this.element.scrollTop=r*this.coeff)}}.call(e.prototype);var i=function(r,n){t.call(this,r),this.scrollLeft=0,this.height=n.$scrollbarWidth,this.inner.style.height=this.element.style.height=(this.height||15)+5+"px"};E.inherits(i,t),function(){this.classSuffix="-h",this.onScroll=function(){this.skipEvent||(this.scrollLeft=this.element.scrollLeft,this._emit("scroll",{data:this.scrollLeft})),this.skipEvent=!1},this.getHeight=function(){return this.isVisible?this.height:0},this.setWidth=function(r){this.element.style.width=r+"px"},this.setInnerWidth=function(r){this.inner.style.width=r+"px"},this.setScrollWidth=function(r){this.inner.style.width=r+"px"},this.setScrollLeft=function(r){this.scrollLeft!=r&&(this.skipEvent=!0,this.scrollLeft=this.element.scrollLeft=r)}}.call(i.prototype),b.ScrollBar=e,b.ScrollBarV=e,b.ScrollBarH=i,b.VScrollBar=e,b.HScrollBar=i}),define("ace/renderloop",["require","exports","module","ace/lib/event"],function(L,b,B){"use strict";var E=L("./lib/event"),A=function(p,h){this.onRender=p,this.pending=!1,this.changes=0,this.$recursionLimit=2,this.window=h||window;var u=this;this._flush=function(t){u.pending=!1;var e=u.changes;if(e&&(E.blockIdle(100),u.changes=0,u.onRender(e)),u.changes){if(u.$recursionLimit--<0)return;u.schedule()}else u.$recursionLimit=2}};(function(){this.schedule=function(p){this.changes=this.changes|p,this.changes&&!this.pending&&(E.nextFrame(this._flush),this.pending=!0)},this.clear=function(p){var h=this.changes;return this.changes=0,h}}).call(A.prototype),b.RenderLoop=A}),define("ace/layer/font_metrics",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/lib/event","ace/lib/useragent","ace/lib/event_emitter"],function(L,b,B){var E=L("../lib/oop"),A=L("../lib/dom"),p=L("../lib/lang"),h=L("../lib/event"),u=L("../lib/useragent"),t=L("../lib/event_emitter").EventEmitter,e=256,i=typeof ResizeObserver=="function",r=200,n=b.FontMetrics=function(o){this.el=A.createElement("div"),this.$setMeasureNodeStyles(this.el.style,!0),this.$main=A.createElement("div"),this.$setMeasureNodeStyles(this.$main.style),this.$measureNode=A.createElement("div"),this.$setMeasureNodeStyles(this.$measureNode.style),this.el.appendChild(this.$main),this.el.appendChild(this.$measureNode),o.appendChild(this.el),this.$measureNode.textContent=p.stringRepeat("X",e),this.$characterSize={width:0,height:0},i?this.$addObserver():this.checkForSizeChanges()};(function(){E.implement(this,t),this.$characterSize={width:0,height:0},this.$setMeasureNodeStyles=function(o,m){o.width=o.height="auto",o.left=o.top="0px",o.visibility="hidden",o.position="absolute",o.whiteSpace="pre",u.isIE<8?o["font-family"]="inherit":o.font="inherit",o.overflow=m?"hidden":"visible"},this.checkForSizeChanges=function(o){if(o===void 0&&(o=this.$measureSizes()),o&&(this.$characterSize.width!==o.width||this.$characterSize.height!==o.height)){this.$measureNode.style.fontWeight="bold";var m=this.$measureSizes();this.$measureNode.style.fontWeight="",this.$characterSize=o,this.charSizes=Object.create(null),this.allowBoldFonts=m&&m.width===o.width&&m.height===o.height,this._emit("changeCharacterSize",{data:o})}},this.$addObserver=function(){var o=this;this.$observer=new window.ResizeObserver(function(m){o.checkForSizeChanges()}),this.$observer.observe(this.$measureNode)},this.$pollSizeChanges=function(){if(this.$pollSizeChangesTimer||this.$observer)return this.$pollSizeChangesTimer;var o=this;return this.$pollSizeChangesTimer=h.onIdle(function m(){o.checkForSizeChanges(),h.onIdle(m,500)},500)},this.setPolling=function(o){o?this.$pollSizeChanges():this.$pollSizeChangesTimer&&(clearInterval(this.$pollSizeChangesTimer),this.$pollSizeChangesTimer=0)},this.$measureSizes=function(o){var m={height:(o||this.$measureNode).clientHeight,width:(o||this.$measureNode).clientWidth/e};return m.width===0||m.height===0?null:m},this.$measureCharWidth=function(o){this.$main.textContent=p.stringRepeat(o,e);var m=this.$main.getBoundingClientRect();return m.width/e},this.getCharacterWidth=function(o){var m=this.charSizes[o];return m===void 0&&(m=this.charSizes[o]=this.$measureCharWidth(o)/this.$characterSize.width),m},this.destroy=function(){clearInterval(this.$pollSizeChangesTimer),this.$observer&&this.$observer.disconnect(),this.el&&this.el.parentNode&&this.el.parentNode.removeChild(this.el)},this.$getZoom=function o(m){return!m||!m.parentElement?1:(window.getComputedStyle(m).zoom||1)*o(m.parentElement)},this.$initTransformMeasureNodes=function(){var o=function(m,w){return["div",{style:"position: absolute;top:"+m+"px;left:"+w+"px;"}]};this.els=A.buildDom([o(0,0),o(r,0),o(0,r),o(r,r)],this.el)},this.transformCoordinates=function(o,m){if(o){var w=this.$getZoom(this.el);o=l(1/w,o)}function S(I,D,T){var P=I[1]*D[0]-I[0]*D[1];return[(-D[1]*T[0]+D[0]*T[1])/P,(+I[1]*T[0]-I[0]*T[1])/P]}function y(I,D){return[I[0]-D[0],I[1]-D[1]]}function c(I,D){return[I[0]+D[0],I[1]+D[1]]}function l(I,D){return[I*D[0],I*D[1]]}this.els||this.$initTransformMeasureNodes();function g(I){var D=I.getBoundingClientRect();return[D.left,D.top]}var d=g(this.els[0]),a=g(this.els[1]),f=g(this.els[2]),s=g(this.els[3]),v=S(y(s,a),y(s,f),y(c(a,f),c(s,d))),$=l(1+v[0],y(a,d)),x=l(1+v[1],y(f,d));if(m){var C=m,k=v[0]*C[0]/r+v[1]*C[1]/r+1,R=c(l(C[0],$),l(C[1],x));return c(l(1/k/r,R),d)}var _=y(o,d),O=S(y($,l(v[0],_)),y(x,l(v[1],_)),_);return l(r,O)}}).call(n.prototype)}),define("ace/virtual_renderer",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/config","ace/layer/gutter","ace/layer/marker","ace/layer/text","ace/layer/cursor","ace/scrollbar","ace/scrollbar","ace/renderloop","ace/layer/font_metrics","ace/lib/event_emitter","ace/lib/useragent"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/dom"),p=L("./config"),h=L("./layer/gutter").Gutter,u=L("./layer/marker").Marker,t=L("./layer/text").Text,e=L("./layer/cursor").Cursor,i=L("./scrollbar").HScrollBar,r=L("./scrollbar").VScrollBar,n=L("./renderloop").RenderLoop,o=L("./layer/font_metrics").FontMetrics,m=L("./lib/event_emitter").EventEmitter,w=`.ace_br1 {border-top-left-radius    : 3px;}.ace_br2 {border-top-right-radius   : 3px;}.ace_br3 {border-top-left-radius    : 3px; border-top-right-radius:    3px;}.ace_br4 {border-bottom-right-radius: 3px;}.ace_br5 {border-top-left-radius    : 3px; border-bottom-right-radius: 3px;}.ace_br6 {border-top-right-radius   : 3px; border-bottom-right-radius: 3px;}.ace_br7 {border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-right-radius: 3px;}.ace_br8 {border-bottom-left-radius : 3px;}.ace_br9 {border-top-left-radius    : 3px; border-bottom-left-radius:  3px;}.ace_br10{border-top-right-radius   : 3px; border-bottom-left-radius:  3px;}.ace_br11{border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-left-radius:  3px;}.ace_br12{border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br13{border-top-left-radius    : 3px; border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br14{border-top-right-radius   : 3px; border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br15{border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-right-radius: 3px; border-bottom-left-radius: 3px;}.ace_editor {position: relative;overflow: hidden;padding: 0;font: 12px/normal 'Monaco', 'Menlo', 'Ubuntu Mono', 'Consolas', 'source-code-pro', monospace;direction: ltr;text-align: left;-webkit-tap-highlight-color: rgba(0, 0, 0, 0);}.ace_scroller {position: absolute;overflow: hidden;top: 0;bottom: 0;background-color: inherit;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;cursor: text;}.ace_content {position: absolute;box-sizing: border-box;min-width: 100%;contain: style size layout;font-variant-ligatures: no-common-ligatures;}.ace_dragging .ace_scroller:before{position: absolute;top: 0;left: 0;right: 0;bottom: 0;content: '';background: rgba(250, 250, 250, 0.01);z-index: 1000;}.ace_dragging.ace_dark .ace_scroller:before{background: rgba(0, 0, 0, 0.01);}.ace_selecting, .ace_selecting * {cursor: text !important;}.ace_gutter {position: absolute;overflow : hidden;width: auto;top: 0;bottom: 0;left: 0;cursor: default;z-index: 4;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;contain: style size layout;}.ace_gutter-active-line {position: absolute;left: 0;right: 0;}.ace_scroller.ace_scroll-left {box-shadow: 17px 0 16px -16px rgba(0, 0, 0, 0.4) inset;}.ace_gutter-cell {position: absolute;top: 0;left: 0;right: 0;padding-left: 19px;padding-right: 6px;background-repeat: no-repeat;}.ace_gutter-cell.ace_error {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAABOFBMVEX/////////QRswFAb/Ui4wFAYwFAYwFAaWGAfDRymzOSH/PxswFAb/SiUwFAYwFAbUPRvjQiDllog5HhHdRybsTi3/Tyv9Tir+Syj/UC3////XurebMBIwFAb/RSHbPx/gUzfdwL3kzMivKBAwFAbbvbnhPx66NhowFAYwFAaZJg8wFAaxKBDZurf/RB6mMxb/SCMwFAYwFAbxQB3+RB4wFAb/Qhy4Oh+4QifbNRcwFAYwFAYwFAb/QRzdNhgwFAYwFAbav7v/Uy7oaE68MBK5LxLewr/r2NXewLswFAaxJw4wFAbkPRy2PyYwFAaxKhLm1tMwFAazPiQwFAaUGAb/QBrfOx3bvrv/VC/maE4wFAbRPBq6MRO8Qynew8Dp2tjfwb0wFAbx6eju5+by6uns4uH9/f36+vr/GkHjAAAAYnRSTlMAGt+64rnWu/bo8eAA4InH3+DwoN7j4eLi4xP99Nfg4+b+/u9B/eDs1MD1mO7+4PHg2MXa347g7vDizMLN4eG+Pv7i5evs/v79yu7S3/DV7/498Yv24eH+4ufQ3Ozu/v7+y13sRqwAAADLSURBVHjaZc/XDsFgGIBhtDrshlitmk2IrbHFqL2pvXf/+78DPokj7+Fz9qpU/9UXJIlhmPaTaQ6QPaz0mm+5gwkgovcV6GZzd5JtCQwgsxoHOvJO15kleRLAnMgHFIESUEPmawB9ngmelTtipwwfASilxOLyiV5UVUyVAfbG0cCPHig+GBkzAENHS0AstVF6bacZIOzgLmxsHbt2OecNgJC83JERmePUYq8ARGkJx6XtFsdddBQgZE2nPR6CICZhawjA4Fb/chv+399kfR+MMMDGOQAAAABJRU5ErkJggg==");background-repeat: no-repeat;background-position: 2px center;}.ace_gutter-cell.ace_warning {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAAAmVBMVEX///8AAAD///8AAAAAAABPSzb/5sAAAAB/blH/73z/ulkAAAAAAAD85pkAAAAAAAACAgP/vGz/rkDerGbGrV7/pkQICAf////e0IsAAAD/oED/qTvhrnUAAAD/yHD/njcAAADuv2r/nz//oTj/p064oGf/zHAAAAA9Nir/tFIAAAD/tlTiuWf/tkIAAACynXEAAAAAAAAtIRW7zBpBAAAAM3RSTlMAABR1m7RXO8Ln31Z36zT+neXe5OzooRDfn+TZ4p3h2hTf4t3k3ucyrN1K5+Xaks52Sfs9CXgrAAAAjklEQVR42o3PbQ+CIBQFYEwboPhSYgoYunIqqLn6/z8uYdH8Vmdnu9vz4WwXgN/xTPRD2+sgOcZjsge/whXZgUaYYvT8QnuJaUrjrHUQreGczuEafQCO/SJTufTbroWsPgsllVhq3wJEk2jUSzX3CUEDJC84707djRc5MTAQxoLgupWRwW6UB5fS++NV8AbOZgnsC7BpEAAAAABJRU5ErkJggg==");background-position: 2px center;}.ace_gutter-cell.ace_info {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAAAAAA6mKC9AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAAJ0Uk5TAAB2k804AAAAPklEQVQY02NgIB68QuO3tiLznjAwpKTgNyDbMegwisCHZUETUZV0ZqOquBpXj2rtnpSJT1AEnnRmL2OgGgAAIKkRQap2htgAAAAASUVORK5CYII=");background-position: 2px center;}.ace_dark .ace_gutter-cell.ace_info {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQBAMAAADt3eJSAAAAJFBMVEUAAAChoaGAgIAqKiq+vr6tra1ZWVmUlJSbm5s8PDxubm56enrdgzg3AAAAAXRSTlMAQObYZgAAAClJREFUeNpjYMAPdsMYHegyJZFQBlsUlMFVCWUYKkAZMxZAGdxlDMQBAG+TBP4B6RyJAAAAAElFTkSuQmCC");}.ace_scrollbar {contain: strict;position: absolute;right: 0;bottom: 0;z-index: 6;}.ace_scrollbar-inner {position: absolute;cursor: text;left: 0;top: 0;}.ace_scrollbar-v{overflow-x: hidden;overflow-y: scroll;top: 0;}.ace_scrollbar-h {overflow-x: scroll;overflow-y: hidden;left: 0;}.ace_print-margin {position: absolute;height: 100%;}.ace_text-input {position: absolute;z-index: 0;width: 0.5em;height: 1em;opacity: 0;background: transparent;-moz-appearance: none;appearance: none;border: none;resize: none;outline: none;overflow: hidden;font: inherit;padding: 0 1px;margin: 0 -1px;contain: strict;-ms-user-select: text;-moz-user-select: text;-webkit-user-select: text;user-select: text;white-space: pre!important;}.ace_text-input.ace_composition {background: transparent;color: inherit;z-index: 1000;opacity: 1;}.ace_composition_placeholder { color: transparent }.ace_composition_marker { border-bottom: 1px solid;position: absolute;border-radius: 0;margin-top: 1px;}[ace_nocontext=true] {transform: none!important;filter: none!important;clip-path: none!important;mask : none!important;contain: none!important;perspective: none!important;mix-blend-mode: initial!important;z-index: auto;}.ace_layer {z-index: 1;position: absolute;overflow: hidden;word-wrap: normal;white-space: pre;height: 100%;width: 100%;box-sizing: border-box;pointer-events: none;}.ace_gutter-layer {position: relative;width: auto;text-align: right;pointer-events: auto;height: 1000000px;contain: style size layout;}.ace_text-layer {font: inherit !important;position: absolute;height: 1000000px;width: 1000000px;contain: style size layout;}.ace_text-layer > .ace_line, .ace_text-layer > .ace_line_group {contain: style size layout;position: absolute;top: 0;left: 0;right: 0;}.ace_hidpi .ace_text-layer,.ace_hidpi .ace_gutter-layer,.ace_hidpi .ace_content,.ace_hidpi .ace_gutter {contain: strict;will-change: transform;}.ace_hidpi .ace_text-layer > .ace_line, .ace_hidpi .ace_text-layer > .ace_line_group {contain: strict;}.ace_cjk {display: inline-block;text-align: center;}.ace_cursor-layer {z-index: 4;}.ace_cursor {z-index: 4;position: absolute;box-sizing: border-box;border-left: 2px solid;transform: translatez(0);}.ace_multiselect .ace_cursor {border-left-width: 1px;}.ace_slim-cursors .ace_cursor {border-left-width: 1px;}.ace_overwrite-cursors .ace_cursor {border-left-width: 0;border-bottom: 1px solid;}.ace_hidden-cursors .ace_cursor {opacity: 0.2;}.ace_hasPlaceholder .ace_hidden-cursors .ace_cursor {opacity: 0;}.ace_smooth-blinking .ace_cursor {transition: opacity 0.18s;}.ace_animate-blinking .ace_cursor {animation-duration: 1000ms;animation-timing-function: step-end;animation-name: blink-ace-animate;animation-iteration-count: infinite;}.ace_animate-blinking.ace_smooth-blinking .ace_cursor {animation-duration: 1000ms;animation-timing-function: ease-in-out;animation-name: blink-ace-animate-smooth;}@keyframes blink-ace-animate {from, to { opacity: 1; }60% { opacity: 0; }}@keyframes blink-ace-animate-smooth {from, to { opacity: 1; }45% { opacity: 1; }60% { opacity: 0; }85% { opacity: 0; }}.ace_marker-layer .ace_step, .ace_marker-layer .ace_stack {position: absolute;z-index: 3;}.ace_marker-layer .ace_selection {position: absolute;z-index: 5;}.ace_marker-layer .ace_bracket {position: absolute;z-index: 6;}.ace_marker-layer .ace_error_bracket {position: absolute;border-bottom: 1px solid #DE5555;border-radius: 0;}.ace_marker-layer .ace_active-line {position: absolute;z-index: 2;}.ace_marker-layer .ace_selected-word {position: absolute;z-index: 4;box-sizing: border-box;}.ace_line .ace_fold {box-sizing: border-box;display: inline-block;height: 11px;margin-top: -2px;vertical-align: middle;background-image:url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABEAAAAJCAYAAADU6McMAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAJpJREFUeNpi/P//PwOlgAXGYGRklAVSokD8GmjwY1wasKljQpYACtpCFeADcHVQfQyMQAwzwAZI3wJKvCLkfKBaMSClBlR7BOQikCFGQEErIH0VqkabiGCAqwUadAzZJRxQr/0gwiXIal8zQQPnNVTgJ1TdawL0T5gBIP1MUJNhBv2HKoQHHjqNrA4WO4zY0glyNKLT2KIfIMAAQsdgGiXvgnYAAAAASUVORK5CYII="),url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAA3CAYAAADNNiA5AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAACJJREFUeNpi+P//fxgTAwPDBxDxD078RSX+YeEyDFMCIMAAI3INmXiwf2YAAAAASUVORK5CYII=");background-repeat: no-repeat, repeat-x;background-position: center center, top left;color: transparent;border: 1px solid black;border-radius: 2px;cursor: pointer;pointer-events: auto;}.ace_dark .ace_fold {}.ace_fold:hover{background-image:url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABEAAAAJCAYAAADU6McMAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAJpJREFUeNpi/P//PwOlgAXGYGRklAVSokD8GmjwY1wasKljQpYACtpCFeADcHVQfQyMQAwzwAZI3wJKvCLkfKBaMSClBlR7BOQikCFGQEErIH0VqkabiGCAqwUadAzZJRxQr/0gwiXIal8zQQPnNVTgJ1TdawL0T5gBIP1MUJNhBv2HKoQHHjqNrA4WO4zY0glyNKLT2KIfIMAAQsdgGiXvgnYAAAAASUVORK5CYII="),url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAA3CAYAAADNNiA5AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAACBJREFUeNpi+P//fz4TAwPDZxDxD5X4i5fLMEwJgAADAEPVDbjNw87ZAAAAAElFTkSuQmCC");}.ace_tooltip {background-color: #FFF;background-image: linear-gradient(to bottom, transparent, rgba(0, 0, 0, 0.1));border: 1px solid gray;border-radius: 1px;box-shadow: 0 1px 2px rgba(0, 0, 0, 0.3);color: black;max-width: 100%;padding: 3px 4px;position: fixed;z-index: 999999;box-sizing: border-box;cursor: default;white-space: pre;word-wrap: break-word;line-height: normal;font-style: normal;font-weight: normal;letter-spacing: normal;pointer-events: none;}.ace_folding-enabled > .ace_gutter-cell {padding-right: 13px;}.ace_fold-widget {box-sizing: border-box;margin: 0 -12px 0 1px;display: none;width: 11px;vertical-align: top;background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAANElEQVR42mWKsQ0AMAzC8ixLlrzQjzmBiEjp0A6WwBCSPgKAXoLkqSot7nN3yMwR7pZ32NzpKkVoDBUxKAAAAABJRU5ErkJggg==");background-repeat: no-repeat;background-position: center;border-radius: 3px;border: 1px solid transparent;cursor: pointer;}.ace_folding-enabled .ace_fold-widget {display: inline-block;   }.ace_fold-widget.ace_end {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAANElEQVR42m3HwQkAMAhD0YzsRchFKI7sAikeWkrxwScEB0nh5e7KTPWimZki4tYfVbX+MNl4pyZXejUO1QAAAABJRU5ErkJggg==");}.ace_fold-widget.ace_closed {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAMAAAAGCAYAAAAG5SQMAAAAOUlEQVR42jXKwQkAMAgDwKwqKD4EwQ26sSOkVWjgIIHAzPiCgaqiqnJHZnKICBERHN194O5b9vbLuAVRL+l0YWnZAAAAAElFTkSuQmCCXA==");}.ace_fold-widget:hover {border: 1px solid rgba(0, 0, 0, 0.3);background-color: rgba(255, 255, 255, 0.2);box-shadow: 0 1px 1px rgba(255, 255, 255, 0.7);}.ace_fold-widget:active {border: 1px solid rgba(0, 0, 0, 0.4);background-color: rgba(0, 0, 0, 0.05);box-shadow: 0 1px 1px rgba(255, 255, 255, 0.8);}.ace_dark .ace_fold-widget {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAAHklEQVQIW2P4//8/AzoGEQ7oGCaLLAhWiSwB146BAQCSTPYocqT0AAAAAElFTkSuQmCC");}.ace_dark .ace_fold-widget.ace_end {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAAH0lEQVQIW2P4//8/AxQ7wNjIAjDMgC4AxjCVKBirIAAF0kz2rlhxpAAAAABJRU5ErkJggg==");}.ace_dark .ace_fold-widget.ace_closed {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAMAAAAFCAYAAACAcVaiAAAAHElEQVQIW2P4//+/AxAzgDADlOOAznHAKgPWAwARji8UIDTfQQAAAABJRU5ErkJggg==");}.ace_dark .ace_fold-widget:hover {box-shadow: 0 1px 1px rgba(255, 255, 255, 0.2);background-color: rgba(255, 255, 255, 0.1);}.ace_dark .ace_fold-widget:active {box-shadow: 0 1px 1px rgba(255, 255, 255, 0.2);}.ace_inline_button {border: 1px solid lightgray;display: inline-block;margin: -1px 8px;padding: 0 5px;pointer-events: auto;cursor: pointer;}.ace_inline_button:hover {border-color: gray;background: rgba(200,200,200,0.2);display: inline-block;pointer-events: auto;}.ace_fold-widget.ace_invalid {background-color: #FFB4B4;border-color: #DE5555;}.ace_fade-fold-widgets .ace_fold-widget {transition: opacity 0.4s ease 0.05s;opacity: 0;}.ace_fade-fold-widgets:hover .ace_fold-widget {transition: opacity 0.05s ease 0.05s;opacity:1;}.ace_underline {text-decoration: underline;}.ace_bold {font-weight: bold;}.ace_nobold .ace_bold {font-weight: normal;}.ace_italic {font-style: italic;}.ace_error-marker {background-color: rgba(255, 0, 0,0.2);position: absolute;z-index: 9;}.ace_highlight-marker {background-color: rgba(255, 255, 0,0.2);position: absolute;z-index: 8;}.ace_mobile-menu {position: absolute;line-height: 1.5;border-radius: 4px;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;background: white;box-shadow: 1px 3px 2px grey;border: 1px solid #dcdcdc;color: black;}.ace_dark > .ace_mobile-menu {background: #333;color: #ccc;box-shadow: 1px 3px 2px grey;border: 1px solid #444;}.ace_mobile-button {padding: 2px;cursor: pointer;overflow: hidden;}.ace_mobile-button:hover {background-color: #eee;opacity:1;}.ace_mobile-button:active {background-color: #ddd;}.ace_placeholder {font-family: arial;transform: scale(0.9);transform-origin: left;white-space: pre;opacity: 0.7;margin: 0 10px;}`,S=L("./lib/useragent"),y=S.isIE;A.importCssString(w,"ace_editor.css");var c=function(l,g){var d=this;this.container=l||A.createElement("div"),A.addCssClass(this.container,"ace_editor"),A.HI_DPI&&A.addCssClass(this.container,"ace_hidpi"),this.setTheme(g),this.$gutter=A.createElement("div"),this.$gutter.className="ace_gutter",this.container.appendChild(this.$gutter),this.$gutter.setAttribute("aria-hidden",!0),this.scroller=A.createElement("div"),this.scroller.className="ace_scroller",this.container.appendChild(this.scroller),this.content=A.createElement("div"),this.content.className="ace_content",this.scroller.appendChild(this.content),this.$gutterLayer=new h(this.$gutter),this.$gutterLayer.on("changeGutterWidth",this.onGutterResize.bind(this)),this.$markerBack=new u(this.content);var a=this.$textLayer=new t(this.content);this.canvas=a.element,this.$markerFront=new u(this.content),this.$cursorLayer=new e(this.content),this.$horizScroll=!1,this.$vScroll=!1,this.scrollBar=this.scrollBarV=new r(this.container,this),this.scrollBarH=new i(this.container,this),this.scrollBarV.on("scroll",function(f){d.$scrollAnimation||d.session.setScrollTop(f.data-d.scrollMargin.top)}),this.scrollBarH.on("scroll",function(f){d.$scrollAnimation||d.session.setScrollLeft(f.data-d.scrollMargin.left)}),this.scrollTop=0,this.scrollLeft=0,this.cursorPos={row:0,column:0},this.$fontMetrics=new o(this.container),this.$textLayer.$setFontMetrics(this.$fontMetrics),this.$textLayer.on("changeCharacterSize",function(f){d.updateCharacterSize(),d.onResize(!0,d.gutterWidth,d.$size.width,d.$size.height),d._signal("changeCharacterSize",f)}),this.$size={width:0,height:0,scrollerHeight:0,scrollerWidth:0,$dirty:!0},this.layerConfig={width:1,padding:0,firstRow:0,firstRowScreen:0,lastRow:0,lineHeight:0,characterWidth:0,minHeight:1,maxHeight:1,offset:0,height:1,gutterOffset:1},this.scrollMargin={left:0,right:0,top:0,bottom:0,v:0,h:0},this.margin={left:0,right:0,top:0,bottom:0,v:0,h:0},this.$keepTextAreaAtCursor=!S.isIOS,this.$loop=new n(this.$renderChanges.bind(this),this.container.ownerDocument.defaultView),this.$loop.schedule(this.CHANGE_FULL),this.updateCharacterSize(),this.setPadding(4),p.resetOptions(this),p._signal("renderer",this)};(function(){this.CHANGE_CURSOR=1,this.CHANGE_MARKER=2,this.CHANGE_GUTTER=4,this.CHANGE_SCROLL=8,this.CHANGE_LINES=16,this.CHANGE_TEXT=32,this.CHANGE_SIZE=64,this.CHANGE_MARKER_BACK=128,this.CHANGE_MARKER_FRONT=256,this.CHANGE_FULL=512,this.CHANGE_H_SCROLL=1024,E.implement(this,m),this.updateCharacterSize=function(){this.$textLayer.allowBoldFonts!=this.$allowBoldFonts&&(this.$allowBoldFonts=this.$textLayer.allowBoldFonts,this.setStyle("ace_nobold",!this.$allowBoldFonts)),this.layerConfig.characterWidth=this.characterWidth=this.$textLayer.getCharacterWidth(),this.layerConfig.lineHeight=this.lineHeight=this.$textLayer.getLineHeight(),this.$updatePrintMargin(),A.setStyle(this.scroller.style,"line-height",this.lineHeight+"px")},this.setSession=function(l){this.session&&this.session.doc.off("changeNewLineMode",this.onChangeNewLineMode),this.session=l,l&&this.scrollMargin.top&&l.getScrollTop()<=0&&l.setScrollTop(-this.scrollMargin.top),this.$cursorLayer.setSession(l),this.$markerBack.setSession(l),this.$markerFront.setSession(l),this.$gutterLayer.setSession(l),this.$textLayer.setSession(l),l&&(this.$loop.schedule(this.CHANGE_FULL),this.session.$setFontMetrics(this.$fontMetrics),this.scrollBarH.scrollLeft=this.scrollBarV.scrollTop=null,this.onChangeNewLineMode=this.onChangeNewLineMode.bind(this),this.onChangeNewLineMode(),this.session.doc.on("changeNewLineMode",this.onChangeNewLineMode))},this.updateLines=function(l,g,d){if(g===void 0&&(g=1/0),this.$changedLines?(this.$changedLines.firstRow>l&&(this.$changedLines.firstRow=l),this.$changedLines.lastRow<g&&(this.$changedLines.lastRow=g)):this.$changedLines={firstRow:l,lastRow:g},this.$changedLines.lastRow<this.layerConfig.firstRow)if(d)this.$changedLines.lastRow=this.layerConfig.lastRow;else return;this.$changedLines.firstRow>this.layerConfig.lastRow||this.$loop.schedule(this.CHANGE_LINES)},this.onChangeNewLineMode=function(){this.$loop.schedule(this.CHANGE_TEXT),this.$textLayer.$updateEolChar(),this.session.$bidiHandler.setEolChar(this.$textLayer.EOL_CHAR)},this.onChangeTabSize=function(){this.$loop.schedule(this.CHANGE_TEXT|this.CHANGE_MARKER),this.$textLayer.onChangeTabSize()},this.updateText=function(){this.$loop.schedule(this.CHANGE_TEXT)},this.updateFull=function(l){l?this.$renderChanges(this.CHANGE_FULL,!0):this.$loop.schedule(this.CHANGE_FULL)},this.updateFontSize=function(){this.$textLayer.checkForSizeChanges()},this.$changes=0,this.$updateSizeAsync=function(){this.$loop.pending?this.$size.$dirty=!0:this.onResize()},this.onResize=function(l,g,d,a){if(!(this.resizing>2)){this.resizing>0?this.resizing++:this.resizing=l?1:0;var f=this.container;a||(a=f.clientHeight||f.scrollHeight),d||(d=f.clientWidth||f.scrollWidth);var s=this.$updateCachedSize(l,g,d,a);if(!this.$size.scrollerHeight||!d&&!a)return this.resizing=0;l&&(this.$gutterLayer.$padding=null),l?this.$renderChanges(s|this.$changes,!0):this.$loop.schedule(s|this.$changes),this.resizing&&(this.resizing=0),this.scrollBarV.scrollLeft=this.scrollBarV.scrollTop=null}},this.$updateCachedSize=function(l,g,d,a){a-=this.$extraHeight||0;var f=0,s=this.$size,v={width:s.width,height:s.height,scrollerHeight:s.scrollerHeight,scrollerWidth:s.scrollerWidth};if(a&&(l||s.height!=a)&&(s.height=a,f|=this.CHANGE_SIZE,s.scrollerHeight=s.height,this.$horizScroll&&(s.scrollerHeight-=this.scrollBarH.getHeight()),this.scrollBarV.element.style.bottom=this.scrollBarH.getHeight()+"px",f=f|this.CHANGE_SCROLL),d&&(l||s.width!=d)){f|=this.CHANGE_SIZE,s.width=d,g==null&&(g=this.$showGutter?this.$gutter.offsetWidth:0),this.gutterWidth=g,A.setStyle(this.scrollBarH.element.style,"left",g+"px"),A.setStyle(this.scroller.style,"left",g+this.margin.left+"px"),s.scrollerWidth=Math.max(0,d-g-this.scrollBarV.getWidth()-this.margin.h),A.setStyle(this.$gutter.style,"left",this.margin.left+"px");var $=this.scrollBarV.getWidth()+"px";A.setStyle(this.scrollBarH.element.style,"right",$),A.setStyle(this.scroller.style,"right",$),A.setStyle(this.scroller.style,"bottom",this.scrollBarH.getHeight()),(this.session&&this.session.getUseWrapMode()&&this.adjustWrapLimit()||l)&&(f|=this.CHANGE_FULL)}return s.$dirty=!d||!a,f&&this._signal("resize",v),f},this.onGutterResize=function(l){var g=this.$showGutter?l:0;g!=this.gutterWidth&&(this.$changes|=this.$updateCachedSize(!0,g,this.$size.width,this.$size.height)),this.session.getUseWrapMode()&&this.adjustWrapLimit()?this.$loop.schedule(this.CHANGE_FULL):this.$size.$dirty?this.$loop.schedule(this.CHANGE_FULL):this.$computeLayerConfig()},this.adjustWrapLimit=function(){var l=this.$size.scrollerWidth-this.$padding*2,g=Math.floor(l/this.characterWidth);return this.session.adjustWrapLimit(g,this.$showPrintMargin&&this.$printMarginColumn)},this.setAnimatedScroll=function(l){this.setOption("animatedScroll",l)},this.getAnimatedScroll=function(){return this.$animatedScroll},this.setShowInvisibles=function(l){this.setOption("showInvisibles",l),this.session.$bidiHandler.setShowInvisibles(l)},this.getShowInvisibles=function(){return this.getOption("showInvisibles")},this.getDisplayIndentGuides=function(){return this.getOption("displayIndentGuides")},this.setDisplayIndentGuides=function(l){this.setOption("displayIndentGuides",l)},this.setShowPrintMargin=function(l){this.setOption("showPrintMargin",l)},this.getShowPrintMargin=function(){return this.getOption("showPrintMargin")},this.setPrintMarginColumn=function(l){this.setOption("printMarginColumn",l)},this.getPrintMarginColumn=function(){return this.getOption("printMarginColumn")},this.getShowGutter=function(){return this.getOption("showGutter")},this.setShowGutter=function(l){return this.setOption("showGutter",l)},this.getFadeFoldWidgets=function(){return this.getOption("fadeFoldWidgets")},this.setFadeFoldWidgets=function(l){this.setOption("fadeFoldWidgets",l)},this.setHighlightGutterLine=function(l){this.setOption("highlightGutterLine",l)},this.getHighlightGutterLine=function(){return this.getOption("highlightGutterLine")},this.$updatePrintMargin=function(){if(!(!this.$showPrintMargin&&!this.$printMarginEl)){if(!this.$printMarginEl){var l=A.createElement("div");l.className="ace_layer ace_print-margin-layer",this.$printMarginEl=A.createElement("div"),this.$printMarginEl.className="ace_print-margin",l.appendChild(this.$printMarginEl),this.content.insertBefore(l,this.content.firstChild)}var g=this.$printMarginEl.style;g.left=Math.round(this.characterWidth*this.$printMarginColumn+this.$padding)+"px",g.visibility=this.$showPrintMargin?"visible":"hidden",this.session&&this.session.$wrap==-1&&this.adjustWrapLimit()}},this.getContainerElement=function(){return this.container},this.getMouseEventTarget=function(){return this.scroller},this.getTextAreaContainer=function(){return this.container},this.$moveTextAreaToCursor=function(){if(!this.$isMousePressed){var l=this.textarea.style,g=this.$composition;if(!this.$keepTextAreaAtCursor&&!g){A.translate(this.textarea,-100,0);return}var d=this.$cursorLayer.$pixelPos;if(d){g&&g.markerRange&&(d=this.$cursorLayer.getPixelPosition(g.markerRange.start,!0));var a=this.layerConfig,f=d.top,s=d.left;f-=a.offset;var v=g&&g.useTextareaForIME?this.lineHeight:y?0:1;if(f<0||f>a.height-v){A.translate(this.textarea,0,0);return}var $=1,x=this.$size.height-v;if(!g)f+=this.lineHeight;else if(g.useTextareaForIME){var C=this.textarea.value;$=this.characterWidth*this.session.$getStringScreenWidth(C)[0]}else f+=this.lineHeight+2;s-=this.scrollLeft,s>this.$size.scrollerWidth-$&&(s=this.$size.scrollerWidth-$),s+=this.gutterWidth+this.margin.left,A.setStyle(l,"height",v+"px"),A.setStyle(l,"width",$+"px"),A.translate(this.textarea,Math.min(s,this.$size.scrollerWidth-$),Math.min(f,x))}}},this.getFirstVisibleRow=function(){return this.layerConfig.firstRow},this.getFirstFullyVisibleRow=function(){return this.layerConfig.firstRow+(this.layerConfig.offset===0?0:1)},this.getLastFullyVisibleRow=function(){var l=this.layerConfig,g=l.lastRow,d=this.session.documentToScreenRow(g,0)*l.lineHeight;return d-this.session.getScrollTop()>l.height-l.lineHeight?g-1:g},this.getLastVisibleRow=function(){return this.layerConfig.lastRow},this.$padding=null,this.setPadding=function(l){this.$padding=l,this.$textLayer.setPadding(l),this.$cursorLayer.setPadding(l),this.$markerFront.setPadding(l),this.$markerBack.setPadding(l),this.$loop.schedule(this.CHANGE_FULL),this.$updatePrintMargin()},this.setScrollMargin=function(l,g,d,a){var f=this.scrollMargin;f.top=l|0,f.bottom=g|0,f.right=a|0,f.left=d|0,f.v=f.top+f.bottom,f.h=f.left+f.right,f.top&&this.scrollTop<=0&&this.session&&this.session.setScrollTop(-f.top),this.updateFull()},this.setMargin=function(l,g,d,a){var f=this.margin;f.top=l|0,f.bottom=g|0,f.right=a|0,f.left=d|0,f.v=f.top+f.bottom,f.h=f.left+f.right,this.$updateCachedSize(!0,this.gutterWidth,this.$size.width,this.$size.height),this.updateFull()},this.getHScrollBarAlwaysVisible=function(){return this.$hScrollBarAlwaysVisible},this.setHScrollBarAlwaysVisible=function(l){this.setOption("hScrollBarAlwaysVisible",l)},this.getVScrollBarAlwaysVisible=function(){return this.$vScrollBarAlwaysVisible},this.setVScrollBarAlwaysVisible=function(l){this.setOption("vScrollBarAlwaysVisible",l)},this.$updateScrollBarV=function(){var l=this.layerConfig.maxHeight,g=this.$size.scrollerHeight;!this.$maxLines&&this.$scrollPastEnd&&(l-=(g-this.lineHeight)*this.$scrollPastEnd,this.scrollTop>l-g&&(l=this.scrollTop+g,this.scrollBarV.scrollTop=null)),this.scrollBarV.setScrollHeight(l+this.scrollMargin.v),this.scrollBarV.setScrollTop(this.scrollTop+this.scrollMargin.top)},this.$updateScrollBarH=function(){this.scrollBarH.setScrollWidth(this.layerConfig.width+2*this.$padding+this.scrollMargin.h),this.scrollBarH.setScrollLeft(this.scrollLeft+this.scrollMargin.left)},this.$frozen=!1,this.freeze=function(){this.$frozen=!0},this.unfreeze=function(){this.$frozen=!1},this.$renderChanges=function(l,g){if(this.$changes&&(l|=this.$changes,this.$changes=0),!this.session||!this.container.offsetWidth||this.$frozen||!l&&!g){this.$changes|=l;return}if(this.$size.$dirty)return this.$changes|=l,this.onResize(!0);this.lineHeight||this.$textLayer.checkForSizeChanges(),this._signal("beforeRender",l),this.session&&this.session.$bidiHandler&&this.session.$bidiHandler.updateCharacterWidths(this.$fontMetrics);var d=this.layerConfig;if(l&this.CHANGE_FULL||l&this.CHANGE_SIZE||l&this.CHANGE_TEXT||l&this.CHANGE_LINES||l&this.CHANGE_SCROLL||l&this.CHANGE_H_SCROLL){if(l|=this.$computeLayerConfig()|this.$loop.clear(),d.firstRow!=this.layerConfig.firstRow&&d.firstRowScreen==this.layerConfig.firstRowScreen){var a=this.scrollTop+(d.firstRow-this.layerConfig.firstRow)*this.lineHeight;a>0&&(this.scrollTop=a,l=l|this.CHANGE_SCROLL,l|=this.$computeLayerConfig()|this.$loop.clear())}d=this.layerConfig,this.$updateScrollBarV(),l&this.CHANGE_H_SCROLL&&this.$updateScrollBarH(),A.translate(this.content,-this.scrollLeft,-d.offset);var f=d.width+2*this.$padding+"px",s=d.minHeight+"px";A.setStyle(this.content.style,"width",f),A.setStyle(this.content.style,"height",s)}if(l&this.CHANGE_H_SCROLL&&(A.translate(this.content,-this.scrollLeft,-d.offset),this.scroller.className=this.scrollLeft<=0?"ace_scroller":"ace_scroller ace_scroll-left"),l&this.CHANGE_FULL){this.$changedLines=null,this.$textLayer.update(d),this.$showGutter&&this.$gutterLayer.update(d),this.$markerBack.update(d),this.$markerFront.update(d),this.$cursorLayer.update(d),this.$moveTextAreaToCursor(),this._signal("afterRender",l);return}if(l&this.CHANGE_SCROLL){this.$changedLines=null,l&this.CHANGE_TEXT||l&this.CHANGE_LINES?this.$textLayer.update(d):this.$textLayer.scrollLines(d),this.$showGutter&&(l&this.CHANGE_GUTTER||l&this.CHANGE_LINES?this.$gutterLayer.update(d):this.$gutterLayer.scrollLines(d)),this.$markerBack.update(d),this.$markerFront.update(d),this.$cursorLayer.update(d),this.$moveTextAreaToCursor(),this._signal("afterRender",l);return}l&this.CHANGE_TEXT?(this.$changedLines=null,this.$textLayer.update(d),this.$showGutter&&this.$gutterLayer.update(d)):l&this.CHANGE_LINES?(this.$updateLines()||l&this.CHANGE_GUTTER&&this.$showGutter)&&this.$gutterLayer.update(d):l&this.CHANGE_TEXT||l&this.CHANGE_GUTTER?this.$showGutter&&this.$gutterLayer.update(d):l&this.CHANGE_CURSOR&&this.$highlightGutterLine&&this.$gutterLayer.updateLineHighlight(d),l&this.CHANGE_CURSOR&&(this.$cursorLayer.update(d),this.$moveTextAreaToCursor()),l&(this.CHANGE_MARKER|this.CHANGE_MARKER_FRONT)&&this.$markerFront.update(d),l&(this.CHANGE_MARKER|this.CHANGE_MARKER_BACK)&&this.$markerBack.update(d),this._signal("afterRender",l)},this.$autosize=function(){var l=this.session.getScreenLength()*this.lineHeight,g=this.$maxLines*this.lineHeight,d=Math.min(g,Math.max((this.$minLines||1)*this.lineHeight,l))+this.scrollMargin.v+(this.$extraHeight||0);this.$horizScroll&&(d+=this.scrollBarH.getHeight()),this.$maxPixelHeight&&d>this.$maxPixelHeight&&(d=this.$maxPixelHeight);var a=d<=2*this.lineHeight,f=!a&&l>g;if(d!=this.desiredHeight||this.$size.height!=this.desiredHeight||f!=this.$vScroll){f!=this.$vScroll&&(this.$vScroll=f,this.scrollBarV.setVisible(f));var s=this.container.clientWidth;this.container.style.height=d+"px",this.$updateCachedSize(!0,this.$gutterWidth,s,d),this.desiredHeight=d,this._signal("autosize")}},this.$computeLayerConfig=function(){var l=this.session,g=this.$size,d=g.height<=2*this.lineHeight,a=this.session.getScreenLength(),f=a*this.lineHeight,s=this.$getLongestLine(),v=!d&&(this.$hScrollBarAlwaysVisible||g.scrollerWidth-s-2*this.$padding<0),$=this.$horizScroll!==v;$&&(this.$horizScroll=v,this.scrollBarH.setVisible(v));var x=this.$vScroll;this.$maxLines&&this.lineHeight>1&&this.$autosize();var C=g.scrollerHeight+this.lineHeight,k=!this.$maxLines&&this.$scrollPastEnd?(g.scrollerHeight-this.lineHeight)*this.$scrollPastEnd:0;f+=k;var R=this.scrollMargin;this.session.setScrollTop(Math.max(-R.top,Math.min(this.scrollTop,f-g.scrollerHeight+R.bottom))),this.session.setScrollLeft(Math.max(-R.left,Math.min(this.scrollLeft,s+2*this.$padding-g.scrollerWidth+R.right)));var _=!d&&(this.$vScrollBarAlwaysVisible||g.scrollerHeight-f+k<0||this.scrollTop>R.top),O=x!==_;O&&(this.$vScroll=_,this.scrollBarV.setVisible(_));var I=this.scrollTop%this.lineHeight,D=Math.ceil(C/this.lineHeight)-1,T=Math.max(0,Math.round((this.scrollTop-I)/this.lineHeight)),P=T+D,M,W,N=this.lineHeight;T=l.screenToDocumentRow(T,0);var G=l.getFoldLine(T);G&&(T=G.start.row),M=l.documentToScreenRow(T,0),W=l.getRowLength(T)*N,P=Math.min(l.screenToDocumentRow(P,0),l.getLength()-1),C=g.scrollerHeight+l.getRowLength(P)*N+W,I=this.scrollTop-M*N;var z=0;return(this.layerConfig.width!=s||$)&&(z=this.CHANGE_H_SCROLL),($||O)&&(z|=this.$updateCachedSize(!0,this.gutterWidth,g.width,g.height),this._signal("scrollbarVisibilityChanged"),O&&(s=this.$getLongestLine())),this.layerConfig={width:s,padding:this.$padding,firstRow:T,firstRowScreen:M,lastRow:P,lineHeight:N,characterWidth:this.characterWidth,minHeight:C,maxHeight:f,offset:I,gutterOffset:N?Math.max(0,Math.ceil((I+g.height-g.scrollerHeight)/N)):0,height:this.$size.scrollerHeight},this.session.$bidiHandler&&this.session.$bidiHandler.setContentWidth(s-this.$padding),z},this.$updateLines=function(){if(this.$changedLines){var l=this.$changedLines.firstRow,g=this.$changedLines.lastRow;this.$changedLines=null;var d=this.layerConfig;if(!(l>d.lastRow+1)&&!(g<d.firstRow)){if(g===1/0){this.$showGutter&&this.$gutterLayer.update(d),this.$textLayer.update(d);return}return this.$textLayer.updateLines(d,l,g),!0}}},this.$getLongestLine=function(){var l=this.session.getScreenWidth();return this.showInvisibles&&!this.session.$useWrapMode&&(l+=1),this.$textLayer&&l>this.$textLayer.MAX_LINE_LENGTH&&(l=this.$textLayer.MAX_LINE_LENGTH+30),Math.max(this.$size.scrollerWidth-2*this.$padding,Math.round(l*this.characterWidth))},this.updateFrontMarkers=function(){this.$markerFront.setMarkers(this.session.getMarkers(!0)),this.$loop.schedule(this.CHANGE_MARKER_FRONT)},this.updateBackMarkers=function(){this.$markerBack.setMarkers(this.session.getMarkers()),this.$loop.schedule(this.CHANGE_MARKER_BACK)},this.addGutterDecoration=function(l,g){this.$gutterLayer.addGutterDecoration(l,g)},this.removeGutterDecoration=function(l,g){this.$gutterLayer.removeGutterDecoration(l,g)},this.updateBreakpoints=function(l){this.$loop.schedule(this.CHANGE_GUTTER)},this.setAnnotations=function(l){this.$gutterLayer.setAnnotations(l),this.$loop.schedule(this.CHANGE_GUTTER)},this.updateCursor=function(){this.$loop.schedule(this.CHANGE_CURSOR)},this.hideCursor=function(){this.$cursorLayer.hideCursor()},this.showCursor=function(){this.$cursorLayer.showCursor()},this.scrollSelectionIntoView=function(l,g,d){this.scrollCursorIntoView(l,d),this.scrollCursorIntoView(g,d)},this.scrollCursorIntoView=function(l,g,d){if(this.$size.scrollerHeight!==0){var a=this.$cursorLayer.getPixelPosition(l),f=a.left,s=a.top,v=d&&d.top||0,$=d&&d.bottom||0,x=this.$scrollAnimation?this.session.getScrollTop():this.scrollTop;x+v>s?(g&&x+v>s+this.lineHeight&&(s-=g*this.$size.scrollerHeight),s===0&&(s=-this.scrollMargin.top),this.session.setScrollTop(s)):x+this.$size.scrollerHeight-$<s+this.lineHeight&&(g&&x+this.$size.scrollerHeight-$<s-this.lineHeight&&(s+=g*this.$size.scrollerHeight),this.session.setScrollTop(s+this.lineHeight+$-this.$size.scrollerHeight));var C=this.scrollLeft;C>f?(f<this.$padding+2*this.layerConfig.characterWidth&&(f=-this.scrollMargin.left),this.session.setScrollLeft(f)):C+this.$size.scrollerWidth<f+this.characterWidth?this.session.setScrollLeft(Math.round(f+this.characterWidth-this.$size.scrollerWidth)):C<=this.$padding&&f-C<this.characterWidth&&this.session.setScrollLeft(0)}},this.getScrollTop=function(){return this.session.getScrollTop()},this.getScrollLeft=function(){return this.session.getScrollLeft()},this.getScrollTopRow=function(){return this.scrollTop/this.lineHeight},this.getScrollBottomRow=function(){return Math.max(0,Math.floor((this.scrollTop+this.$size.scrollerHeight)/this.lineHeight)-1)},this.scrollToRow=function(l){this.session.setScrollTop(l*this.lineHeight)},this.alignCursor=function(l,g){typeof l=="number"&&(l={row:l,column:0});var d=this.$cursorLayer.getPixelPosition(l),a=this.$size.scrollerHeight-this.lineHeight,f=d.top-a*(g||0);return this.session.setScrollTop(f),f},this.STEPS=8,this.$calcSteps=function(l,g){var d=0,a=this.STEPS,f=[],s=function(v,$,x){return x*(Math.pow(v-1,3)+1)+$};for(d=0;d<a;++d)f.push(s(d/this.STEPS,l,g-l));return f},this.scrollToLine=function(l,g,d,a){var f=this.$cursorLayer.getPixelPosition({row:l,column:0}),s=f.top;g&&(s-=this.$size.scrollerHeight/2);var v=this.scrollTop;this.session.setScrollTop(s),d!==!1&&this.animateScrolling(v,a)},this.animateScrolling=function(l,g){var d=this.scrollTop;if(this.$animatedScroll){var a=this;if(l!=d){if(this.$scrollAnimation){var f=this.$scrollAnimation.steps;if(f.length&&(l=f[0],l==d))return}var s=a.$calcSteps(l,d);this.$scrollAnimation={from:l,to:d,steps:s},clearInterval(this.$timer),a.session.setScrollTop(s.shift()),a.session.$scrollTop=d,this.$timer=setInterval(function(){if(!a.session)return clearInterval(a.$timer);s.length?(a.session.setScrollTop(s.shift()),a.session.$scrollTop=d):d!=null?(a.session.$scrollTop=-1,a.session.setScrollTop(d),d=null):(a.$timer=clearInterval(a.$timer),a.$scrollAnimation=null,g&&g())},10)}}},this.scrollToY=function(l){this.scrollTop!==l&&(this.$loop.schedule(this.CHANGE_SCROLL),this.scrollTop=l)},this.scrollToX=function(l){this.scrollLeft!==l&&(this.scrollLeft=l),this.$loop.schedule(this.CHANGE_H_SCROLL)},this.scrollTo=function(l,g){this.session.setScrollTop(g),this.session.setScrollLeft(g)},this.scrollBy=function(l,g){g&&this.session.setScrollTop(this.session.getScrollTop()+g),l&&this.session.setScrollLeft(this.session.getScrollLeft()+l)},this.isScrollableBy=function(l,g){if(g<0&&this.session.getScrollTop()>=1-this.scrollMargin.top||g>0&&this.session.getScrollTop()+this.$size.scrollerHeight-this.layerConfig.maxHeight<-1+this.scrollMargin.bottom||l<0&&this.session.getScrollLeft()>=1-this.scrollMargin.left||l>0&&this.session.getScrollLeft()+this.$size.scrollerWidth-this.layerConfig.width<-1+this.scrollMargin.right)return!0},this.pixelToScreenCoordinates=function(l,g){var d;if(this.$hasCssTransforms){d={top:0,left:0};var a=this.$fontMetrics.transformCoordinates([l,g]);l=a[1]-this.gutterWidth-this.margin.left,g=a[0]}else d=this.scroller.getBoundingClientRect();var f=l+this.scrollLeft-d.left-this.$padding,s=f/this.characterWidth,v=Math.floor((g+this.scrollTop-d.top)/this.lineHeight),$=this.$blockCursor?Math.floor(s):Math.round(s);return{row:v,column:$,side:s-$>0?1:-1,offsetX:f}},this.screenToTextCoordinates=function(l,g){var d;if(this.$hasCssTransforms){d={top:0,left:0};var a=this.$fontMetrics.transformCoordinates([l,g]);l=a[1]-this.gutterWidth-this.margin.left,g=a[0]}else d=this.scroller.getBoundingClientRect();var f=l+this.scrollLeft-d.left-this.$padding,s=f/this.characterWidth,v=this.$blockCursor?Math.floor(s):Math.round(s),$=Math.floor((g+this.scrollTop-d.top)/this.lineHeight);return this.session.screenToDocumentPosition($,Math.max(v,0),f)},this.textToScreenCoordinates=function(l,g){var d=this.scroller.getBoundingClientRect(),a=this.session.documentToScreenPosition(l,g),f=this.$padding+(this.session.$bidiHandler.isBidiRow(a.row,l)?this.session.$bidiHandler.getPosLeft(a.column):Math.round(a.column*this.characterWidth)),s=a.row*this.lineHeight;return{pageX:d.left+f-this.scrollLeft,pageY:d.top+s-this.scrollTop}},this.visualizeFocus=function(){A.addCssClass(this.container,"ace_focus")},this.visualizeBlur=function(){A.removeCssClass(this.container,"ace_focus")},this.showComposition=function(l){this.$composition=l,l.cssText||(l.cssText=this.textarea.style.cssText),l.useTextareaForIME==null&&(l.useTextareaForIME=this.$useTextareaForIME),this.$useTextareaForIME?(A.addCssClass(this.textarea,"ace_composition"),this.textarea.style.cssText="",this.$moveTextAreaToCursor(),this.$cursorLayer.element.style.display="none"):l.markerId=this.session.addMarker(l.markerRange,"ace_composition_marker","text")},this.setCompositionText=function(l){var g=this.session.selection.cursor;this.addToken(l,"composition_placeholder",g.row,g.column),this.$moveTextAreaToCursor()},this.hideComposition=function(){if(this.$composition){this.$composition.markerId&&this.session.removeMarker(this.$composition.markerId),A.removeCssClass(this.textarea,"ace_composition"),this.textarea.style.cssText=this.$composition.cssText;var l=this.session.selection.cursor;this.removeExtraToken(l.row,l.column),this.$composition=null,this.$cursorLayer.element.style.display=""}},this.addToken=function(l,g,d,a){var f=this.session;f.bgTokenizer.lines[d]=null;var s={type:g,value:l},v=f.getTokens(d);if(a==null)v.push(s);else for(var $=0,x=0;x<v.length;x++){var C=v[x];if($+=C.value.length,a<=$){var k=C.value.length-($-a),R=C.value.slice(0,k),_=C.value.slice(k);v.splice(x,1,{type:C.type,value:R},s,{type:C.type,value:_});break}}this.updateLines(d,d)},this.removeExtraToken=function(l,g){this.updateLines(l,l)},this.setTheme=function(l,g){var d=this;if(this.$themeId=l,d._dispatchEvent("themeChange",{theme:l}),!l||typeof l=="string"){var a=l||this.$options.theme.initialValue;p.loadModule(["theme",a],f)}else f(l);function f(s){if(d.$themeId!=l)return g&&g();if(!s||!s.cssClass)throw new Error("couldn't load module "+l+" or it didn't call define");s.$id&&(d.$themeId=s.$id),A.importCssString(s.cssText,s.cssClass,d.container),d.theme&&A.removeCssClass(d.container,d.theme.cssClass);var v="padding"in s?s.padding:"padding"in(d.theme||{})?4:d.$padding;d.$padding&&v!=d.$padding&&d.setPadding(v),d.$theme=s.cssClass,d.theme=s,A.addCssClass(d.container,s.cssClass),A.setCssClass(d.container,"ace_dark",s.isDark),d.$size&&(d.$size.width=0,d.$updateSizeAsync()),d._dispatchEvent("themeLoaded",{theme:s}),g&&g()}},this.getTheme=function(){return this.$themeId},this.setStyle=function(l,g){A.setCssClass(this.container,l,g!==!1)},this.unsetStyle=function(l){A.removeCssClass(this.container,l)},this.setCursorStyle=function(l){A.setStyle(this.scroller.style,"cursor",l)},this.setMouseCursor=function(l){A.setStyle(this.scroller.style,"cursor",l)},this.attachToShadowRoot=function(){A.importCssString(w,"ace_editor.css",this.container)},this.destroy=function(){this.freeze(),this.$fontMetrics.destroy(),this.$cursorLayer.destroy(),this.removeAllListeners(),this.container.textContent=""}}).call(c.prototype),p.defineOptions(c.prototype,"renderer",{animatedScroll:{initialValue:!1},showInvisibles:{set:function(l){this.$textLayer.setShowInvisibles(l)&&this.$loop.schedule(this.CHANGE_TEXT)},initialValue:!1},showPrintMargin:{set:function(){this.$updatePrintMargin()},initialValue:!0},printMarginColumn:{set:function(){this.$updatePrintMargin()},initialValue:80},printMargin:{set:function(l){typeof l=="number"&&(this.$printMarginColumn=l),this.$showPrintMargin=!!l,this.$updatePrintMargin()},get:function(){return this.$showPrintMargin&&this.$printMarginColumn}},showGutter:{set:function(l){this.$gutter.style.display=l?"block":"none",this.$loop.schedule(this.CHANGE_FULL),this.onGutterResize()},initialValue:!0},fadeFoldWidgets:{set:function(l){A.setCssClass(this.$gutter,"ace_fade-fold-widgets",l)},initialValue:!1},showFoldWidgets:{set:function(l){this.$gutterLayer.setShowFoldWidgets(l),this.$loop.schedule(this.CHANGE_GUTTER)},initialValue:!0},displayIndentGuides:{set:function(l){this.$textLayer.setDisplayIndentGuides(l)&&this.$loop.schedule(this.CHANGE_TEXT)},initialValue:!0},highlightGutterLine:{set:function(l){this.$gutterLayer.setHighlightGutterLine(l),this.$loop.schedule(this.CHANGE_GUTTER)},initialValue:!0},hScrollBarAlwaysVisible:{set:function(l){(!this.$hScrollBarAlwaysVisible||!this.$horizScroll)&&this.$loop.schedule(this.CHANGE_SCROLL)},initialValue:!1},vScrollBarAlwaysVisible:{set:function(l){(!this.$vScrollBarAlwaysVisible||!this.$vScroll)&&this.$loop.schedule(this.CHANGE_SCROLL)},initialValue:!1},fontSize:{set:function(l){typeof l=="number"&&(l=l+"px"),this.container.style.fontSize=l,this.updateFontSize()},initialValue:12},fontFamily:{set:function(l){this.container.style.fontFamily=l,this.updateFontSize()}},maxLines:{set:function(l){this.updateFull()}},minLines:{set:function(l){this.$minLines<562949953421311||(this.$minLines=0),this.updateFull()}},maxPixelHeight:{set:function(l){this.updateFull()},initialValue:0},scrollPastEnd:{set:function(l){l=+l||0,this.$scrollPastEnd!=l&&(this.$scrollPastEnd=l,this.$loop.schedule(this.CHANGE_SCROLL))},initialValue:0,handlesSet:!0},fixedWidthGutter:{set:function(l){this.$gutterLayer.$fixedWidth=!!l,this.$loop.schedule(this.CHANGE_GUTTER)}},theme:{set:function(l){this.setTheme(l)},get:function(){return this.$themeId||this.theme},initialValue:"./theme/textmate",handlesSet:!0},hasCssTransforms:{},useTextareaForIME:{initialValue:!S.isMobile&&!S.isIE}}),b.VirtualRenderer=c}),define("ace/worker/worker_client",["require","exports","module","ace/lib/oop","ace/lib/net","ace/lib/event_emitter","ace/config"],function(L,b,B){"use strict";var E=L("../lib/oop"),A=L("../lib/net"),p=L("../lib/event_emitter").EventEmitter,h=L("../config");function u(r){var n="importScripts('"+A.qualifyURL(r)+"');";try{return new Blob([n],{type:"application/javascript"})}catch{var o=window.BlobBuilder||window.WebKitBlobBuilder||window.MozBlobBuilder,m=new o;return m.append(n),m.getBlob("application/javascript")}}function t(r){if(typeof Worker>"u")return{postMessage:function(){},terminate:function(){}};if(h.get("loadWorkerFromBlob")){var n=u(r),o=window.URL||window.webkitURL,m=o.createObjectURL(n);return new Worker(m)}return new Worker(r)}var e=function(r){r.postMessage||(r=this.$createWorkerFromOldConfig.apply(this,arguments)),this.$worker=r,this.$sendDeltaQueue=this.$sendDeltaQueue.bind(this),this.changeListener=this.changeListener.bind(this),this.onMessage=this.onMessage.bind(this),this.callbackId=1,this.callbacks={},this.$worker.onmessage=this.onMessage};(function(){E.implement(this,p),this.$createWorkerFromOldConfig=function(r,n,o,m,w){if(L.nameToUrl&&!L.toUrl&&(L.toUrl=L.nameToUrl),h.get("packaged")||!L.toUrl)m=m||h.moduleUrl(n,"worker");else{var S=this.$normalizePath;m=m||S(L.toUrl("ace/worker/worker.js",null,"_"));var y={};r.forEach(function(c){y[c]=S(L.toUrl(c,null,"_").replace(/(\.js)?(\?.*)?$/,""))})}return this.$worker=t(m),w&&this.send("importScripts",w),this.$worker.postMessage({init:!0,tlns:y,module:n,classname:o}),this.$worker},this.onMessage=function(r){var n=r.data;switch(n.type){case"event":this._signal(n.name,{data:n.data});break;case"call":var o=this.callbacks[n.id];o&&(o(n.data),delete this.callbacks[n.id]);break;case"error":this.reportError(n.data);break;case"log":window.console&&console.log&&console.log.apply(console,n.data);break}},this.reportError=function(r){window.console&&console.error&&console.error(r)},this.$normalizePath=function(r){return A.qualifyURL(r)},this.terminate=function(){this._signal("terminate",{}),this.deltaQueue=null,this.$worker.terminate(),this.$worker=null,this.$doc&&this.$doc.off("change",this.changeListener),this.$doc=null},this.send=function(r,n){this.$worker.postMessage({command:r,args:n})},this.call=function(r,n,o){if(o){var m=this.callbackId++;this.callbacks[m]=o,n.push(m)}this.send(r,n)},this.emit=function(r,n){try{n.data&&n.data.err&&(n.data.err={message:n.data.err.message,stack:n.data.err.stack,code:n.data.err.code}),this.$worker.postMessage({event:r,data:{data:n.data}})}catch(o){console.error(o.stack)}},this.attachToDocument=function(r){this.$doc&&this.terminate(),this.$doc=r,this.call("setValue",[r.getValue()]),r.on("change",this.changeListener)},this.changeListener=function(r){this.deltaQueue||(this.deltaQueue=[],setTimeout(this.$sendDeltaQueue,0)),r.action=="insert"?this.deltaQueue.push(r.start,r.lines):this.deltaQueue.push(r.start,r.end)},this.$sendDeltaQueue=function(){var r=this.deltaQueue;r&&(this.deltaQueue=null,r.length>50&&r.length>this.$doc.getLength()>>1?this.call("setValue",[this.$doc.getValue()]):this.emit("change",{data:r}))}}).call(e.prototype);var i=function(r,n,o){var m=null,w=!1,S=Object.create(p),y=[],c=new e({messageBuffer:y,terminate:function(){},postMessage:function(g){y.push(g),m&&(w?setTimeout(l):l())}});c.setEmitSync=function(g){w=g};var l=function(){var g=y.shift();g.command?m[g.command].apply(m,g.args):g.event&&S._signal(g.event,g.data)};return S.postMessage=function(g){c.onMessage({data:g})},S.callback=function(g,d){this.postMessage({type:"call",id:d,data:g})},S.emit=function(g,d){this.postMessage({type:"event",name:g,data:d})},h.loadModule(["worker",n],function(g){for(m=new g[o](S);y.length;)l()}),c};b.UIWorkerClient=i,b.WorkerClient=e,b.createWorker=t}),define("ace/placeholder",["require","exports","module","ace/range","ace/lib/event_emitter","ace/lib/oop"],function(L,b,B){"use strict";var E=L("./range").Range,A=L("./lib/event_emitter").EventEmitter,p=L("./lib/oop"),h=function(u,t,e,i,r,n){var o=this;this.length=t,this.session=u,this.doc=u.getDocument(),this.mainClass=r,this.othersClass=n,this.$onUpdate=this.onUpdate.bind(this),this.doc.on("change",this.$onUpdate),this.$others=i,this.$onCursorChange=function(){setTimeout(function(){o.onCursorChange()})},this.$pos=e;var m=u.getUndoManager().$undoStack||u.getUndoManager().$undostack||{length:-1};this.$undoStackDepth=m.length,this.setup(),u.selection.on("changeCursor",this.$onCursorChange)};(function(){p.implement(this,A),this.setup=function(){var u=this,t=this.doc,e=this.session;this.selectionBefore=e.selection.toJSON(),e.selection.inMultiSelectMode&&e.selection.toSingleRange(),this.pos=t.createAnchor(this.$pos.row,this.$pos.column);var i=this.pos;i.$insertRight=!0,i.detach(),i.markerId=e.addMarker(new E(i.row,i.column,i.row,i.column+this.length),this.mainClass,null,!1),this.others=[],this.$others.forEach(function(r){var n=t.createAnchor(r.row,r.column);n.$insertRight=!0,n.detach(),u.others.push(n)}),e.setUndoSelect(!1)},this.showOtherMarkers=function(){if(!this.othersActive){var u=this.session,t=this;this.othersActive=!0,this.others.forEach(function(e){e.markerId=u.addMarker(new E(e.row,e.column,e.row,e.column+t.length),t.othersClass,null,!1)})}},this.hideOtherMarkers=function(){if(this.othersActive){this.othersActive=!1;for(var u=0;u<this.others.length;u++)this.session.removeMarker(this.others[u].markerId)}},this.onUpdate=function(u){if(this.$updating)return this.updateAnchors(u);var t=u;if(t.start.row===t.end.row&&t.start.row===this.pos.row){this.$updating=!0;var e=u.action==="insert"?t.end.column-t.start.column:t.start.column-t.end.column,i=t.start.column>=this.pos.column&&t.start.column<=this.pos.column+this.length+1,r=t.start.column-this.pos.column;if(this.updateAnchors(u),i&&(this.length+=e),i&&!this.session.$fromUndo){if(u.action==="insert")for(var n=this.others.length-1;n>=0;n--){var o=this.others[n],m={row:o.row,column:o.column+r};this.doc.insertMergedLines(m,u.lines)}else if(u.action==="remove")for(var n=this.others.length-1;n>=0;n--){var o=this.others[n],m={row:o.row,column:o.column+r};this.doc.remove(new E(m.row,m.column,m.row,m.column-e))}}this.$updating=!1,this.updateMarkers()}},this.updateAnchors=function(u){this.pos.onChange(u);for(var t=this.others.length;t--;)this.others[t].onChange(u);this.updateMarkers()},this.updateMarkers=function(){if(!this.$updating){var u=this,t=this.session,e=function(r,n){t.removeMarker(r.markerId),r.markerId=t.addMarker(new E(r.row,r.column,r.row,r.column+u.length),n,null,!1)};e(this.pos,this.mainClass);for(var i=this.others.length;i--;)e(this.others[i],this.othersClass)}},this.onCursorChange=function(u){if(!(this.$updating||!this.session)){var t=this.session.selection.getCursor();t.row===this.pos.row&&t.column>=this.pos.column&&t.column<=this.pos.column+this.length?(this.showOtherMarkers(),this._emit("cursorEnter",u)):(this.hideOtherMarkers(),this._emit("cursorLeave",u))}},this.detach=function(){this.session.removeMarker(this.pos&&this.pos.markerId),this.hideOtherMarkers(),this.doc.off("change",this.$onUpdate),this.session.selection.off("changeCursor",this.$onCursorChange),this.session.setUndoSelect(!0),this.session=null},this.cancel=function(){if(this.$undoStackDepth!==-1){for(var u=this.session.getUndoManager(),t=(u.$undoStack||u.$undostack).length-this.$undoStackDepth,e=0;e<t;e++)u.undo(this.session,!0);this.selectionBefore&&this.session.selection.fromJSON(this.selectionBefore)}}}).call(h.prototype),b.PlaceHolder=h}),define("ace/mouse/multi_select_handler",["require","exports","module","ace/lib/event","ace/lib/useragent"],function(L,b,B){var E=L("../lib/event"),A=L("../lib/useragent");function p(u,t){return u.row==t.row&&u.column==t.column}function h(u){var t=u.domEvent,e=t.altKey,i=t.shiftKey,r=t.ctrlKey,n=u.getAccelKey(),o=u.getButton();if(r&&A.isMac&&(o=t.button),u.editor.inMultiSelectMode&&o==2){u.editor.textInput.onContextMenu(u.domEvent);return}if(!r&&!e&&!n){o===0&&u.editor.inMultiSelectMode&&u.editor.exitMultiSelectMode();return}if(o===0){var m=u.editor,w=m.selection,S=m.inMultiSelectMode,y=u.getDocumentPosition(),c=w.getCursor(),l=u.inSelection()||w.isEmpty()&&p(y,c),g=u.x,d=u.y,a=function(T){g=T.clientX,d=T.clientY},f=m.session,s=m.renderer.pixelToScreenCoordinates(g,d),v=s,$;if(m.$mouseHandler.$enableJumpToDef)r&&e||n&&e?$=i?"block":"add":e&&m.$blockSelectEnabled&&($="block");else if(n&&!e){if($="add",!S&&i)return}else e&&m.$blockSelectEnabled&&($="block");if($&&A.isMac&&t.ctrlKey&&m.$mouseHandler.cancelContextMenu(),$=="add"){if(!S&&l)return;if(!S){var x=w.toOrientedRange();m.addSelectionMarker(x)}var C=w.rangeList.rangeAtPoint(y);m.inVirtualSelectionMode=!0,i&&(C=null,x=w.ranges[0]||x,m.removeSelectionMarker(x)),m.once("mouseup",function(){var T=w.toOrientedRange();C&&T.isEmpty()&&p(C.cursor,T.cursor)?w.substractPoint(T.cursor):(i?w.substractPoint(x.cursor):x&&(m.removeSelectionMarker(x),w.addRange(x)),w.addRange(T)),m.inVirtualSelectionMode=!1})}else if($=="block"){u.stop(),m.inVirtualSelectionMode=!0;var k,R=[],_=function(){var T=m.renderer.pixelToScreenCoordinates(g,d),P=f.screenToDocumentPosition(T.row,T.column,T.offsetX);p(v,T)&&p(P,w.lead)||(v=T,m.selection.moveToPosition(P),m.renderer.scrollCursorIntoView(),m.removeSelectionMarkers(R),R=w.rectangularRangeBlock(v,s),m.$mouseHandler.$clickSelection&&R.length==1&&R[0].isEmpty()&&(R[0]=m.$mouseHandler.$clickSelection.clone()),R.forEach(m.addSelectionMarker,m),m.updateSelectionMarkers())};S&&!n?w.toSingleRange():!S&&n&&(k=w.toOrientedRange(),m.addSelectionMarker(k)),i?s=f.documentToScreenPosition(w.lead):w.moveToPosition(y),v={row:-1,column:-1};var O=function(T){_(),clearInterval(D),m.removeSelectionMarkers(R),R.length||(R=[w.toOrientedRange()]),k&&(m.removeSelectionMarker(k),w.toSingleRange(k));for(var P=0;P<R.length;P++)w.addRange(R[P]);m.inVirtualSelectionMode=!1,m.$mouseHandler.$clickSelection=null},I=_;E.capture(m.container,a,O);var D=setInterval(function(){I()},20);return u.preventDefault()}}}b.onMouseDown=h}),define("ace/commands/multi_select_commands",["require","exports","module","ace/keyboard/hash_handler"],function(L,b,B){b.defaultCommands=[{name:"addCursorAbove",description:"Add cursor above",exec:function(A){A.selectMoreLines(-1)},bindKey:{win:"Ctrl-Alt-Up",mac:"Ctrl-Alt-Up"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorBelow",description:"Add cursor below",exec:function(A){A.selectMoreLines(1)},bindKey:{win:"Ctrl-Alt-Down",mac:"Ctrl-Alt-Down"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorAboveSkipCurrent",description:"Add cursor above (skip current)",exec:function(A){A.selectMoreLines(-1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Up",mac:"Ctrl-Alt-Shift-Up"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorBelowSkipCurrent",description:"Add cursor below (skip current)",exec:function(A){A.selectMoreLines(1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Down",mac:"Ctrl-Alt-Shift-Down"},scrollIntoView:"cursor",readOnly:!0},{name:"selectMoreBefore",description:"Select more before",exec:function(A){A.selectMore(-1)},bindKey:{win:"Ctrl-Alt-Left",mac:"Ctrl-Alt-Left"},scrollIntoView:"cursor",readOnly:!0},{name:"selectMoreAfter",description:"Select more after",exec:function(A){A.selectMore(1)},bindKey:{win:"Ctrl-Alt-Right",mac:"Ctrl-Alt-Right"},scrollIntoView:"cursor",readOnly:!0},{name:"selectNextBefore",description:"Select next before",exec:function(A){A.selectMore(-1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Left",mac:"Ctrl-Alt-Shift-Left"},scrollIntoView:"cursor",readOnly:!0},{name:"selectNextAfter",description:"Select next after",exec:function(A){A.selectMore(1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Right",mac:"Ctrl-Alt-Shift-Right"},scrollIntoView:"cursor",readOnly:!0},{name:"toggleSplitSelectionIntoLines",description:"Split into lines",exec:function(A){A.multiSelect.rangeCount>1?A.multiSelect.joinSelections():A.multiSelect.splitIntoLines()},bindKey:{win:"Ctrl-Alt-L",mac:"Ctrl-Alt-L"},readOnly:!0},{name:"splitSelectionIntoLines",description:"Split into lines",exec:function(A){A.multiSelect.splitIntoLines()},readOnly:!0},{name:"alignCursors",description:"Align cursors",exec:function(A){A.alignCursors()},bindKey:{win:"Ctrl-Alt-A",mac:"Ctrl-Alt-A"},scrollIntoView:"cursor"},{name:"findAll",description:"Find all",exec:function(A){A.findAll()},bindKey:{win:"Ctrl-Alt-K",mac:"Ctrl-Alt-G"},scrollIntoView:"cursor",readOnly:!0}],b.multiSelectCommands=[{name:"singleSelection",description:"Single selection",bindKey:"esc",exec:function(A){A.exitMultiSelectMode()},scrollIntoView:"cursor",readOnly:!0,isAvailable:function(A){return A&&A.inMultiSelectMode}}];var E=L("../keyboard/hash_handler").HashHandler;b.keyboardHandler=new E(b.multiSelectCommands)}),define("ace/multi_select",["require","exports","module","ace/range_list","ace/range","ace/selection","ace/mouse/multi_select_handler","ace/lib/event","ace/lib/lang","ace/commands/multi_select_commands","ace/search","ace/edit_session","ace/editor","ace/config"],function(L,b,B){var E=L("./range_list").RangeList,A=L("./range").Range,p=L("./selection").Selection,h=L("./mouse/multi_select_handler").onMouseDown,u=L("./lib/event"),t=L("./lib/lang"),e=L("./commands/multi_select_commands");b.commands=e.defaultCommands.concat(e.multiSelectCommands);var i=L("./search").Search,r=new i;function n(c,l,g){return r.$options.wrap=!0,r.$options.needle=l,r.$options.backwards=g==-1,r.find(c)}var o=L("./edit_session").EditSession;(function(){this.getSelectionMarkers=function(){return this.$selectionMarkers}}).call(o.prototype),function(){this.ranges=null,this.rangeList=null,this.addRange=function(c,l){if(c){if(!this.inMultiSelectMode&&this.rangeCount===0){var g=this.toOrientedRange();if(this.rangeList.add(g),this.rangeList.add(c),this.rangeList.ranges.length!=2)return this.rangeList.removeAll(),l||this.fromOrientedRange(c);this.rangeList.removeAll(),this.rangeList.add(g),this.$onAddRange(g)}c.cursor||(c.cursor=c.end);var d=this.rangeList.add(c);return this.$onAddRange(c),d.length&&this.$onRemoveRange(d),this.rangeCount>1&&!this.inMultiSelectMode&&(this._signal("multiSelect"),this.inMultiSelectMode=!0,this.session.$undoSelect=!1,this.rangeList.attach(this.session)),l||this.fromOrientedRange(c)}},this.toSingleRange=function(c){c=c||this.ranges[0];var l=this.rangeList.removeAll();l.length&&this.$onRemoveRange(l),c&&this.fromOrientedRange(c)},this.substractPoint=function(c){var l=this.rangeList.substractPoint(c);if(l)return this.$onRemoveRange(l),l[0]},this.mergeOverlappingRanges=function(){var c=this.rangeList.merge();c.length&&this.$onRemoveRange(c)},this.$onAddRange=function(c){this.rangeCount=this.rangeList.ranges.length,this.ranges.unshift(c),this._signal("addRange",{range:c})},this.$onRemoveRange=function(c){if(this.rangeCount=this.rangeList.ranges.length,this.rangeCount==1&&this.inMultiSelectMode){var l=this.rangeList.ranges.pop();c.push(l),this.rangeCount=0}for(var g=c.length;g--;){var d=this.ranges.indexOf(c[g]);this.ranges.splice(d,1)}this._signal("removeRange",{ranges:c}),this.rangeCount===0&&this.inMultiSelectMode&&(this.inMultiSelectMode=!1,this._signal("singleSelect"),this.session.$undoSelect=!0,this.rangeList.detach(this.session)),l=l||this.ranges[0],l&&!l.isEqual(this.getRange())&&this.fromOrientedRange(l)},this.$initRangeList=function(){this.rangeList||(this.rangeList=new E,this.ranges=[],this.rangeCount=0)},this.getAllRanges=function(){return this.rangeCount?this.rangeList.ranges.concat():[this.getRange()]},this.splitIntoLines=function(){for(var c=this.ranges.length?this.ranges:[this.getRange()],l=[],g=0;g<c.length;g++){var d=c[g],a=d.start.row,f=d.end.row;if(a===f)l.push(d.clone());else{for(l.push(new A(a,d.start.column,a,this.session.getLine(a).length));++a<f;)l.push(this.getLineRange(a,!0));l.push(new A(f,0,f,d.end.column))}g==0&&!this.isBackwards()&&(l=l.reverse())}this.toSingleRange();for(var g=l.length;g--;)this.addRange(l[g])},this.joinSelections=function(){var c=this.rangeList.ranges,l=c[c.length-1],g=A.fromPoints(c[0].start,l.end);this.toSingleRange(),this.setSelectionRange(g,l.cursor==l.start)},this.toggleBlockSelection=function(){if(this.rangeCount>1){var c=this.rangeList.ranges,l=c[c.length-1],g=A.fromPoints(c[0].start,l.end);this.toSingleRange(),this.setSelectionRange(g,l.cursor==l.start)}else{var d=this.session.documentToScreenPosition(this.cursor),a=this.session.documentToScreenPosition(this.anchor),f=this.rectangularRangeBlock(d,a);f.forEach(this.addRange,this)}},this.rectangularRangeBlock=function(c,l,g){var d=[],a=c.column<l.column;if(a)var f=c.column,s=l.column,v=c.offsetX,$=l.offsetX;else var f=l.column,s=c.column,v=l.offsetX,$=c.offsetX;var x=c.row<l.row;if(x)var C=c.row,k=l.row;else var C=l.row,k=c.row;f<0&&(f=0),C<0&&(C=0),C==k&&(g=!0);for(var R,_=C;_<=k;_++){var O=A.fromPoints(this.session.screenToDocumentPosition(_,f,v),this.session.screenToDocumentPosition(_,s,$));if(O.isEmpty()){if(R&&w(O.end,R))break;R=O.end}O.cursor=a?O.start:O.end,d.push(O)}if(x&&d.reverse(),!g){for(var I=d.length-1;d[I].isEmpty()&&I>0;)I--;if(I>0)for(var D=0;d[D].isEmpty();)D++;for(var T=I;T>=D;T--)d[T].isEmpty()&&d.splice(T,1)}return d}}.call(p.prototype);var m=L("./editor").Editor;(function(){this.updateSelectionMarkers=function(){this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.addSelectionMarker=function(c){c.cursor||(c.cursor=c.end);var l=this.getSelectionStyle();return c.marker=this.session.addMarker(c,"ace_selection",l),this.session.$selectionMarkers.push(c),this.session.selectionMarkerCount=this.session.$selectionMarkers.length,c},this.removeSelectionMarker=function(c){if(c.marker){this.session.removeMarker(c.marker);var l=this.session.$selectionMarkers.indexOf(c);l!=-1&&this.session.$selectionMarkers.splice(l,1),this.session.selectionMarkerCount=this.session.$selectionMarkers.length}},this.removeSelectionMarkers=function(c){for(var l=this.session.$selectionMarkers,g=c.length;g--;){var d=c[g];if(d.marker){this.session.removeMarker(d.marker);var a=l.indexOf(d);a!=-1&&l.splice(a,1)}}this.session.selectionMarkerCount=l.length},this.$onAddRange=function(c){this.addSelectionMarker(c.range),this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.$onRemoveRange=function(c){this.removeSelectionMarkers(c.ranges),this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.$onMultiSelect=function(c){this.inMultiSelectMode||(this.inMultiSelectMode=!0,this.setStyle("ace_multiselect"),this.keyBinding.addKeyboardHandler(e.keyboardHandler),this.commands.setDefaultHandler("exec",this.$onMultiSelectExec),this.renderer.updateCursor(),this.renderer.updateBackMarkers())},this.$onSingleSelect=function(c){this.session.multiSelect.inVirtualMode||(this.inMultiSelectMode=!1,this.unsetStyle("ace_multiselect"),this.keyBinding.removeKeyboardHandler(e.keyboardHandler),this.commands.removeDefaultHandler("exec",this.$onMultiSelectExec),this.renderer.updateCursor(),this.renderer.updateBackMarkers(),this._emit("changeSelection"))},this.$onMultiSelectExec=function(c){var l=c.command,g=c.editor;if(g.multiSelect){if(l.multiSelectAction)l.multiSelectAction=="forEach"?d=g.forEachSelection(l,c.args):l.multiSelectAction=="forEachLine"?d=g.forEachSelection(l,c.args,!0):l.multiSelectAction=="single"?(g.exitMultiSelectMode(),d=l.exec(g,c.args||{})):d=l.multiSelectAction(g,c.args||{});else{var d=l.exec(g,c.args||{});g.multiSelect.addRange(g.multiSelect.toOrientedRange()),g.multiSelect.mergeOverlappingRanges()}return d}},this.forEachSelection=function(c,l,g){if(!this.inVirtualSelectionMode){var d=g&&g.keepOrder,a=g==!0||g&&g.$byLines,f=this.session,s=this.selection,v=s.rangeList,$=(d?s:v).ranges,x;if(!$.length)return c.exec?c.exec(this,l||{}):c(this,l||{});var C=s._eventRegistry;s._eventRegistry={};var k=new p(f);this.inVirtualSelectionMode=!0;for(var R=$.length;R--;){if(a)for(;R>0&&$[R].start.row==$[R-1].end.row;)R--;k.fromOrientedRange($[R]),k.index=R,this.selection=f.selection=k;var _=c.exec?c.exec(this,l||{}):c(this,l||{});!x&&_!==void 0&&(x=_),k.toOrientedRange($[R])}k.detach(),this.selection=f.selection=s,this.inVirtualSelectionMode=!1,s._eventRegistry=C,s.mergeOverlappingRanges(),s.ranges[0]&&s.fromOrientedRange(s.ranges[0]);var O=this.renderer.$scrollAnimation;return this.onCursorChange(),this.onSelectionChange(),O&&O.from==O.to&&this.renderer.animateScrolling(O.from),x}},this.exitMultiSelectMode=function(){!this.inMultiSelectMode||this.inVirtualSelectionMode||this.multiSelect.toSingleRange()},this.getSelectedText=function(){var c="";if(this.inMultiSelectMode&&!this.inVirtualSelectionMode){for(var l=this.multiSelect.rangeList.ranges,g=[],d=0;d<l.length;d++)g.push(this.session.getTextRange(l[d]));var a=this.session.getDocument().getNewLineCharacter();c=g.join(a),c.length==(g.length-1)*a.length&&(c="")}else this.selection.isEmpty()||(c=this.session.getTextRange(this.getSelectionRange()));return c},this.$checkMultiselectChange=function(c,l){if(this.inMultiSelectMode&&!this.inVirtualSelectionMode){var g=this.multiSelect.ranges[0];if(this.multiSelect.isEmpty()&&l==this.multiSelect.anchor)return;var d=l==this.multiSelect.anchor?g.cursor==g.start?g.end:g.start:g.cursor;d.row!=l.row||this.session.$clipPositionToDocument(d.row,d.column).column!=l.column?this.multiSelect.toSingleRange(this.multiSelect.toOrientedRange()):this.multiSelect.mergeOverlappingRanges()}},this.findAll=function(c,l,g){if(l=l||{},l.needle=c||l.needle,l.needle==null){var d=this.selection.isEmpty()?this.selection.getWordRange():this.selection.getRange();l.needle=this.session.getTextRange(d)}this.$search.set(l);var a=this.$search.findAll(this.session);if(!a.length)return 0;var f=this.multiSelect;g||f.toSingleRange(a[0]);for(var s=a.length;s--;)f.addRange(a[s],!0);return d&&f.rangeList.rangeAtPoint(d.start)&&f.addRange(d,!0),a.length},this.selectMoreLines=function(c,l){var g=this.selection.toOrientedRange(),d=g.cursor==g.end,a=this.session.documentToScreenPosition(g.cursor);this.selection.$desiredColumn&&(a.column=this.selection.$desiredColumn);var f=this.session.screenToDocumentPosition(a.row+c,a.column);if(g.isEmpty())var v=f;else var s=this.session.documentToScreenPosition(d?g.end:g.start),v=this.session.screenToDocumentPosition(s.row+c,s.column);if(d){var $=A.fromPoints(f,v);$.cursor=$.start}else{var $=A.fromPoints(v,f);$.cursor=$.end}if($.desiredColumn=a.column,!this.selection.inMultiSelectMode)this.selection.addRange(g);else if(l)var x=g.cursor;this.selection.addRange($),x&&this.selection.substractPoint(x)},this.transposeSelections=function(c){for(var l=this.session,g=l.multiSelect,d=g.ranges,a=d.length;a--;){var f=d[a];if(f.isEmpty()){var s=l.getWordRange(f.start.row,f.start.column);f.start.row=s.start.row,f.start.column=s.start.column,f.end.row=s.end.row,f.end.column=s.end.column}}g.mergeOverlappingRanges();for(var v=[],a=d.length;a--;){var f=d[a];v.unshift(l.getTextRange(f))}c<0?v.unshift(v.pop()):v.push(v.shift());for(var a=d.length;a--;){var f=d[a],s=f.clone();l.replace(f,v[a]),f.start.row=s.start.row,f.start.column=s.start.column}g.fromOrientedRange(g.ranges[0])},this.selectMore=function(c,l,g){var d=this.session,a=d.multiSelect,f=a.toOrientedRange();if(!(f.isEmpty()&&(f=d.getWordRange(f.start.row,f.start.column),f.cursor=c==-1?f.start:f.end,this.multiSelect.addRange(f),g))){var s=d.getTextRange(f),v=n(d,s,c);v&&(v.cursor=c==-1?v.start:v.end,this.session.unfold(v),this.multiSelect.addRange(v),this.renderer.scrollCursorIntoView(null,.5)),l&&this.multiSelect.substractPoint(f.cursor)}},this.alignCursors=function(){var c=this.session,l=c.multiSelect,g=l.ranges,d=-1,a=g.filter(function(I){if(I.cursor.row==d)return!0;d=I.cursor.row});if(!g.length||a.length==g.length-1){var f=this.selection.getRange(),s=f.start.row,v=f.end.row,$=s==v;if($){var x=this.session.getLength(),C;do C=this.session.getLine(v);while(/[=:]/.test(C)&&++v<x);do C=this.session.getLine(s);while(/[=:]/.test(C)&&--s>0);s<0&&(s=0),v>=x&&(v=x-1)}var k=this.session.removeFullLines(s,v);k=this.$reAlignText(k,$),this.session.insert({row:s,column:0},k.join(`
+`+r(this.$redoStack)}}).call(E.prototype);function A(u,o){for(var f=o;f--;){var s=u[f];if(s&&!s[0].ignore){for(;f<o-1;){var v=m(u[f],u[f+1]);u[f]=v[0],u[f+1]=v[1],f++}return!0}}}var p=k("./range").Range,h=p.comparePoints,d=p.comparePoints;function t(u){var o=u.action=="insert",f=u.start,s=u.end,v=(s.row-f.row)*(o?1:-1),$=(s.column-f.column)*(o?1:-1);o&&(s=f);for(var C in this.marks){var x=this.marks[C],L=d(x,f);if(!(L<0)){if(L===0&&o)if(x.bias==1)L=1;else{x.bias==-1;continue}var R=o?L:d(x,s);if(R>0){x.row+=v,x.column+=x.row==s.row?$:0;continue}!o&&R<=0&&(x.row=f.row,x.column=f.column,R===0&&(x.bias=1))}}}function e(u){return{row:u.row,column:u.column}}function i(u){return{start:e(u.start),end:e(u.end),action:u.action,lines:u.lines.slice()}}function r(u){if(u=u||this,Array.isArray(u))return u.map(r).join(`
+`);var o="";return u.action?(o=u.action=="insert"?"+":"-",o+="["+u.lines+"]"):u.value&&(Array.isArray(u.value)?o=u.value.map(n).join(`
+`):o=n(u.value)),u.start&&(o+=n(u)),(u.id||u.rev)&&(o+="	("+(u.id||u.rev)+")"),o}function n(u){return u.start.row+":"+u.start.column+"=>"+u.end.row+":"+u.end.column}function a(u,o){var f=u.action=="insert",s=o.action=="insert";if(f&&s)if(h(o.start,u.end)>=0)S(o,u,-1);else if(h(o.start,u.start)<=0)S(u,o,1);else return null;else if(f&&!s)if(h(o.start,u.end)>=0)S(o,u,-1);else if(h(o.end,u.start)<=0)S(u,o,-1);else return null;else if(!f&&s)if(h(o.start,u.start)>=0)S(o,u,1);else if(h(o.start,u.start)<=0)S(u,o,1);else return null;else if(!f&&!s)if(h(o.start,u.start)>=0)S(o,u,1);else if(h(o.end,u.start)<=0)S(u,o,-1);else return null;return[o,u]}function m(u,o){for(var f=u.length;f--;)for(var s=0;s<o.length;s++)if(!a(u[f],o[s])){for(;f<u.length;){for(;s--;)a(o[s],u[f]);s=o.length,f++}return[u,o]}return u.selectionBefore=o.selectionBefore=u.selectionAfter=o.selectionAfter=null,[o,u]}function w(u,o){var f=u.action=="insert",s=o.action=="insert";if(f&&s)h(u.start,o.start)<0?S(o,u,1):S(u,o,1);else if(f&&!s)h(u.start,o.end)>=0?S(u,o,-1):(h(u.start,o.start)<=0||S(u,p.fromPoints(o.start,u.start),-1),S(o,u,1));else if(!f&&s)h(o.start,u.end)>=0?S(o,u,-1):(h(o.start,u.start)<=0||S(o,p.fromPoints(u.start,o.start),-1),S(u,o,1));else if(!f&&!s)if(h(o.start,u.end)>=0)S(o,u,-1);else if(h(o.end,u.start)<=0)S(u,o,-1);else{var v,$;return h(u.start,o.start)<0&&(v=u,u=c(u,o.start)),h(u.end,o.end)>0&&($=c(u,o.end)),b(o.end,u.start,u.end,-1),$&&!v&&(u.lines=$.lines,u.start=$.start,u.end=$.end,$=u),[o,v,$].filter(Boolean)}return[o,u]}function S(u,o,f){b(u.start,o.start,o.end,f),b(u.end,o.start,o.end,f)}function b(u,o,f,s){u.row==(s==1?o:f).row&&(u.column+=s*(f.column-o.column)),u.row+=s*(f.row-o.row)}function c(u,o){var f=u.lines,s=u.end;u.end=e(o);var v=u.end.row-u.start.row,$=f.splice(v,f.length),C=v?o.column:o.column-u.start.column;f.push($[0].substring(0,C)),$[0]=$[0].substr(C);var x={start:e(o),end:s,lines:$,action:u.action};return x}function l(u,o){o=i(o);for(var f=u.length;f--;){for(var s=u[f],v=0;v<s.length;v++){var $=s[v],C=w($,o);o=C[0],C.length!=2&&(C[2]?(s.splice(v+1,1,C[1],C[2]),v++):C[1]||(s.splice(v,1),v--))}s.length||u.splice(f,1)}return u}function g(u,o){for(var f=0;f<o.length;f++)for(var s=o[f],v=0;v<s.length;v++)l(u,s[v])}y.UndoManager=E}),define("ace/layer/lines",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";var E=k("../lib/dom"),A=function(p,h){this.element=p,this.canvasHeight=h||5e5,this.element.style.height=this.canvasHeight*2+"px",this.cells=[],this.cellCache=[],this.$offsetCoefficient=0};(function(){this.moveContainer=function(p){E.translate(this.element,0,-(p.firstRowScreen*p.lineHeight%this.canvasHeight)-p.offset*this.$offsetCoefficient)},this.pageChanged=function(p,h){return Math.floor(p.firstRowScreen*p.lineHeight/this.canvasHeight)!==Math.floor(h.firstRowScreen*h.lineHeight/this.canvasHeight)},this.computeLineTop=function(p,h,d){var t=h.firstRowScreen*h.lineHeight,e=Math.floor(t/this.canvasHeight),i=d.documentToScreenRow(p,0)*h.lineHeight;return i-e*this.canvasHeight},this.computeLineHeight=function(p,h,d){return h.lineHeight*d.getRowLineCount(p)},this.getLength=function(){return this.cells.length},this.get=function(p){return this.cells[p]},this.shift=function(){this.$cacheCell(this.cells.shift())},this.pop=function(){this.$cacheCell(this.cells.pop())},this.push=function(p){if(Array.isArray(p)){this.cells.push.apply(this.cells,p);for(var h=E.createFragment(this.element),d=0;d<p.length;d++)h.appendChild(p[d].element);this.element.appendChild(h)}else this.cells.push(p),this.element.appendChild(p.element)},this.unshift=function(p){if(Array.isArray(p)){this.cells.unshift.apply(this.cells,p);for(var h=E.createFragment(this.element),d=0;d<p.length;d++)h.appendChild(p[d].element);this.element.firstChild?this.element.insertBefore(h,this.element.firstChild):this.element.appendChild(h)}else this.cells.unshift(p),this.element.insertAdjacentElement("afterbegin",p.element)},this.last=function(){return this.cells.length?this.cells[this.cells.length-1]:null},this.$cacheCell=function(p){p&&(p.element.remove(),this.cellCache.push(p))},this.createCell=function(p,h,d,t){var e=this.cellCache.pop();if(!e){var i=E.createElement("div");t&&t(i),this.element.appendChild(i),e={element:i,text:"",row:p}}return e.row=p,e}}).call(A.prototype),y.Lines=A}),define("ace/layer/gutter",["require","exports","module","ace/lib/dom","ace/lib/oop","ace/lib/lang","ace/lib/event_emitter","ace/layer/lines"],function(k,y,B){"use strict";var E=k("../lib/dom"),A=k("../lib/oop"),p=k("../lib/lang"),h=k("../lib/event_emitter").EventEmitter,d=k("./lines").Lines,t=function(i){this.element=E.createElement("div"),this.element.className="ace_layer ace_gutter-layer",i.appendChild(this.element),this.setShowFoldWidgets(this.$showFoldWidgets),this.gutterWidth=0,this.$annotations=[],this.$updateAnnotations=this.$updateAnnotations.bind(this),this.$lines=new d(this.element),this.$lines.$offsetCoefficient=1};(function(){A.implement(this,h),this.setSession=function(i){this.session&&this.session.off("change",this.$updateAnnotations),this.session=i,i&&i.on("change",this.$updateAnnotations)},this.addGutterDecoration=function(i,r){window.console&&console.warn&&console.warn("deprecated use session.addGutterDecoration"),this.session.addGutterDecoration(i,r)},this.removeGutterDecoration=function(i,r){window.console&&console.warn&&console.warn("deprecated use session.removeGutterDecoration"),this.session.removeGutterDecoration(i,r)},this.setAnnotations=function(i){this.$annotations=[];for(var r=0;r<i.length;r++){var n=i[r],a=n.row,m=this.$annotations[a];m||(m=this.$annotations[a]={text:[]});var w=n.text;w=w?p.escapeHTML(w):n.html||"",m.text.indexOf(w)===-1&&m.text.push(w);var S=n.type;S=="error"?m.className=" ace_error":S=="warning"&&m.className!=" ace_error"?m.className=" ace_warning":S=="info"&&!m.className&&(m.className=" ace_info")}},this.$updateAnnotations=function(i){if(this.$annotations.length){var r=i.start.row,n=i.end.row-r;if(n!==0)if(i.action=="remove")this.$annotations.splice(r,n+1,null);else{var a=new Array(n+1);a.unshift(r,1),this.$annotations.splice.apply(this.$annotations,a)}}},this.update=function(i){this.config=i;var r=this.session,n=i.firstRow,a=Math.min(i.lastRow+i.gutterOffset,r.getLength()-1);this.oldLastRow=a,this.config=i,this.$lines.moveContainer(i),this.$updateCursorRow();for(var m=r.getNextFoldLine(n),w=m?m.start.row:1/0,S=null,b=-1,c=n;;){if(c>w&&(c=m.end.row+1,m=r.getNextFoldLine(c,m),w=m?m.start.row:1/0),c>a){for(;this.$lines.getLength()>b+1;)this.$lines.pop();break}S=this.$lines.get(++b),S?S.row=c:(S=this.$lines.createCell(c,i,this.session,e),this.$lines.push(S)),this.$renderCell(S,i,m,c),c++}this._signal("afterRender"),this.$updateGutterWidth(i)},this.$updateGutterWidth=function(i){var r=this.session,n=r.gutterRenderer||this.$renderer,a=r.$firstLineNumber,m=this.$lines.last()?this.$lines.last().text:"";(this.$fixedWidth||r.$useWrapMode)&&(m=r.getLength()+a-1);var w=n?n.getWidth(r,m,i):m.toString().length*i.characterWidth,S=this.$padding||this.$computePadding();w+=S.left+S.right,w!==this.gutterWidth&&!isNaN(w)&&(this.gutterWidth=w,this.element.parentNode.style.width=this.element.style.width=Math.ceil(this.gutterWidth)+"px",this._signal("changeGutterWidth",w))},this.$updateCursorRow=function(){if(this.$highlightGutterLine){var i=this.session.selection.getCursor();this.$cursorRow!==i.row&&(this.$cursorRow=i.row)}},this.updateLineHighlight=function(){if(this.$highlightGutterLine){var i=this.session.selection.cursor.row;if(this.$cursorRow=i,!(this.$cursorCell&&this.$cursorCell.row==i)){this.$cursorCell&&(this.$cursorCell.element.className=this.$cursorCell.element.className.replace("ace_gutter-active-line ",""));var r=this.$lines.cells;this.$cursorCell=null;for(var n=0;n<r.length;n++){var a=r[n];if(a.row>=this.$cursorRow){if(a.row>this.$cursorRow){var m=this.session.getFoldLine(this.$cursorRow);if(n>0&&m&&m.start.row==r[n-1].row)a=r[n-1];else break}a.element.className="ace_gutter-active-line "+a.element.className,this.$cursorCell=a;break}}}}},this.scrollLines=function(i){var r=this.config;if(this.config=i,this.$updateCursorRow(),this.$lines.pageChanged(r,i))return this.update(i);this.$lines.moveContainer(i);var n=Math.min(i.lastRow+i.gutterOffset,this.session.getLength()-1),a=this.oldLastRow;if(this.oldLastRow=n,!r||a<i.firstRow)return this.update(i);if(n<r.firstRow)return this.update(i);if(r.firstRow<i.firstRow)for(var m=this.session.getFoldedRowCount(r.firstRow,i.firstRow-1);m>0;m--)this.$lines.shift();if(a>n)for(var m=this.session.getFoldedRowCount(n+1,a);m>0;m--)this.$lines.pop();i.firstRow<r.firstRow&&this.$lines.unshift(this.$renderLines(i,i.firstRow,r.firstRow-1)),n>a&&this.$lines.push(this.$renderLines(i,a+1,n)),this.updateLineHighlight(),this._signal("afterRender"),this.$updateGutterWidth(i)},this.$renderLines=function(i,r,n){for(var a=[],m=r,w=this.session.getNextFoldLine(m),S=w?w.start.row:1/0;m>S&&(m=w.end.row+1,w=this.session.getNextFoldLine(m,w),S=w?w.start.row:1/0),!(m>n);){var b=this.$lines.createCell(m,i,this.session,e);this.$renderCell(b,i,w,m),a.push(b),m++}return a},this.$renderCell=function(i,r,n,a){var m=i.element,w=this.session,S=m.childNodes[0],b=m.childNodes[1],c=w.$firstLineNumber,l=w.$breakpoints,g=w.$decorations,u=w.gutterRenderer||this.$renderer,o=this.$showFoldWidgets&&w.foldWidgets,f=n?n.start.row:Number.MAX_VALUE,s="ace_gutter-cell ";if(this.$highlightGutterLine&&(a==this.$cursorRow||n&&a<this.$cursorRow&&a>=f&&this.$cursorRow<=n.end.row)&&(s+="ace_gutter-active-line ",this.$cursorCell!=i&&(this.$cursorCell&&(this.$cursorCell.element.className=this.$cursorCell.element.className.replace("ace_gutter-active-line ","")),this.$cursorCell=i)),l[a]&&(s+=l[a]),g[a]&&(s+=g[a]),this.$annotations[a]&&(s+=this.$annotations[a].className),m.className!=s&&(m.className=s),o){var v=o[a];v==null&&(v=o[a]=w.getFoldWidget(a))}if(v){var s="ace_fold-widget ace_"+v;v=="start"&&a==f&&a<n.end.row?s+=" ace_closed":s+=" ace_open",b.className!=s&&(b.className=s);var $=r.lineHeight+"px";E.setStyle(b.style,"height",$),E.setStyle(b.style,"display","inline-block")}else b&&E.setStyle(b.style,"display","none");var C=(u?u.getText(w,a):a+c).toString();return C!==S.data&&(S.data=C),E.setStyle(i.element.style,"height",this.$lines.computeLineHeight(a,r,w)+"px"),E.setStyle(i.element.style,"top",this.$lines.computeLineTop(a,r,w)+"px"),i.text=C,i},this.$fixedWidth=!1,this.$highlightGutterLine=!0,this.$renderer="",this.setHighlightGutterLine=function(i){this.$highlightGutterLine=i},this.$showLineNumbers=!0,this.$renderer="",this.setShowLineNumbers=function(i){this.$renderer=!i&&{getWidth:function(){return 0},getText:function(){return""}}},this.getShowLineNumbers=function(){return this.$showLineNumbers},this.$showFoldWidgets=!0,this.setShowFoldWidgets=function(i){i?E.addCssClass(this.element,"ace_folding-enabled"):E.removeCssClass(this.element,"ace_folding-enabled"),this.$showFoldWidgets=i,this.$padding=null},this.getShowFoldWidgets=function(){return this.$showFoldWidgets},this.$computePadding=function(){if(!this.element.firstChild)return{left:0,right:0};var i=E.computedStyle(this.element.firstChild);return this.$padding={},this.$padding.left=(parseInt(i.borderLeftWidth)||0)+(parseInt(i.paddingLeft)||0)+1,this.$padding.right=(parseInt(i.borderRightWidth)||0)+(parseInt(i.paddingRight)||0),this.$padding},this.getRegion=function(i){var r=this.$padding||this.$computePadding(),n=this.element.getBoundingClientRect();if(i.x<r.left+n.left)return"markers";if(this.$showFoldWidgets&&i.x>n.right-r.right)return"foldWidgets"}}).call(t.prototype);function e(i){var r=document.createTextNode("");i.appendChild(r);var n=E.createElement("span");return i.appendChild(n),i}y.Gutter=t}),define("ace/layer/marker",["require","exports","module","ace/range","ace/lib/dom"],function(k,y,B){"use strict";var E=k("../range").Range,A=k("../lib/dom"),p=function(h){this.element=A.createElement("div"),this.element.className="ace_layer ace_marker-layer",h.appendChild(this.element)};(function(){this.$padding=0,this.setPadding=function(d){this.$padding=d},this.setSession=function(d){this.session=d},this.setMarkers=function(d){this.markers=d},this.elt=function(d,t){var e=this.i!=-1&&this.element.childNodes[this.i];e?this.i++:(e=document.createElement("div"),this.element.appendChild(e),this.i=-1),e.style.cssText=t,e.className=d},this.update=function(d){if(d){this.config=d,this.i=0;var t;for(var e in this.markers){var i=this.markers[e];if(!i.range){i.update(t,this,this.session,d);continue}var r=i.range.clipRows(d.firstRow,d.lastRow);if(!r.isEmpty())if(r=r.toScreenRange(this.session),i.renderer){var n=this.$getTop(r.start.row,d),a=this.$padding+r.start.column*d.characterWidth;i.renderer(t,r,a,n,d)}else i.type=="fullLine"?this.drawFullLineMarker(t,r,i.clazz,d):i.type=="screenLine"?this.drawScreenLineMarker(t,r,i.clazz,d):r.isMultiLine()?i.type=="text"?this.drawTextMarker(t,r,i.clazz,d):this.drawMultiLineMarker(t,r,i.clazz,d):this.drawSingleLineMarker(t,r,i.clazz+" ace_start ace_br15",d)}if(this.i!=-1)for(;this.i<this.element.childElementCount;)this.element.removeChild(this.element.lastChild)}},this.$getTop=function(d,t){return(d-t.firstRowScreen)*t.lineHeight};function h(d,t,e,i){return(d?1:0)|(t?2:0)|(e?4:0)|(i?8:0)}this.drawTextMarker=function(d,t,e,i,r){for(var n=this.session,a=t.start.row,m=t.end.row,w=a,S=0,b=0,c=n.getScreenLastRowColumn(w),l=new E(w,t.start.column,w,b);w<=m;w++)l.start.row=l.end.row=w,l.start.column=w==a?t.start.column:n.getRowWrapIndent(w),l.end.column=c,S=b,b=c,c=w+1<m?n.getScreenLastRowColumn(w+1):w==m?0:t.end.column,this.drawSingleLineMarker(d,l,e+(w==a?" ace_start":"")+" ace_br"+h(w==a||w==a+1&&t.start.column,S<b,b>c,w==m),i,w==m?0:1,r)},this.drawMultiLineMarker=function(d,t,e,i,r){var n=this.$padding,a=i.lineHeight,m=this.$getTop(t.start.row,i),w=n+t.start.column*i.characterWidth;if(r=r||"",this.session.$bidiHandler.isBidiRow(t.start.row)){var S=t.clone();S.end.row=S.start.row,S.end.column=this.session.getLine(S.start.row).length,this.drawBidiSingleLineMarker(d,S,e+" ace_br1 ace_start",i,null,r)}else this.elt(e+" ace_br1 ace_start","height:"+a+"px;right:0;top:"+m+"px;left:"+w+"px;"+(r||""));if(this.session.$bidiHandler.isBidiRow(t.end.row)){var S=t.clone();S.start.row=S.end.row,S.start.column=0,this.drawBidiSingleLineMarker(d,S,e+" ace_br12",i,null,r)}else{m=this.$getTop(t.end.row,i);var b=t.end.column*i.characterWidth;this.elt(e+" ace_br12","height:"+a+"px;width:"+b+"px;top:"+m+"px;left:"+n+"px;"+(r||""))}if(a=(t.end.row-t.start.row-1)*i.lineHeight,!(a<=0)){m=this.$getTop(t.start.row+1,i);var c=(t.start.column?1:0)|(t.end.column?0:8);this.elt(e+(c?" ace_br"+c:""),"height:"+a+"px;right:0;top:"+m+"px;left:"+n+"px;"+(r||""))}},this.drawSingleLineMarker=function(d,t,e,i,r,n){if(this.session.$bidiHandler.isBidiRow(t.start.row))return this.drawBidiSingleLineMarker(d,t,e,i,r,n);var a=i.lineHeight,m=(t.end.column+(r||0)-t.start.column)*i.characterWidth,w=this.$getTop(t.start.row,i),S=this.$padding+t.start.column*i.characterWidth;this.elt(e,"height:"+a+"px;width:"+m+"px;top:"+w+"px;left:"+S+"px;"+(n||""))},this.drawBidiSingleLineMarker=function(d,t,e,i,r,n){var a=i.lineHeight,m=this.$getTop(t.start.row,i),w=this.$padding,S=this.session.$bidiHandler.getSelections(t.start.column,t.end.column);S.forEach(function(b){this.elt(e,"height:"+a+"px;width:"+b.width+(r||0)+"px;top:"+m+"px;left:"+(w+b.left)+"px;"+(n||""))},this)},this.drawFullLineMarker=function(d,t,e,i,r){var n=this.$getTop(t.start.row,i),a=i.lineHeight;t.start.row!=t.end.row&&(a+=this.$getTop(t.end.row,i)-n),this.elt(e,"height:"+a+"px;top:"+n+"px;left:0;right:0;"+(r||""))},this.drawScreenLineMarker=function(d,t,e,i,r){var n=this.$getTop(t.start.row,i),a=i.lineHeight;this.elt(e,"height:"+a+"px;top:"+n+"px;left:0;right:0;"+(r||""))}}).call(p.prototype),y.Marker=p}),define("ace/layer/text",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/layer/lines","ace/lib/event_emitter"],function(k,y,B){"use strict";var E=k("../lib/oop"),A=k("../lib/dom"),p=k("../lib/lang"),h=k("./lines").Lines,d=k("../lib/event_emitter").EventEmitter,t=function(e){this.dom=A,this.element=this.dom.createElement("div"),this.element.className="ace_layer ace_text-layer",e.appendChild(this.element),this.$updateEolChar=this.$updateEolChar.bind(this),this.$lines=new h(this.element)};(function(){E.implement(this,d),this.EOF_CHAR="\xB6",this.EOL_CHAR_LF="\xAC",this.EOL_CHAR_CRLF="\xA4",this.EOL_CHAR=this.EOL_CHAR_LF,this.TAB_CHAR="\u2014",this.SPACE_CHAR="\xB7",this.$padding=0,this.MAX_LINE_LENGTH=1e4,this.$updateEolChar=function(){var e=this.session.doc,i=e.getNewLineCharacter()==`
+`&&e.getNewLineMode()!="windows",r=i?this.EOL_CHAR_LF:this.EOL_CHAR_CRLF;if(this.EOL_CHAR!=r)return this.EOL_CHAR=r,!0},this.setPadding=function(e){this.$padding=e,this.element.style.margin="0 "+e+"px"},this.getLineHeight=function(){return this.$fontMetrics.$characterSize.height||0},this.getCharacterWidth=function(){return this.$fontMetrics.$characterSize.width||0},this.$setFontMetrics=function(e){this.$fontMetrics=e,this.$fontMetrics.on("changeCharacterSize",function(i){this._signal("changeCharacterSize",i)}.bind(this)),this.$pollSizeChanges()},this.checkForSizeChanges=function(){this.$fontMetrics.checkForSizeChanges()},this.$pollSizeChanges=function(){return this.$pollSizeChangesTimer=this.$fontMetrics.$pollSizeChanges()},this.setSession=function(e){this.session=e,e&&this.$computeTabString()},this.showInvisibles=!1,this.showSpaces=!1,this.showTabs=!1,this.showEOL=!1,this.setShowInvisibles=function(e){return this.showInvisibles==e?!1:(this.showInvisibles=e,typeof e=="string"?(this.showSpaces=/tab/i.test(e),this.showTabs=/space/i.test(e),this.showEOL=/eol/i.test(e)):this.showSpaces=this.showTabs=this.showEOL=e,this.$computeTabString(),!0)},this.displayIndentGuides=!0,this.setDisplayIndentGuides=function(e){return this.displayIndentGuides==e?!1:(this.displayIndentGuides=e,this.$computeTabString(),!0)},this.$tabStrings=[],this.onChangeTabSize=this.$computeTabString=function(){var e=this.session.getTabSize();this.tabSize=e;for(var i=this.$tabStrings=[0],r=1;r<e+1;r++)if(this.showTabs){var n=this.dom.createElement("span");n.className="ace_invisible ace_invisible_tab",n.textContent=p.stringRepeat(this.TAB_CHAR,r),i.push(n)}else i.push(this.dom.createTextNode(p.stringRepeat(" ",r),this.element));if(this.displayIndentGuides){this.$indentGuideRe=/\s\S| \t|\t |\s$/;var a="ace_indent-guide",m=this.showSpaces?" ace_invisible ace_invisible_space":"",w=this.showSpaces?p.stringRepeat(this.SPACE_CHAR,this.tabSize):p.stringRepeat(" ",this.tabSize),S=this.showTabs?" ace_invisible ace_invisible_tab":"",b=this.showTabs?p.stringRepeat(this.TAB_CHAR,this.tabSize):w,n=this.dom.createElement("span");n.className=a+m,n.textContent=w,this.$tabStrings[" "]=n;var n=this.dom.createElement("span");n.className=a+S,n.textContent=b,this.$tabStrings["	"]=n}},this.updateLines=function(e,i,r){if(this.config.lastRow!=e.lastRow||this.config.firstRow!=e.firstRow)return this.update(e);this.config=e;for(var n=Math.max(i,e.firstRow),a=Math.min(r,e.lastRow),m=this.element.childNodes,w=0,b=e.firstRow;b<n;b++){var c=this.session.getFoldLine(b);if(c)if(c.containsRow(n)){n=c.start.row;break}else b=c.end.row;w++}for(var S=!1,b=n,c=this.session.getNextFoldLine(b),l=c?c.start.row:1/0;b>l&&(b=c.end.row+1,c=this.session.getNextFoldLine(b,c),l=c?c.start.row:1/0),!(b>a);){var g=m[w++];if(g){this.dom.removeChildren(g),this.$renderLine(g,b,b==l?c:!1),S&&(g.style.top=this.$lines.computeLineTop(b,e,this.session)+"px");var u=e.lineHeight*this.session.getRowLength(b)+"px";g.style.height!=u&&(S=!0,g.style.height=u)}b++}if(S)for(;w<this.$lines.cells.length;){var o=this.$lines.cells[w++];o.element.style.top=this.$lines.computeLineTop(o.row,e,this.session)+"px"}},this.scrollLines=function(e){var i=this.config;if(this.config=e,this.$lines.pageChanged(i,e))return this.update(e);this.$lines.moveContainer(e);var r=e.lastRow,n=i?i.lastRow:-1;if(!i||n<e.firstRow)return this.update(e);if(r<i.firstRow)return this.update(e);if(!i||i.lastRow<e.firstRow)return this.update(e);if(e.lastRow<i.firstRow)return this.update(e);if(i.firstRow<e.firstRow)for(var a=this.session.getFoldedRowCount(i.firstRow,e.firstRow-1);a>0;a--)this.$lines.shift();if(i.lastRow>e.lastRow)for(var a=this.session.getFoldedRowCount(e.lastRow+1,i.lastRow);a>0;a--)this.$lines.pop();e.firstRow<i.firstRow&&this.$lines.unshift(this.$renderLinesFragment(e,e.firstRow,i.firstRow-1)),e.lastRow>i.lastRow&&this.$lines.push(this.$renderLinesFragment(e,i.lastRow+1,e.lastRow))},this.$renderLinesFragment=function(e,i,r){for(var n=[],a=i,m=this.session.getNextFoldLine(a),w=m?m.start.row:1/0;a>w&&(a=m.end.row+1,m=this.session.getNextFoldLine(a,m),w=m?m.start.row:1/0),!(a>r);){var S=this.$lines.createCell(a,e,this.session),b=S.element;this.dom.removeChildren(b),A.setStyle(b.style,"height",this.$lines.computeLineHeight(a,e,this.session)+"px"),A.setStyle(b.style,"top",this.$lines.computeLineTop(a,e,this.session)+"px"),this.$renderLine(b,a,a==w?m:!1),this.$useLineGroups()?b.className="ace_line_group":b.className="ace_line",n.push(S),a++}return n},this.update=function(e){this.$lines.moveContainer(e),this.config=e;for(var i=e.firstRow,r=e.lastRow,n=this.$lines;n.getLength();)n.pop();n.push(this.$renderLinesFragment(e,i,r))},this.$textToken={text:!0,rparen:!0,lparen:!0},this.$renderToken=function(e,i,r,n){for(var a=this,m=/(\t)|( +)|([\x00-\x1f\x80-\xa0\xad\u1680\u180E\u2000-\u200f\u2028\u2029\u202F\u205F\uFEFF\uFFF9-\uFFFC]+)|(\u3000)|([\u1100-\u115F\u11A3-\u11A7\u11FA-\u11FF\u2329-\u232A\u2E80-\u2E99\u2E9B-\u2EF3\u2F00-\u2FD5\u2FF0-\u2FFB\u3001-\u303E\u3041-\u3096\u3099-\u30FF\u3105-\u312D\u3131-\u318E\u3190-\u31BA\u31C0-\u31E3\u31F0-\u321E\u3220-\u3247\u3250-\u32FE\u3300-\u4DBF\u4E00-\uA48C\uA490-\uA4C6\uA960-\uA97C\uAC00-\uD7A3\uD7B0-\uD7C6\uD7CB-\uD7FB\uF900-\uFAFF\uFE10-\uFE19\uFE30-\uFE52\uFE54-\uFE66\uFE68-\uFE6B\uFF01-\uFF60\uFFE0-\uFFE6]|[\uD800-\uDBFF][\uDC00-\uDFFF])/g,w=this.dom.createFragment(this.element),S,b=0;S=m.exec(n);){var c=S[1],l=S[2],g=S[3],u=S[4],o=S[5];if(!(!a.showSpaces&&l)){var f=b!=S.index?n.slice(b,S.index):"";if(b=S.index+S[0].length,f&&w.appendChild(this.dom.createTextNode(f,this.element)),c){var s=a.session.getScreenTabSize(i+S.index);w.appendChild(a.$tabStrings[s].cloneNode(!0)),i+=s-1}else if(l)if(a.showSpaces){var v=this.dom.createElement("span");v.className="ace_invisible ace_invisible_space",v.textContent=p.stringRepeat(a.SPACE_CHAR,l.length),w.appendChild(v)}else w.appendChild(this.com.createTextNode(l,this.element));else if(g){var v=this.dom.createElement("span");v.className="ace_invisible ace_invisible_space ace_invalid",v.textContent=p.stringRepeat(a.SPACE_CHAR,g.length),w.appendChild(v)}else if(u){i+=1;var v=this.dom.createElement("span");v.style.width=a.config.characterWidth*2+"px",v.className=a.showSpaces?"ace_cjk ace_invisible ace_invisible_space":"ace_cjk",v.textContent=a.showSpaces?a.SPACE_CHAR:u,w.appendChild(v)}else if(o){i+=1;var v=this.dom.createElement("span");v.style.width=a.config.characterWidth*2+"px",v.className="ace_cjk",v.textContent=o,w.appendChild(v)}}}if(w.appendChild(this.dom.createTextNode(b?n.slice(b):n,this.element)),this.$textToken[r.type])e.appendChild(w);else{var $="ace_"+r.type.replace(/\./g," ace_"),v=this.dom.createElement("span");r.type=="fold"&&(v.style.width=r.value.length*this.config.characterWidth+"px"),v.className=$,v.appendChild(w),e.appendChild(v)}return i+n.length},this.renderIndentGuide=function(e,i,r){var n=i.search(this.$indentGuideRe);if(n<=0||n>=r)return i;if(i[0]==" "){n-=n%this.tabSize;for(var a=n/this.tabSize,m=0;m<a;m++)e.appendChild(this.$tabStrings[" "].cloneNode(!0));return i.substr(n)}else if(i[0]=="	"){for(var m=0;m<n;m++)e.appendChild(this.$tabStrings["	"].cloneNode(!0));return i.substr(n)}return i},this.$createLineElement=function(e){var i=this.dom.createElement("div");return i.className="ace_line",i.style.height=this.config.lineHeight+"px",i},this.$renderWrappedLine=function(e,i,r){var n=0,a=0,m=r[0],w=0,S=this.$createLineElement();e.appendChild(S);for(var b=0;b<i.length;b++){var c=i[b],l=c.value;if(b==0&&this.displayIndentGuides){if(n=l.length,l=this.renderIndentGuide(S,l,m),!l)continue;n-=l.length}if(n+l.length<m)w=this.$renderToken(S,w,c,l),n+=l.length;else{for(;n+l.length>=m;)w=this.$renderToken(S,w,c,l.substring(0,m-n)),l=l.substring(m-n),n=m,S=this.$createLineElement(),e.appendChild(S),S.appendChild(this.dom.createTextNode(p.stringRepeat("\xA0",r.indent),this.element)),a++,w=0,m=r[a]||Number.MAX_VALUE;l.length!=0&&(n+=l.length,w=this.$renderToken(S,w,c,l))}}r[r.length-1]>this.MAX_LINE_LENGTH&&this.$renderOverflowMessage(S,w,null,"",!0)},this.$renderSimpleLine=function(e,i){var r=0,n=i[0],a=n.value;this.displayIndentGuides&&(a=this.renderIndentGuide(e,a)),a&&(r=this.$renderToken(e,r,n,a));for(var m=1;m<i.length;m++){if(n=i[m],a=n.value,r+a.length>this.MAX_LINE_LENGTH)return this.$renderOverflowMessage(e,r,n,a);r=this.$renderToken(e,r,n,a)}},this.$renderOverflowMessage=function(e,i,r,n,a){r&&this.$renderToken(e,i,r,n.slice(0,this.MAX_LINE_LENGTH-i));var m=this.dom.createElement("span");m.className="ace_inline_button ace_keyword ace_toggle_wrap",m.textContent=a?"<hide>":"<click to see more...>",e.appendChild(m)},this.$renderLine=function(e,i,r){if(!r&&r!=!1&&(r=this.session.getFoldLine(i)),r)var n=this.$getFoldLineTokens(i,r);else var n=this.session.getTokens(i);var a=e;if(n.length){var m=this.session.getRowSplitData(i);if(m&&m.length){this.$renderWrappedLine(e,n,m);var a=e.lastChild}else{var a=e;this.$useLineGroups()&&(a=this.$createLineElement(),e.appendChild(a)),this.$renderSimpleLine(a,n)}}else this.$useLineGroups()&&(a=this.$createLineElement(),e.appendChild(a));if(this.showEOL&&a){r&&(i=r.end.row);var w=this.dom.createElement("span");w.className="ace_invisible ace_invisible_eol",w.textContent=i==this.session.getLength()-1?this.EOF_CHAR:this.EOL_CHAR,a.appendChild(w)}},this.$getFoldLineTokens=function(e,i){var r=this.session,n=[];function a(w,S,b){for(var c=0,l=0;l+w[c].value.length<S;)if(l+=w[c].value.length,c++,c==w.length)return;if(l!=S){var g=w[c].value.substring(S-l);g.length>b-S&&(g=g.substring(0,b-S)),n.push({type:w[c].type,value:g}),l=S+g.length,c+=1}for(;l<b&&c<w.length;){var g=w[c].value;g.length+l>b?n.push({type:w[c].type,value:g.substring(0,b-l)}):n.push(w[c]),l+=g.length,c+=1}}var m=r.getTokens(e);return i.walk(function(w,S,b,c,l){w!=null?n.push({type:"fold",value:w}):(l&&(m=r.getTokens(S)),m.length&&a(m,c,b))},i.end.row,this.session.getLine(i.end.row).length),n},this.$useLineGroups=function(){return this.session.getUseWrapMode()},this.destroy=function(){}}).call(t.prototype),y.Text=t}),define("ace/layer/cursor",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";var E=k("../lib/dom"),A=function(p){this.element=E.createElement("div"),this.element.className="ace_layer ace_cursor-layer",p.appendChild(this.element),this.isVisible=!1,this.isBlinking=!0,this.blinkInterval=1e3,this.smoothBlinking=!1,this.cursors=[],this.cursor=this.addCursor(),E.addCssClass(this.element,"ace_hidden-cursors"),this.$updateCursors=this.$updateOpacity.bind(this)};(function(){this.$updateOpacity=function(p){for(var h=this.cursors,d=h.length;d--;)E.setStyle(h[d].style,"opacity",p?"":"0")},this.$startCssAnimation=function(){for(var p=this.cursors,h=p.length;h--;)p[h].style.animationDuration=this.blinkInterval+"ms";setTimeout(function(){E.addCssClass(this.element,"ace_animate-blinking")}.bind(this))},this.$stopCssAnimation=function(){E.removeCssClass(this.element,"ace_animate-blinking")},this.$padding=0,this.setPadding=function(p){this.$padding=p},this.setSession=function(p){this.session=p},this.setBlinking=function(p){p!=this.isBlinking&&(this.isBlinking=p,this.restartTimer())},this.setBlinkInterval=function(p){p!=this.blinkInterval&&(this.blinkInterval=p,this.restartTimer())},this.setSmoothBlinking=function(p){p!=this.smoothBlinking&&(this.smoothBlinking=p,E.setCssClass(this.element,"ace_smooth-blinking",p),this.$updateCursors(!0),this.restartTimer())},this.addCursor=function(){var p=E.createElement("div");return p.className="ace_cursor",this.element.appendChild(p),this.cursors.push(p),p},this.removeCursor=function(){if(this.cursors.length>1){var p=this.cursors.pop();return p.parentNode.removeChild(p),p}},this.hideCursor=function(){this.isVisible=!1,E.addCssClass(this.element,"ace_hidden-cursors"),this.restartTimer()},this.showCursor=function(){this.isVisible=!0,E.removeCssClass(this.element,"ace_hidden-cursors"),this.restartTimer()},this.restartTimer=function(){var p=this.$updateCursors;if(clearInterval(this.intervalId),clearTimeout(this.timeoutId),this.$stopCssAnimation(),this.smoothBlinking&&E.removeCssClass(this.element,"ace_smooth-blinking"),p(!0),!this.isBlinking||!this.blinkInterval||!this.isVisible){this.$stopCssAnimation();return}if(this.smoothBlinking&&setTimeout(function(){E.addCssClass(this.element,"ace_smooth-blinking")}.bind(this)),E.HAS_CSS_ANIMATION)this.$startCssAnimation();else{var h=function(){this.timeoutId=setTimeout(function(){p(!1)},.6*this.blinkInterval)}.bind(this);this.intervalId=setInterval(function(){p(!0),h()},this.blinkInterval),h()}},this.getPixelPosition=function(p,h){if(!this.config||!this.session)return{left:0,top:0};p||(p=this.session.selection.getCursor());var d=this.session.documentToScreenPosition(p),t=this.$padding+(this.session.$bidiHandler.isBidiRow(d.row,p.row)?this.session.$bidiHandler.getPosLeft(d.column):d.column*this.config.characterWidth),e=(d.row-(h?this.config.firstRowScreen:0))*this.config.lineHeight;return{left:t,top:e}},this.isCursorInView=function(p,h){return p.top>=0&&p.top<h.maxHeight},this.update=function(p){this.config=p;var h=this.session.$selectionMarkers,d=0,t=0;(h===void 0||h.length===0)&&(h=[{cursor:null}]);for(var d=0,e=h.length;d<e;d++){var i=this.getPixelPosition(h[d].cursor,!0);if(!((i.top>p.height+p.offset||i.top<0)&&d>1)){var r=this.cursors[t++]||this.addCursor(),n=r.style;this.drawCursor?this.drawCursor(r,i,p,h[d],this.session):this.isCursorInView(i,p)?(E.setStyle(n,"display","block"),E.translate(r,i.left,i.top),E.setStyle(n,"width",Math.round(p.characterWidth)+"px"),E.setStyle(n,"height",p.lineHeight+"px")):E.setStyle(n,"display","none")}}for(;this.cursors.length>t;)this.removeCursor();var a=this.session.getOverwrite();this.$setOverwrite(a),this.$pixelPos=i,this.restartTimer()},this.drawCursor=null,this.$setOverwrite=function(p){p!=this.overwrite&&(this.overwrite=p,p?E.addCssClass(this.element,"ace_overwrite-cursors"):E.removeCssClass(this.element,"ace_overwrite-cursors"))},this.destroy=function(){clearInterval(this.intervalId),clearTimeout(this.timeoutId)}}).call(A.prototype),y.Cursor=A}),define("ace/scrollbar",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/event","ace/lib/event_emitter"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/dom"),p=k("./lib/event"),h=k("./lib/event_emitter").EventEmitter,d=32768,t=function(r){this.element=A.createElement("div"),this.element.className="ace_scrollbar ace_scrollbar"+this.classSuffix,this.inner=A.createElement("div"),this.inner.className="ace_scrollbar-inner",this.inner.textContent="\xA0",this.element.appendChild(this.inner),r.appendChild(this.element),this.setVisible(!1),this.skipEvent=!1,p.addListener(this.element,"scroll",this.onScroll.bind(this)),p.addListener(this.element,"mousedown",p.preventDefault)};(function(){E.implement(this,h),this.setVisible=function(r){this.element.style.display=r?"":"none",this.isVisible=r,this.coeff=1}}).call(t.prototype);var e=function(r,n){t.call(this,r),this.scrollTop=0,this.scrollHeight=0,n.$scrollbarWidth=this.width=A.scrollbarWidth(r.ownerDocument),this.inner.style.width=this.element.style.width=(this.width||15)+5+"px",this.$minWidth=0};E.inherits(e,t),function(){this.classSuffix="-v",this.onScroll=function(){if(!this.skipEvent){if(this.scrollTop=this.element.scrollTop,this.coeff!=1){var r=this.element.clientHeight/this.scrollHeight;this.scrollTop=this.scrollTop*(1-r)/(this.coeff-r)}this._emit("scroll",{data:this.scrollTop})}this.skipEvent=!1},this.getWidth=function(){return Math.max(this.isVisible?this.width:0,this.$minWidth||0)},this.setHeight=function(r){this.element.style.height=r+"px"},this.setInnerHeight=this.setScrollHeight=function(r){this.scrollHeight=r,r>d?(this.coeff=d/r,r=d):this.coeff!=1&&(this.coeff=1),this.inner.style.height=r+"px"},this.setScrollTop=function(r){this.scrollTop!=r&&(this.skipEvent=!0,this.scrollTop=r,this.element.scrollTop=r*this.coeff)}}.call(e.prototype);var i=function(r,n){t.call(this,r),this.scrollLeft=0,this.height=n.$scrollbarWidth,this.inner.style.height=this.element.style.height=(this.height||15)+5+"px"};E.inherits(i,t),function(){this.classSuffix="-h",this.onScroll=function(){this.skipEvent||(this.scrollLeft=this.element.scrollLeft,this._emit("scroll",{data:this.scrollLeft})),this.skipEvent=!1},this.getHeight=function(){return this.isVisible?this.height:0},this.setWidth=function(r){this.element.style.width=r+"px"},this.setInnerWidth=function(r){this.inner.style.width=r+"px"},this.setScrollWidth=function(r){this.inner.style.width=r+"px"},this.setScrollLeft=function(r){this.scrollLeft!=r&&(this.skipEvent=!0,this.scrollLeft=this.element.scrollLeft=r)}}.call(i.prototype),y.ScrollBar=e,y.ScrollBarV=e,y.ScrollBarH=i,y.VScrollBar=e,y.HScrollBar=i}),define("ace/renderloop",["require","exports","module","ace/lib/event"],function(k,y,B){"use strict";var E=k("./lib/event"),A=function(p,h){this.onRender=p,this.pending=!1,this.changes=0,this.$recursionLimit=2,this.window=h||window;var d=this;this._flush=function(t){d.pending=!1;var e=d.changes;if(e&&(E.blockIdle(100),d.changes=0,d.onRender(e)),d.changes){if(d.$recursionLimit--<0)return;d.schedule()}else d.$recursionLimit=2}};(function(){this.schedule=function(p){this.changes=this.changes|p,this.changes&&!this.pending&&(E.nextFrame(this._flush),this.pending=!0)},this.clear=function(p){var h=this.changes;return this.changes=0,h}}).call(A.prototype),y.RenderLoop=A}),define("ace/layer/font_metrics",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/lib/lang","ace/lib/event","ace/lib/useragent","ace/lib/event_emitter"],function(k,y,B){var E=k("../lib/oop"),A=k("../lib/dom"),p=k("../lib/lang"),h=k("../lib/event"),d=k("../lib/useragent"),t=k("../lib/event_emitter").EventEmitter,e=256,i=typeof ResizeObserver=="function",r=200,n=y.FontMetrics=function(a){this.el=A.createElement("div"),this.$setMeasureNodeStyles(this.el.style,!0),this.$main=A.createElement("div"),this.$setMeasureNodeStyles(this.$main.style),this.$measureNode=A.createElement("div"),this.$setMeasureNodeStyles(this.$measureNode.style),this.el.appendChild(this.$main),this.el.appendChild(this.$measureNode),a.appendChild(this.el),this.$measureNode.textContent=p.stringRepeat("X",e),this.$characterSize={width:0,height:0},i?this.$addObserver():this.checkForSizeChanges()};(function(){E.implement(this,t),this.$characterSize={width:0,height:0},this.$setMeasureNodeStyles=function(a,m){a.width=a.height="auto",a.left=a.top="0px",a.visibility="hidden",a.position="absolute",a.whiteSpace="pre",d.isIE<8?a["font-family"]="inherit":a.font="inherit",a.overflow=m?"hidden":"visible"},this.checkForSizeChanges=function(a){if(a===void 0&&(a=this.$measureSizes()),a&&(this.$characterSize.width!==a.width||this.$characterSize.height!==a.height)){this.$measureNode.style.fontWeight="bold";var m=this.$measureSizes();this.$measureNode.style.fontWeight="",this.$characterSize=a,this.charSizes=Object.create(null),this.allowBoldFonts=m&&m.width===a.width&&m.height===a.height,this._emit("changeCharacterSize",{data:a})}},this.$addObserver=function(){var a=this;this.$observer=new window.ResizeObserver(function(m){a.checkForSizeChanges()}),this.$observer.observe(this.$measureNode)},this.$pollSizeChanges=function(){if(this.$pollSizeChangesTimer||this.$observer)return this.$pollSizeChangesTimer;var a=this;return this.$pollSizeChangesTimer=h.onIdle(function m(){a.checkForSizeChanges(),h.onIdle(m,500)},500)},this.setPolling=function(a){a?this.$pollSizeChanges():this.$pollSizeChangesTimer&&(clearInterval(this.$pollSizeChangesTimer),this.$pollSizeChangesTimer=0)},this.$measureSizes=function(a){var m={height:(a||this.$measureNode).clientHeight,width:(a||this.$measureNode).clientWidth/e};return m.width===0||m.height===0?null:m},this.$measureCharWidth=function(a){this.$main.textContent=p.stringRepeat(a,e);var m=this.$main.getBoundingClientRect();return m.width/e},this.getCharacterWidth=function(a){var m=this.charSizes[a];return m===void 0&&(m=this.charSizes[a]=this.$measureCharWidth(a)/this.$characterSize.width),m},this.destroy=function(){clearInterval(this.$pollSizeChangesTimer),this.$observer&&this.$observer.disconnect(),this.el&&this.el.parentNode&&this.el.parentNode.removeChild(this.el)},this.$getZoom=function a(m){return!m||!m.parentElement?1:(window.getComputedStyle(m).zoom||1)*a(m.parentElement)},this.$initTransformMeasureNodes=function(){var a=function(m,w){return["div",{style:"position: absolute;top:"+m+"px;left:"+w+"px;"}]};this.els=A.buildDom([a(0,0),a(r,0),a(0,r),a(r,r)],this.el)},this.transformCoordinates=function(a,m){if(a){var w=this.$getZoom(this.el);a=l(1/w,a)}function S(F,O,M){var P=F[1]*O[0]-F[0]*O[1];return[(-O[1]*M[0]+O[0]*M[1])/P,(+F[1]*M[0]-F[0]*M[1])/P]}function b(F,O){return[F[0]-O[0],F[1]-O[1]]}function c(F,O){return[F[0]+O[0],F[1]+O[1]]}function l(F,O){return[F*O[0],F*O[1]]}this.els||this.$initTransformMeasureNodes();function g(F){var O=F.getBoundingClientRect();return[O.left,O.top]}var u=g(this.els[0]),o=g(this.els[1]),f=g(this.els[2]),s=g(this.els[3]),v=S(b(s,o),b(s,f),b(c(o,f),c(s,u))),$=l(1+v[0],b(o,u)),C=l(1+v[1],b(f,u));if(m){var x=m,L=v[0]*x[0]/r+v[1]*x[1]/r+1,R=c(l(x[0],$),l(x[1],C));return c(l(1/L/r,R),u)}var T=b(a,u),_=S(b($,l(v[0],T)),b(C,l(v[1],T)),T);return l(r,_)}}).call(n.prototype)}),define("ace/virtual_renderer",["require","exports","module","ace/lib/oop","ace/lib/dom","ace/config","ace/layer/gutter","ace/layer/marker","ace/layer/text","ace/layer/cursor","ace/scrollbar","ace/scrollbar","ace/renderloop","ace/layer/font_metrics","ace/lib/event_emitter","ace/lib/useragent"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/dom"),p=k("./config"),h=k("./layer/gutter").Gutter,d=k("./layer/marker").Marker,t=k("./layer/text").Text,e=k("./layer/cursor").Cursor,i=k("./scrollbar").HScrollBar,r=k("./scrollbar").VScrollBar,n=k("./renderloop").RenderLoop,a=k("./layer/font_metrics").FontMetrics,m=k("./lib/event_emitter").EventEmitter,w=`.ace_br1 {border-top-left-radius    : 3px;}.ace_br2 {border-top-right-radius   : 3px;}.ace_br3 {border-top-left-radius    : 3px; border-top-right-radius:    3px;}.ace_br4 {border-bottom-right-radius: 3px;}.ace_br5 {border-top-left-radius    : 3px; border-bottom-right-radius: 3px;}.ace_br6 {border-top-right-radius   : 3px; border-bottom-right-radius: 3px;}.ace_br7 {border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-right-radius: 3px;}.ace_br8 {border-bottom-left-radius : 3px;}.ace_br9 {border-top-left-radius    : 3px; border-bottom-left-radius:  3px;}.ace_br10{border-top-right-radius   : 3px; border-bottom-left-radius:  3px;}.ace_br11{border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-left-radius:  3px;}.ace_br12{border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br13{border-top-left-radius    : 3px; border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br14{border-top-right-radius   : 3px; border-bottom-right-radius: 3px; border-bottom-left-radius:  3px;}.ace_br15{border-top-left-radius    : 3px; border-top-right-radius:    3px; border-bottom-right-radius: 3px; border-bottom-left-radius: 3px;}.ace_editor {position: relative;overflow: hidden;padding: 0;font: 12px/normal 'Monaco', 'Menlo', 'Ubuntu Mono', 'Consolas', 'source-code-pro', monospace;direction: ltr;text-align: left;-webkit-tap-highlight-color: rgba(0, 0, 0, 0);}.ace_scroller {position: absolute;overflow: hidden;top: 0;bottom: 0;background-color: inherit;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;cursor: text;}.ace_content {position: absolute;box-sizing: border-box;min-width: 100%;contain: style size layout;font-variant-ligatures: no-common-ligatures;}.ace_dragging .ace_scroller:before{position: absolute;top: 0;left: 0;right: 0;bottom: 0;content: '';background: rgba(250, 250, 250, 0.01);z-index: 1000;}.ace_dragging.ace_dark .ace_scroller:before{background: rgba(0, 0, 0, 0.01);}.ace_selecting, .ace_selecting * {cursor: text !important;}.ace_gutter {position: absolute;overflow : hidden;width: auto;top: 0;bottom: 0;left: 0;cursor: default;z-index: 4;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;contain: style size layout;}.ace_gutter-active-line {position: absolute;left: 0;right: 0;}.ace_scroller.ace_scroll-left {box-shadow: 17px 0 16px -16px rgba(0, 0, 0, 0.4) inset;}.ace_gutter-cell {position: absolute;top: 0;left: 0;right: 0;padding-left: 19px;padding-right: 6px;background-repeat: no-repeat;}.ace_gutter-cell.ace_error {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAABOFBMVEX/////////QRswFAb/Ui4wFAYwFAYwFAaWGAfDRymzOSH/PxswFAb/SiUwFAYwFAbUPRvjQiDllog5HhHdRybsTi3/Tyv9Tir+Syj/UC3////XurebMBIwFAb/RSHbPx/gUzfdwL3kzMivKBAwFAbbvbnhPx66NhowFAYwFAaZJg8wFAaxKBDZurf/RB6mMxb/SCMwFAYwFAbxQB3+RB4wFAb/Qhy4Oh+4QifbNRcwFAYwFAYwFAb/QRzdNhgwFAYwFAbav7v/Uy7oaE68MBK5LxLewr/r2NXewLswFAaxJw4wFAbkPRy2PyYwFAaxKhLm1tMwFAazPiQwFAaUGAb/QBrfOx3bvrv/VC/maE4wFAbRPBq6MRO8Qynew8Dp2tjfwb0wFAbx6eju5+by6uns4uH9/f36+vr/GkHjAAAAYnRSTlMAGt+64rnWu/bo8eAA4InH3+DwoN7j4eLi4xP99Nfg4+b+/u9B/eDs1MD1mO7+4PHg2MXa347g7vDizMLN4eG+Pv7i5evs/v79yu7S3/DV7/498Yv24eH+4ufQ3Ozu/v7+y13sRqwAAADLSURBVHjaZc/XDsFgGIBhtDrshlitmk2IrbHFqL2pvXf/+78DPokj7+Fz9qpU/9UXJIlhmPaTaQ6QPaz0mm+5gwkgovcV6GZzd5JtCQwgsxoHOvJO15kleRLAnMgHFIESUEPmawB9ngmelTtipwwfASilxOLyiV5UVUyVAfbG0cCPHig+GBkzAENHS0AstVF6bacZIOzgLmxsHbt2OecNgJC83JERmePUYq8ARGkJx6XtFsdddBQgZE2nPR6CICZhawjA4Fb/chv+399kfR+MMMDGOQAAAABJRU5ErkJggg==");background-repeat: no-repeat;background-position: 2px center;}.ace_gutter-cell.ace_warning {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAAAmVBMVEX///8AAAD///8AAAAAAABPSzb/5sAAAAB/blH/73z/ulkAAAAAAAD85pkAAAAAAAACAgP/vGz/rkDerGbGrV7/pkQICAf////e0IsAAAD/oED/qTvhrnUAAAD/yHD/njcAAADuv2r/nz//oTj/p064oGf/zHAAAAA9Nir/tFIAAAD/tlTiuWf/tkIAAACynXEAAAAAAAAtIRW7zBpBAAAAM3RSTlMAABR1m7RXO8Ln31Z36zT+neXe5OzooRDfn+TZ4p3h2hTf4t3k3ucyrN1K5+Xaks52Sfs9CXgrAAAAjklEQVR42o3PbQ+CIBQFYEwboPhSYgoYunIqqLn6/z8uYdH8Vmdnu9vz4WwXgN/xTPRD2+sgOcZjsge/whXZgUaYYvT8QnuJaUrjrHUQreGczuEafQCO/SJTufTbroWsPgsllVhq3wJEk2jUSzX3CUEDJC84707djRc5MTAQxoLgupWRwW6UB5fS++NV8AbOZgnsC7BpEAAAAABJRU5ErkJggg==");background-position: 2px center;}.ace_gutter-cell.ace_info {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAAAAAA6mKC9AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAAJ0Uk5TAAB2k804AAAAPklEQVQY02NgIB68QuO3tiLznjAwpKTgNyDbMegwisCHZUETUZV0ZqOquBpXj2rtnpSJT1AEnnRmL2OgGgAAIKkRQap2htgAAAAASUVORK5CYII=");background-position: 2px center;}.ace_dark .ace_gutter-cell.ace_info {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABAAAAAQBAMAAADt3eJSAAAAJFBMVEUAAAChoaGAgIAqKiq+vr6tra1ZWVmUlJSbm5s8PDxubm56enrdgzg3AAAAAXRSTlMAQObYZgAAAClJREFUeNpjYMAPdsMYHegyJZFQBlsUlMFVCWUYKkAZMxZAGdxlDMQBAG+TBP4B6RyJAAAAAElFTkSuQmCC");}.ace_scrollbar {contain: strict;position: absolute;right: 0;bottom: 0;z-index: 6;}.ace_scrollbar-inner {position: absolute;cursor: text;left: 0;top: 0;}.ace_scrollbar-v{overflow-x: hidden;overflow-y: scroll;top: 0;}.ace_scrollbar-h {overflow-x: scroll;overflow-y: hidden;left: 0;}.ace_print-margin {position: absolute;height: 100%;}.ace_text-input {position: absolute;z-index: 0;width: 0.5em;height: 1em;opacity: 0;background: transparent;-moz-appearance: none;appearance: none;border: none;resize: none;outline: none;overflow: hidden;font: inherit;padding: 0 1px;margin: 0 -1px;contain: strict;-ms-user-select: text;-moz-user-select: text;-webkit-user-select: text;user-select: text;white-space: pre!important;}.ace_text-input.ace_composition {background: transparent;color: inherit;z-index: 1000;opacity: 1;}.ace_composition_placeholder { color: transparent }.ace_composition_marker { border-bottom: 1px solid;position: absolute;border-radius: 0;margin-top: 1px;}[ace_nocontext=true] {transform: none!important;filter: none!important;clip-path: none!important;mask : none!important;contain: none!important;perspective: none!important;mix-blend-mode: initial!important;z-index: auto;}.ace_layer {z-index: 1;position: absolute;overflow: hidden;word-wrap: normal;white-space: pre;height: 100%;width: 100%;box-sizing: border-box;pointer-events: none;}.ace_gutter-layer {position: relative;width: auto;text-align: right;pointer-events: auto;height: 1000000px;contain: style size layout;}.ace_text-layer {font: inherit !important;position: absolute;height: 1000000px;width: 1000000px;contain: style size layout;}.ace_text-layer > .ace_line, .ace_text-layer > .ace_line_group {contain: style size layout;position: absolute;top: 0;left: 0;right: 0;}.ace_hidpi .ace_text-layer,.ace_hidpi .ace_gutter-layer,.ace_hidpi .ace_content,.ace_hidpi .ace_gutter {contain: strict;will-change: transform;}.ace_hidpi .ace_text-layer > .ace_line, .ace_hidpi .ace_text-layer > .ace_line_group {contain: strict;}.ace_cjk {display: inline-block;text-align: center;}.ace_cursor-layer {z-index: 4;}.ace_cursor {z-index: 4;position: absolute;box-sizing: border-box;border-left: 2px solid;transform: translatez(0);}.ace_multiselect .ace_cursor {border-left-width: 1px;}.ace_slim-cursors .ace_cursor {border-left-width: 1px;}.ace_overwrite-cursors .ace_cursor {border-left-width: 0;border-bottom: 1px solid;}.ace_hidden-cursors .ace_cursor {opacity: 0.2;}.ace_hasPlaceholder .ace_hidden-cursors .ace_cursor {opacity: 0;}.ace_smooth-blinking .ace_cursor {transition: opacity 0.18s;}.ace_animate-blinking .ace_cursor {animation-duration: 1000ms;animation-timing-function: step-end;animation-name: blink-ace-animate;animation-iteration-count: infinite;}.ace_animate-blinking.ace_smooth-blinking .ace_cursor {animation-duration: 1000ms;animation-timing-function: ease-in-out;animation-name: blink-ace-animate-smooth;}@keyframes blink-ace-animate {from, to { opacity: 1; }60% { opacity: 0; }}@keyframes blink-ace-animate-smooth {from, to { opacity: 1; }45% { opacity: 1; }60% { opacity: 0; }85% { opacity: 0; }}.ace_marker-layer .ace_step, .ace_marker-layer .ace_stack {position: absolute;z-index: 3;}.ace_marker-layer .ace_selection {position: absolute;z-index: 5;}.ace_marker-layer .ace_bracket {position: absolute;z-index: 6;}.ace_marker-layer .ace_error_bracket {position: absolute;border-bottom: 1px solid #DE5555;border-radius: 0;}.ace_marker-layer .ace_active-line {position: absolute;z-index: 2;}.ace_marker-layer .ace_selected-word {position: absolute;z-index: 4;box-sizing: border-box;}.ace_line .ace_fold {box-sizing: border-box;display: inline-block;height: 11px;margin-top: -2px;vertical-align: middle;background-image:url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABEAAAAJCAYAAADU6McMAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAJpJREFUeNpi/P//PwOlgAXGYGRklAVSokD8GmjwY1wasKljQpYACtpCFeADcHVQfQyMQAwzwAZI3wJKvCLkfKBaMSClBlR7BOQikCFGQEErIH0VqkabiGCAqwUadAzZJRxQr/0gwiXIal8zQQPnNVTgJ1TdawL0T5gBIP1MUJNhBv2HKoQHHjqNrA4WO4zY0glyNKLT2KIfIMAAQsdgGiXvgnYAAAAASUVORK5CYII="),url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAA3CAYAAADNNiA5AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAACJJREFUeNpi+P//fxgTAwPDBxDxD078RSX+YeEyDFMCIMAAI3INmXiwf2YAAAAASUVORK5CYII=");background-repeat: no-repeat, repeat-x;background-position: center center, top left;color: transparent;border: 1px solid black;border-radius: 2px;cursor: pointer;pointer-events: auto;}.ace_dark .ace_fold {}.ace_fold:hover{background-image:url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAABEAAAAJCAYAAADU6McMAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAJpJREFUeNpi/P//PwOlgAXGYGRklAVSokD8GmjwY1wasKljQpYACtpCFeADcHVQfQyMQAwzwAZI3wJKvCLkfKBaMSClBlR7BOQikCFGQEErIH0VqkabiGCAqwUadAzZJRxQr/0gwiXIal8zQQPnNVTgJ1TdawL0T5gBIP1MUJNhBv2HKoQHHjqNrA4WO4zY0glyNKLT2KIfIMAAQsdgGiXvgnYAAAAASUVORK5CYII="),url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAA3CAYAAADNNiA5AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAACBJREFUeNpi+P//fz4TAwPDZxDxD5X4i5fLMEwJgAADAEPVDbjNw87ZAAAAAElFTkSuQmCC");}.ace_tooltip {background-color: #FFF;background-image: linear-gradient(to bottom, transparent, rgba(0, 0, 0, 0.1));border: 1px solid gray;border-radius: 1px;box-shadow: 0 1px 2px rgba(0, 0, 0, 0.3);color: black;max-width: 100%;padding: 3px 4px;position: fixed;z-index: 999999;box-sizing: border-box;cursor: default;white-space: pre;word-wrap: break-word;line-height: normal;font-style: normal;font-weight: normal;letter-spacing: normal;pointer-events: none;}.ace_folding-enabled > .ace_gutter-cell {padding-right: 13px;}.ace_fold-widget {box-sizing: border-box;margin: 0 -12px 0 1px;display: none;width: 11px;vertical-align: top;background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAANElEQVR42mWKsQ0AMAzC8ixLlrzQjzmBiEjp0A6WwBCSPgKAXoLkqSot7nN3yMwR7pZ32NzpKkVoDBUxKAAAAABJRU5ErkJggg==");background-repeat: no-repeat;background-position: center;border-radius: 3px;border: 1px solid transparent;cursor: pointer;}.ace_folding-enabled .ace_fold-widget {display: inline-block;   }.ace_fold-widget.ace_end {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAANElEQVR42m3HwQkAMAhD0YzsRchFKI7sAikeWkrxwScEB0nh5e7KTPWimZki4tYfVbX+MNl4pyZXejUO1QAAAABJRU5ErkJggg==");}.ace_fold-widget.ace_closed {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAMAAAAGCAYAAAAG5SQMAAAAOUlEQVR42jXKwQkAMAgDwKwqKD4EwQ26sSOkVWjgIIHAzPiCgaqiqnJHZnKICBERHN194O5b9vbLuAVRL+l0YWnZAAAAAElFTkSuQmCCXA==");}.ace_fold-widget:hover {border: 1px solid rgba(0, 0, 0, 0.3);background-color: rgba(255, 255, 255, 0.2);box-shadow: 0 1px 1px rgba(255, 255, 255, 0.7);}.ace_fold-widget:active {border: 1px solid rgba(0, 0, 0, 0.4);background-color: rgba(0, 0, 0, 0.05);box-shadow: 0 1px 1px rgba(255, 255, 255, 0.8);}.ace_dark .ace_fold-widget {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAAHklEQVQIW2P4//8/AzoGEQ7oGCaLLAhWiSwB146BAQCSTPYocqT0AAAAAElFTkSuQmCC");}.ace_dark .ace_fold-widget.ace_end {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAUAAAAFCAYAAACNbyblAAAAH0lEQVQIW2P4//8/AxQ7wNjIAjDMgC4AxjCVKBirIAAF0kz2rlhxpAAAAABJRU5ErkJggg==");}.ace_dark .ace_fold-widget.ace_closed {background-image: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAMAAAAFCAYAAACAcVaiAAAAHElEQVQIW2P4//+/AxAzgDADlOOAznHAKgPWAwARji8UIDTfQQAAAABJRU5ErkJggg==");}.ace_dark .ace_fold-widget:hover {box-shadow: 0 1px 1px rgba(255, 255, 255, 0.2);background-color: rgba(255, 255, 255, 0.1);}.ace_dark .ace_fold-widget:active {box-shadow: 0 1px 1px rgba(255, 255, 255, 0.2);}.ace_inline_button {border: 1px solid lightgray;display: inline-block;margin: -1px 8px;padding: 0 5px;pointer-events: auto;cursor: pointer;}.ace_inline_button:hover {border-color: gray;background: rgba(200,200,200,0.2);display: inline-block;pointer-events: auto;}.ace_fold-widget.ace_invalid {background-color: #FFB4B4;border-color: #DE5555;}.ace_fade-fold-widgets .ace_fold-widget {transition: opacity 0.4s ease 0.05s;opacity: 0;}.ace_fade-fold-widgets:hover .ace_fold-widget {transition: opacity 0.05s ease 0.05s;opacity:1;}.ace_underline {text-decoration: underline;}.ace_bold {font-weight: bold;}.ace_nobold .ace_bold {font-weight: normal;}.ace_italic {font-style: italic;}.ace_error-marker {background-color: rgba(255, 0, 0,0.2);position: absolute;z-index: 9;}.ace_highlight-marker {background-color: rgba(255, 255, 0,0.2);position: absolute;z-index: 8;}.ace_mobile-menu {position: absolute;line-height: 1.5;border-radius: 4px;-ms-user-select: none;-moz-user-select: none;-webkit-user-select: none;user-select: none;background: white;box-shadow: 1px 3px 2px grey;border: 1px solid #dcdcdc;color: black;}.ace_dark > .ace_mobile-menu {background: #333;color: #ccc;box-shadow: 1px 3px 2px grey;border: 1px solid #444;}.ace_mobile-button {padding: 2px;cursor: pointer;overflow: hidden;}.ace_mobile-button:hover {background-color: #eee;opacity:1;}.ace_mobile-button:active {background-color: #ddd;}.ace_placeholder {font-family: arial;transform: scale(0.9);transform-origin: left;white-space: pre;opacity: 0.7;margin: 0 10px;}`,S=k("./lib/useragent"),b=S.isIE;A.importCssString(w,"ace_editor.css");var c=function(l,g){var u=this;this.container=l||A.createElement("div"),A.addCssClass(this.container,"ace_editor"),A.HI_DPI&&A.addCssClass(this.container,"ace_hidpi"),this.setTheme(g),this.$gutter=A.createElement("div"),this.$gutter.className="ace_gutter",this.container.appendChild(this.$gutter),this.$gutter.setAttribute("aria-hidden",!0),this.scroller=A.createElement("div"),this.scroller.className="ace_scroller",this.container.appendChild(this.scroller),this.content=A.createElement("div"),this.content.className="ace_content",this.scroller.appendChild(this.content),this.$gutterLayer=new h(this.$gutter),this.$gutterLayer.on("changeGutterWidth",this.onGutterResize.bind(this)),this.$markerBack=new d(this.content);var o=this.$textLayer=new t(this.content);this.canvas=o.element,this.$markerFront=new d(this.content),this.$cursorLayer=new e(this.content),this.$horizScroll=!1,this.$vScroll=!1,this.scrollBar=this.scrollBarV=new r(this.container,this),this.scrollBarH=new i(this.container,this),this.scrollBarV.on("scroll",function(f){u.$scrollAnimation||u.session.setScrollTop(f.data-u.scrollMargin.top)}),this.scrollBarH.on("scroll",function(f){u.$scrollAnimation||u.session.setScrollLeft(f.data-u.scrollMargin.left)}),this.scrollTop=0,this.scrollLeft=0,this.cursorPos={row:0,column:0},this.$fontMetrics=new a(this.container),this.$textLayer.$setFontMetrics(this.$fontMetrics),this.$textLayer.on("changeCharacterSize",function(f){u.updateCharacterSize(),u.onResize(!0,u.gutterWidth,u.$size.width,u.$size.height),u._signal("changeCharacterSize",f)}),this.$size={width:0,height:0,scrollerHeight:0,scrollerWidth:0,$dirty:!0},this.layerConfig={width:1,padding:0,firstRow:0,firstRowScreen:0,lastRow:0,lineHeight:0,characterWidth:0,minHeight:1,maxHeight:1,offset:0,height:1,gutterOffset:1},this.scrollMargin={left:0,right:0,top:0,bottom:0,v:0,h:0},this.margin={left:0,right:0,top:0,bottom:0,v:0,h:0},this.$keepTextAreaAtCursor=!S.isIOS,this.$loop=new n(this.$renderChanges.bind(this),this.container.ownerDocument.defaultView),this.$loop.schedule(this.CHANGE_FULL),this.updateCharacterSize(),this.setPadding(4),p.resetOptions(this),p._signal("renderer",this)};(function(){this.CHANGE_CURSOR=1,this.CHANGE_MARKER=2,this.CHANGE_GUTTER=4,this.CHANGE_SCROLL=8,this.CHANGE_LINES=16,this.CHANGE_TEXT=32,this.CHANGE_SIZE=64,this.CHANGE_MARKER_BACK=128,this.CHANGE_MARKER_FRONT=256,this.CHANGE_FULL=512,this.CHANGE_H_SCROLL=1024,E.implement(this,m),this.updateCharacterSize=function(){this.$textLayer.allowBoldFonts!=this.$allowBoldFonts&&(this.$allowBoldFonts=this.$textLayer.allowBoldFonts,this.setStyle("ace_nobold",!this.$allowBoldFonts)),this.layerConfig.characterWidth=this.characterWidth=this.$textLayer.getCharacterWidth(),this.layerConfig.lineHeight=this.lineHeight=this.$textLayer.getLineHeight(),this.$updatePrintMargin(),A.setStyle(this.scroller.style,"line-height",this.lineHeight+"px")},this.setSession=function(l){this.session&&this.session.doc.off("changeNewLineMode",this.onChangeNewLineMode),this.session=l,l&&this.scrollMargin.top&&l.getScrollTop()<=0&&l.setScrollTop(-this.scrollMargin.top),this.$cursorLayer.setSession(l),this.$markerBack.setSession(l),this.$markerFront.setSession(l),this.$gutterLayer.setSession(l),this.$textLayer.setSession(l),l&&(this.$loop.schedule(this.CHANGE_FULL),this.session.$setFontMetrics(this.$fontMetrics),this.scrollBarH.scrollLeft=this.scrollBarV.scrollTop=null,this.onChangeNewLineMode=this.onChangeNewLineMode.bind(this),this.onChangeNewLineMode(),this.session.doc.on("changeNewLineMode",this.onChangeNewLineMode))},this.updateLines=function(l,g,u){if(g===void 0&&(g=1/0),this.$changedLines?(this.$changedLines.firstRow>l&&(this.$changedLines.firstRow=l),this.$changedLines.lastRow<g&&(this.$changedLines.lastRow=g)):this.$changedLines={firstRow:l,lastRow:g},this.$changedLines.lastRow<this.layerConfig.firstRow)if(u)this.$changedLines.lastRow=this.layerConfig.lastRow;else return;this.$changedLines.firstRow>this.layerConfig.lastRow||this.$loop.schedule(this.CHANGE_LINES)},this.onChangeNewLineMode=function(){this.$loop.schedule(this.CHANGE_TEXT),this.$textLayer.$updateEolChar(),this.session.$bidiHandler.setEolChar(this.$textLayer.EOL_CHAR)},this.onChangeTabSize=function(){this.$loop.schedule(this.CHANGE_TEXT|this.CHANGE_MARKER),this.$textLayer.onChangeTabSize()},this.updateText=function(){this.$loop.schedule(this.CHANGE_TEXT)},this.updateFull=function(l){l?this.$renderChanges(this.CHANGE_FULL,!0):this.$loop.schedule(this.CHANGE_FULL)},this.updateFontSize=function(){this.$textLayer.checkForSizeChanges()},this.$changes=0,this.$updateSizeAsync=function(){this.$loop.pending?this.$size.$dirty=!0:this.onResize()},this.onResize=function(l,g,u,o){if(!(this.resizing>2)){this.resizing>0?this.resizing++:this.resizing=l?1:0;var f=this.container;o||(o=f.clientHeight||f.scrollHeight),u||(u=f.clientWidth||f.scrollWidth);var s=this.$updateCachedSize(l,g,u,o);if(!this.$size.scrollerHeight||!u&&!o)return this.resizing=0;l&&(this.$gutterLayer.$padding=null),l?this.$renderChanges(s|this.$changes,!0):this.$loop.schedule(s|this.$changes),this.resizing&&(this.resizing=0),this.scrollBarV.scrollLeft=this.scrollBarV.scrollTop=null}},this.$updateCachedSize=function(l,g,u,o){o-=this.$extraHeight||0;var f=0,s=this.$size,v={width:s.width,height:s.height,scrollerHeight:s.scrollerHeight,scrollerWidth:s.scrollerWidth};if(o&&(l||s.height!=o)&&(s.height=o,f|=this.CHANGE_SIZE,s.scrollerHeight=s.height,this.$horizScroll&&(s.scrollerHeight-=this.scrollBarH.getHeight()),this.scrollBarV.element.style.bottom=this.scrollBarH.getHeight()+"px",f=f|this.CHANGE_SCROLL),u&&(l||s.width!=u)){f|=this.CHANGE_SIZE,s.width=u,g==null&&(g=this.$showGutter?this.$gutter.offsetWidth:0),this.gutterWidth=g,A.setStyle(this.scrollBarH.element.style,"left",g+"px"),A.setStyle(this.scroller.style,"left",g+this.margin.left+"px"),s.scrollerWidth=Math.max(0,u-g-this.scrollBarV.getWidth()-this.margin.h),A.setStyle(this.$gutter.style,"left",this.margin.left+"px");var $=this.scrollBarV.getWidth()+"px";A.setStyle(this.scrollBarH.element.style,"right",$),A.setStyle(this.scroller.style,"right",$),A.setStyle(this.scroller.style,"bottom",this.scrollBarH.getHeight()),(this.session&&this.session.getUseWrapMode()&&this.adjustWrapLimit()||l)&&(f|=this.CHANGE_FULL)}return s.$dirty=!u||!o,f&&this._signal("resize",v),f},this.onGutterResize=function(l){var g=this.$showGutter?l:0;g!=this.gutterWidth&&(this.$changes|=this.$updateCachedSize(!0,g,this.$size.width,this.$size.height)),this.session.getUseWrapMode()&&this.adjustWrapLimit()?this.$loop.schedule(this.CHANGE_FULL):this.$size.$dirty?this.$loop.schedule(this.CHANGE_FULL):this.$computeLayerConfig()},this.adjustWrapLimit=function(){var l=this.$size.scrollerWidth-this.$padding*2,g=Math.floor(l/this.characterWidth);return this.session.adjustWrapLimit(g,this.$showPrintMargin&&this.$printMarginColumn)},this.setAnimatedScroll=function(l){this.setOption("animatedScroll",l)},this.getAnimatedScroll=function(){return this.$animatedScroll},this.setShowInvisibles=function(l){this.setOption("showInvisibles",l),this.session.$bidiHandler.setShowInvisibles(l)},this.getShowInvisibles=function(){return this.getOption("showInvisibles")},this.getDisplayIndentGuides=function(){return this.getOption("displayIndentGuides")},this.setDisplayIndentGuides=function(l){this.setOption("displayIndentGuides",l)},this.setShowPrintMargin=function(l){this.setOption("showPrintMargin",l)},this.getShowPrintMargin=function(){return this.getOption("showPrintMargin")},this.setPrintMarginColumn=function(l){this.setOption("printMarginColumn",l)},this.getPrintMarginColumn=function(){return this.getOption("printMarginColumn")},this.getShowGutter=function(){return this.getOption("showGutter")},this.setShowGutter=function(l){return this.setOption("showGutter",l)},this.getFadeFoldWidgets=function(){return this.getOption("fadeFoldWidgets")},this.setFadeFoldWidgets=function(l){this.setOption("fadeFoldWidgets",l)},this.setHighlightGutterLine=function(l){this.setOption("highlightGutterLine",l)},this.getHighlightGutterLine=function(){return this.getOption("highlightGutterLine")},this.$updatePrintMargin=function(){if(!(!this.$showPrintMargin&&!this.$printMarginEl)){if(!this.$printMarginEl){var l=A.createElement("div");l.className="ace_layer ace_print-margin-layer",this.$printMarginEl=A.createElement("div"),this.$printMarginEl.className="ace_print-margin",l.appendChild(this.$printMarginEl),this.content.insertBefore(l,this.content.firstChild)}var g=this.$printMarginEl.style;g.left=Math.round(this.characterWidth*this.$printMarginColumn+this.$padding)+"px",g.visibility=this.$showPrintMargin?"visible":"hidden",this.session&&this.session.$wrap==-1&&this.adjustWrapLimit()}},this.getContainerElement=function(){return this.container},this.getMouseEventTarget=function(){return this.scroller},this.getTextAreaContainer=function(){return this.container},this.$moveTextAreaToCursor=function(){if(!this.$isMousePressed){var l=this.textarea.style,g=this.$composition;if(!this.$keepTextAreaAtCursor&&!g){A.translate(this.textarea,-100,0);return}var u=this.$cursorLayer.$pixelPos;if(u){g&&g.markerRange&&(u=this.$cursorLayer.getPixelPosition(g.markerRange.start,!0));var o=this.layerConfig,f=u.top,s=u.left;f-=o.offset;var v=g&&g.useTextareaForIME?this.lineHeight:b?0:1;if(f<0||f>o.height-v){A.translate(this.textarea,0,0);return}var $=1,C=this.$size.height-v;if(!g)f+=this.lineHeight;else if(g.useTextareaForIME){var x=this.textarea.value;$=this.characterWidth*this.session.$getStringScreenWidth(x)[0]}else f+=this.lineHeight+2;s-=this.scrollLeft,s>this.$size.scrollerWidth-$&&(s=this.$size.scrollerWidth-$),s+=this.gutterWidth+this.margin.left,A.setStyle(l,"height",v+"px"),A.setStyle(l,"width",$+"px"),A.translate(this.textarea,Math.min(s,this.$size.scrollerWidth-$),Math.min(f,C))}}},this.getFirstVisibleRow=function(){return this.layerConfig.firstRow},this.getFirstFullyVisibleRow=function(){return this.layerConfig.firstRow+(this.layerConfig.offset===0?0:1)},this.getLastFullyVisibleRow=function(){var l=this.layerConfig,g=l.lastRow,u=this.session.documentToScreenRow(g,0)*l.lineHeight;return u-this.session.getScrollTop()>l.height-l.lineHeight?g-1:g},this.getLastVisibleRow=function(){return this.layerConfig.lastRow},this.$padding=null,this.setPadding=function(l){this.$padding=l,this.$textLayer.setPadding(l),this.$cursorLayer.setPadding(l),this.$markerFront.setPadding(l),this.$markerBack.setPadding(l),this.$loop.schedule(this.CHANGE_FULL),this.$updatePrintMargin()},this.setScrollMargin=function(l,g,u,o){var f=this.scrollMargin;f.top=l|0,f.bottom=g|0,f.right=o|0,f.left=u|0,f.v=f.top+f.bottom,f.h=f.left+f.right,f.top&&this.scrollTop<=0&&this.session&&this.session.setScrollTop(-f.top),this.updateFull()},this.setMargin=function(l,g,u,o){var f=this.margin;f.top=l|0,f.bottom=g|0,f.right=o|0,f.left=u|0,f.v=f.top+f.bottom,f.h=f.left+f.right,this.$updateCachedSize(!0,this.gutterWidth,this.$size.width,this.$size.height),this.updateFull()},this.getHScrollBarAlwaysVisible=function(){return this.$hScrollBarAlwaysVisible},this.setHScrollBarAlwaysVisible=function(l){this.setOption("hScrollBarAlwaysVisible",l)},this.getVScrollBarAlwaysVisible=function(){return this.$vScrollBarAlwaysVisible},this.setVScrollBarAlwaysVisible=function(l){this.setOption("vScrollBarAlwaysVisible",l)},this.$updateScrollBarV=function(){var l=this.layerConfig.maxHeight,g=this.$size.scrollerHeight;!this.$maxLines&&this.$scrollPastEnd&&(l-=(g-this.lineHeight)*this.$scrollPastEnd,this.scrollTop>l-g&&(l=this.scrollTop+g,this.scrollBarV.scrollTop=null)),this.scrollBarV.setScrollHeight(l+this.scrollMargin.v),this.scrollBarV.setScrollTop(this.scrollTop+this.scrollMargin.top)},this.$updateScrollBarH=function(){this.scrollBarH.setScrollWidth(this.layerConfig.width+2*this.$padding+this.scrollMargin.h),this.scrollBarH.setScrollLeft(this.scrollLeft+this.scrollMargin.left)},this.$frozen=!1,this.freeze=function(){this.$frozen=!0},this.unfreeze=function(){this.$frozen=!1},this.$renderChanges=function(l,g){if(this.$changes&&(l|=this.$changes,this.$changes=0),!this.session||!this.container.offsetWidth||this.$frozen||!l&&!g){this.$changes|=l;return}if(this.$size.$dirty)return this.$changes|=l,this.onResize(!0);this.lineHeight||this.$textLayer.checkForSizeChanges(),this._signal("beforeRender",l),this.session&&this.session.$bidiHandler&&this.session.$bidiHandler.updateCharacterWidths(this.$fontMetrics);var u=this.layerConfig;if(l&this.CHANGE_FULL||l&this.CHANGE_SIZE||l&this.CHANGE_TEXT||l&this.CHANGE_LINES||l&this.CHANGE_SCROLL||l&this.CHANGE_H_SCROLL){if(l|=this.$computeLayerConfig()|this.$loop.clear(),u.firstRow!=this.layerConfig.firstRow&&u.firstRowScreen==this.layerConfig.firstRowScreen){var o=this.scrollTop+(u.firstRow-this.layerConfig.firstRow)*this.lineHeight;o>0&&(this.scrollTop=o,l=l|this.CHANGE_SCROLL,l|=this.$computeLayerConfig()|this.$loop.clear())}u=this.layerConfig,this.$updateScrollBarV(),l&this.CHANGE_H_SCROLL&&this.$updateScrollBarH(),A.translate(this.content,-this.scrollLeft,-u.offset);var f=u.width+2*this.$padding+"px",s=u.minHeight+"px";A.setStyle(this.content.style,"width",f),A.setStyle(this.content.style,"height",s)}if(l&this.CHANGE_H_SCROLL&&(A.translate(this.content,-this.scrollLeft,-u.offset),this.scroller.className=this.scrollLeft<=0?"ace_scroller":"ace_scroller ace_scroll-left"),l&this.CHANGE_FULL){this.$changedLines=null,this.$textLayer.update(u),this.$showGutter&&this.$gutterLayer.update(u),this.$markerBack.update(u),this.$markerFront.update(u),this.$cursorLayer.update(u),this.$moveTextAreaToCursor(),this._signal("afterRender",l);return}if(l&this.CHANGE_SCROLL){this.$changedLines=null,l&this.CHANGE_TEXT||l&this.CHANGE_LINES?this.$textLayer.update(u):this.$textLayer.scrollLines(u),this.$showGutter&&(l&this.CHANGE_GUTTER||l&this.CHANGE_LINES?this.$gutterLayer.update(u):this.$gutterLayer.scrollLines(u)),this.$markerBack.update(u),this.$markerFront.update(u),this.$cursorLayer.update(u),this.$moveTextAreaToCursor(),this._signal("afterRender",l);return}l&this.CHANGE_TEXT?(this.$changedLines=null,this.$textLayer.update(u),this.$showGutter&&this.$gutterLayer.update(u)):l&this.CHANGE_LINES?(this.$updateLines()||l&this.CHANGE_GUTTER&&this.$showGutter)&&this.$gutterLayer.update(u):l&this.CHANGE_TEXT||l&this.CHANGE_GUTTER?this.$showGutter&&this.$gutterLayer.update(u):l&this.CHANGE_CURSOR&&this.$highlightGutterLine&&this.$gutterLayer.updateLineHighlight(u),l&this.CHANGE_CURSOR&&(this.$cursorLayer.update(u),this.$moveTextAreaToCursor()),l&(this.CHANGE_MARKER|this.CHANGE_MARKER_FRONT)&&this.$markerFront.update(u),l&(this.CHANGE_MARKER|this.CHANGE_MARKER_BACK)&&this.$markerBack.update(u),this._signal("afterRender",l)},this.$autosize=function(){var l=this.session.getScreenLength()*this.lineHeight,g=this.$maxLines*this.lineHeight,u=Math.min(g,Math.max((this.$minLines||1)*this.lineHeight,l))+this.scrollMargin.v+(this.$extraHeight||0);this.$horizScroll&&(u+=this.scrollBarH.getHeight()),this.$maxPixelHeight&&u>this.$maxPixelHeight&&(u=this.$maxPixelHeight);var o=u<=2*this.lineHeight,f=!o&&l>g;if(u!=this.desiredHeight||this.$size.height!=this.desiredHeight||f!=this.$vScroll){f!=this.$vScroll&&(this.$vScroll=f,this.scrollBarV.setVisible(f));var s=this.container.clientWidth;this.container.style.height=u+"px",this.$updateCachedSize(!0,this.$gutterWidth,s,u),this.desiredHeight=u,this._signal("autosize")}},this.$computeLayerConfig=function(){var l=this.session,g=this.$size,u=g.height<=2*this.lineHeight,o=this.session.getScreenLength(),f=o*this.lineHeight,s=this.$getLongestLine(),v=!u&&(this.$hScrollBarAlwaysVisible||g.scrollerWidth-s-2*this.$padding<0),$=this.$horizScroll!==v;$&&(this.$horizScroll=v,this.scrollBarH.setVisible(v));var C=this.$vScroll;this.$maxLines&&this.lineHeight>1&&this.$autosize();var x=g.scrollerHeight+this.lineHeight,L=!this.$maxLines&&this.$scrollPastEnd?(g.scrollerHeight-this.lineHeight)*this.$scrollPastEnd:0;f+=L;var R=this.scrollMargin;this.session.setScrollTop(Math.max(-R.top,Math.min(this.scrollTop,f-g.scrollerHeight+R.bottom))),this.session.setScrollLeft(Math.max(-R.left,Math.min(this.scrollLeft,s+2*this.$padding-g.scrollerWidth+R.right)));var T=!u&&(this.$vScrollBarAlwaysVisible||g.scrollerHeight-f+L<0||this.scrollTop>R.top),_=C!==T;_&&(this.$vScroll=T,this.scrollBarV.setVisible(T));var F=this.scrollTop%this.lineHeight,O=Math.ceil(x/this.lineHeight)-1,M=Math.max(0,Math.round((this.scrollTop-F)/this.lineHeight)),P=M+O,I,D,N=this.lineHeight;M=l.screenToDocumentRow(M,0);var V=l.getFoldLine(M);V&&(M=V.start.row),I=l.documentToScreenRow(M,0),D=l.getRowLength(M)*N,P=Math.min(l.screenToDocumentRow(P,0),l.getLength()-1),x=g.scrollerHeight+l.getRowLength(P)*N+D,F=this.scrollTop-I*N;var z=0;return(this.layerConfig.width!=s||$)&&(z=this.CHANGE_H_SCROLL),($||_)&&(z|=this.$updateCachedSize(!0,this.gutterWidth,g.width,g.height),this._signal("scrollbarVisibilityChanged"),_&&(s=this.$getLongestLine())),this.layerConfig={width:s,padding:this.$padding,firstRow:M,firstRowScreen:I,lastRow:P,lineHeight:N,characterWidth:this.characterWidth,minHeight:x,maxHeight:f,offset:F,gutterOffset:N?Math.max(0,Math.ceil((F+g.height-g.scrollerHeight)/N)):0,height:this.$size.scrollerHeight},this.session.$bidiHandler&&this.session.$bidiHandler.setContentWidth(s-this.$padding),z},this.$updateLines=function(){if(this.$changedLines){var l=this.$changedLines.firstRow,g=this.$changedLines.lastRow;this.$changedLines=null;var u=this.layerConfig;if(!(l>u.lastRow+1)&&!(g<u.firstRow)){if(g===1/0){this.$showGutter&&this.$gutterLayer.update(u),this.$textLayer.update(u);return}return this.$textLayer.updateLines(u,l,g),!0}}},this.$getLongestLine=function(){var l=this.session.getScreenWidth();return this.showInvisibles&&!this.session.$useWrapMode&&(l+=1),this.$textLayer&&l>this.$textLayer.MAX_LINE_LENGTH&&(l=this.$textLayer.MAX_LINE_LENGTH+30),Math.max(this.$size.scrollerWidth-2*this.$padding,Math.round(l*this.characterWidth))},this.updateFrontMarkers=function(){this.$markerFront.setMarkers(this.session.getMarkers(!0)),this.$loop.schedule(this.CHANGE_MARKER_FRONT)},this.updateBackMarkers=function(){this.$markerBack.setMarkers(this.session.getMarkers()),this.$loop.schedule(this.CHANGE_MARKER_BACK)},this.addGutterDecoration=function(l,g){this.$gutterLayer.addGutterDecoration(l,g)},this.removeGutterDecoration=function(l,g){this.$gutterLayer.removeGutterDecoration(l,g)},this.updateBreakpoints=function(l){this.$loop.schedule(this.CHANGE_GUTTER)},this.setAnnotations=function(l){this.$gutterLayer.setAnnotations(l),this.$loop.schedule(this.CHANGE_GUTTER)},this.updateCursor=function(){this.$loop.schedule(this.CHANGE_CURSOR)},this.hideCursor=function(){this.$cursorLayer.hideCursor()},this.showCursor=function(){this.$cursorLayer.showCursor()},this.scrollSelectionIntoView=function(l,g,u){this.scrollCursorIntoView(l,u),this.scrollCursorIntoView(g,u)},this.scrollCursorIntoView=function(l,g,u){if(this.$size.scrollerHeight!==0){var o=this.$cursorLayer.getPixelPosition(l),f=o.left,s=o.top,v=u&&u.top||0,$=u&&u.bottom||0,C=this.$scrollAnimation?this.session.getScrollTop():this.scrollTop;C+v>s?(g&&C+v>s+this.lineHeight&&(s-=g*this.$size.scrollerHeight),s===0&&(s=-this.scrollMargin.top),this.session.setScrollTop(s)):C+this.$size.scrollerHeight-$<s+this.lineHeight&&(g&&C+this.$size.scrollerHeight-$<s-this.lineHeight&&(s+=g*this.$size.scrollerHeight),this.session.setScrollTop(s+this.lineHeight+$-this.$size.scrollerHeight));var x=this.scrollLeft;x>f?(f<this.$padding+2*this.layerConfig.characterWidth&&(f=-this.scrollMargin.left),this.session.setScrollLeft(f)):x+this.$size.scrollerWidth<f+this.characterWidth?this.session.setScrollLeft(Math.round(f+this.characterWidth-this.$size.scrollerWidth)):x<=this.$padding&&f-x<this.characterWidth&&this.session.setScrollLeft(0)}},this.getScrollTop=function(){return this.session.getScrollTop()},this.getScrollLeft=function(){return this.session.getScrollLeft()},this.getScrollTopRow=function(){return this.scrollTop/this.lineHeight},this.getScrollBottomRow=function(){return Math.max(0,Math.floor((this.scrollTop+this.$size.scrollerHeight)/this.lineHeight)-1)},this.scrollToRow=function(l){this.session.setScrollTop(l*this.lineHeight)},this.alignCursor=function(l,g){typeof l=="number"&&(l={row:l,column:0});var u=this.$cursorLayer.getPixelPosition(l),o=this.$size.scrollerHeight-this.lineHeight,f=u.top-o*(g||0);return this.session.setScrollTop(f),f},this.STEPS=8,this.$calcSteps=function(l,g){var u=0,o=this.STEPS,f=[],s=function(v,$,C){return C*(Math.pow(v-1,3)+1)+$};for(u=0;u<o;++u)f.push(s(u/this.STEPS,l,g-l));return f},this.scrollToLine=function(l,g,u,o){var f=this.$cursorLayer.getPixelPosition({row:l,column:0}),s=f.top;g&&(s-=this.$size.scrollerHeight/2);var v=this.scrollTop;this.session.setScrollTop(s),u!==!1&&this.animateScrolling(v,o)},this.animateScrolling=function(l,g){var u=this.scrollTop;if(this.$animatedScroll){var o=this;if(l!=u){if(this.$scrollAnimation){var f=this.$scrollAnimation.steps;if(f.length&&(l=f[0],l==u))return}var s=o.$calcSteps(l,u);this.$scrollAnimation={from:l,to:u,steps:s},clearInterval(this.$timer),o.session.setScrollTop(s.shift()),o.session.$scrollTop=u,this.$timer=setInterval(function(){if(!o.session)return clearInterval(o.$timer);s.length?(o.session.setScrollTop(s.shift()),o.session.$scrollTop=u):u!=null?(o.session.$scrollTop=-1,o.session.setScrollTop(u),u=null):(o.$timer=clearInterval(o.$timer),o.$scrollAnimation=null,g&&g())},10)}}},this.scrollToY=function(l){this.scrollTop!==l&&(this.$loop.schedule(this.CHANGE_SCROLL),this.scrollTop=l)},this.scrollToX=function(l){this.scrollLeft!==l&&(this.scrollLeft=l),this.$loop.schedule(this.CHANGE_H_SCROLL)},this.scrollTo=function(l,g){this.session.setScrollTop(g),this.session.setScrollLeft(g)},this.scrollBy=function(l,g){g&&this.session.setScrollTop(this.session.getScrollTop()+g),l&&this.session.setScrollLeft(this.session.getScrollLeft()+l)},this.isScrollableBy=function(l,g){if(g<0&&this.session.getScrollTop()>=1-this.scrollMargin.top||g>0&&this.session.getScrollTop()+this.$size.scrollerHeight-this.layerConfig.maxHeight<-1+this.scrollMargin.bottom||l<0&&this.session.getScrollLeft()>=1-this.scrollMargin.left||l>0&&this.session.getScrollLeft()+this.$size.scrollerWidth-this.layerConfig.width<-1+this.scrollMargin.right)return!0},this.pixelToScreenCoordinates=function(l,g){var u;if(this.$hasCssTransforms){u={top:0,left:0};var o=this.$fontMetrics.transformCoordinates([l,g]);l=o[1]-this.gutterWidth-this.margin.left,g=o[0]}else u=this.scroller.getBoundingClientRect();var f=l+this.scrollLeft-u.left-this.$padding,s=f/this.characterWidth,v=Math.floor((g+this.scrollTop-u.top)/this.lineHeight),$=this.$blockCursor?Math.floor(s):Math.round(s);return{row:v,column:$,side:s-$>0?1:-1,offsetX:f}},this.screenToTextCoordinates=function(l,g){var u;if(this.$hasCssTransforms){u={top:0,left:0};var o=this.$fontMetrics.transformCoordinates([l,g]);l=o[1]-this.gutterWidth-this.margin.left,g=o[0]}else u=this.scroller.getBoundingClientRect();var f=l+this.scrollLeft-u.left-this.$padding,s=f/this.characterWidth,v=this.$blockCursor?Math.floor(s):Math.round(s),$=Math.floor((g+this.scrollTop-u.top)/this.lineHeight);return this.session.screenToDocumentPosition($,Math.max(v,0),f)},this.textToScreenCoordinates=function(l,g){var u=this.scroller.getBoundingClientRect(),o=this.session.documentToScreenPosition(l,g),f=this.$padding+(this.session.$bidiHandler.isBidiRow(o.row,l)?this.session.$bidiHandler.getPosLeft(o.column):Math.round(o.column*this.characterWidth)),s=o.row*this.lineHeight;return{pageX:u.left+f-this.scrollLeft,pageY:u.top+s-this.scrollTop}},this.visualizeFocus=function(){A.addCssClass(this.container,"ace_focus")},this.visualizeBlur=function(){A.removeCssClass(this.container,"ace_focus")},this.showComposition=function(l){this.$composition=l,l.cssText||(l.cssText=this.textarea.style.cssText),l.useTextareaForIME==null&&(l.useTextareaForIME=this.$useTextareaForIME),this.$useTextareaForIME?(A.addCssClass(this.textarea,"ace_composition"),this.textarea.style.cssText="",this.$moveTextAreaToCursor(),this.$cursorLayer.element.style.display="none"):l.markerId=this.session.addMarker(l.markerRange,"ace_composition_marker","text")},this.setCompositionText=function(l){var g=this.session.selection.cursor;this.addToken(l,"composition_placeholder",g.row,g.column),this.$moveTextAreaToCursor()},this.hideComposition=function(){if(this.$composition){this.$composition.markerId&&this.session.removeMarker(this.$composition.markerId),A.removeCssClass(this.textarea,"ace_composition"),this.textarea.style.cssText=this.$composition.cssText;var l=this.session.selection.cursor;this.removeExtraToken(l.row,l.column),this.$composition=null,this.$cursorLayer.element.style.display=""}},this.addToken=function(l,g,u,o){var f=this.session;f.bgTokenizer.lines[u]=null;var s={type:g,value:l},v=f.getTokens(u);if(o==null)v.push(s);else for(var $=0,C=0;C<v.length;C++){var x=v[C];if($+=x.value.length,o<=$){var L=x.value.length-($-o),R=x.value.slice(0,L),T=x.value.slice(L);v.splice(C,1,{type:x.type,value:R},s,{type:x.type,value:T});break}}this.updateLines(u,u)},this.removeExtraToken=function(l,g){this.updateLines(l,l)},this.setTheme=function(l,g){var u=this;if(this.$themeId=l,u._dispatchEvent("themeChange",{theme:l}),!l||typeof l=="string"){var o=l||this.$options.theme.initialValue;p.loadModule(["theme",o],f)}else f(l);function f(s){if(u.$themeId!=l)return g&&g();if(!s||!s.cssClass)throw new Error("couldn't load module "+l+" or it didn't call define");s.$id&&(u.$themeId=s.$id),A.importCssString(s.cssText,s.cssClass,u.container),u.theme&&A.removeCssClass(u.container,u.theme.cssClass);var v="padding"in s?s.padding:"padding"in(u.theme||{})?4:u.$padding;u.$padding&&v!=u.$padding&&u.setPadding(v),u.$theme=s.cssClass,u.theme=s,A.addCssClass(u.container,s.cssClass),A.setCssClass(u.container,"ace_dark",s.isDark),u.$size&&(u.$size.width=0,u.$updateSizeAsync()),u._dispatchEvent("themeLoaded",{theme:s}),g&&g()}},this.getTheme=function(){return this.$themeId},this.setStyle=function(l,g){A.setCssClass(this.container,l,g!==!1)},this.unsetStyle=function(l){A.removeCssClass(this.container,l)},this.setCursorStyle=function(l){A.setStyle(this.scroller.style,"cursor",l)},this.setMouseCursor=function(l){A.setStyle(this.scroller.style,"cursor",l)},this.attachToShadowRoot=function(){A.importCssString(w,"ace_editor.css",this.container)},this.destroy=function(){this.freeze(),this.$fontMetrics.destroy(),this.$cursorLayer.destroy(),this.removeAllListeners(),this.container.textContent=""}}).call(c.prototype),p.defineOptions(c.prototype,"renderer",{animatedScroll:{initialValue:!1},showInvisibles:{set:function(l){this.$textLayer.setShowInvisibles(l)&&this.$loop.schedule(this.CHANGE_TEXT)},initialValue:!1},showPrintMargin:{set:function(){this.$updatePrintMargin()},initialValue:!0},printMarginColumn:{set:function(){this.$updatePrintMargin()},initialValue:80},printMargin:{set:function(l){typeof l=="number"&&(this.$printMarginColumn=l),this.$showPrintMargin=!!l,this.$updatePrintMargin()},get:function(){return this.$showPrintMargin&&this.$printMarginColumn}},showGutter:{set:function(l){this.$gutter.style.display=l?"block":"none",this.$loop.schedule(this.CHANGE_FULL),this.onGutterResize()},initialValue:!0},fadeFoldWidgets:{set:function(l){A.setCssClass(this.$gutter,"ace_fade-fold-widgets",l)},initialValue:!1},showFoldWidgets:{set:function(l){this.$gutterLayer.setShowFoldWidgets(l),this.$loop.schedule(this.CHANGE_GUTTER)},initialValue:!0},displayIndentGuides:{set:function(l){this.$textLayer.setDisplayIndentGuides(l)&&this.$loop.schedule(this.CHANGE_TEXT)},initialValue:!0},highlightGutterLine:{set:function(l){this.$gutterLayer.setHighlightGutterLine(l),this.$loop.schedule(this.CHANGE_GUTTER)},initialValue:!0},hScrollBarAlwaysVisible:{set:function(l){(!this.$hScrollBarAlwaysVisible||!this.$horizScroll)&&this.$loop.schedule(this.CHANGE_SCROLL)},initialValue:!1},vScrollBarAlwaysVisible:{set:function(l){(!this.$vScrollBarAlwaysVisible||!this.$vScroll)&&this.$loop.schedule(this.CHANGE_SCROLL)},initialValue:!1},fontSize:{set:function(l){typeof l=="number"&&(l=l+"px"),this.container.style.fontSize=l,this.updateFontSize()},initialValue:12},fontFamily:{set:function(l){this.container.style.fontFamily=l,this.updateFontSize()}},maxLines:{set:function(l){this.updateFull()}},minLines:{set:function(l){this.$minLines<562949953421311||(this.$minLines=0),this.updateFull()}},maxPixelHeight:{set:function(l){this.updateFull()},initialValue:0},scrollPastEnd:{set:function(l){l=+l||0,this.$scrollPastEnd!=l&&(this.$scrollPastEnd=l,this.$loop.schedule(this.CHANGE_SCROLL))},initialValue:0,handlesSet:!0},fixedWidthGutter:{set:function(l){this.$gutterLayer.$fixedWidth=!!l,this.$loop.schedule(this.CHANGE_GUTTER)}},theme:{set:function(l){this.setTheme(l)},get:function(){return this.$themeId||this.theme},initialValue:"./theme/textmate",handlesSet:!0},hasCssTransforms:{},useTextareaForIME:{initialValue:!S.isMobile&&!S.isIE}}),y.VirtualRenderer=c}),define("ace/worker/worker_client",["require","exports","module","ace/lib/oop","ace/lib/net","ace/lib/event_emitter","ace/config"],function(k,y,B){"use strict";var E=k("../lib/oop"),A=k("../lib/net"),p=k("../lib/event_emitter").EventEmitter,h=k("../config");function d(r){var n="importScripts('"+A.qualifyURL(r)+"');";try{return new Blob([n],{type:"application/javascript"})}catch{var a=window.BlobBuilder||window.WebKitBlobBuilder||window.MozBlobBuilder,m=new a;return m.append(n),m.getBlob("application/javascript")}}function t(r){if(typeof Worker>"u")return{postMessage:function(){},terminate:function(){}};if(h.get("loadWorkerFromBlob")){var n=d(r),a=window.URL||window.webkitURL,m=a.createObjectURL(n);return new Worker(m)}return new Worker(r)}var e=function(r){r.postMessage||(r=this.$createWorkerFromOldConfig.apply(this,arguments)),this.$worker=r,this.$sendDeltaQueue=this.$sendDeltaQueue.bind(this),this.changeListener=this.changeListener.bind(this),this.onMessage=this.onMessage.bind(this),this.callbackId=1,this.callbacks={},this.$worker.onmessage=this.onMessage};(function(){E.implement(this,p),this.$createWorkerFromOldConfig=function(r,n,a,m,w){if(k.nameToUrl&&!k.toUrl&&(k.toUrl=k.nameToUrl),h.get("packaged")||!k.toUrl)m=m||h.moduleUrl(n,"worker");else{var S=this.$normalizePath;m=m||S(k.toUrl("ace/worker/worker.js",null,"_"));var b={};r.forEach(function(c){b[c]=S(k.toUrl(c,null,"_").replace(/(\.js)?(\?.*)?$/,""))})}return this.$worker=t(m),w&&this.send("importScripts",w),this.$worker.postMessage({init:!0,tlns:b,module:n,classname:a}),this.$worker},this.onMessage=function(r){var n=r.data;switch(n.type){case"event":this._signal(n.name,{data:n.data});break;case"call":var a=this.callbacks[n.id];a&&(a(n.data),delete this.callbacks[n.id]);break;case"error":this.reportError(n.data);break;case"log":window.console&&console.log&&console.log.apply(console,n.data);break}},this.reportError=function(r){window.console&&console.error&&console.error(r)},this.$normalizePath=function(r){return A.qualifyURL(r)},this.terminate=function(){this._signal("terminate",{}),this.deltaQueue=null,this.$worker.terminate(),this.$worker=null,this.$doc&&this.$doc.off("change",this.changeListener),this.$doc=null},this.send=function(r,n){this.$worker.postMessage({command:r,args:n})},this.call=function(r,n,a){if(a){var m=this.callbackId++;this.callbacks[m]=a,n.push(m)}this.send(r,n)},this.emit=function(r,n){try{n.data&&n.data.err&&(n.data.err={message:n.data.err.message,stack:n.data.err.stack,code:n.data.err.code}),this.$worker.postMessage({event:r,data:{data:n.data}})}catch(a){console.error(a.stack)}},this.attachToDocument=function(r){this.$doc&&this.terminate(),this.$doc=r,this.call("setValue",[r.getValue()]),r.on("change",this.changeListener)},this.changeListener=function(r){this.deltaQueue||(this.deltaQueue=[],setTimeout(this.$sendDeltaQueue,0)),r.action=="insert"?this.deltaQueue.push(r.start,r.lines):this.deltaQueue.push(r.start,r.end)},this.$sendDeltaQueue=function(){var r=this.deltaQueue;r&&(this.deltaQueue=null,r.length>50&&r.length>this.$doc.getLength()>>1?this.call("setValue",[this.$doc.getValue()]):this.emit("change",{data:r}))}}).call(e.prototype);var i=function(r,n,a){var m=null,w=!1,S=Object.create(p),b=[],c=new e({messageBuffer:b,terminate:function(){},postMessage:function(g){b.push(g),m&&(w?setTimeout(l):l())}});c.setEmitSync=function(g){w=g};var l=function(){var g=b.shift();g.command?m[g.command].apply(m,g.args):g.event&&S._signal(g.event,g.data)};return S.postMessage=function(g){c.onMessage({data:g})},S.callback=function(g,u){this.postMessage({type:"call",id:u,data:g})},S.emit=function(g,u){this.postMessage({type:"event",name:g,data:u})},h.loadModule(["worker",n],function(g){for(m=new g[a](S);b.length;)l()}),c};y.UIWorkerClient=i,y.WorkerClient=e,y.createWorker=t}),define("ace/placeholder",["require","exports","module","ace/range","ace/lib/event_emitter","ace/lib/oop"],function(k,y,B){"use strict";var E=k("./range").Range,A=k("./lib/event_emitter").EventEmitter,p=k("./lib/oop"),h=function(d,t,e,i,r,n){var a=this;this.length=t,this.session=d,this.doc=d.getDocument(),this.mainClass=r,this.othersClass=n,this.$onUpdate=this.onUpdate.bind(this),this.doc.on("change",this.$onUpdate),this.$others=i,this.$onCursorChange=function(){setTimeout(function(){a.onCursorChange()})},this.$pos=e;var m=d.getUndoManager().$undoStack||d.getUndoManager().$undostack||{length:-1};this.$undoStackDepth=m.length,this.setup(),d.selection.on("changeCursor",this.$onCursorChange)};(function(){p.implement(this,A),this.setup=function(){var d=this,t=this.doc,e=this.session;this.selectionBefore=e.selection.toJSON(),e.selection.inMultiSelectMode&&e.selection.toSingleRange(),this.pos=t.createAnchor(this.$pos.row,this.$pos.column);var i=this.pos;i.$insertRight=!0,i.detach(),i.markerId=e.addMarker(new E(i.row,i.column,i.row,i.column+this.length),this.mainClass,null,!1),this.others=[],this.$others.forEach(function(r){var n=t.createAnchor(r.row,r.column);n.$insertRight=!0,n.detach(),d.others.push(n)}),e.setUndoSelect(!1)},this.showOtherMarkers=function(){if(!this.othersActive){var d=this.session,t=this;this.othersActive=!0,this.others.forEach(function(e){e.markerId=d.addMarker(new E(e.row,e.column,e.row,e.column+t.length),t.othersClass,null,!1)})}},this.hideOtherMarkers=function(){if(this.othersActive){this.othersActive=!1;for(var d=0;d<this.others.length;d++)this.session.removeMarker(this.others[d].markerId)}},this.onUpdate=function(d){if(this.$updating)return this.updateAnchors(d);var t=d;if(t.start.row===t.end.row&&t.start.row===this.pos.row){this.$updating=!0;var e=d.action==="insert"?t.end.column-t.start.column:t.start.column-t.end.column,i=t.start.column>=this.pos.column&&t.start.column<=this.pos.column+this.length+1,r=t.start.column-this.pos.column;if(this.updateAnchors(d),i&&(this.length+=e),i&&!this.session.$fromUndo){if(d.action==="insert")for(var n=this.others.length-1;n>=0;n--){var a=this.others[n],m={row:a.row,column:a.column+r};this.doc.insertMergedLines(m,d.lines)}else if(d.action==="remove")for(var n=this.others.length-1;n>=0;n--){var a=this.others[n],m={row:a.row,column:a.column+r};this.doc.remove(new E(m.row,m.column,m.row,m.column-e))}}this.$updating=!1,this.updateMarkers()}},this.updateAnchors=function(d){this.pos.onChange(d);for(var t=this.others.length;t--;)this.others[t].onChange(d);this.updateMarkers()},this.updateMarkers=function(){if(!this.$updating){var d=this,t=this.session,e=function(r,n){t.removeMarker(r.markerId),r.markerId=t.addMarker(new E(r.row,r.column,r.row,r.column+d.length),n,null,!1)};e(this.pos,this.mainClass);for(var i=this.others.length;i--;)e(this.others[i],this.othersClass)}},this.onCursorChange=function(d){if(!(this.$updating||!this.session)){var t=this.session.selection.getCursor();t.row===this.pos.row&&t.column>=this.pos.column&&t.column<=this.pos.column+this.length?(this.showOtherMarkers(),this._emit("cursorEnter",d)):(this.hideOtherMarkers(),this._emit("cursorLeave",d))}},this.detach=function(){this.session.removeMarker(this.pos&&this.pos.markerId),this.hideOtherMarkers(),this.doc.off("change",this.$onUpdate),this.session.selection.off("changeCursor",this.$onCursorChange),this.session.setUndoSelect(!0),this.session=null},this.cancel=function(){if(this.$undoStackDepth!==-1){for(var d=this.session.getUndoManager(),t=(d.$undoStack||d.$undostack).length-this.$undoStackDepth,e=0;e<t;e++)d.undo(this.session,!0);this.selectionBefore&&this.session.selection.fromJSON(this.selectionBefore)}}}).call(h.prototype),y.PlaceHolder=h}),define("ace/mouse/multi_select_handler",["require","exports","module","ace/lib/event","ace/lib/useragent"],function(k,y,B){var E=k("../lib/event"),A=k("../lib/useragent");function p(d,t){return d.row==t.row&&d.column==t.column}function h(d){var t=d.domEvent,e=t.altKey,i=t.shiftKey,r=t.ctrlKey,n=d.getAccelKey(),a=d.getButton();if(r&&A.isMac&&(a=t.button),d.editor.inMultiSelectMode&&a==2){d.editor.textInput.onContextMenu(d.domEvent);return}if(!r&&!e&&!n){a===0&&d.editor.inMultiSelectMode&&d.editor.exitMultiSelectMode();return}if(a===0){var m=d.editor,w=m.selection,S=m.inMultiSelectMode,b=d.getDocumentPosition(),c=w.getCursor(),l=d.inSelection()||w.isEmpty()&&p(b,c),g=d.x,u=d.y,o=function(M){g=M.clientX,u=M.clientY},f=m.session,s=m.renderer.pixelToScreenCoordinates(g,u),v=s,$;if(m.$mouseHandler.$enableJumpToDef)r&&e||n&&e?$=i?"block":"add":e&&m.$blockSelectEnabled&&($="block");else if(n&&!e){if($="add",!S&&i)return}else e&&m.$blockSelectEnabled&&($="block");if($&&A.isMac&&t.ctrlKey&&m.$mouseHandler.cancelContextMenu(),$=="add"){if(!S&&l)return;if(!S){var C=w.toOrientedRange();m.addSelectionMarker(C)}var x=w.rangeList.rangeAtPoint(b);m.inVirtualSelectionMode=!0,i&&(x=null,C=w.ranges[0]||C,m.removeSelectionMarker(C)),m.once("mouseup",function(){var M=w.toOrientedRange();x&&M.isEmpty()&&p(x.cursor,M.cursor)?w.substractPoint(M.cursor):(i?w.substractPoint(C.cursor):C&&(m.removeSelectionMarker(C),w.addRange(C)),w.addRange(M)),m.inVirtualSelectionMode=!1})}else if($=="block"){d.stop(),m.inVirtualSelectionMode=!0;var L,R=[],T=function(){var M=m.renderer.pixelToScreenCoordinates(g,u),P=f.screenToDocumentPosition(M.row,M.column,M.offsetX);p(v,M)&&p(P,w.lead)||(v=M,m.selection.moveToPosition(P),m.renderer.scrollCursorIntoView(),m.removeSelectionMarkers(R),R=w.rectangularRangeBlock(v,s),m.$mouseHandler.$clickSelection&&R.length==1&&R[0].isEmpty()&&(R[0]=m.$mouseHandler.$clickSelection.clone()),R.forEach(m.addSelectionMarker,m),m.updateSelectionMarkers())};S&&!n?w.toSingleRange():!S&&n&&(L=w.toOrientedRange(),m.addSelectionMarker(L)),i?s=f.documentToScreenPosition(w.lead):w.moveToPosition(b),v={row:-1,column:-1};var _=function(M){T(),clearInterval(O),m.removeSelectionMarkers(R),R.length||(R=[w.toOrientedRange()]),L&&(m.removeSelectionMarker(L),w.toSingleRange(L));for(var P=0;P<R.length;P++)w.addRange(R[P]);m.inVirtualSelectionMode=!1,m.$mouseHandler.$clickSelection=null},F=T;E.capture(m.container,o,_);var O=setInterval(function(){F()},20);return d.preventDefault()}}}y.onMouseDown=h}),define("ace/commands/multi_select_commands",["require","exports","module","ace/keyboard/hash_handler"],function(k,y,B){y.defaultCommands=[{name:"addCursorAbove",description:"Add cursor above",exec:function(A){A.selectMoreLines(-1)},bindKey:{win:"Ctrl-Alt-Up",mac:"Ctrl-Alt-Up"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorBelow",description:"Add cursor below",exec:function(A){A.selectMoreLines(1)},bindKey:{win:"Ctrl-Alt-Down",mac:"Ctrl-Alt-Down"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorAboveSkipCurrent",description:"Add cursor above (skip current)",exec:function(A){A.selectMoreLines(-1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Up",mac:"Ctrl-Alt-Shift-Up"},scrollIntoView:"cursor",readOnly:!0},{name:"addCursorBelowSkipCurrent",description:"Add cursor below (skip current)",exec:function(A){A.selectMoreLines(1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Down",mac:"Ctrl-Alt-Shift-Down"},scrollIntoView:"cursor",readOnly:!0},{name:"selectMoreBefore",description:"Select more before",exec:function(A){A.selectMore(-1)},bindKey:{win:"Ctrl-Alt-Left",mac:"Ctrl-Alt-Left"},scrollIntoView:"cursor",readOnly:!0},{name:"selectMoreAfter",description:"Select more after",exec:function(A){A.selectMore(1)},bindKey:{win:"Ctrl-Alt-Right",mac:"Ctrl-Alt-Right"},scrollIntoView:"cursor",readOnly:!0},{name:"selectNextBefore",description:"Select next before",exec:function(A){A.selectMore(-1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Left",mac:"Ctrl-Alt-Shift-Left"},scrollIntoView:"cursor",readOnly:!0},{name:"selectNextAfter",description:"Select next after",exec:function(A){A.selectMore(1,!0)},bindKey:{win:"Ctrl-Alt-Shift-Right",mac:"Ctrl-Alt-Shift-Right"},scrollIntoView:"cursor",readOnly:!0},{name:"toggleSplitSelectionIntoLines",description:"Split into lines",exec:function(A){A.multiSelect.rangeCount>1?A.multiSelect.joinSelections():A.multiSelect.splitIntoLines()},bindKey:{win:"Ctrl-Alt-L",mac:"Ctrl-Alt-L"},readOnly:!0},{name:"splitSelectionIntoLines",description:"Split into lines",exec:function(A){A.multiSelect.splitIntoLines()},readOnly:!0},{name:"alignCursors",description:"Align cursors",exec:function(A){A.alignCursors()},bindKey:{win:"Ctrl-Alt-A",mac:"Ctrl-Alt-A"},scrollIntoView:"cursor"},{name:"findAll",description:"Find all",exec:function(A){A.findAll()},bindKey:{win:"Ctrl-Alt-K",mac:"Ctrl-Alt-G"},scrollIntoView:"cursor",readOnly:!0}],y.multiSelectCommands=[{name:"singleSelection",description:"Single selection",bindKey:"esc",exec:function(A){A.exitMultiSelectMode()},scrollIntoView:"cursor",readOnly:!0,isAvailable:function(A){return A&&A.inMultiSelectMode}}];var E=k("../keyboard/hash_handler").HashHandler;y.keyboardHandler=new E(y.multiSelectCommands)}),define("ace/multi_select",["require","exports","module","ace/range_list","ace/range","ace/selection","ace/mouse/multi_select_handler","ace/lib/event","ace/lib/lang","ace/commands/multi_select_commands","ace/search","ace/edit_session","ace/editor","ace/config"],function(k,y,B){var E=k("./range_list").RangeList,A=k("./range").Range,p=k("./selection").Selection,h=k("./mouse/multi_select_handler").onMouseDown,d=k("./lib/event"),t=k("./lib/lang"),e=k("./commands/multi_select_commands");y.commands=e.defaultCommands.concat(e.multiSelectCommands);var i=k("./search").Search,r=new i;function n(c,l,g){return r.$options.wrap=!0,r.$options.needle=l,r.$options.backwards=g==-1,r.find(c)}var a=k("./edit_session").EditSession;(function(){this.getSelectionMarkers=function(){return this.$selectionMarkers}}).call(a.prototype),function(){this.ranges=null,this.rangeList=null,this.addRange=function(c,l){if(c){if(!this.inMultiSelectMode&&this.rangeCount===0){var g=this.toOrientedRange();if(this.rangeList.add(g),this.rangeList.add(c),this.rangeList.ranges.length!=2)return this.rangeList.removeAll(),l||this.fromOrientedRange(c);this.rangeList.removeAll(),this.rangeList.add(g),this.$onAddRange(g)}c.cursor||(c.cursor=c.end);var u=this.rangeList.add(c);return this.$onAddRange(c),u.length&&this.$onRemoveRange(u),this.rangeCount>1&&!this.inMultiSelectMode&&(this._signal("multiSelect"),this.inMultiSelectMode=!0,this.session.$undoSelect=!1,this.rangeList.attach(this.session)),l||this.fromOrientedRange(c)}},this.toSingleRange=function(c){c=c||this.ranges[0];var l=this.rangeList.removeAll();l.length&&this.$onRemoveRange(l),c&&this.fromOrientedRange(c)},this.substractPoint=function(c){var l=this.rangeList.substractPoint(c);if(l)return this.$onRemoveRange(l),l[0]},this.mergeOverlappingRanges=function(){var c=this.rangeList.merge();c.length&&this.$onRemoveRange(c)},this.$onAddRange=function(c){this.rangeCount=this.rangeList.ranges.length,this.ranges.unshift(c),this._signal("addRange",{range:c})},this.$onRemoveRange=function(c){if(this.rangeCount=this.rangeList.ranges.length,this.rangeCount==1&&this.inMultiSelectMode){var l=this.rangeList.ranges.pop();c.push(l),this.rangeCount=0}for(var g=c.length;g--;){var u=this.ranges.indexOf(c[g]);this.ranges.splice(u,1)}this._signal("removeRange",{ranges:c}),this.rangeCount===0&&this.inMultiSelectMode&&(this.inMultiSelectMode=!1,this._signal("singleSelect"),this.session.$undoSelect=!0,this.rangeList.detach(this.session)),l=l||this.ranges[0],l&&!l.isEqual(this.getRange())&&this.fromOrientedRange(l)},this.$initRangeList=function(){this.rangeList||(this.rangeList=new E,this.ranges=[],this.rangeCount=0)},this.getAllRanges=function(){return this.rangeCount?this.rangeList.ranges.concat():[this.getRange()]},this.splitIntoLines=function(){for(var c=this.ranges.length?this.ranges:[this.getRange()],l=[],g=0;g<c.length;g++){var u=c[g],o=u.start.row,f=u.end.row;if(o===f)l.push(u.clone());else{for(l.push(new A(o,u.start.column,o,this.session.getLine(o).length));++o<f;)l.push(this.getLineRange(o,!0));l.push(new A(f,0,f,u.end.column))}g==0&&!this.isBackwards()&&(l=l.reverse())}this.toSingleRange();for(var g=l.length;g--;)this.addRange(l[g])},this.joinSelections=function(){var c=this.rangeList.ranges,l=c[c.length-1],g=A.fromPoints(c[0].start,l.end);this.toSingleRange(),this.setSelectionRange(g,l.cursor==l.start)},this.toggleBlockSelection=function(){if(this.rangeCount>1){var c=this.rangeList.ranges,l=c[c.length-1],g=A.fromPoints(c[0].start,l.end);this.toSingleRange(),this.setSelectionRange(g,l.cursor==l.start)}else{var u=this.session.documentToScreenPosition(this.cursor),o=this.session.documentToScreenPosition(this.anchor),f=this.rectangularRangeBlock(u,o);f.forEach(this.addRange,this)}},this.rectangularRangeBlock=function(c,l,g){var u=[],o=c.column<l.column;if(o)var f=c.column,s=l.column,v=c.offsetX,$=l.offsetX;else var f=l.column,s=c.column,v=l.offsetX,$=c.offsetX;var C=c.row<l.row;if(C)var x=c.row,L=l.row;else var x=l.row,L=c.row;f<0&&(f=0),x<0&&(x=0),x==L&&(g=!0);for(var R,T=x;T<=L;T++){var _=A.fromPoints(this.session.screenToDocumentPosition(T,f,v),this.session.screenToDocumentPosition(T,s,$));if(_.isEmpty()){if(R&&w(_.end,R))break;R=_.end}_.cursor=o?_.start:_.end,u.push(_)}if(C&&u.reverse(),!g){for(var F=u.length-1;u[F].isEmpty()&&F>0;)F--;if(F>0)for(var O=0;u[O].isEmpty();)O++;for(var M=F;M>=O;M--)u[M].isEmpty()&&u.splice(M,1)}return u}}.call(p.prototype);var m=k("./editor").Editor;(function(){this.updateSelectionMarkers=function(){this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.addSelectionMarker=function(c){c.cursor||(c.cursor=c.end);var l=this.getSelectionStyle();return c.marker=this.session.addMarker(c,"ace_selection",l),this.session.$selectionMarkers.push(c),this.session.selectionMarkerCount=this.session.$selectionMarkers.length,c},this.removeSelectionMarker=function(c){if(c.marker){this.session.removeMarker(c.marker);var l=this.session.$selectionMarkers.indexOf(c);l!=-1&&this.session.$selectionMarkers.splice(l,1),this.session.selectionMarkerCount=this.session.$selectionMarkers.length}},this.removeSelectionMarkers=function(c){for(var l=this.session.$selectionMarkers,g=c.length;g--;){var u=c[g];if(u.marker){this.session.removeMarker(u.marker);var o=l.indexOf(u);o!=-1&&l.splice(o,1)}}this.session.selectionMarkerCount=l.length},this.$onAddRange=function(c){this.addSelectionMarker(c.range),this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.$onRemoveRange=function(c){this.removeSelectionMarkers(c.ranges),this.renderer.updateCursor(),this.renderer.updateBackMarkers()},this.$onMultiSelect=function(c){this.inMultiSelectMode||(this.inMultiSelectMode=!0,this.setStyle("ace_multiselect"),this.keyBinding.addKeyboardHandler(e.keyboardHandler),this.commands.setDefaultHandler("exec",this.$onMultiSelectExec),this.renderer.updateCursor(),this.renderer.updateBackMarkers())},this.$onSingleSelect=function(c){this.session.multiSelect.inVirtualMode||(this.inMultiSelectMode=!1,this.unsetStyle("ace_multiselect"),this.keyBinding.removeKeyboardHandler(e.keyboardHandler),this.commands.removeDefaultHandler("exec",this.$onMultiSelectExec),this.renderer.updateCursor(),this.renderer.updateBackMarkers(),this._emit("changeSelection"))},this.$onMultiSelectExec=function(c){var l=c.command,g=c.editor;if(g.multiSelect){if(l.multiSelectAction)l.multiSelectAction=="forEach"?u=g.forEachSelection(l,c.args):l.multiSelectAction=="forEachLine"?u=g.forEachSelection(l,c.args,!0):l.multiSelectAction=="single"?(g.exitMultiSelectMode(),u=l.exec(g,c.args||{})):u=l.multiSelectAction(g,c.args||{});else{var u=l.exec(g,c.args||{});g.multiSelect.addRange(g.multiSelect.toOrientedRange()),g.multiSelect.mergeOverlappingRanges()}return u}},this.forEachSelection=function(c,l,g){if(!this.inVirtualSelectionMode){var u=g&&g.keepOrder,o=g==!0||g&&g.$byLines,f=this.session,s=this.selection,v=s.rangeList,$=(u?s:v).ranges,C;if(!$.length)return c.exec?c.exec(this,l||{}):c(this,l||{});var x=s._eventRegistry;s._eventRegistry={};var L=new p(f);this.inVirtualSelectionMode=!0;for(var R=$.length;R--;){if(o)for(;R>0&&$[R].start.row==$[R-1].end.row;)R--;L.fromOrientedRange($[R]),L.index=R,this.selection=f.selection=L;var T=c.exec?c.exec(this,l||{}):c(this,l||{});!C&&T!==void 0&&(C=T),L.toOrientedRange($[R])}L.detach(),this.selection=f.selection=s,this.inVirtualSelectionMode=!1,s._eventRegistry=x,s.mergeOverlappingRanges(),s.ranges[0]&&s.fromOrientedRange(s.ranges[0]);var _=this.renderer.$scrollAnimation;return this.onCursorChange(),this.onSelectionChange(),_&&_.from==_.to&&this.renderer.animateScrolling(_.from),C}},this.exitMultiSelectMode=function(){!this.inMultiSelectMode||this.inVirtualSelectionMode||this.multiSelect.toSingleRange()},this.getSelectedText=function(){var c="";if(this.inMultiSelectMode&&!this.inVirtualSelectionMode){for(var l=this.multiSelect.rangeList.ranges,g=[],u=0;u<l.length;u++)g.push(this.session.getTextRange(l[u]));var o=this.session.getDocument().getNewLineCharacter();c=g.join(o),c.length==(g.length-1)*o.length&&(c="")}else this.selection.isEmpty()||(c=this.session.getTextRange(this.getSelectionRange()));return c},this.$checkMultiselectChange=function(c,l){if(this.inMultiSelectMode&&!this.inVirtualSelectionMode){var g=this.multiSelect.ranges[0];if(this.multiSelect.isEmpty()&&l==this.multiSelect.anchor)return;var u=l==this.multiSelect.anchor?g.cursor==g.start?g.end:g.start:g.cursor;u.row!=l.row||this.session.$clipPositionToDocument(u.row,u.column).column!=l.column?this.multiSelect.toSingleRange(this.multiSelect.toOrientedRange()):this.multiSelect.mergeOverlappingRanges()}},this.findAll=function(c,l,g){if(l=l||{},l.needle=c||l.needle,l.needle==null){var u=this.selection.isEmpty()?this.selection.getWordRange():this.selection.getRange();l.needle=this.session.getTextRange(u)}this.$search.set(l);var o=this.$search.findAll(this.session);if(!o.length)return 0;var f=this.multiSelect;g||f.toSingleRange(o[0]);for(var s=o.length;s--;)f.addRange(o[s],!0);return u&&f.rangeList.rangeAtPoint(u.start)&&f.addRange(u,!0),o.length},this.selectMoreLines=function(c,l){var g=this.selection.toOrientedRange(),u=g.cursor==g.end,o=this.session.documentToScreenPosition(g.cursor);this.selection.$desiredColumn&&(o.column=this.selection.$desiredColumn);var f=this.session.screenToDocumentPosition(o.row+c,o.column);if(g.isEmpty())var v=f;else var s=this.session.documentToScreenPosition(u?g.end:g.start),v=this.session.screenToDocumentPosition(s.row+c,s.column);if(u){var $=A.fromPoints(f,v);$.cursor=$.start}else{var $=A.fromPoints(v,f);$.cursor=$.end}if($.desiredColumn=o.column,!this.selection.inMultiSelectMode)this.selection.addRange(g);else if(l)var C=g.cursor;this.selection.addRange($),C&&this.selection.substractPoint(C)},this.transposeSelections=function(c){for(var l=this.session,g=l.multiSelect,u=g.ranges,o=u.length;o--;){var f=u[o];if(f.isEmpty()){var s=l.getWordRange(f.start.row,f.start.column);f.start.row=s.start.row,f.start.column=s.start.column,f.end.row=s.end.row,f.end.column=s.end.column}}g.mergeOverlappingRanges();for(var v=[],o=u.length;o--;){var f=u[o];v.unshift(l.getTextRange(f))}c<0?v.unshift(v.pop()):v.push(v.shift());for(var o=u.length;o--;){var f=u[o],s=f.clone();l.replace(f,v[o]),f.start.row=s.start.row,f.start.column=s.start.column}g.fromOrientedRange(g.ranges[0])},this.selectMore=function(c,l,g){var u=this.session,o=u.multiSelect,f=o.toOrientedRange();if(!(f.isEmpty()&&(f=u.getWordRange(f.start.row,f.start.column),f.cursor=c==-1?f.start:f.end,this.multiSelect.addRange(f),g))){var s=u.getTextRange(f),v=n(u,s,c);v&&(v.cursor=c==-1?v.start:v.end,this.session.unfold(v),this.multiSelect.addRange(v),this.renderer.scrollCursorIntoView(null,.5)),l&&this.multiSelect.substractPoint(f.cursor)}},this.alignCursors=function(){var c=this.session,l=c.multiSelect,g=l.ranges,u=-1,o=g.filter(function(F){if(F.cursor.row==u)return!0;u=F.cursor.row});if(!g.length||o.length==g.length-1){var f=this.selection.getRange(),s=f.start.row,v=f.end.row,$=s==v;if($){var C=this.session.getLength(),x;do x=this.session.getLine(v);while(/[=:]/.test(x)&&++v<C);do x=this.session.getLine(s);while(/[=:]/.test(x)&&--s>0);s<0&&(s=0),v>=C&&(v=C-1)}var L=this.session.removeFullLines(s,v);L=this.$reAlignText(L,$),this.session.insert({row:s,column:0},L.join(`
 `)+`
-`),$||(f.start.column=0,f.end.column=k[k.length-1].length),this.selection.setRange(f)}else{a.forEach(function(I){l.substractPoint(I.cursor)});var R=0,_=1/0,O=g.map(function(I){var D=I.cursor,T=c.getLine(D.row),P=T.substr(D.column).search(/\S/g);return P==-1&&(P=0),D.column>R&&(R=D.column),P<_&&(_=P),P});g.forEach(function(I,D){var T=I.cursor,P=R-T.column,M=O[D]-_;P>M?c.insert(T,t.stringRepeat(" ",P-M)):c.remove(new A(T.row,T.column,T.row,T.column-P+M)),I.start.column=I.end.column=R,I.start.row=I.end.row=T.row,I.cursor=I.end}),l.fromOrientedRange(g[0]),this.renderer.updateCursor(),this.renderer.updateBackMarkers()}},this.$reAlignText=function(c,l){var g=!0,d=!0,a,f,s;return c.map(function(k){var R=k.match(/(\s*)(.*?)(\s*)([=:].*)/);return R?a==null?(a=R[1].length,f=R[2].length,s=R[3].length,R):(a+f+s!=R[1].length+R[2].length+R[3].length&&(d=!1),a!=R[1].length&&(g=!1),a>R[1].length&&(a=R[1].length),f<R[2].length&&(f=R[2].length),s>R[3].length&&(s=R[3].length),R):[k]}).map(l?$:g?d?x:$:C);function v(k){return t.stringRepeat(" ",k)}function $(k){return k[2]?v(a)+k[2]+v(f-k[2].length+s)+k[4].replace(/^([=:])\s+/,"$1 "):k[0]}function x(k){return k[2]?v(a+f-k[2].length)+k[2]+v(s)+k[4].replace(/^([=:])\s+/,"$1 "):k[0]}function C(k){return k[2]?v(a)+k[2]+v(s)+k[4].replace(/^([=:])\s+/,"$1 "):k[0]}}}).call(m.prototype);function w(c,l){return c.row==l.row&&c.column==l.column}b.onSessionChange=function(c){var l=c.session;l&&!l.multiSelect&&(l.$selectionMarkers=[],l.selection.$initRangeList(),l.multiSelect=l.selection),this.multiSelect=l&&l.multiSelect;var g=c.oldSession;g&&(g.multiSelect.off("addRange",this.$onAddRange),g.multiSelect.off("removeRange",this.$onRemoveRange),g.multiSelect.off("multiSelect",this.$onMultiSelect),g.multiSelect.off("singleSelect",this.$onSingleSelect),g.multiSelect.lead.off("change",this.$checkMultiselectChange),g.multiSelect.anchor.off("change",this.$checkMultiselectChange)),l&&(l.multiSelect.on("addRange",this.$onAddRange),l.multiSelect.on("removeRange",this.$onRemoveRange),l.multiSelect.on("multiSelect",this.$onMultiSelect),l.multiSelect.on("singleSelect",this.$onSingleSelect),l.multiSelect.lead.on("change",this.$checkMultiselectChange),l.multiSelect.anchor.on("change",this.$checkMultiselectChange)),l&&this.inMultiSelectMode!=l.selection.inMultiSelectMode&&(l.selection.inMultiSelectMode?this.$onMultiSelect():this.$onSingleSelect())};function S(c){c.$multiselectOnSessionChange||(c.$onAddRange=c.$onAddRange.bind(c),c.$onRemoveRange=c.$onRemoveRange.bind(c),c.$onMultiSelect=c.$onMultiSelect.bind(c),c.$onSingleSelect=c.$onSingleSelect.bind(c),c.$multiselectOnSessionChange=b.onSessionChange.bind(c),c.$checkMultiselectChange=c.$checkMultiselectChange.bind(c),c.$multiselectOnSessionChange(c),c.on("changeSession",c.$multiselectOnSessionChange),c.on("mousedown",h),c.commands.addCommands(e.defaultCommands),y(c))}function y(c){if(!c.textInput)return;var l=c.textInput.getElement(),g=!1;u.addListener(l,"keydown",function(a){var f=a.keyCode==18&&!(a.ctrlKey||a.shiftKey||a.metaKey);c.$blockSelectEnabled&&f?g||(c.renderer.setMouseCursor("crosshair"),g=!0):g&&d()},c),u.addListener(l,"keyup",d,c),u.addListener(l,"blur",d,c);function d(a){g&&(c.renderer.setMouseCursor(""),g=!1)}}b.MultiSelect=S,L("./config").defineOptions(m.prototype,"editor",{enableMultiselect:{set:function(c){S(this),c?(this.on("changeSession",this.$multiselectOnSessionChange),this.on("mousedown",h)):(this.off("changeSession",this.$multiselectOnSessionChange),this.off("mousedown",h))},value:!0},enableBlockSelect:{set:function(c){this.$blockSelectEnabled=c},value:!0}})}),define("ace/mode/folding/fold_mode",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=L("../../range").Range,A=b.FoldMode=function(){};(function(){this.foldingStartMarker=null,this.foldingStopMarker=null,this.getFoldWidget=function(p,h,u){var t=p.getLine(u);return this.foldingStartMarker.test(t)?"start":h=="markbeginend"&&this.foldingStopMarker&&this.foldingStopMarker.test(t)?"end":""},this.getFoldWidgetRange=function(p,h,u){return null},this.indentationBlock=function(p,h,u){var t=/\S/,e=p.getLine(h),i=e.search(t);if(i!=-1){for(var r=u||e.length,n=p.getLength(),o=h,m=h;++h<n;){var w=p.getLine(h).search(t);if(w!=-1){if(w<=i){var S=p.getTokenAt(h,0);if(!S||S.type!=="string")break}m=h}}if(m>o){var y=p.getLine(m).length;return new E(o,r,m,y)}}},this.openingBracketBlock=function(p,h,u,t,e){var i={row:u,column:t+1},r=p.$findClosingBracket(h,i,e);if(r){var n=p.foldWidgets[r.row];return n==null&&(n=p.getFoldWidget(r.row)),n=="start"&&r.row>i.row&&(r.row--,r.column=p.getLine(r.row).length),E.fromPoints(i,r)}},this.closingBracketBlock=function(p,h,u,t,e){var i={row:u,column:t},r=p.$findOpeningBracket(h,i);if(r)return r.column++,i.column--,E.fromPoints(r,i)}}).call(A.prototype)}),define("ace/theme/textmate",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";b.isDark=!1,b.cssClass="ace-tm",b.cssText='.ace-tm .ace_gutter {background: #f0f0f0;color: #333;}.ace-tm .ace_print-margin {width: 1px;background: #e8e8e8;}.ace-tm .ace_fold {background-color: #6B72E6;}.ace-tm {background-color: #FFFFFF;color: black;}.ace-tm .ace_cursor {color: black;}.ace-tm .ace_invisible {color: rgb(191, 191, 191);}.ace-tm .ace_storage,.ace-tm .ace_keyword {color: blue;}.ace-tm .ace_constant {color: rgb(197, 6, 11);}.ace-tm .ace_constant.ace_buildin {color: rgb(88, 72, 246);}.ace-tm .ace_constant.ace_language {color: rgb(88, 92, 246);}.ace-tm .ace_constant.ace_library {color: rgb(6, 150, 14);}.ace-tm .ace_invalid {background-color: rgba(255, 0, 0, 0.1);color: red;}.ace-tm .ace_support.ace_function {color: rgb(60, 76, 114);}.ace-tm .ace_support.ace_constant {color: rgb(6, 150, 14);}.ace-tm .ace_support.ace_type,.ace-tm .ace_support.ace_class {color: rgb(109, 121, 222);}.ace-tm .ace_keyword.ace_operator {color: rgb(104, 118, 135);}.ace-tm .ace_string {color: rgb(3, 106, 7);}.ace-tm .ace_comment {color: rgb(76, 136, 107);}.ace-tm .ace_comment.ace_doc {color: rgb(0, 102, 255);}.ace-tm .ace_comment.ace_doc.ace_tag {color: rgb(128, 159, 191);}.ace-tm .ace_constant.ace_numeric {color: rgb(0, 0, 205);}.ace-tm .ace_variable {color: rgb(49, 132, 149);}.ace-tm .ace_xml-pe {color: rgb(104, 104, 91);}.ace-tm .ace_entity.ace_name.ace_function {color: #0000A2;}.ace-tm .ace_heading {color: rgb(12, 7, 255);}.ace-tm .ace_list {color:rgb(185, 6, 144);}.ace-tm .ace_meta.ace_tag {color:rgb(0, 22, 142);}.ace-tm .ace_string.ace_regex {color: rgb(255, 0, 0)}.ace-tm .ace_marker-layer .ace_selection {background: rgb(181, 213, 255);}.ace-tm.ace_multiselect .ace_selection.ace_start {box-shadow: 0 0 3px 0px white;}.ace-tm .ace_marker-layer .ace_step {background: rgb(252, 255, 0);}.ace-tm .ace_marker-layer .ace_stack {background: rgb(164, 229, 101);}.ace-tm .ace_marker-layer .ace_bracket {margin: -1px 0 0 -1px;border: 1px solid rgb(192, 192, 192);}.ace-tm .ace_marker-layer .ace_active-line {background: rgba(0, 0, 0, 0.07);}.ace-tm .ace_gutter-active-line {background-color : #dcdcdc;}.ace-tm .ace_marker-layer .ace_selected-word {background: rgb(250, 250, 255);border: 1px solid rgb(200, 200, 250);}.ace-tm .ace_indent-guide {background: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAACCAYAAACZgbYnAAAAE0lEQVQImWP4////f4bLly//BwAmVgd1/w11/gAAAABJRU5ErkJggg==") right repeat-y;}',b.$id="ace/theme/textmate";var E=L("../lib/dom");E.importCssString(b.cssText,b.cssClass)}),define("ace/line_widgets",["require","exports","module","ace/lib/dom"],function(L,b,B){"use strict";var E=L("./lib/dom");function A(p){this.session=p,this.session.widgetManager=this,this.session.getRowLength=this.getRowLength,this.session.$getWidgetScreenLength=this.$getWidgetScreenLength,this.updateOnChange=this.updateOnChange.bind(this),this.renderWidgets=this.renderWidgets.bind(this),this.measureWidgets=this.measureWidgets.bind(this),this.session._changedWidgets=[],this.$onChangeEditor=this.$onChangeEditor.bind(this),this.session.on("change",this.updateOnChange),this.session.on("changeFold",this.updateOnFold),this.session.on("changeEditor",this.$onChangeEditor)}(function(){this.getRowLength=function(p){var h;return this.lineWidgets?h=this.lineWidgets[p]&&this.lineWidgets[p].rowCount||0:h=0,!this.$useWrapMode||!this.$wrapData[p]?1+h:this.$wrapData[p].length+1+h},this.$getWidgetScreenLength=function(){var p=0;return this.lineWidgets.forEach(function(h){h&&h.rowCount&&!h.hidden&&(p+=h.rowCount)}),p},this.$onChangeEditor=function(p){this.attach(p.editor)},this.attach=function(p){p&&p.widgetManager&&p.widgetManager!=this&&p.widgetManager.detach(),this.editor!=p&&(this.detach(),this.editor=p,p&&(p.widgetManager=this,p.renderer.on("beforeRender",this.measureWidgets),p.renderer.on("afterRender",this.renderWidgets)))},this.detach=function(p){var h=this.editor;if(h){this.editor=null,h.widgetManager=null,h.renderer.off("beforeRender",this.measureWidgets),h.renderer.off("afterRender",this.renderWidgets);var u=this.session.lineWidgets;u&&u.forEach(function(t){t&&t.el&&t.el.parentNode&&(t._inDocument=!1,t.el.parentNode.removeChild(t.el))})}},this.updateOnFold=function(p,h){var u=h.lineWidgets;if(!(!u||!p.action)){for(var t=p.data,e=t.start.row,i=t.end.row,r=p.action=="add",n=e+1;n<i;n++)u[n]&&(u[n].hidden=r);u[i]&&(r?u[e]?u[i].hidden=r:u[e]=u[i]:(u[e]==u[i]&&(u[e]=void 0),u[i].hidden=r))}},this.updateOnChange=function(p){var h=this.session.lineWidgets;if(h){var u=p.start.row,t=p.end.row-u;if(t!==0)if(p.action=="remove"){var e=h.splice(u+1,t);!h[u]&&e[e.length-1]&&(h[u]=e.pop()),e.forEach(function(r){r&&this.removeLineWidget(r)},this),this.$updateRows()}else{var i=new Array(t);h[u]&&h[u].column!=null&&p.start.column>h[u].column&&u++,i.unshift(u,0),h.splice.apply(h,i),this.$updateRows()}}},this.$updateRows=function(){var p=this.session.lineWidgets;if(p){var h=!0;p.forEach(function(u,t){if(u)for(h=!1,u.row=t;u.$oldWidget;)u.$oldWidget.row=t,u=u.$oldWidget}),h&&(this.session.lineWidgets=null)}},this.$registerLineWidget=function(p){this.session.lineWidgets||(this.session.lineWidgets=new Array(this.session.getLength()));var h=this.session.lineWidgets[p.row];return h&&(p.$oldWidget=h,h.el&&h.el.parentNode&&(h.el.parentNode.removeChild(h.el),h._inDocument=!1)),this.session.lineWidgets[p.row]=p,p},this.addLineWidget=function(p){if(this.$registerLineWidget(p),p.session=this.session,!this.editor)return p;var h=this.editor.renderer;p.html&&!p.el&&(p.el=E.createElement("div"),p.el.innerHTML=p.html),p.el&&(E.addCssClass(p.el,"ace_lineWidgetContainer"),p.el.style.position="absolute",p.el.style.zIndex=5,h.container.appendChild(p.el),p._inDocument=!0,p.coverGutter||(p.el.style.zIndex=3),p.pixelHeight==null&&(p.pixelHeight=p.el.offsetHeight)),p.rowCount==null&&(p.rowCount=p.pixelHeight/h.layerConfig.lineHeight);var u=this.session.getFoldAt(p.row,0);if(p.$fold=u,u){var t=this.session.lineWidgets;p.row==u.end.row&&!t[u.start.row]?t[u.start.row]=p:p.hidden=!0}return this.session._emit("changeFold",{data:{start:{row:p.row}}}),this.$updateRows(),this.renderWidgets(null,h),this.onWidgetChanged(p),p},this.removeLineWidget=function(p){if(p._inDocument=!1,p.session=null,p.el&&p.el.parentNode&&p.el.parentNode.removeChild(p.el),p.editor&&p.editor.destroy)try{p.editor.destroy()}catch{}if(this.session.lineWidgets){var h=this.session.lineWidgets[p.row];if(h==p)this.session.lineWidgets[p.row]=p.$oldWidget,p.$oldWidget&&this.onWidgetChanged(p.$oldWidget);else for(;h;){if(h.$oldWidget==p){h.$oldWidget=p.$oldWidget;break}h=h.$oldWidget}}this.session._emit("changeFold",{data:{start:{row:p.row}}}),this.$updateRows()},this.getWidgetsAtRow=function(p){for(var h=this.session.lineWidgets,u=h&&h[p],t=[];u;)t.push(u),u=u.$oldWidget;return t},this.onWidgetChanged=function(p){this.session._changedWidgets.push(p),this.editor&&this.editor.renderer.updateFull()},this.measureWidgets=function(p,h){var u=this.session._changedWidgets,t=h.layerConfig;if(!(!u||!u.length)){for(var e=1/0,i=0;i<u.length;i++){var r=u[i];if(!(!r||!r.el)&&r.session==this.session){if(!r._inDocument){if(this.session.lineWidgets[r.row]!=r)continue;r._inDocument=!0,h.container.appendChild(r.el)}r.h=r.el.offsetHeight,r.fixedWidth||(r.w=r.el.offsetWidth,r.screenWidth=Math.ceil(r.w/t.characterWidth));var n=r.h/t.lineHeight;r.coverLine&&(n-=this.session.getRowLineCount(r.row),n<0&&(n=0)),r.rowCount!=n&&(r.rowCount=n,r.row<e&&(e=r.row))}}e!=1/0&&(this.session._emit("changeFold",{data:{start:{row:e}}}),this.session.lineWidgetWidth=null),this.session._changedWidgets=[]}},this.renderWidgets=function(p,h){var u=h.layerConfig,t=this.session.lineWidgets;if(t){for(var e=Math.min(this.firstRow,u.firstRow),i=Math.max(this.lastRow,u.lastRow,t.length);e>0&&!t[e];)e--;this.firstRow=u.firstRow,this.lastRow=u.lastRow,h.$cursorLayer.config=u;for(var r=e;r<=i;r++){var n=t[r];if(!(!n||!n.el)){if(n.hidden){n.el.style.top=-100-(n.pixelHeight||0)+"px";continue}n._inDocument||(n._inDocument=!0,h.container.appendChild(n.el));var o=h.$cursorLayer.getPixelPosition({row:r,column:0},!0).top;n.coverLine||(o+=u.lineHeight*this.session.getRowLineCount(n.row)),n.el.style.top=o-u.offset+"px";var m=n.coverGutter?0:h.gutterWidth;n.fixedWidth||(m-=h.scrollLeft),n.el.style.left=m+"px",n.fullWidth&&n.screenWidth&&(n.el.style.minWidth=u.width+2*u.padding+"px"),n.fixedWidth?n.el.style.right=h.scrollBar.getWidth()+"px":n.el.style.right=""}}}}}).call(A.prototype),b.LineWidgets=A}),define("ace/ext/error_marker",["require","exports","module","ace/line_widgets","ace/lib/dom","ace/range"],function(L,b,B){"use strict";var E=L("../line_widgets").LineWidgets,A=L("../lib/dom"),p=L("../range").Range;function h(t,e,i){for(var r=0,n=t.length-1;r<=n;){var o=r+n>>1,m=i(e,t[o]);if(m>0)r=o+1;else if(m<0)n=o-1;else return o}return-(r+1)}function u(t,e,i){var r=t.getAnnotations().sort(p.comparePoints);if(r.length){var n=h(r,{row:e,column:-1},p.comparePoints);n<0&&(n=-n-1),n>=r.length?n=i>0?0:r.length-1:n===0&&i<0&&(n=r.length-1);var o=r[n];if(!(!o||!i)){if(o.row===e){do o=r[n+=i];while(o&&o.row===e);if(!o)return r.slice()}var m=[];e=o.row;do m[i<0?"unshift":"push"](o),o=r[n+=i];while(o&&o.row==e);return m.length&&m}}}b.showErrorMarker=function(t,e){var i=t.session;i.widgetManager||(i.widgetManager=new E(i),i.widgetManager.attach(t));var r=t.getCursorPosition(),n=r.row,o=i.widgetManager.getWidgetsAtRow(n).filter(function(a){return a.type=="errorMarker"})[0];o?o.destroy():n-=e;var m=u(i,n,e),w;if(m){var S=m[0];r.column=(S.pos&&typeof S.column!="number"?S.pos.sc:S.column)||0,r.row=S.row,w=t.renderer.$gutterLayer.$annotations[r.row]}else{if(o)return;w={text:["Looks good!"],className:"ace_ok"}}t.session.unfold(r.row),t.selection.moveToPosition(r);var y={row:r.row,fixedWidth:!0,coverGutter:!0,el:A.createElement("div"),type:"errorMarker"},c=y.el.appendChild(A.createElement("div")),l=y.el.appendChild(A.createElement("div"));l.className="error_widget_arrow "+w.className;var g=t.renderer.$cursorLayer.getPixelPosition(r).left;l.style.left=g+t.renderer.gutterWidth-5+"px",y.el.className="error_widget_wrapper",c.className="error_widget "+w.className,c.innerHTML=w.text.join("<br>"),c.appendChild(A.createElement("div"));var d=function(a,f,s){if(f===0&&(s==="esc"||s==="return"))return y.destroy(),{command:"null"}};y.destroy=function(){t.$mouseHandler.isMousePressed||(t.keyBinding.removeKeyboardHandler(d),i.widgetManager.removeLineWidget(y),t.off("changeSelection",y.destroy),t.off("changeSession",y.destroy),t.off("mouseup",y.destroy),t.off("change",y.destroy))},t.keyBinding.addKeyboardHandler(d),t.on("changeSelection",y.destroy),t.on("changeSession",y.destroy),t.on("mouseup",y.destroy),t.on("change",y.destroy),t.session.widgetManager.addLineWidget(y),y.el.onmousedown=t.focus.bind(t),t.renderer.scrollCursorIntoView(null,.5,{bottom:y.el.offsetHeight})},A.importCssString("    .error_widget_wrapper {        background: inherit;        color: inherit;        border:none    }    .error_widget {        border-top: solid 2px;        border-bottom: solid 2px;        margin: 5px 0;        padding: 10px 40px;        white-space: pre-wrap;    }    .error_widget.ace_error, .error_widget_arrow.ace_error{        border-color: #ff5a5a    }    .error_widget.ace_warning, .error_widget_arrow.ace_warning{        border-color: #F1D817    }    .error_widget.ace_info, .error_widget_arrow.ace_info{        border-color: #5a5a5a    }    .error_widget.ace_ok, .error_widget_arrow.ace_ok{        border-color: #5aaa5a    }    .error_widget_arrow {        position: absolute;        border: solid 5px;        border-top-color: transparent!important;        border-right-color: transparent!important;        border-left-color: transparent!important;        top: -5px;    }","")}),define("ace/ace",["require","exports","module","ace/lib/fixoldbrowsers","ace/lib/dom","ace/lib/event","ace/range","ace/editor","ace/edit_session","ace/undomanager","ace/virtual_renderer","ace/worker/worker_client","ace/keyboard/hash_handler","ace/placeholder","ace/multi_select","ace/mode/folding/fold_mode","ace/theme/textmate","ace/ext/error_marker","ace/config"],function(L,b,B){"use strict";L("./lib/fixoldbrowsers");var E=L("./lib/dom"),A=L("./lib/event"),p=L("./range").Range,h=L("./editor").Editor,u=L("./edit_session").EditSession,t=L("./undomanager").UndoManager,e=L("./virtual_renderer").VirtualRenderer;L("./worker/worker_client"),L("./keyboard/hash_handler"),L("./placeholder"),L("./multi_select"),L("./mode/folding/fold_mode"),L("./theme/textmate"),L("./ext/error_marker"),b.config=L("./config"),b.require=L,typeof define=="function"&&(b.define=define),b.edit=function(i,r){if(typeof i=="string"){var n=i;if(i=document.getElementById(n),!i)throw new Error("ace.edit can't find div #"+n)}if(i&&i.env&&i.env.editor instanceof h)return i.env.editor;var o="";if(i&&/input|textarea/i.test(i.tagName)){var m=i;o=m.value,i=E.createElement("pre"),m.parentNode.replaceChild(i,m)}else i&&(o=i.textContent,i.innerHTML="");var w=b.createEditSession(o),S=new h(new e(i),w,r),y={document:w,editor:S,onResize:S.resize.bind(S,null)};return m&&(y.textarea=m),A.addListener(window,"resize",y.onResize),S.on("destroy",function(){A.removeListener(window,"resize",y.onResize),y.editor.container.env=null}),S.container.env=S.env=y,S},b.createEditSession=function(i,r){var n=new u(i,r);return n.setUndoManager(new t),n},b.Range=p,b.Editor=h,b.EditSession=u,b.UndoManager=t,b.VirtualRenderer=e,b.version=b.config.version}),function(){window.require(["ace/ace"],function(L){L&&(L.config.init(!0),L.define=window.define),window.ace||(window.ace=L);for(var b in L)L.hasOwnProperty(b)&&(window.ace[b]=L[b]);window.ace.default=window.ace,typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=window.ace)})}(),define("ace/snippets",["require","exports","module","ace/lib/oop","ace/lib/event_emitter","ace/lib/lang","ace/range","ace/range_list","ace/keyboard/hash_handler","ace/tokenizer","ace/clipboard","ace/lib/dom","ace/editor"],function(L,b,B){"use strict";var E=L("./lib/oop"),A=L("./lib/event_emitter").EventEmitter,p=L("./lib/lang"),h=L("./range").Range,u=L("./range_list").RangeList,t=L("./keyboard/hash_handler").HashHandler,e=L("./tokenizer").Tokenizer,i=L("./clipboard"),r={CURRENT_WORD:function(c){return c.session.getTextRange(c.session.getWordRange())},SELECTION:function(c,l,g){var d=c.session.getTextRange();return g?d.replace(/\n\r?([ \t]*\S)/g,`
-`+g+"$1"):d},CURRENT_LINE:function(c){return c.session.getLine(c.getCursorPosition().row)},PREV_LINE:function(c){return c.session.getLine(c.getCursorPosition().row-1)},LINE_INDEX:function(c){return c.getCursorPosition().row},LINE_NUMBER:function(c){return c.getCursorPosition().row+1},SOFT_TABS:function(c){return c.session.getUseSoftTabs()?"YES":"NO"},TAB_SIZE:function(c){return c.session.getTabSize()},CLIPBOARD:function(c){return i.getText&&i.getText()},FILENAME:function(c){return/[^/\\]*$/.exec(this.FILEPATH(c))[0]},FILENAME_BASE:function(c){return/[^/\\]*$/.exec(this.FILEPATH(c))[0].replace(/\.[^.]*$/,"")},DIRECTORY:function(c){return this.FILEPATH(c).replace(/[^/\\]*$/,"")},FILEPATH:function(c){return"/not implemented.txt"},WORKSPACE_NAME:function(){return"Unknown"},FULLNAME:function(){return"Unknown"},BLOCK_COMMENT_START:function(c){var l=c.session.$mode||{};return l.blockComment&&l.blockComment.start||""},BLOCK_COMMENT_END:function(c){var l=c.session.$mode||{};return l.blockComment&&l.blockComment.end||""},LINE_COMMENT:function(c){var l=c.session.$mode||{};return l.lineCommentStart||""},CURRENT_YEAR:n.bind(null,{year:"numeric"}),CURRENT_YEAR_SHORT:n.bind(null,{year:"2-digit"}),CURRENT_MONTH:n.bind(null,{month:"numeric"}),CURRENT_MONTH_NAME:n.bind(null,{month:"long"}),CURRENT_MONTH_NAME_SHORT:n.bind(null,{month:"short"}),CURRENT_DATE:n.bind(null,{day:"2-digit"}),CURRENT_DAY_NAME:n.bind(null,{weekday:"long"}),CURRENT_DAY_NAME_SHORT:n.bind(null,{weekday:"short"}),CURRENT_HOUR:n.bind(null,{hour:"2-digit",hour12:!1}),CURRENT_MINUTE:n.bind(null,{minute:"2-digit"}),CURRENT_SECOND:n.bind(null,{second:"2-digit"})};r.SELECTED_TEXT=r.SELECTION;function n(c){var l=new Date().toLocaleString("en-us",c);return l.length==1?"0"+l:l}var o=function(){this.snippetMap={},this.snippetNameMap={}};(function(){E.implement(this,A),this.getTokenizer=function(){return o.$tokenizer||this.createTokenizer()},this.createTokenizer=function(){function c(d){return d=d.substr(1),/^\d+$/.test(d)?[{tabstopId:parseInt(d,10)}]:[{text:d}]}function l(d){return"(?:[^\\\\"+d+"]|\\\\.)"}var g={regex:"/("+l("/")+"+)/",onMatch:function(d,a,f){var s=f[0];return s.fmtString=!0,s.guard=d.slice(1,-1),s.flag="",""},next:"formatString"};return o.$tokenizer=new e({start:[{regex:/\\./,onMatch:function(d,a,f){var s=d[1];return(s=="}"&&f.length||"`$\\".indexOf(s)!=-1)&&(d=s),[d]}},{regex:/}/,onMatch:function(d,a,f){return[f.length?f.shift():d]}},{regex:/\$(?:\d+|\w+)/,onMatch:c},{regex:/\$\{[\dA-Z_a-z]+/,onMatch:function(d,a,f){var s=c(d.substr(1));return f.unshift(s[0]),s},next:"snippetVar"},{regex:/\n/,token:"newline",merge:!1}],snippetVar:[{regex:"\\|"+l("\\|")+"*\\|",onMatch:function(d,a,f){var s=d.slice(1,-1).replace(/\\[,|\\]|,/g,function(v){return v.length==2?v[1]:"\0"}).split("\0").map(function(v){return{value:v}});return f[0].choices=s,[s[0]]},next:"start"},g,{regex:"([^:}\\\\]|\\\\.)*:?",token:"",next:"start"}],formatString:[{regex:/:/,onMatch:function(d,a,f){return f.length&&f[0].expectElse?(f[0].expectElse=!1,f[0].ifEnd={elseEnd:f[0]},[f[0].ifEnd]):":"}},{regex:/\\./,onMatch:function(d,a,f){var s=d[1];return s=="}"&&f.length||"`$\\".indexOf(s)!=-1?d=s:s=="n"?d=`
-`:s=="t"?d="	":"ulULE".indexOf(s)!=-1&&(d={changeCase:s,local:s>"a"}),[d]}},{regex:"/\\w*}",onMatch:function(d,a,f){var s=f.shift();return s&&(s.flag=d.slice(1,-1)),this.next=s&&s.tabstopId?"start":"",[s||d]},next:"start"},{regex:/\$(?:\d+|\w+)/,onMatch:function(d,a,f){return[{text:d.slice(1)}]}},{regex:/\${\w+/,onMatch:function(d,a,f){var s={text:d.slice(2)};return f.unshift(s),[s]},next:"formatStringVar"},{regex:/\n/,token:"newline",merge:!1},{regex:/}/,onMatch:function(d,a,f){var s=f.shift();return this.next=s&&s.tabstopId?"start":"",[s||d]},next:"start"}],formatStringVar:[{regex:/:\/\w+}/,onMatch:function(d,a,f){var s=f[0];return s.formatFunction=d.slice(2,-1),[f.shift()]},next:"formatString"},g,{regex:/:[\?\-+]?/,onMatch:function(d,a,f){d[1]=="+"&&(f[0].ifEnd=f[0]),d[1]=="?"&&(f[0].expectElse=!0)},next:"formatString"},{regex:"([^:}\\\\]|\\\\.)*:?",token:"",next:"formatString"}]}),o.$tokenizer},this.tokenizeTmSnippet=function(c,l){return this.getTokenizer().getLineTokens(c,l).tokens.map(function(g){return g.value||g})},this.getVariableValue=function(c,l,g){if(/^\d+$/.test(l))return(this.variables.__||{})[l]||"";if(/^[A-Z]\d+$/.test(l))return(this.variables[l[0]+"__"]||{})[l.substr(1)]||"";if(l=l.replace(/^TM_/,""),!this.variables.hasOwnProperty(l))return"";var d=this.variables[l];return typeof d=="function"&&(d=this.variables[l](c,l,g)),d??""},this.variables=r,this.tmStrFormat=function(c,l,g){if(!l.fmt)return c;var d=l.flag||"",a=l.guard;a=new RegExp(a,d.replace(/[^gim]/g,""));var f=typeof l.fmt=="string"?this.tokenizeTmSnippet(l.fmt,"formatString"):l.fmt,s=this,v=c.replace(a,function(){var $=s.variables.__;s.variables.__=[].slice.call(arguments);for(var x=s.resolveVariables(f,g),C="E",k=0;k<x.length;k++){var R=x[k];if(typeof R=="object")if(x[k]="",R.changeCase&&R.local){var _=x[k+1];_&&typeof _=="string"&&(R.changeCase=="u"?x[k]=_[0].toUpperCase():x[k]=_[0].toLowerCase(),x[k+1]=_.substr(1))}else R.changeCase&&(C=R.changeCase);else C=="U"?x[k]=R.toUpperCase():C=="L"&&(x[k]=R.toLowerCase())}return s.variables.__=$,x.join("")});return v},this.tmFormatFunction=function(c,l,g){return l.formatFunction=="upcase"?c.toUpperCase():l.formatFunction=="downcase"?c.toLowerCase():c},this.resolveVariables=function(c,l){for(var g=[],d="",a=!0,f=0;f<c.length;f++){var s=c[f];if(typeof s=="string"){g.push(s),s==`
-`?(a=!0,d=""):a&&(d=/^\t*/.exec(s)[0],a=/\S/.test(s));continue}if(s){if(a=!1,s.fmtString){var v=c.indexOf(s,f+1);v==-1&&(v=c.length),s.fmt=c.slice(f+1,v),f=v}if(s.text){var $=this.getVariableValue(l,s.text,d)+"";s.fmtString&&($=this.tmStrFormat($,s,l)),s.formatFunction&&($=this.tmFormatFunction($,s,l)),$&&!s.ifEnd?(g.push($),x(s)):!$&&s.ifEnd&&x(s.ifEnd)}else s.elseEnd?x(s.elseEnd):(s.tabstopId!=null||s.changeCase!=null)&&g.push(s)}}function x(C){var k=c.indexOf(C,f+1);k!=-1&&(f=k)}return g},this.insertSnippetForSelection=function(c,l){var g=c.getCursorPosition(),d=c.session.getLine(g.row),a=c.session.getTabString(),f=d.match(/^\s*/)[0];g.column<f.length&&(f=f.slice(0,g.column)),l=l.replace(/\r/g,"");var s=this.tokenizeTmSnippet(l);s=this.resolveVariables(s,c),s=s.map(function(z){return z==`
-`?z+f:typeof z=="string"?z.replace(/\t/g,a):z});var v=[];s.forEach(function(z,J){if(typeof z=="object"){var X=z.tabstopId,V=v[X];if(V||(V=v[X]=[],V.index=X,V.value="",V.parents={}),V.indexOf(z)===-1){z.choices&&!V.choices&&(V.choices=z.choices),V.push(z);var j=s.indexOf(z,J+1);if(j!==-1){var K=s.slice(J+1,j),q=K.some(function(ee){return typeof ee=="object"});q&&!V.value?V.value=K:K.length&&(!V.value||typeof V.value!="string")&&(V.value=K.join(""))}}}}),v.forEach(function(z){z.length=0});var $={};function x(z){for(var J=[],X=0;X<z.length;X++){var V=z[X];if(typeof V=="object"){if($[V.tabstopId])continue;var j=z.lastIndexOf(V,X-1);V=J[j]||{tabstopId:V.tabstopId}}J[X]=V}return J}for(var C=0;C<s.length;C++){var k=s[C];if(typeof k=="object"){var R=k.tabstopId,_=v[R],O=s.indexOf(k,C+1);if($[R]){$[R]===k&&(delete $[R],Object.keys($).forEach(function(z){_.parents[z]=!0}));continue}$[R]=k;var I=_.value;typeof I!="string"?I=x(I):k.fmt&&(I=this.tmStrFormat(I,k,c)),s.splice.apply(s,[C+1,Math.max(0,O-C)].concat(I,k)),_.indexOf(k)===-1&&_.push(k)}}var D=0,T=0,P="";s.forEach(function(z){if(typeof z=="string"){var J=z.split(`
-`);J.length>1?(T=J[J.length-1].length,D+=J.length-1):T+=z.length,P+=z}else z&&(z.start?z.end={row:D,column:T}:z.start={row:D,column:T})});var M=c.getSelectionRange(),W=c.session.replace(M,P),N=new m(c),G=c.inVirtualSelectionMode&&c.selection.index;N.addTabstops(v,M.start,W,G)},this.insertSnippet=function(c,l){var g=this;if(c.inVirtualSelectionMode)return g.insertSnippetForSelection(c,l);c.forEachSelection(function(){g.insertSnippetForSelection(c,l)},null,{keepOrder:!0}),c.tabstopManager&&c.tabstopManager.tabNext()},this.$getScope=function(c){var l=c.session.$mode.$id||"";if(l=l.split("/").pop(),l==="html"||l==="php"){l==="php"&&!c.session.$mode.inlinePhp&&(l="html");var g=c.getCursorPosition(),d=c.session.getState(g.row);typeof d=="object"&&(d=d[0]),d.substring&&(d.substring(0,3)=="js-"?l="javascript":d.substring(0,4)=="css-"?l="css":d.substring(0,4)=="php-"&&(l="php"))}return l},this.getActiveScopes=function(c){var l=this.$getScope(c),g=[l],d=this.snippetMap;return d[l]&&d[l].includeScopes&&g.push.apply(g,d[l].includeScopes),g.push("_"),g},this.expandWithTab=function(c,l){var g=this,d=c.forEachSelection(function(){return g.expandSnippetForSelection(c,l)},null,{keepOrder:!0});return d&&c.tabstopManager&&c.tabstopManager.tabNext(),d},this.expandSnippetForSelection=function(c,l){var g=c.getCursorPosition(),d=c.session.getLine(g.row),a=d.substring(0,g.column),f=d.substr(g.column),s=this.snippetMap,v;return this.getActiveScopes(c).some(function($){var x=s[$];return x&&(v=this.findMatchingSnippet(x,a,f)),!!v},this),v?(l&&l.dryRun||(c.session.doc.removeInLine(g.row,g.column-v.replaceBefore.length,g.column+v.replaceAfter.length),this.variables.M__=v.matchBefore,this.variables.T__=v.matchAfter,this.insertSnippetForSelection(c,v.content),this.variables.M__=this.variables.T__=null),!0):!1},this.findMatchingSnippet=function(c,l,g){for(var d=c.length;d--;){var a=c[d];if(!(a.startRe&&!a.startRe.test(l))&&!(a.endRe&&!a.endRe.test(g))&&!(!a.startRe&&!a.endRe))return a.matchBefore=a.startRe?a.startRe.exec(l):[""],a.matchAfter=a.endRe?a.endRe.exec(g):[""],a.replaceBefore=a.triggerRe?a.triggerRe.exec(l)[0]:"",a.replaceAfter=a.endTriggerRe?a.endTriggerRe.exec(g)[0]:"",a}},this.snippetMap={},this.snippetNameMap={},this.register=function(c,l){var g=this.snippetMap,d=this.snippetNameMap,a=this;c||(c=[]);function f($){return $&&!/^\^?\(.*\)\$?$|^\\b$/.test($)&&($="(?:"+$+")"),$||""}function s($,x,C){return $=f($),x=f(x),C?($=x+$,$&&$[$.length-1]!="$"&&($=$+"$")):($=$+x,$&&$[0]!="^"&&($="^"+$)),new RegExp($)}function v($){$.scope||($.scope=l||"_"),l=$.scope,g[l]||(g[l]=[],d[l]={});var x=d[l];if($.name){var C=x[$.name];C&&a.unregister(C),x[$.name]=$}g[l].push($),$.prefix&&($.tabTrigger=$.prefix),!$.content&&$.body&&($.content=Array.isArray($.body)?$.body.join(`
-`):$.body),$.tabTrigger&&!$.trigger&&(!$.guard&&/^\w/.test($.tabTrigger)&&($.guard="\\b"),$.trigger=p.escapeRegExp($.tabTrigger)),!(!$.trigger&&!$.guard&&!$.endTrigger&&!$.endGuard)&&($.startRe=s($.trigger,$.guard,!0),$.triggerRe=new RegExp($.trigger),$.endRe=s($.endTrigger,$.endGuard,!0),$.endTriggerRe=new RegExp($.endTrigger))}Array.isArray(c)?c.forEach(v):Object.keys(c).forEach(function($){v(c[$])}),this._signal("registerSnippets",{scope:l})},this.unregister=function(c,l){var g=this.snippetMap,d=this.snippetNameMap;function a(f){var s=d[f.scope||l];if(s&&s[f.name]){delete s[f.name];var v=g[f.scope||l],$=v&&v.indexOf(f);$>=0&&v.splice($,1)}}c.content?a(c):Array.isArray(c)&&c.forEach(a)},this.parseSnippetFile=function(c){c=c.replace(/\r/g,"");for(var l=[],g={},d=/^#.*|^({[\s\S]*})\s*$|^(\S+) (.*)$|^((?:\n*\t.*)+)/gm,a;a=d.exec(c);){if(a[1])try{g=JSON.parse(a[1]),l.push(g)}catch{}if(a[4])g.content=a[4].replace(/^\t/gm,""),l.push(g),g={};else{var f=a[2],s=a[3];if(f=="regex"){var v=/\/((?:[^\/\\]|\\.)*)|$/g;g.guard=v.exec(s)[1],g.trigger=v.exec(s)[1],g.endTrigger=v.exec(s)[1],g.endGuard=v.exec(s)[1]}else f=="snippet"?(g.tabTrigger=s.match(/^\S*/)[0],g.name||(g.name=s)):f&&(g[f]=s)}}return l},this.getSnippetByName=function(c,l){var g=this.snippetNameMap,d;return this.getActiveScopes(l).some(function(a){var f=g[a];return f&&(d=f[c]),!!d},this),d}}).call(o.prototype);var m=function(c){if(c.tabstopManager)return c.tabstopManager;c.tabstopManager=this,this.$onChange=this.onChange.bind(this),this.$onChangeSelection=p.delayedCall(this.onChangeSelection.bind(this)).schedule,this.$onChangeSession=this.onChangeSession.bind(this),this.$onAfterExec=this.onAfterExec.bind(this),this.attach(c)};(function(){this.attach=function(c){this.index=0,this.ranges=[],this.tabstops=[],this.$openTabstops=null,this.selectedTabstop=null,this.editor=c,this.editor.on("change",this.$onChange),this.editor.on("changeSelection",this.$onChangeSelection),this.editor.on("changeSession",this.$onChangeSession),this.editor.commands.on("afterExec",this.$onAfterExec),this.editor.keyBinding.addKeyboardHandler(this.keyboardHandler)},this.detach=function(){this.tabstops.forEach(this.removeTabstopMarkers,this),this.ranges=null,this.tabstops=null,this.selectedTabstop=null,this.editor.removeListener("change",this.$onChange),this.editor.removeListener("changeSelection",this.$onChangeSelection),this.editor.removeListener("changeSession",this.$onChangeSession),this.editor.commands.removeListener("afterExec",this.$onAfterExec),this.editor.keyBinding.removeKeyboardHandler(this.keyboardHandler),this.editor.tabstopManager=null,this.editor=null},this.onChange=function(c){for(var l=c.action[0]=="r",g=this.selectedTabstop||{},d=g.parents||{},a=(this.tabstops||[]).slice(),f=0;f<a.length;f++){var s=a[f],v=s==g||d[s.index];if(s.rangeList.$bias=v?0:1,c.action=="remove"&&s!==g){var $=s.parents&&s.parents[g.index],x=s.rangeList.pointIndex(c.start,$);x=x<0?-x-1:x+1;var C=s.rangeList.pointIndex(c.end,$);C=C<0?-C-1:C-1;for(var k=s.rangeList.ranges.slice(x,C),R=0;R<k.length;R++)this.removeRange(k[R])}s.rangeList.$onChange(c)}var _=this.editor.session;!this.$inChange&&l&&_.getLength()==1&&!_.getValue()&&this.detach()},this.updateLinkedFields=function(){var c=this.selectedTabstop;if(!(!c||!c.hasLinkedRanges||!c.firstNonLinked)){this.$inChange=!0;for(var l=this.editor.session,g=l.getTextRange(c.firstNonLinked),d=0;d<c.length;d++){var a=c[d];if(a.linked){var f=a.original,s=b.snippetManager.tmStrFormat(g,f,this.editor);l.replace(a,s)}}this.$inChange=!1}},this.onAfterExec=function(c){c.command&&!c.command.readOnly&&this.updateLinkedFields()},this.onChangeSelection=function(){if(this.editor){for(var c=this.editor.selection.lead,l=this.editor.selection.anchor,g=this.editor.selection.isEmpty(),d=0;d<this.ranges.length;d++)if(!this.ranges[d].linked){var a=this.ranges[d].contains(c.row,c.column),f=g||this.ranges[d].contains(l.row,l.column);if(a&&f)return}this.detach()}},this.onChangeSession=function(){this.detach()},this.tabNext=function(c){var l=this.tabstops.length,g=this.index+(c||1);g=Math.min(Math.max(g,1),l),g==l&&(g=0),this.selectTabstop(g),g===0&&this.detach()},this.selectTabstop=function(c){this.$openTabstops=null;var l=this.tabstops[this.index];if(l&&this.addTabstopMarkers(l),this.index=c,l=this.tabstops[this.index],!(!l||!l.length)){this.selectedTabstop=l;var g=l.firstNonLinked||l;if(l.choices&&(g.cursor=g.start),this.editor.inVirtualSelectionMode)this.editor.selection.fromOrientedRange(g);else{var d=this.editor.multiSelect;d.toSingleRange(g);for(var a=0;a<l.length;a++)l.hasLinkedRanges&&l[a].linked||d.addRange(l[a].clone(),!0)}this.editor.keyBinding.addKeyboardHandler(this.keyboardHandler),this.selectedTabstop&&this.selectedTabstop.choices&&this.editor.execCommand("startAutocomplete",{matches:this.selectedTabstop.choices})}},this.addTabstops=function(c,l,g){var d=this.useLink||!this.editor.getOption("enableMultiselect");if(this.$openTabstops||(this.$openTabstops=[]),!c[0]){var a=h.fromPoints(g,g);S(a.start,l),S(a.end,l),c[0]=[a],c[0].index=0}var f=this.index,s=[f+1,0],v=this.ranges;c.forEach(function($,x){for(var C=this.$openTabstops[x]||$,k=0;k<$.length;k++){var R=$[k],_=h.fromPoints(R.start,R.end||R.start);w(_.start,l),w(_.end,l),_.original=R,_.tabstop=C,v.push(_),C!=$?C.unshift(_):C[k]=_,R.fmtString||C.firstNonLinked&&d?(_.linked=!0,C.hasLinkedRanges=!0):C.firstNonLinked||(C.firstNonLinked=_)}C.firstNonLinked||(C.hasLinkedRanges=!1),C===$&&(s.push(C),this.$openTabstops[x]=C),this.addTabstopMarkers(C),C.rangeList=C.rangeList||new u,C.rangeList.$bias=0,C.rangeList.addList(C)},this),s.length>2&&(this.tabstops.length&&s.push(s.splice(2,1)[0]),this.tabstops.splice.apply(this.tabstops,s))},this.addTabstopMarkers=function(c){var l=this.editor.session;c.forEach(function(g){g.markerId||(g.markerId=l.addMarker(g,"ace_snippet-marker","text"))})},this.removeTabstopMarkers=function(c){var l=this.editor.session;c.forEach(function(g){l.removeMarker(g.markerId),g.markerId=null})},this.removeRange=function(c){var l=c.tabstop.indexOf(c);l!=-1&&c.tabstop.splice(l,1),l=this.ranges.indexOf(c),l!=-1&&this.ranges.splice(l,1),l=c.tabstop.rangeList.ranges.indexOf(c),l!=-1&&c.tabstop.splice(l,1),this.editor.session.removeMarker(c.markerId),c.tabstop.length||(l=this.tabstops.indexOf(c.tabstop),l!=-1&&this.tabstops.splice(l,1),this.tabstops.length||this.detach())},this.keyboardHandler=new t,this.keyboardHandler.bindKeys({Tab:function(c){b.snippetManager&&b.snippetManager.expandWithTab(c)||(c.tabstopManager.tabNext(1),c.renderer.scrollCursorIntoView())},"Shift-Tab":function(c){c.tabstopManager.tabNext(-1),c.renderer.scrollCursorIntoView()},Esc:function(c){c.tabstopManager.detach()}})}).call(m.prototype);var w=function(c,l){c.row==0&&(c.column+=l.column),c.row+=l.row},S=function(c,l){c.row==l.row&&(c.column-=l.column),c.row-=l.row};L("./lib/dom").importCssString(".ace_snippet-marker {    -moz-box-sizing: border-box;    box-sizing: border-box;    background: rgba(194, 193, 208, 0.09);    border: 1px dotted rgba(211, 208, 235, 0.62);    position: absolute;}"),b.snippetManager=new o;var y=L("./editor").Editor;(function(){this.insertSnippet=function(c,l){return b.snippetManager.insertSnippet(this,c,l)},this.expandSnippet=function(c){return b.snippetManager.expandWithTab(this,c)}}).call(y.prototype)}),define("ace/autocomplete/popup",["require","exports","module","ace/virtual_renderer","ace/editor","ace/range","ace/lib/event","ace/lib/lang","ace/lib/dom"],function(L,b,B){"use strict";var E=L("../virtual_renderer").VirtualRenderer,A=L("../editor").Editor,p=L("../range").Range,h=L("../lib/event"),u=L("../lib/lang"),t=L("../lib/dom"),e=function(r){var n=new E(r);n.$maxLines=4;var o=new A(n);return o.setHighlightActiveLine(!1),o.setShowPrintMargin(!1),o.renderer.setShowGutter(!1),o.renderer.setHighlightGutterLine(!1),o.$mouseHandler.$focusTimeout=0,o.$highlightTagPending=!0,o},i=function(r){var n=t.createElement("div"),o=new e(n);r&&r.appendChild(n),n.style.display="none",o.renderer.content.style.cursor="default",o.renderer.setStyle("ace_autocomplete"),o.setOption("displayIndentGuides",!1),o.setOption("dragDelay",150);var m=function(){};o.focus=m,o.$isFocused=!0,o.renderer.$cursorLayer.restartTimer=m,o.renderer.$cursorLayer.element.style.opacity=0,o.renderer.$maxLines=8,o.renderer.$keepTextAreaAtCursor=!1,o.setHighlightActiveLine(!1),o.session.highlight(""),o.session.$searchHighlight.clazz="ace_highlight-marker",o.on("mousedown",function(d){var a=d.getDocumentPosition();o.selection.moveToPosition(a),y.start.row=y.end.row=a.row,d.stop()});var w,S=new p(-1,0,-1,1/0),y=new p(-1,0,-1,1/0);y.id=o.session.addMarker(y,"ace_active-line","fullLine"),o.setSelectOnHover=function(d){d?S.id&&(o.session.removeMarker(S.id),S.id=null):S.id=o.session.addMarker(S,"ace_line-hover","fullLine")},o.setSelectOnHover(!1),o.on("mousemove",function(d){if(!w){w=d;return}if(!(w.x==d.x&&w.y==d.y)){w=d,w.scrollTop=o.renderer.scrollTop;var a=w.getDocumentPosition().row;S.start.row!=a&&(S.id||o.setRow(a),l(a))}}),o.renderer.on("beforeRender",function(){if(w&&S.start.row!=-1){w.$pos=null;var d=w.getDocumentPosition().row;S.id||o.setRow(d),l(d,!0)}}),o.renderer.on("afterRender",function(){var d=o.getRow(),a=o.renderer.$textLayer,f=a.element.childNodes[d-a.config.firstRow];f!==a.selectedNode&&a.selectedNode&&t.removeCssClass(a.selectedNode,"ace_selected"),a.selectedNode=f,f&&t.addCssClass(f,"ace_selected")});var c=function(){l(-1)},l=function(d,a){d!==S.start.row&&(S.start.row=S.end.row=d,a||o.session._emit("changeBackMarker"),o._emit("changeHoverMarker"))};o.getHoveredRow=function(){return S.start.row},h.addListener(o.container,"mouseout",c),o.on("hide",c),o.on("changeSelection",c),o.session.doc.getLength=function(){return o.data.length},o.session.doc.getLine=function(d){var a=o.data[d];return typeof a=="string"?a:a&&a.value||""};var g=o.session.bgTokenizer;return g.$tokenizeRow=function(d){var a=o.data[d],f=[];if(!a)return f;typeof a=="string"&&(a={value:a});var s=a.caption||a.value||a.name;function v(I,D){I&&f.push({type:(a.className||"")+(D||""),value:I})}for(var $=s.toLowerCase(),x=(o.filterText||"").toLowerCase(),C=0,k=0,R=0;R<=x.length;R++)if(R!=k&&(a.matchMask&1<<R||R==x.length)){var _=x.slice(k,R);k=R;var O=$.indexOf(_,C);if(O==-1)continue;v(s.slice(C,O),""),C=O+_.length,v(s.slice(O,C),"completion-highlight")}return v(s.slice(C,s.length),""),a.meta&&f.push({type:"completion-meta",value:a.meta}),a.message&&f.push({type:"completion-message",value:a.message}),f},g.$updateOnChange=m,g.start=m,o.session.$computeWidth=function(){return this.screenWidth=0},o.isOpen=!1,o.isTopdown=!1,o.autoSelect=!0,o.filterText="",o.data=[],o.setData=function(d,a){o.filterText=a||"",o.setValue(u.stringRepeat(`
-`,d.length),-1),o.data=d||[],o.setRow(0)},o.getData=function(d){return o.data[d]},o.getRow=function(){return y.start.row},o.setRow=function(d){d=Math.max(this.autoSelect?0:-1,Math.min(this.data.length,d)),y.start.row!=d&&(o.selection.clearSelection(),y.start.row=y.end.row=d||0,o.session._emit("changeBackMarker"),o.moveCursorTo(d||0,0),o.isOpen&&o._signal("select"))},o.on("changeSelection",function(){o.isOpen&&o.setRow(o.selection.lead.row),o.renderer.scrollCursorIntoView()}),o.hide=function(){this.container.style.display="none",this._signal("hide"),o.isOpen=!1},o.show=function(d,a,f){var s=this.container,v=window.innerHeight,$=window.innerWidth,x=this.renderer,C=x.$maxLines*a*1.4,k=d.top+this.$borderSize,R=k>v/2&&!f;R&&k+a+C>v?(x.$maxPixelHeight=k-2*this.$borderSize,s.style.top="",s.style.bottom=v-k+"px",o.isTopdown=!1):(k+=a,x.$maxPixelHeight=v-k-.2*a,s.style.top=k+"px",s.style.bottom="",o.isTopdown=!0),s.style.display="";var _=d.left;_+s.offsetWidth>$&&(_=$-s.offsetWidth),s.style.left=_+"px",this._signal("show"),w=null,o.isOpen=!0},o.goTo=function(d){var a=this.getRow(),f=this.session.getLength()-1;switch(d){case"up":a=a<=0?f:a-1;break;case"down":a=a>=f?-1:a+1;break;case"start":a=0;break;case"end":a=f;break}this.setRow(a)},o.getTextLeftOffset=function(){return this.$borderSize+this.renderer.$padding+this.$imageSize},o.$imageSize=0,o.$borderSize=1,o};t.importCssString(".ace_editor.ace_autocomplete .ace_marker-layer .ace_active-line {    background-color: #CAD6FA;    z-index: 1;}.ace_dark.ace_editor.ace_autocomplete .ace_marker-layer .ace_active-line {    background-color: #3a674e;}.ace_editor.ace_autocomplete .ace_line-hover {    border: 1px solid #abbffe;    margin-top: -1px;    background: rgba(233,233,253,0.4);    position: absolute;    z-index: 2;}.ace_dark.ace_editor.ace_autocomplete .ace_line-hover {    border: 1px solid rgba(109, 150, 13, 0.8);    background: rgba(58, 103, 78, 0.62);}.ace_completion-meta {    opacity: 0.5;    margin: 0.9em;}.ace_completion-message {    color: blue;}.ace_editor.ace_autocomplete .ace_completion-highlight{    color: #2d69c7;}.ace_dark.ace_editor.ace_autocomplete .ace_completion-highlight{    color: #93ca12;}.ace_editor.ace_autocomplete {    width: 300px;    z-index: 200000;    border: 1px lightgray solid;    position: fixed;    box-shadow: 2px 3px 5px rgba(0,0,0,.2);    line-height: 1.4;    background: #fefefe;    color: #111;}.ace_dark.ace_editor.ace_autocomplete {    border: 1px #484747 solid;    box-shadow: 2px 3px 5px rgba(0, 0, 0, 0.51);    line-height: 1.4;    background: #25282c;    color: #c1c1c1;}","autocompletion.css"),b.AcePopup=i,b.$singleLineEditor=e}),define("ace/autocomplete/util",["require","exports","module"],function(L,b,B){"use strict";b.parForEach=function(A,p,h){var u=0,t=A.length;t===0&&h();for(var e=0;e<t;e++)p(A[e],function(i,r){u++,u===t&&h(i,r)})};var E=/[a-zA-Z_0-9\$\-\u00A2-\u2000\u2070-\uFFFF]/;b.retrievePrecedingIdentifier=function(A,p,h){h=h||E;for(var u=[],t=p-1;t>=0&&h.test(A[t]);t--)u.push(A[t]);return u.reverse().join("")},b.retrieveFollowingIdentifier=function(A,p,h){h=h||E;for(var u=[],t=p;t<A.length&&h.test(A[t]);t++)u.push(A[t]);return u},b.getCompletionPrefix=function(A){var p=A.getCursorPosition(),h=A.session.getLine(p.row),u;return A.completers.forEach(function(t){t.identifierRegexps&&t.identifierRegexps.forEach(function(e){!u&&e&&(u=this.retrievePrecedingIdentifier(h,p.column,e))}.bind(this))}.bind(this)),u||this.retrievePrecedingIdentifier(h,p.column)}}),define("ace/autocomplete",["require","exports","module","ace/keyboard/hash_handler","ace/autocomplete/popup","ace/autocomplete/util","ace/lib/lang","ace/lib/dom","ace/snippets","ace/config"],function(L,b,B){"use strict";var E=L("./keyboard/hash_handler").HashHandler,A=L("./autocomplete/popup").AcePopup,p=L("./autocomplete/util"),h=L("./lib/lang"),u=L("./lib/dom"),t=L("./snippets").snippetManager,e=L("./config"),i=function(){this.autoInsert=!1,this.autoSelect=!0,this.exactMatch=!1,this.gatherCompletionsId=0,this.keyboardHandler=new E,this.keyboardHandler.bindKeys(this.commands),this.blurListener=this.blurListener.bind(this),this.changeListener=this.changeListener.bind(this),this.mousedownListener=this.mousedownListener.bind(this),this.mousewheelListener=this.mousewheelListener.bind(this),this.changeTimer=h.delayedCall(function(){this.updateCompletions(!0)}.bind(this)),this.tooltipTimer=h.delayedCall(this.updateDocTooltip.bind(this),50)};(function(){this.$init=function(){return this.popup=new A(document.body||document.documentElement),this.popup.on("click",function(n){this.insertMatch(),n.stop()}.bind(this)),this.popup.focus=this.editor.focus.bind(this.editor),this.popup.on("show",this.tooltipTimer.bind(null,null)),this.popup.on("select",this.tooltipTimer.bind(null,null)),this.popup.on("changeHoverMarker",this.tooltipTimer.bind(null,null)),this.popup},this.getPopup=function(){return this.popup||this.$init()},this.openPopup=function(n,o,m){this.popup||this.$init(),this.popup.autoSelect=this.autoSelect,this.popup.setData(this.completions.filtered,this.completions.filterText),n.keyBinding.addKeyboardHandler(this.keyboardHandler);var w=n.renderer;if(this.popup.setRow(this.autoSelect?0:-1),m)m&&!o&&this.detach();else{this.popup.setTheme(n.getTheme()),this.popup.setFontSize(n.getFontSize());var S=w.layerConfig.lineHeight,y=w.$cursorLayer.getPixelPosition(this.base,!0);y.left-=this.popup.getTextLeftOffset();var c=n.container.getBoundingClientRect();y.top+=c.top-w.layerConfig.offset,y.left+=c.left-n.renderer.scrollLeft,y.left+=w.gutterWidth,this.popup.show(y,S)}this.changeTimer.cancel()},this.detach=function(){this.editor.keyBinding.removeKeyboardHandler(this.keyboardHandler),this.editor.off("changeSelection",this.changeListener),this.editor.off("blur",this.blurListener),this.editor.off("mousedown",this.mousedownListener),this.editor.off("mousewheel",this.mousewheelListener),this.changeTimer.cancel(),this.hideDocTooltip(),this.gatherCompletionsId+=1,this.popup&&this.popup.isOpen&&this.popup.hide(),this.base&&this.base.detach(),this.activated=!1,this.completions=this.base=null},this.changeListener=function(n){var o=this.editor.selection.lead;(o.row!=this.base.row||o.column<this.base.column)&&this.detach(),this.activated?this.changeTimer.schedule():this.detach()},this.blurListener=function(n){var o=document.activeElement,m=this.editor.textInput.getElement(),w=n.relatedTarget&&this.tooltipNode&&this.tooltipNode.contains(n.relatedTarget),S=this.popup&&this.popup.container;o!=m&&o.parentNode!=S&&!w&&o!=this.tooltipNode&&n.relatedTarget!=m&&this.detach()},this.mousedownListener=function(n){this.detach()},this.mousewheelListener=function(n){this.detach()},this.goTo=function(n){this.popup.goTo(n)},this.insertMatch=function(n,o){if(n||(n=this.popup.getData(this.popup.getRow())),!n)return!1;var m=this.completions;if(this.editor.startOperation({command:{name:"insertMatch"}}),n.completer&&n.completer.insertMatch)n.completer.insertMatch(this.editor,n);else{if(m.filterText)for(var w=this.editor.selection.getAllRanges(),S=0,y;y=w[S];S++)y.start.column-=m.filterText.length,this.editor.session.remove(y);n.snippet?t.insertSnippet(this.editor,n.snippet):this.editor.execCommand("insertstring",n.value||n)}this.completions==m&&this.detach(),this.editor.endOperation()},this.commands={Up:function(n){n.completer.goTo("up")},Down:function(n){n.completer.goTo("down")},"Ctrl-Up|Ctrl-Home":function(n){n.completer.goTo("start")},"Ctrl-Down|Ctrl-End":function(n){n.completer.goTo("end")},Esc:function(n){n.completer.detach()},Return:function(n){return n.completer.insertMatch()},"Shift-Return":function(n){n.completer.insertMatch(null,{deleteSuffix:!0})},Tab:function(n){var o=n.completer.insertMatch();if(!o&&!n.tabstopManager)n.completer.goTo("down");else return o},PageUp:function(n){n.completer.popup.gotoPageUp()},PageDown:function(n){n.completer.popup.gotoPageDown()}},this.gatherCompletions=function(n,o){var m=n.getSession(),w=n.getCursorPosition(),S=p.getCompletionPrefix(n);this.base=m.doc.createAnchor(w.row,w.column-S.length),this.base.$insertRight=!0;var y=[],c=n.completers.length;return n.completers.forEach(function(l,g){l.getCompletions(n,m,w,S,function(d,a){!d&&a&&(y=y.concat(a)),o(null,{prefix:p.getCompletionPrefix(n),matches:y,finished:--c===0})})}),!0},this.showPopup=function(n,o){this.editor&&this.detach(),this.activated=!0,this.editor=n,n.completer!=this&&(n.completer&&n.completer.detach(),n.completer=this),n.on("changeSelection",this.changeListener),n.on("blur",this.blurListener),n.on("mousedown",this.mousedownListener),n.on("mousewheel",this.mousewheelListener),this.updateCompletions(!1,o)},this.updateCompletions=function(n,o){if(n&&this.base&&this.completions){var m=this.editor.getCursorPosition(),w=this.editor.session.getTextRange({start:this.base,end:m});if(w==this.completions.filterText)return;if(this.completions.setFilter(w),!this.completions.filtered.length)return this.detach();if(this.completions.filtered.length==1&&this.completions.filtered[0].value==w&&!this.completions.filtered[0].snippet)return this.detach();this.openPopup(this.editor,w,n);return}if(o&&o.matches){var m=this.editor.getSelectionRange().start;return this.base=this.editor.session.doc.createAnchor(m.row,m.column),this.base.$insertRight=!0,this.completions=new r(o.matches),this.openPopup(this.editor,"",n)}var S=this.gatherCompletionsId;this.gatherCompletions(this.editor,function(y,c){var l=function(){if(c.finished)return this.detach()}.bind(this),g=c.prefix,d=c&&c.matches;if(!d||!d.length)return l();if(!(g.indexOf(c.prefix)!==0||S!=this.gatherCompletionsId)){this.completions=new r(d),this.exactMatch&&(this.completions.exactMatch=!0),this.completions.setFilter(g);var a=this.completions.filtered;if(!a.length||a.length==1&&a[0].value==g&&!a[0].snippet)return l();if(this.autoInsert&&a.length==1&&c.finished)return this.insertMatch(a[0]);this.openPopup(this.editor,g,n)}}.bind(this))},this.cancelContextMenu=function(){this.editor.$mouseHandler.cancelContextMenu()},this.updateDocTooltip=function(){var n=this.popup,o=n.data,m=o&&(o[n.getHoveredRow()]||o[n.getRow()]),w=null;if(!m||!this.editor||!this.popup.isOpen)return this.hideDocTooltip();if(this.editor.completers.some(function(S){return S.getDocTooltip&&(w=S.getDocTooltip(m)),w}),!w&&typeof m!="string"&&(w=m),typeof w=="string"&&(w={docText:w}),!w||!(w.docHTML||w.docText))return this.hideDocTooltip();this.showDocTooltip(w)},this.showDocTooltip=function(n){this.tooltipNode||(this.tooltipNode=u.createElement("div"),this.tooltipNode.className="ace_tooltip ace_doc-tooltip",this.tooltipNode.style.margin=0,this.tooltipNode.style.pointerEvents="auto",this.tooltipNode.tabIndex=-1,this.tooltipNode.onblur=this.blurListener.bind(this),this.tooltipNode.onclick=this.onTooltipClick.bind(this));var o=this.tooltipNode;n.docHTML?o.innerHTML=n.docHTML:n.docText&&(o.textContent=n.docText),o.parentNode||document.body.appendChild(o);var m=this.popup,w=m.container.getBoundingClientRect();o.style.top=m.container.style.top,o.style.bottom=m.container.style.bottom,o.style.display="block",window.innerWidth-w.right<320?w.left<320?m.isTopdown?(o.style.top=w.bottom+"px",o.style.left=w.left+"px",o.style.right="",o.style.bottom=""):(o.style.top=m.container.offsetTop-o.offsetHeight+"px",o.style.left=w.left+"px",o.style.right="",o.style.bottom=""):(o.style.right=window.innerWidth-w.left+"px",o.style.left=""):(o.style.left=w.right+1+"px",o.style.right="")},this.hideDocTooltip=function(){if(this.tooltipTimer.cancel(),!!this.tooltipNode){var n=this.tooltipNode;!this.editor.isFocused()&&document.activeElement==n&&this.editor.focus(),this.tooltipNode=null,n.parentNode&&n.parentNode.removeChild(n)}},this.onTooltipClick=function(n){for(var o=n.target;o&&o!=this.tooltipNode;){if(o.nodeName=="A"&&o.href){o.rel="noreferrer",o.target="_blank";break}o=o.parentNode}},this.destroy=function(){if(this.detach(),this.popup){this.popup.destroy();var n=this.popup.container;n&&n.parentNode&&n.parentNode.removeChild(n)}this.editor&&this.editor.completer==this&&this.editor.completer==null,this.popup=null}}).call(i.prototype),i.for=function(n){return n.completer||(e.get("sharedPopups")?(i.$shared||(i.$sharedInstance=new i),n.completer=i.$sharedInstance):(n.completer=new i,n.once("destroy",function(o,m){m.completer.destroy()}))),n.completer},i.startCommand={name:"startAutocomplete",exec:function(n,o){var m=i.for(n);m.autoInsert=!1,m.autoSelect=!0,m.showPopup(n,o),m.cancelContextMenu()},bindKey:"Ctrl-Space|Ctrl-Shift-Space|Alt-Space"};var r=function(n,o){this.all=n,this.filtered=n,this.filterText=o||"",this.exactMatch=!1};(function(){this.setFilter=function(n){if(n.length>this.filterText&&n.lastIndexOf(this.filterText,0)===0)var o=this.filtered;else var o=this.all;this.filterText=n,o=this.filterCompletions(o,this.filterText),o=o.sort(function(w,S){return S.exactMatch-w.exactMatch||S.$score-w.$score||(w.caption||w.value).localeCompare(S.caption||S.value)});var m=null;o=o.filter(function(w){var S=w.snippet||w.caption||w.value;return S===m?!1:(m=S,!0)}),this.filtered=o},this.filterCompletions=function(n,o){var m=[],w=o.toUpperCase(),S=o.toLowerCase();e:for(var y=0,c;c=n[y];y++){var l=c.caption||c.value||c.snippet;if(l){var g=-1,d=0,a=0,f,s;if(this.exactMatch){if(o!==l.substr(0,o.length))continue e}else{var v=l.toLowerCase().indexOf(S);if(v>-1)a=v;else for(var $=0;$<o.length;$++){var x=l.indexOf(S[$],g+1),C=l.indexOf(w[$],g+1);if(f=x>=0&&(C<0||x<C)?x:C,f<0)continue e;s=f-g-1,s>0&&(g===-1&&(a+=10),a+=s,d=d|1<<$),g=f}}c.matchMask=d,c.exactMatch=a?0:1,c.$score=(c.score||0)-a,m.push(c)}}return m}}).call(r.prototype),b.Autocomplete=i,b.FilteredList=r}),define("ace/autocomplete/text_completer",["require","exports","module","ace/range"],function(L,b,B){var E=L("../range").Range,A=/[^a-zA-Z_0-9\$\-\u00C0-\u1FFF\u2C00-\uD7FF\w]+/;function p(u,t){var e=u.getTextRange(E.fromPoints({row:0,column:0},t));return e.split(A).length-1}function h(u,t){var e=p(u,t),i=u.getValue().split(A),r=Object.create(null),n=i[e];return i.forEach(function(o,m){if(!(!o||o===n)){var w=Math.abs(e-m),S=i.length-w;r[o]?r[o]=Math.max(S,r[o]):r[o]=S}}),r}b.getCompletions=function(u,t,e,i,r){var n=h(t,e),o=Object.keys(n);r(null,o.map(function(m){return{caption:m,value:m,score:n[m],meta:"local"}}))}}),define("ace/ext/language_tools",["require","exports","module","ace/snippets","ace/autocomplete","ace/config","ace/lib/lang","ace/autocomplete/util","ace/autocomplete/text_completer","ace/editor","ace/config"],function(L,b,B){"use strict";var E=L("../snippets").snippetManager,A=L("../autocomplete").Autocomplete,p=L("../config"),h=L("../lib/lang"),u=L("../autocomplete/util"),t=L("../autocomplete/text_completer"),e={getCompletions:function(c,l,g,d,a){if(l.$mode.completer)return l.$mode.completer.getCompletions(c,l,g,d,a);var f=c.session.getState(g.row),s=l.$mode.getCompletions(f,l,g,d);a(null,s)}},i={getCompletions:function(c,l,g,d,a){var f=[],s=l.getTokenAt(g.row,g.column);s&&s.type.match(/(tag-name|tag-open|tag-whitespace|attribute-name|attribute-value)\.xml$/)?f.push("html-tag"):f=E.getActiveScopes(c);var v=E.snippetMap,$=[];f.forEach(function(x){for(var C=v[x]||[],k=C.length;k--;){var R=C[k],_=R.name||R.tabTrigger;_&&$.push({caption:_,snippet:R.content,meta:R.tabTrigger&&!R.name?R.tabTrigger+"\u21E5 ":"snippet",type:"snippet"})}},this),a(null,$)},getDocTooltip:function(c){c.type=="snippet"&&!c.docHTML&&(c.docHTML=["<b>",h.escapeHTML(c.caption),"</b>","<hr></hr>",h.escapeHTML(c.snippet)].join(""))}},r=[i,t,e];b.setCompleters=function(c){r.length=0,c&&r.push.apply(r,c)},b.addCompleter=function(c){r.push(c)},b.textCompleter=t,b.keyWordCompleter=e,b.snippetCompleter=i;var n={name:"expandSnippet",exec:function(c){return E.expandWithTab(c)},bindKey:"Tab"},o=function(c,l){m(l.session.$mode)},m=function(c){typeof c=="string"&&(c=p.$modes[c]),c&&(E.files||(E.files={}),w(c.$id,c.snippetFileId),c.modes&&c.modes.forEach(m))},w=function(c,l){!l||!c||E.files[c]||(E.files[c]={},p.loadModule(l,function(g){g&&(E.files[c]=g,!g.snippets&&g.snippetText&&(g.snippets=E.parseSnippetFile(g.snippetText)),E.register(g.snippets||[],g.scope),g.includeScopes&&(E.snippetMap[g.scope].includeScopes=g.includeScopes,g.includeScopes.forEach(function(d){m("ace/mode/"+d)})))}))},S=function(c){var l=c.editor,g=l.completer&&l.completer.activated;if(c.command.name==="backspace")g&&!u.getCompletionPrefix(l)&&l.completer.detach();else if(c.command.name==="insertstring"){var d=u.getCompletionPrefix(l);if(d&&!g){var a=A.for(l);a.autoInsert=!1,a.showPopup(l)}}},y=L("../editor").Editor;L("../config").defineOptions(y.prototype,"editor",{enableBasicAutocompletion:{set:function(c){c?(this.completers||(this.completers=Array.isArray(c)?c:r),this.commands.addCommand(A.startCommand)):this.commands.removeCommand(A.startCommand)},value:!1},enableLiveAutocompletion:{set:function(c){c?(this.completers||(this.completers=Array.isArray(c)?c:r),this.commands.on("afterExec",S)):this.commands.removeListener("afterExec",S)},value:!1},enableSnippets:{set:function(c){c?(this.commands.addCommand(n),this.on("changeMode",o),o(null,this)):(this.commands.removeCommand(n),this.off("changeMode",o))},value:!1}})}),function(){window.require(["ace/ext/language_tools"],function(L){typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=L)})}(),define("ace/mode/mongo_highlight_rules",["require","exports","module","ace/lib/oop","ace/mode/text_highlight_rules"],function(L,b,B){"use strict";var E=L("../lib/oop"),A=L("./text_highlight_rules").TextHighlightRules,p=function(){var h=function(u){switch(u){case"ObjectId":case"ISODate":case"Timestamp":case"NumberInt":case"NumberLong":case"NumberDecimal":case"BinData":case"true":case"false":case"null":case"undefined":return"constant.language";case"db":case"find":case"aggregate":case"update":case"explain":return"storage.function";default:return"identifier"}};this.$rules={start:[{token:"comment",regex:"//.*"},{token:"key",regex:'["](?:(?:\\\\.)|(?:[^"\\\\]))*?["]\\s*(?=:)'},{token:"string",regex:'["](?:(?:\\\\.)|(?:[^"\\\\]))*?["]'},{token:"constant.numeric",regex:"0[xX][0-9a-fA-F]+\\b"},{token:"constant.numeric",regex:"[+-]?\\d+(?:(?:\\.\\d*)?(?:[eE][+-]?\\d+)?)?\\b"},{token:"paren.lparen",regex:"[[({]"},{token:"paren.rparen",regex:"[\\])}]"},{token:h,regex:"[a-zA-Z_$][a-zA-Z0-9_$]*\\b"}]}};E.inherits(p,A),b.MongoHighlightRules=p}),define("ace/mode/matching_brace_outdent",["require","exports","module","ace/range"],function(L,b,B){"use strict";var E=L("../range").Range,A=function(){this.checkOutdent=function(p,h){return/^\s+$/.test(p)?/^\s*\}/.test(h):!1},this.autoOutdent=function(p,h){var u=p.getLine(h),t=u.match(/^(\s*\})/);if(!t)return 0;var e=t[1].length,i=p.findMatchingBracket({row:h,column:e});if(!i||i.row==h)return 0;var r=this.$getIndent(p.getLine(i.row));p.replace(new E(h,0,h,e-1),r)},this.$getIndent=function(p){return p.match(/^\s*/)[0]}};b.MatchingBraceOutdent=A}),define("ace/mode/folding/cstyle",["require","exports","module","ace/lib/oop","ace/mode/folding/fold_mode"],function(L,b,B){"use strict";var E=L("../../lib/oop"),A=L("./fold_mode").FoldMode,p=function(){this.foldingStartMarker=/([\{\[\(])[^\}\]\)]*$/,this.foldingStopMarker=/^[^\[\{\(]*([\}\]\)])/,this._getFoldWidgetBase=this.getFoldWidget,this.getFoldWidget=function(h,u,t){return this._getFoldWidgetBase(h,u,t)},this.getFoldWidgetRange=function(h,u,t,e){var i=h.getLine(t),r=i.match(this.foldingStartMarker);if(r.length>=2)return this.openingBracketBlock(h,r[1],t,r.index);if(u!=="markbegin"&&(r=i.match(this.foldingStopMarker),r.length>=2))return this.closingBracketBlock(h,r[1],t,r.index+r[0].length)}};E.inherits(p,A),b.FoldMode=p}),define("ace/mode/mongo",["require","exports","module","ace/lib/oop","ace/mode/text","ace/mode/mongo_highlight_rules","ace/mode/matching_brace_outdent","ace/mode/behaviour/cstyle","ace/mode/folding/cstyle"],function(L,b,B){"use strict";var E=L("../lib/oop"),A=L("./text").Mode,p=L("./mongo_highlight_rules").MongoHighlightRules,h=L("./matching_brace_outdent").MatchingBraceOutdent,u=L("./behaviour/cstyle").CstyleBehaviour,t=L("./folding/cstyle").FoldMode,e=function(){this.HighlightRules=p,this.$outdent=new h,this.$behaviour=new u,this.foldingRules=new t,this.getNextLineIndent=function(i,r,n){var o=this.$getIndent(r);if(i=="start"){var m=r.match(/^.*[\{\(\[]\s*$/);m&&(o+=n)}return o},this.checkOutdent=function(i,r,n){return this.$outdent.checkOutdent(r,n)},this.autoOutdent=function(i,r,n){this.$outdent.autoOutdent(r,n)},this.$id="ace/mode/mongo"};E.inherits(e,A),b.Mode=e}),function(){window.require(["ace/mode/mongo"],function(L){typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=L)})}();var Completer=function(L){const b=[{caption:"find()",value:"find()",meta:"method"},{caption:"aggregate()",value:"aggregate()",meta:"method"},{caption:"update()",value:"update()",meta:"method"},{caption:"explain()",value:"explain()",meta:"method"}],B=[{caption:"true",value:"true",meta:"bson keyword"},{caption:"false",value:"false",meta:"bson keyword"},{caption:"null",value:"null",meta:"bson keyword"},{caption:"$numberDecimal",value:"$numberDecimal: ",meta:"bson keyword"},{caption:"$numberDouble",value:"$numberDouble: ",meta:"bson keyword"},{caption:"$numberLong",value:"$numberLong: ",meta:"bson keyword"},{caption:"$numberInt",value:"$numberLong: ",meta:"bson keyword"},{caption:"$oid",value:"$oid: ",meta:"bson keyword"},{caption:"$regularExpression",value:`$regularExpression: {
+`),$||(f.start.column=0,f.end.column=L[L.length-1].length),this.selection.setRange(f)}else{o.forEach(function(F){l.substractPoint(F.cursor)});var R=0,T=1/0,_=g.map(function(F){var O=F.cursor,M=c.getLine(O.row),P=M.substr(O.column).search(/\S/g);return P==-1&&(P=0),O.column>R&&(R=O.column),P<T&&(T=P),P});g.forEach(function(F,O){var M=F.cursor,P=R-M.column,I=_[O]-T;P>I?c.insert(M,t.stringRepeat(" ",P-I)):c.remove(new A(M.row,M.column,M.row,M.column-P+I)),F.start.column=F.end.column=R,F.start.row=F.end.row=M.row,F.cursor=F.end}),l.fromOrientedRange(g[0]),this.renderer.updateCursor(),this.renderer.updateBackMarkers()}},this.$reAlignText=function(c,l){var g=!0,u=!0,o,f,s;return c.map(function(L){var R=L.match(/(\s*)(.*?)(\s*)([=:].*)/);return R?o==null?(o=R[1].length,f=R[2].length,s=R[3].length,R):(o+f+s!=R[1].length+R[2].length+R[3].length&&(u=!1),o!=R[1].length&&(g=!1),o>R[1].length&&(o=R[1].length),f<R[2].length&&(f=R[2].length),s>R[3].length&&(s=R[3].length),R):[L]}).map(l?$:g?u?C:$:x);function v(L){return t.stringRepeat(" ",L)}function $(L){return L[2]?v(o)+L[2]+v(f-L[2].length+s)+L[4].replace(/^([=:])\s+/,"$1 "):L[0]}function C(L){return L[2]?v(o+f-L[2].length)+L[2]+v(s)+L[4].replace(/^([=:])\s+/,"$1 "):L[0]}function x(L){return L[2]?v(o)+L[2]+v(s)+L[4].replace(/^([=:])\s+/,"$1 "):L[0]}}}).call(m.prototype);function w(c,l){return c.row==l.row&&c.column==l.column}y.onSessionChange=function(c){var l=c.session;l&&!l.multiSelect&&(l.$selectionMarkers=[],l.selection.$initRangeList(),l.multiSelect=l.selection),this.multiSelect=l&&l.multiSelect;var g=c.oldSession;g&&(g.multiSelect.off("addRange",this.$onAddRange),g.multiSelect.off("removeRange",this.$onRemoveRange),g.multiSelect.off("multiSelect",this.$onMultiSelect),g.multiSelect.off("singleSelect",this.$onSingleSelect),g.multiSelect.lead.off("change",this.$checkMultiselectChange),g.multiSelect.anchor.off("change",this.$checkMultiselectChange)),l&&(l.multiSelect.on("addRange",this.$onAddRange),l.multiSelect.on("removeRange",this.$onRemoveRange),l.multiSelect.on("multiSelect",this.$onMultiSelect),l.multiSelect.on("singleSelect",this.$onSingleSelect),l.multiSelect.lead.on("change",this.$checkMultiselectChange),l.multiSelect.anchor.on("change",this.$checkMultiselectChange)),l&&this.inMultiSelectMode!=l.selection.inMultiSelectMode&&(l.selection.inMultiSelectMode?this.$onMultiSelect():this.$onSingleSelect())};function S(c){c.$multiselectOnSessionChange||(c.$onAddRange=c.$onAddRange.bind(c),c.$onRemoveRange=c.$onRemoveRange.bind(c),c.$onMultiSelect=c.$onMultiSelect.bind(c),c.$onSingleSelect=c.$onSingleSelect.bind(c),c.$multiselectOnSessionChange=y.onSessionChange.bind(c),c.$checkMultiselectChange=c.$checkMultiselectChange.bind(c),c.$multiselectOnSessionChange(c),c.on("changeSession",c.$multiselectOnSessionChange),c.on("mousedown",h),c.commands.addCommands(e.defaultCommands),b(c))}function b(c){if(!c.textInput)return;var l=c.textInput.getElement(),g=!1;d.addListener(l,"keydown",function(o){var f=o.keyCode==18&&!(o.ctrlKey||o.shiftKey||o.metaKey);c.$blockSelectEnabled&&f?g||(c.renderer.setMouseCursor("crosshair"),g=!0):g&&u()},c),d.addListener(l,"keyup",u,c),d.addListener(l,"blur",u,c);function u(o){g&&(c.renderer.setMouseCursor(""),g=!1)}}y.MultiSelect=S,k("./config").defineOptions(m.prototype,"editor",{enableMultiselect:{set:function(c){S(this),c?(this.on("changeSession",this.$multiselectOnSessionChange),this.on("mousedown",h)):(this.off("changeSession",this.$multiselectOnSessionChange),this.off("mousedown",h))},value:!0},enableBlockSelect:{set:function(c){this.$blockSelectEnabled=c},value:!0}})}),define("ace/mode/folding/fold_mode",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=k("../../range").Range,A=y.FoldMode=function(){};(function(){this.foldingStartMarker=null,this.foldingStopMarker=null,this.getFoldWidget=function(p,h,d){var t=p.getLine(d);return this.foldingStartMarker.test(t)?"start":h=="markbeginend"&&this.foldingStopMarker&&this.foldingStopMarker.test(t)?"end":""},this.getFoldWidgetRange=function(p,h,d){return null},this.indentationBlock=function(p,h,d){var t=/\S/,e=p.getLine(h),i=e.search(t);if(i!=-1){for(var r=d||e.length,n=p.getLength(),a=h,m=h;++h<n;){var w=p.getLine(h).search(t);if(w!=-1){if(w<=i){var S=p.getTokenAt(h,0);if(!S||S.type!=="string")break}m=h}}if(m>a){var b=p.getLine(m).length;return new E(a,r,m,b)}}},this.openingBracketBlock=function(p,h,d,t,e){var i={row:d,column:t+1},r=p.$findClosingBracket(h,i,e);if(r){var n=p.foldWidgets[r.row];return n==null&&(n=p.getFoldWidget(r.row)),n=="start"&&r.row>i.row&&(r.row--,r.column=p.getLine(r.row).length),E.fromPoints(i,r)}},this.closingBracketBlock=function(p,h,d,t,e){var i={row:d,column:t},r=p.$findOpeningBracket(h,i);if(r)return r.column++,i.column--,E.fromPoints(r,i)}}).call(A.prototype)}),define("ace/theme/textmate",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";y.isDark=!1,y.cssClass="ace-tm",y.cssText='.ace-tm .ace_gutter {background: #f0f0f0;color: #333;}.ace-tm .ace_print-margin {width: 1px;background: #e8e8e8;}.ace-tm .ace_fold {background-color: #6B72E6;}.ace-tm {background-color: #FFFFFF;color: black;}.ace-tm .ace_cursor {color: black;}.ace-tm .ace_invisible {color: rgb(191, 191, 191);}.ace-tm .ace_storage,.ace-tm .ace_keyword {color: blue;}.ace-tm .ace_constant {color: rgb(197, 6, 11);}.ace-tm .ace_constant.ace_buildin {color: rgb(88, 72, 246);}.ace-tm .ace_constant.ace_language {color: rgb(88, 92, 246);}.ace-tm .ace_constant.ace_library {color: rgb(6, 150, 14);}.ace-tm .ace_invalid {background-color: rgba(255, 0, 0, 0.1);color: red;}.ace-tm .ace_support.ace_function {color: rgb(60, 76, 114);}.ace-tm .ace_support.ace_constant {color: rgb(6, 150, 14);}.ace-tm .ace_support.ace_type,.ace-tm .ace_support.ace_class {color: rgb(109, 121, 222);}.ace-tm .ace_keyword.ace_operator {color: rgb(104, 118, 135);}.ace-tm .ace_string {color: rgb(3, 106, 7);}.ace-tm .ace_comment {color: rgb(76, 136, 107);}.ace-tm .ace_comment.ace_doc {color: rgb(0, 102, 255);}.ace-tm .ace_comment.ace_doc.ace_tag {color: rgb(128, 159, 191);}.ace-tm .ace_constant.ace_numeric {color: rgb(0, 0, 205);}.ace-tm .ace_variable {color: rgb(49, 132, 149);}.ace-tm .ace_xml-pe {color: rgb(104, 104, 91);}.ace-tm .ace_entity.ace_name.ace_function {color: #0000A2;}.ace-tm .ace_heading {color: rgb(12, 7, 255);}.ace-tm .ace_list {color:rgb(185, 6, 144);}.ace-tm .ace_meta.ace_tag {color:rgb(0, 22, 142);}.ace-tm .ace_string.ace_regex {color: rgb(255, 0, 0)}.ace-tm .ace_marker-layer .ace_selection {background: rgb(181, 213, 255);}.ace-tm.ace_multiselect .ace_selection.ace_start {box-shadow: 0 0 3px 0px white;}.ace-tm .ace_marker-layer .ace_step {background: rgb(252, 255, 0);}.ace-tm .ace_marker-layer .ace_stack {background: rgb(164, 229, 101);}.ace-tm .ace_marker-layer .ace_bracket {margin: -1px 0 0 -1px;border: 1px solid rgb(192, 192, 192);}.ace-tm .ace_marker-layer .ace_active-line {background: rgba(0, 0, 0, 0.07);}.ace-tm .ace_gutter-active-line {background-color : #dcdcdc;}.ace-tm .ace_marker-layer .ace_selected-word {background: rgb(250, 250, 255);border: 1px solid rgb(200, 200, 250);}.ace-tm .ace_indent-guide {background: url("data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAACCAYAAACZgbYnAAAAE0lEQVQImWP4////f4bLly//BwAmVgd1/w11/gAAAABJRU5ErkJggg==") right repeat-y;}',y.$id="ace/theme/textmate";var E=k("../lib/dom");E.importCssString(y.cssText,y.cssClass)}),define("ace/line_widgets",["require","exports","module","ace/lib/dom"],function(k,y,B){"use strict";var E=k("./lib/dom");function A(p){this.session=p,this.session.widgetManager=this,this.session.getRowLength=this.getRowLength,this.session.$getWidgetScreenLength=this.$getWidgetScreenLength,this.updateOnChange=this.updateOnChange.bind(this),this.renderWidgets=this.renderWidgets.bind(this),this.measureWidgets=this.measureWidgets.bind(this),this.session._changedWidgets=[],this.$onChangeEditor=this.$onChangeEditor.bind(this),this.session.on("change",this.updateOnChange),this.session.on("changeFold",this.updateOnFold),this.session.on("changeEditor",this.$onChangeEditor)}(function(){this.getRowLength=function(p){var h;return this.lineWidgets?h=this.lineWidgets[p]&&this.lineWidgets[p].rowCount||0:h=0,!this.$useWrapMode||!this.$wrapData[p]?1+h:this.$wrapData[p].length+1+h},this.$getWidgetScreenLength=function(){var p=0;return this.lineWidgets.forEach(function(h){h&&h.rowCount&&!h.hidden&&(p+=h.rowCount)}),p},this.$onChangeEditor=function(p){this.attach(p.editor)},this.attach=function(p){p&&p.widgetManager&&p.widgetManager!=this&&p.widgetManager.detach(),this.editor!=p&&(this.detach(),this.editor=p,p&&(p.widgetManager=this,p.renderer.on("beforeRender",this.measureWidgets),p.renderer.on("afterRender",this.renderWidgets)))},this.detach=function(p){var h=this.editor;if(h){this.editor=null,h.widgetManager=null,h.renderer.off("beforeRender",this.measureWidgets),h.renderer.off("afterRender",this.renderWidgets);var d=this.session.lineWidgets;d&&d.forEach(function(t){t&&t.el&&t.el.parentNode&&(t._inDocument=!1,t.el.parentNode.removeChild(t.el))})}},this.updateOnFold=function(p,h){var d=h.lineWidgets;if(!(!d||!p.action)){for(var t=p.data,e=t.start.row,i=t.end.row,r=p.action=="add",n=e+1;n<i;n++)d[n]&&(d[n].hidden=r);d[i]&&(r?d[e]?d[i].hidden=r:d[e]=d[i]:(d[e]==d[i]&&(d[e]=void 0),d[i].hidden=r))}},this.updateOnChange=function(p){var h=this.session.lineWidgets;if(h){var d=p.start.row,t=p.end.row-d;if(t!==0)if(p.action=="remove"){var e=h.splice(d+1,t);!h[d]&&e[e.length-1]&&(h[d]=e.pop()),e.forEach(function(r){r&&this.removeLineWidget(r)},this),this.$updateRows()}else{var i=new Array(t);h[d]&&h[d].column!=null&&p.start.column>h[d].column&&d++,i.unshift(d,0),h.splice.apply(h,i),this.$updateRows()}}},this.$updateRows=function(){var p=this.session.lineWidgets;if(p){var h=!0;p.forEach(function(d,t){if(d)for(h=!1,d.row=t;d.$oldWidget;)d.$oldWidget.row=t,d=d.$oldWidget}),h&&(this.session.lineWidgets=null)}},this.$registerLineWidget=function(p){this.session.lineWidgets||(this.session.lineWidgets=new Array(this.session.getLength()));var h=this.session.lineWidgets[p.row];return h&&(p.$oldWidget=h,h.el&&h.el.parentNode&&(h.el.parentNode.removeChild(h.el),h._inDocument=!1)),this.session.lineWidgets[p.row]=p,p},this.addLineWidget=function(p){if(this.$registerLineWidget(p),p.session=this.session,!this.editor)return p;var h=this.editor.renderer;p.html&&!p.el&&(p.el=E.createElement("div"),p.el.innerHTML=p.html),p.el&&(E.addCssClass(p.el,"ace_lineWidgetContainer"),p.el.style.position="absolute",p.el.style.zIndex=5,h.container.appendChild(p.el),p._inDocument=!0,p.coverGutter||(p.el.style.zIndex=3),p.pixelHeight==null&&(p.pixelHeight=p.el.offsetHeight)),p.rowCount==null&&(p.rowCount=p.pixelHeight/h.layerConfig.lineHeight);var d=this.session.getFoldAt(p.row,0);if(p.$fold=d,d){var t=this.session.lineWidgets;p.row==d.end.row&&!t[d.start.row]?t[d.start.row]=p:p.hidden=!0}return this.session._emit("changeFold",{data:{start:{row:p.row}}}),this.$updateRows(),this.renderWidgets(null,h),this.onWidgetChanged(p),p},this.removeLineWidget=function(p){if(p._inDocument=!1,p.session=null,p.el&&p.el.parentNode&&p.el.parentNode.removeChild(p.el),p.editor&&p.editor.destroy)try{p.editor.destroy()}catch{}if(this.session.lineWidgets){var h=this.session.lineWidgets[p.row];if(h==p)this.session.lineWidgets[p.row]=p.$oldWidget,p.$oldWidget&&this.onWidgetChanged(p.$oldWidget);else for(;h;){if(h.$oldWidget==p){h.$oldWidget=p.$oldWidget;break}h=h.$oldWidget}}this.session._emit("changeFold",{data:{start:{row:p.row}}}),this.$updateRows()},this.getWidgetsAtRow=function(p){for(var h=this.session.lineWidgets,d=h&&h[p],t=[];d;)t.push(d),d=d.$oldWidget;return t},this.onWidgetChanged=function(p){this.session._changedWidgets.push(p),this.editor&&this.editor.renderer.updateFull()},this.measureWidgets=function(p,h){var d=this.session._changedWidgets,t=h.layerConfig;if(!(!d||!d.length)){for(var e=1/0,i=0;i<d.length;i++){var r=d[i];if(!(!r||!r.el)&&r.session==this.session){if(!r._inDocument){if(this.session.lineWidgets[r.row]!=r)continue;r._inDocument=!0,h.container.appendChild(r.el)}r.h=r.el.offsetHeight,r.fixedWidth||(r.w=r.el.offsetWidth,r.screenWidth=Math.ceil(r.w/t.characterWidth));var n=r.h/t.lineHeight;r.coverLine&&(n-=this.session.getRowLineCount(r.row),n<0&&(n=0)),r.rowCount!=n&&(r.rowCount=n,r.row<e&&(e=r.row))}}e!=1/0&&(this.session._emit("changeFold",{data:{start:{row:e}}}),this.session.lineWidgetWidth=null),this.session._changedWidgets=[]}},this.renderWidgets=function(p,h){var d=h.layerConfig,t=this.session.lineWidgets;if(t){for(var e=Math.min(this.firstRow,d.firstRow),i=Math.max(this.lastRow,d.lastRow,t.length);e>0&&!t[e];)e--;this.firstRow=d.firstRow,this.lastRow=d.lastRow,h.$cursorLayer.config=d;for(var r=e;r<=i;r++){var n=t[r];if(!(!n||!n.el)){if(n.hidden){n.el.style.top=-100-(n.pixelHeight||0)+"px";continue}n._inDocument||(n._inDocument=!0,h.container.appendChild(n.el));var a=h.$cursorLayer.getPixelPosition({row:r,column:0},!0).top;n.coverLine||(a+=d.lineHeight*this.session.getRowLineCount(n.row)),n.el.style.top=a-d.offset+"px";var m=n.coverGutter?0:h.gutterWidth;n.fixedWidth||(m-=h.scrollLeft),n.el.style.left=m+"px",n.fullWidth&&n.screenWidth&&(n.el.style.minWidth=d.width+2*d.padding+"px"),n.fixedWidth?n.el.style.right=h.scrollBar.getWidth()+"px":n.el.style.right=""}}}}}).call(A.prototype),y.LineWidgets=A}),define("ace/ext/error_marker",["require","exports","module","ace/line_widgets","ace/lib/dom","ace/range"],function(k,y,B){"use strict";var E=k("../line_widgets").LineWidgets,A=k("../lib/dom"),p=k("../range").Range;function h(t,e,i){for(var r=0,n=t.length-1;r<=n;){var a=r+n>>1,m=i(e,t[a]);if(m>0)r=a+1;else if(m<0)n=a-1;else return a}return-(r+1)}function d(t,e,i){var r=t.getAnnotations().sort(p.comparePoints);if(r.length){var n=h(r,{row:e,column:-1},p.comparePoints);n<0&&(n=-n-1),n>=r.length?n=i>0?0:r.length-1:n===0&&i<0&&(n=r.length-1);var a=r[n];if(!(!a||!i)){if(a.row===e){do a=r[n+=i];while(a&&a.row===e);if(!a)return r.slice()}var m=[];e=a.row;do m[i<0?"unshift":"push"](a),a=r[n+=i];while(a&&a.row==e);return m.length&&m}}}y.showErrorMarker=function(t,e){var i=t.session;i.widgetManager||(i.widgetManager=new E(i),i.widgetManager.attach(t));var r=t.getCursorPosition(),n=r.row,a=i.widgetManager.getWidgetsAtRow(n).filter(function(o){return o.type=="errorMarker"})[0];a?a.destroy():n-=e;var m=d(i,n,e),w;if(m){var S=m[0];r.column=(S.pos&&typeof S.column!="number"?S.pos.sc:S.column)||0,r.row=S.row,w=t.renderer.$gutterLayer.$annotations[r.row]}else{if(a)return;w={text:["Looks good!"],className:"ace_ok"}}t.session.unfold(r.row),t.selection.moveToPosition(r);var b={row:r.row,fixedWidth:!0,coverGutter:!0,el:A.createElement("div"),type:"errorMarker"},c=b.el.appendChild(A.createElement("div")),l=b.el.appendChild(A.createElement("div"));l.className="error_widget_arrow "+w.className;var g=t.renderer.$cursorLayer.getPixelPosition(r).left;l.style.left=g+t.renderer.gutterWidth-5+"px",b.el.className="error_widget_wrapper",c.className="error_widget "+w.className,c.innerHTML=w.text.join("<br>"),c.appendChild(A.createElement("div"));var u=function(o,f,s){if(f===0&&(s==="esc"||s==="return"))return b.destroy(),{command:"null"}};b.destroy=function(){t.$mouseHandler.isMousePressed||(t.keyBinding.removeKeyboardHandler(u),i.widgetManager.removeLineWidget(b),t.off("changeSelection",b.destroy),t.off("changeSession",b.destroy),t.off("mouseup",b.destroy),t.off("change",b.destroy))},t.keyBinding.addKeyboardHandler(u),t.on("changeSelection",b.destroy),t.on("changeSession",b.destroy),t.on("mouseup",b.destroy),t.on("change",b.destroy),t.session.widgetManager.addLineWidget(b),b.el.onmousedown=t.focus.bind(t),t.renderer.scrollCursorIntoView(null,.5,{bottom:b.el.offsetHeight})},A.importCssString("    .error_widget_wrapper {        background: inherit;        color: inherit;        border:none    }    .error_widget {        border-top: solid 2px;        border-bottom: solid 2px;        margin: 5px 0;        padding: 10px 40px;        white-space: pre-wrap;    }    .error_widget.ace_error, .error_widget_arrow.ace_error{        border-color: #ff5a5a    }    .error_widget.ace_warning, .error_widget_arrow.ace_warning{        border-color: #F1D817    }    .error_widget.ace_info, .error_widget_arrow.ace_info{        border-color: #5a5a5a    }    .error_widget.ace_ok, .error_widget_arrow.ace_ok{        border-color: #5aaa5a    }    .error_widget_arrow {        position: absolute;        border: solid 5px;        border-top-color: transparent!important;        border-right-color: transparent!important;        border-left-color: transparent!important;        top: -5px;    }","")}),define("ace/ace",["require","exports","module","ace/lib/fixoldbrowsers","ace/lib/dom","ace/lib/event","ace/range","ace/editor","ace/edit_session","ace/undomanager","ace/virtual_renderer","ace/worker/worker_client","ace/keyboard/hash_handler","ace/placeholder","ace/multi_select","ace/mode/folding/fold_mode","ace/theme/textmate","ace/ext/error_marker","ace/config"],function(k,y,B){"use strict";k("./lib/fixoldbrowsers");var E=k("./lib/dom"),A=k("./lib/event"),p=k("./range").Range,h=k("./editor").Editor,d=k("./edit_session").EditSession,t=k("./undomanager").UndoManager,e=k("./virtual_renderer").VirtualRenderer;k("./worker/worker_client"),k("./keyboard/hash_handler"),k("./placeholder"),k("./multi_select"),k("./mode/folding/fold_mode"),k("./theme/textmate"),k("./ext/error_marker"),y.config=k("./config"),y.require=k,typeof define=="function"&&(y.define=define),y.edit=function(i,r){if(typeof i=="string"){var n=i;if(i=document.getElementById(n),!i)throw new Error("ace.edit can't find div #"+n)}if(i&&i.env&&i.env.editor instanceof h)return i.env.editor;var a="";if(i&&/input|textarea/i.test(i.tagName)){var m=i;a=m.value,i=E.createElement("pre"),m.parentNode.replaceChild(i,m)}else i&&(a=i.textContent,i.innerHTML="");var w=y.createEditSession(a),S=new h(new e(i),w,r),b={document:w,editor:S,onResize:S.resize.bind(S,null)};return m&&(b.textarea=m),A.addListener(window,"resize",b.onResize),S.on("destroy",function(){A.removeListener(window,"resize",b.onResize),b.editor.container.env=null}),S.container.env=S.env=b,S},y.createEditSession=function(i,r){var n=new d(i,r);return n.setUndoManager(new t),n},y.Range=p,y.Editor=h,y.EditSession=d,y.UndoManager=t,y.VirtualRenderer=e,y.version=y.config.version}),function(){window.require(["ace/ace"],function(k){k&&(k.config.init(!0),k.define=window.define),window.ace||(window.ace=k);for(var y in k)k.hasOwnProperty(y)&&(window.ace[y]=k[y]);window.ace.default=window.ace,typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=window.ace)})}(),define("ace/snippets",["require","exports","module","ace/lib/oop","ace/lib/event_emitter","ace/lib/lang","ace/range","ace/range_list","ace/keyboard/hash_handler","ace/tokenizer","ace/clipboard","ace/lib/dom","ace/editor"],function(k,y,B){"use strict";var E=k("./lib/oop"),A=k("./lib/event_emitter").EventEmitter,p=k("./lib/lang"),h=k("./range").Range,d=k("./range_list").RangeList,t=k("./keyboard/hash_handler").HashHandler,e=k("./tokenizer").Tokenizer,i=k("./clipboard"),r={CURRENT_WORD:function(c){return c.session.getTextRange(c.session.getWordRange())},SELECTION:function(c,l,g){var u=c.session.getTextRange();return g?u.replace(/\n\r?([ \t]*\S)/g,`
+`+g+"$1"):u},CURRENT_LINE:function(c){return c.session.getLine(c.getCursorPosition().row)},PREV_LINE:function(c){return c.session.getLine(c.getCursorPosition().row-1)},LINE_INDEX:function(c){return c.getCursorPosition().row},LINE_NUMBER:function(c){return c.getCursorPosition().row+1},SOFT_TABS:function(c){return c.session.getUseSoftTabs()?"YES":"NO"},TAB_SIZE:function(c){return c.session.getTabSize()},CLIPBOARD:function(c){return i.getText&&i.getText()},FILENAME:function(c){return/[^/\\]*$/.exec(this.FILEPATH(c))[0]},FILENAME_BASE:function(c){return/[^/\\]*$/.exec(this.FILEPATH(c))[0].replace(/\.[^.]*$/,"")},DIRECTORY:function(c){return this.FILEPATH(c).replace(/[^/\\]*$/,"")},FILEPATH:function(c){return"/not implemented.txt"},WORKSPACE_NAME:function(){return"Unknown"},FULLNAME:function(){return"Unknown"},BLOCK_COMMENT_START:function(c){var l=c.session.$mode||{};return l.blockComment&&l.blockComment.start||""},BLOCK_COMMENT_END:function(c){var l=c.session.$mode||{};return l.blockComment&&l.blockComment.end||""},LINE_COMMENT:function(c){var l=c.session.$mode||{};return l.lineCommentStart||""},CURRENT_YEAR:n.bind(null,{year:"numeric"}),CURRENT_YEAR_SHORT:n.bind(null,{year:"2-digit"}),CURRENT_MONTH:n.bind(null,{month:"numeric"}),CURRENT_MONTH_NAME:n.bind(null,{month:"long"}),CURRENT_MONTH_NAME_SHORT:n.bind(null,{month:"short"}),CURRENT_DATE:n.bind(null,{day:"2-digit"}),CURRENT_DAY_NAME:n.bind(null,{weekday:"long"}),CURRENT_DAY_NAME_SHORT:n.bind(null,{weekday:"short"}),CURRENT_HOUR:n.bind(null,{hour:"2-digit",hour12:!1}),CURRENT_MINUTE:n.bind(null,{minute:"2-digit"}),CURRENT_SECOND:n.bind(null,{second:"2-digit"})};r.SELECTED_TEXT=r.SELECTION;function n(c){var l=new Date().toLocaleString("en-us",c);return l.length==1?"0"+l:l}var a=function(){this.snippetMap={},this.snippetNameMap={}};(function(){E.implement(this,A),this.getTokenizer=function(){return a.$tokenizer||this.createTokenizer()},this.createTokenizer=function(){function c(u){return u=u.substr(1),/^\d+$/.test(u)?[{tabstopId:parseInt(u,10)}]:[{text:u}]}function l(u){return"(?:[^\\\\"+u+"]|\\\\.)"}var g={regex:"/("+l("/")+"+)/",onMatch:function(u,o,f){var s=f[0];return s.fmtString=!0,s.guard=u.slice(1,-1),s.flag="",""},next:"formatString"};return a.$tokenizer=new e({start:[{regex:/\\./,onMatch:function(u,o,f){var s=u[1];return(s=="}"&&f.length||"`$\\".indexOf(s)!=-1)&&(u=s),[u]}},{regex:/}/,onMatch:function(u,o,f){return[f.length?f.shift():u]}},{regex:/\$(?:\d+|\w+)/,onMatch:c},{regex:/\$\{[\dA-Z_a-z]+/,onMatch:function(u,o,f){var s=c(u.substr(1));return f.unshift(s[0]),s},next:"snippetVar"},{regex:/\n/,token:"newline",merge:!1}],snippetVar:[{regex:"\\|"+l("\\|")+"*\\|",onMatch:function(u,o,f){var s=u.slice(1,-1).replace(/\\[,|\\]|,/g,function(v){return v.length==2?v[1]:"\0"}).split("\0").map(function(v){return{value:v}});return f[0].choices=s,[s[0]]},next:"start"},g,{regex:"([^:}\\\\]|\\\\.)*:?",token:"",next:"start"}],formatString:[{regex:/:/,onMatch:function(u,o,f){return f.length&&f[0].expectElse?(f[0].expectElse=!1,f[0].ifEnd={elseEnd:f[0]},[f[0].ifEnd]):":"}},{regex:/\\./,onMatch:function(u,o,f){var s=u[1];return s=="}"&&f.length||"`$\\".indexOf(s)!=-1?u=s:s=="n"?u=`
+`:s=="t"?u="	":"ulULE".indexOf(s)!=-1&&(u={changeCase:s,local:s>"a"}),[u]}},{regex:"/\\w*}",onMatch:function(u,o,f){var s=f.shift();return s&&(s.flag=u.slice(1,-1)),this.next=s&&s.tabstopId?"start":"",[s||u]},next:"start"},{regex:/\$(?:\d+|\w+)/,onMatch:function(u,o,f){return[{text:u.slice(1)}]}},{regex:/\${\w+/,onMatch:function(u,o,f){var s={text:u.slice(2)};return f.unshift(s),[s]},next:"formatStringVar"},{regex:/\n/,token:"newline",merge:!1},{regex:/}/,onMatch:function(u,o,f){var s=f.shift();return this.next=s&&s.tabstopId?"start":"",[s||u]},next:"start"}],formatStringVar:[{regex:/:\/\w+}/,onMatch:function(u,o,f){var s=f[0];return s.formatFunction=u.slice(2,-1),[f.shift()]},next:"formatString"},g,{regex:/:[\?\-+]?/,onMatch:function(u,o,f){u[1]=="+"&&(f[0].ifEnd=f[0]),u[1]=="?"&&(f[0].expectElse=!0)},next:"formatString"},{regex:"([^:}\\\\]|\\\\.)*:?",token:"",next:"formatString"}]}),a.$tokenizer},this.tokenizeTmSnippet=function(c,l){return this.getTokenizer().getLineTokens(c,l).tokens.map(function(g){return g.value||g})},this.getVariableValue=function(c,l,g){if(/^\d+$/.test(l))return(this.variables.__||{})[l]||"";if(/^[A-Z]\d+$/.test(l))return(this.variables[l[0]+"__"]||{})[l.substr(1)]||"";if(l=l.replace(/^TM_/,""),!this.variables.hasOwnProperty(l))return"";var u=this.variables[l];return typeof u=="function"&&(u=this.variables[l](c,l,g)),u??""},this.variables=r,this.tmStrFormat=function(c,l,g){if(!l.fmt)return c;var u=l.flag||"",o=l.guard;o=new RegExp(o,u.replace(/[^gim]/g,""));var f=typeof l.fmt=="string"?this.tokenizeTmSnippet(l.fmt,"formatString"):l.fmt,s=this,v=c.replace(o,function(){var $=s.variables.__;s.variables.__=[].slice.call(arguments);for(var C=s.resolveVariables(f,g),x="E",L=0;L<C.length;L++){var R=C[L];if(typeof R=="object")if(C[L]="",R.changeCase&&R.local){var T=C[L+1];T&&typeof T=="string"&&(R.changeCase=="u"?C[L]=T[0].toUpperCase():C[L]=T[0].toLowerCase(),C[L+1]=T.substr(1))}else R.changeCase&&(x=R.changeCase);else x=="U"?C[L]=R.toUpperCase():x=="L"&&(C[L]=R.toLowerCase())}return s.variables.__=$,C.join("")});return v},this.tmFormatFunction=function(c,l,g){return l.formatFunction=="upcase"?c.toUpperCase():l.formatFunction=="downcase"?c.toLowerCase():c},this.resolveVariables=function(c,l){for(var g=[],u="",o=!0,f=0;f<c.length;f++){var s=c[f];if(typeof s=="string"){g.push(s),s==`
+`?(o=!0,u=""):o&&(u=/^\t*/.exec(s)[0],o=/\S/.test(s));continue}if(s){if(o=!1,s.fmtString){var v=c.indexOf(s,f+1);v==-1&&(v=c.length),s.fmt=c.slice(f+1,v),f=v}if(s.text){var $=this.getVariableValue(l,s.text,u)+"";s.fmtString&&($=this.tmStrFormat($,s,l)),s.formatFunction&&($=this.tmFormatFunction($,s,l)),$&&!s.ifEnd?(g.push($),C(s)):!$&&s.ifEnd&&C(s.ifEnd)}else s.elseEnd?C(s.elseEnd):(s.tabstopId!=null||s.changeCase!=null)&&g.push(s)}}function C(x){var L=c.indexOf(x,f+1);L!=-1&&(f=L)}return g},this.insertSnippetForSelection=function(c,l){var g=c.getCursorPosition(),u=c.session.getLine(g.row),o=c.session.getTabString(),f=u.match(/^\s*/)[0];g.column<f.length&&(f=f.slice(0,g.column)),l=l.replace(/\r/g,"");var s=this.tokenizeTmSnippet(l);s=this.resolveVariables(s,c),s=s.map(function(z){return z==`
+`?z+f:typeof z=="string"?z.replace(/\t/g,o):z});var v=[];s.forEach(function(z,Q){if(typeof z=="object"){var X=z.tabstopId,U=v[X];if(U||(U=v[X]=[],U.index=X,U.value="",U.parents={}),U.indexOf(z)===-1){z.choices&&!U.choices&&(U.choices=z.choices),U.push(z);var Z=s.indexOf(z,Q+1);if(Z!==-1){var G=s.slice(Q+1,Z),ie=G.some(function(te){return typeof te=="object"});ie&&!U.value?U.value=G:G.length&&(!U.value||typeof U.value!="string")&&(U.value=G.join(""))}}}}),v.forEach(function(z){z.length=0});var $={};function C(z){for(var Q=[],X=0;X<z.length;X++){var U=z[X];if(typeof U=="object"){if($[U.tabstopId])continue;var Z=z.lastIndexOf(U,X-1);U=Q[Z]||{tabstopId:U.tabstopId}}Q[X]=U}return Q}for(var x=0;x<s.length;x++){var L=s[x];if(typeof L=="object"){var R=L.tabstopId,T=v[R],_=s.indexOf(L,x+1);if($[R]){$[R]===L&&(delete $[R],Object.keys($).forEach(function(z){T.parents[z]=!0}));continue}$[R]=L;var F=T.value;typeof F!="string"?F=C(F):L.fmt&&(F=this.tmStrFormat(F,L,c)),s.splice.apply(s,[x+1,Math.max(0,_-x)].concat(F,L)),T.indexOf(L)===-1&&T.push(L)}}var O=0,M=0,P="";s.forEach(function(z){if(typeof z=="string"){var Q=z.split(`
+`);Q.length>1?(M=Q[Q.length-1].length,O+=Q.length-1):M+=z.length,P+=z}else z&&(z.start?z.end={row:O,column:M}:z.start={row:O,column:M})});var I=c.getSelectionRange(),D=c.session.replace(I,P),N=new m(c),V=c.inVirtualSelectionMode&&c.selection.index;N.addTabstops(v,I.start,D,V)},this.insertSnippet=function(c,l){var g=this;if(c.inVirtualSelectionMode)return g.insertSnippetForSelection(c,l);c.forEachSelection(function(){g.insertSnippetForSelection(c,l)},null,{keepOrder:!0}),c.tabstopManager&&c.tabstopManager.tabNext()},this.$getScope=function(c){var l=c.session.$mode.$id||"";if(l=l.split("/").pop(),l==="html"||l==="php"){l==="php"&&!c.session.$mode.inlinePhp&&(l="html");var g=c.getCursorPosition(),u=c.session.getState(g.row);typeof u=="object"&&(u=u[0]),u.substring&&(u.substring(0,3)=="js-"?l="javascript":u.substring(0,4)=="css-"?l="css":u.substring(0,4)=="php-"&&(l="php"))}return l},this.getActiveScopes=function(c){var l=this.$getScope(c),g=[l],u=this.snippetMap;return u[l]&&u[l].includeScopes&&g.push.apply(g,u[l].includeScopes),g.push("_"),g},this.expandWithTab=function(c,l){var g=this,u=c.forEachSelection(function(){return g.expandSnippetForSelection(c,l)},null,{keepOrder:!0});return u&&c.tabstopManager&&c.tabstopManager.tabNext(),u},this.expandSnippetForSelection=function(c,l){var g=c.getCursorPosition(),u=c.session.getLine(g.row),o=u.substring(0,g.column),f=u.substr(g.column),s=this.snippetMap,v;return this.getActiveScopes(c).some(function($){var C=s[$];return C&&(v=this.findMatchingSnippet(C,o,f)),!!v},this),v?(l&&l.dryRun||(c.session.doc.removeInLine(g.row,g.column-v.replaceBefore.length,g.column+v.replaceAfter.length),this.variables.M__=v.matchBefore,this.variables.T__=v.matchAfter,this.insertSnippetForSelection(c,v.content),this.variables.M__=this.variables.T__=null),!0):!1},this.findMatchingSnippet=function(c,l,g){for(var u=c.length;u--;){var o=c[u];if(!(o.startRe&&!o.startRe.test(l))&&!(o.endRe&&!o.endRe.test(g))&&!(!o.startRe&&!o.endRe))return o.matchBefore=o.startRe?o.startRe.exec(l):[""],o.matchAfter=o.endRe?o.endRe.exec(g):[""],o.replaceBefore=o.triggerRe?o.triggerRe.exec(l)[0]:"",o.replaceAfter=o.endTriggerRe?o.endTriggerRe.exec(g)[0]:"",o}},this.snippetMap={},this.snippetNameMap={},this.register=function(c,l){var g=this.snippetMap,u=this.snippetNameMap,o=this;c||(c=[]);function f($){return $&&!/^\^?\(.*\)\$?$|^\\b$/.test($)&&($="(?:"+$+")"),$||""}function s($,C,x){return $=f($),C=f(C),x?($=C+$,$&&$[$.length-1]!="$"&&($=$+"$")):($=$+C,$&&$[0]!="^"&&($="^"+$)),new RegExp($)}function v($){$.scope||($.scope=l||"_"),l=$.scope,g[l]||(g[l]=[],u[l]={});var C=u[l];if($.name){var x=C[$.name];x&&o.unregister(x),C[$.name]=$}g[l].push($),$.prefix&&($.tabTrigger=$.prefix),!$.content&&$.body&&($.content=Array.isArray($.body)?$.body.join(`
+`):$.body),$.tabTrigger&&!$.trigger&&(!$.guard&&/^\w/.test($.tabTrigger)&&($.guard="\\b"),$.trigger=p.escapeRegExp($.tabTrigger)),!(!$.trigger&&!$.guard&&!$.endTrigger&&!$.endGuard)&&($.startRe=s($.trigger,$.guard,!0),$.triggerRe=new RegExp($.trigger),$.endRe=s($.endTrigger,$.endGuard,!0),$.endTriggerRe=new RegExp($.endTrigger))}Array.isArray(c)?c.forEach(v):Object.keys(c).forEach(function($){v(c[$])}),this._signal("registerSnippets",{scope:l})},this.unregister=function(c,l){var g=this.snippetMap,u=this.snippetNameMap;function o(f){var s=u[f.scope||l];if(s&&s[f.name]){delete s[f.name];var v=g[f.scope||l],$=v&&v.indexOf(f);$>=0&&v.splice($,1)}}c.content?o(c):Array.isArray(c)&&c.forEach(o)},this.parseSnippetFile=function(c){c=c.replace(/\r/g,"");for(var l=[],g={},u=/^#.*|^({[\s\S]*})\s*$|^(\S+) (.*)$|^((?:\n*\t.*)+)/gm,o;o=u.exec(c);){if(o[1])try{g=JSON.parse(o[1]),l.push(g)}catch{}if(o[4])g.content=o[4].replace(/^\t/gm,""),l.push(g),g={};else{var f=o[2],s=o[3];if(f=="regex"){var v=/\/((?:[^\/\\]|\\.)*)|$/g;g.guard=v.exec(s)[1],g.trigger=v.exec(s)[1],g.endTrigger=v.exec(s)[1],g.endGuard=v.exec(s)[1]}else f=="snippet"?(g.tabTrigger=s.match(/^\S*/)[0],g.name||(g.name=s)):f&&(g[f]=s)}}return l},this.getSnippetByName=function(c,l){var g=this.snippetNameMap,u;return this.getActiveScopes(l).some(function(o){var f=g[o];return f&&(u=f[c]),!!u},this),u}}).call(a.prototype);var m=function(c){if(c.tabstopManager)return c.tabstopManager;c.tabstopManager=this,this.$onChange=this.onChange.bind(this),this.$onChangeSelection=p.delayedCall(this.onChangeSelection.bind(this)).schedule,this.$onChangeSession=this.onChangeSession.bind(this),this.$onAfterExec=this.onAfterExec.bind(this),this.attach(c)};(function(){this.attach=function(c){this.index=0,this.ranges=[],this.tabstops=[],this.$openTabstops=null,this.selectedTabstop=null,this.editor=c,this.editor.on("change",this.$onChange),this.editor.on("changeSelection",this.$onChangeSelection),this.editor.on("changeSession",this.$onChangeSession),this.editor.commands.on("afterExec",this.$onAfterExec),this.editor.keyBinding.addKeyboardHandler(this.keyboardHandler)},this.detach=function(){this.tabstops.forEach(this.removeTabstopMarkers,this),this.ranges=null,this.tabstops=null,this.selectedTabstop=null,this.editor.removeListener("change",this.$onChange),this.editor.removeListener("changeSelection",this.$onChangeSelection),this.editor.removeListener("changeSession",this.$onChangeSession),this.editor.commands.removeListener("afterExec",this.$onAfterExec),this.editor.keyBinding.removeKeyboardHandler(this.keyboardHandler),this.editor.tabstopManager=null,this.editor=null},this.onChange=function(c){for(var l=c.action[0]=="r",g=this.selectedTabstop||{},u=g.parents||{},o=(this.tabstops||[]).slice(),f=0;f<o.length;f++){var s=o[f],v=s==g||u[s.index];if(s.rangeList.$bias=v?0:1,c.action=="remove"&&s!==g){var $=s.parents&&s.parents[g.index],C=s.rangeList.pointIndex(c.start,$);C=C<0?-C-1:C+1;var x=s.rangeList.pointIndex(c.end,$);x=x<0?-x-1:x-1;for(var L=s.rangeList.ranges.slice(C,x),R=0;R<L.length;R++)this.removeRange(L[R])}s.rangeList.$onChange(c)}var T=this.editor.session;!this.$inChange&&l&&T.getLength()==1&&!T.getValue()&&this.detach()},this.updateLinkedFields=function(){var c=this.selectedTabstop;if(!(!c||!c.hasLinkedRanges||!c.firstNonLinked)){this.$inChange=!0;for(var l=this.editor.session,g=l.getTextRange(c.firstNonLinked),u=0;u<c.length;u++){var o=c[u];if(o.linked){var f=o.original,s=y.snippetManager.tmStrFormat(g,f,this.editor);l.replace(o,s)}}this.$inChange=!1}},this.onAfterExec=function(c){c.command&&!c.command.readOnly&&this.updateLinkedFields()},this.onChangeSelection=function(){if(this.editor){for(var c=this.editor.selection.lead,l=this.editor.selection.anchor,g=this.editor.selection.isEmpty(),u=0;u<this.ranges.length;u++)if(!this.ranges[u].linked){var o=this.ranges[u].contains(c.row,c.column),f=g||this.ranges[u].contains(l.row,l.column);if(o&&f)return}this.detach()}},this.onChangeSession=function(){this.detach()},this.tabNext=function(c){var l=this.tabstops.length,g=this.index+(c||1);g=Math.min(Math.max(g,1),l),g==l&&(g=0),this.selectTabstop(g),g===0&&this.detach()},this.selectTabstop=function(c){this.$openTabstops=null;var l=this.tabstops[this.index];if(l&&this.addTabstopMarkers(l),this.index=c,l=this.tabstops[this.index],!(!l||!l.length)){this.selectedTabstop=l;var g=l.firstNonLinked||l;if(l.choices&&(g.cursor=g.start),this.editor.inVirtualSelectionMode)this.editor.selection.fromOrientedRange(g);else{var u=this.editor.multiSelect;u.toSingleRange(g);for(var o=0;o<l.length;o++)l.hasLinkedRanges&&l[o].linked||u.addRange(l[o].clone(),!0)}this.editor.keyBinding.addKeyboardHandler(this.keyboardHandler),this.selectedTabstop&&this.selectedTabstop.choices&&this.editor.execCommand("startAutocomplete",{matches:this.selectedTabstop.choices})}},this.addTabstops=function(c,l,g){var u=this.useLink||!this.editor.getOption("enableMultiselect");if(this.$openTabstops||(this.$openTabstops=[]),!c[0]){var o=h.fromPoints(g,g);S(o.start,l),S(o.end,l),c[0]=[o],c[0].index=0}var f=this.index,s=[f+1,0],v=this.ranges;c.forEach(function($,C){for(var x=this.$openTabstops[C]||$,L=0;L<$.length;L++){var R=$[L],T=h.fromPoints(R.start,R.end||R.start);w(T.start,l),w(T.end,l),T.original=R,T.tabstop=x,v.push(T),x!=$?x.unshift(T):x[L]=T,R.fmtString||x.firstNonLinked&&u?(T.linked=!0,x.hasLinkedRanges=!0):x.firstNonLinked||(x.firstNonLinked=T)}x.firstNonLinked||(x.hasLinkedRanges=!1),x===$&&(s.push(x),this.$openTabstops[C]=x),this.addTabstopMarkers(x),x.rangeList=x.rangeList||new d,x.rangeList.$bias=0,x.rangeList.addList(x)},this),s.length>2&&(this.tabstops.length&&s.push(s.splice(2,1)[0]),this.tabstops.splice.apply(this.tabstops,s))},this.addTabstopMarkers=function(c){var l=this.editor.session;c.forEach(function(g){g.markerId||(g.markerId=l.addMarker(g,"ace_snippet-marker","text"))})},this.removeTabstopMarkers=function(c){var l=this.editor.session;c.forEach(function(g){l.removeMarker(g.markerId),g.markerId=null})},this.removeRange=function(c){var l=c.tabstop.indexOf(c);l!=-1&&c.tabstop.splice(l,1),l=this.ranges.indexOf(c),l!=-1&&this.ranges.splice(l,1),l=c.tabstop.rangeList.ranges.indexOf(c),l!=-1&&c.tabstop.splice(l,1),this.editor.session.removeMarker(c.markerId),c.tabstop.length||(l=this.tabstops.indexOf(c.tabstop),l!=-1&&this.tabstops.splice(l,1),this.tabstops.length||this.detach())},this.keyboardHandler=new t,this.keyboardHandler.bindKeys({Tab:function(c){y.snippetManager&&y.snippetManager.expandWithTab(c)||(c.tabstopManager.tabNext(1),c.renderer.scrollCursorIntoView())},"Shift-Tab":function(c){c.tabstopManager.tabNext(-1),c.renderer.scrollCursorIntoView()},Esc:function(c){c.tabstopManager.detach()}})}).call(m.prototype);var w=function(c,l){c.row==0&&(c.column+=l.column),c.row+=l.row},S=function(c,l){c.row==l.row&&(c.column-=l.column),c.row-=l.row};k("./lib/dom").importCssString(".ace_snippet-marker {    -moz-box-sizing: border-box;    box-sizing: border-box;    background: rgba(194, 193, 208, 0.09);    border: 1px dotted rgba(211, 208, 235, 0.62);    position: absolute;}"),y.snippetManager=new a;var b=k("./editor").Editor;(function(){this.insertSnippet=function(c,l){return y.snippetManager.insertSnippet(this,c,l)},this.expandSnippet=function(c){return y.snippetManager.expandWithTab(this,c)}}).call(b.prototype)}),define("ace/autocomplete/popup",["require","exports","module","ace/virtual_renderer","ace/editor","ace/range","ace/lib/event","ace/lib/lang","ace/lib/dom"],function(k,y,B){"use strict";var E=k("../virtual_renderer").VirtualRenderer,A=k("../editor").Editor,p=k("../range").Range,h=k("../lib/event"),d=k("../lib/lang"),t=k("../lib/dom"),e=function(r){var n=new E(r);n.$maxLines=4;var a=new A(n);return a.setHighlightActiveLine(!1),a.setShowPrintMargin(!1),a.renderer.setShowGutter(!1),a.renderer.setHighlightGutterLine(!1),a.$mouseHandler.$focusTimeout=0,a.$highlightTagPending=!0,a},i=function(r){var n=t.createElement("div"),a=new e(n);r&&r.appendChild(n),n.style.display="none",a.renderer.content.style.cursor="default",a.renderer.setStyle("ace_autocomplete"),a.setOption("displayIndentGuides",!1),a.setOption("dragDelay",150);var m=function(){};a.focus=m,a.$isFocused=!0,a.renderer.$cursorLayer.restartTimer=m,a.renderer.$cursorLayer.element.style.opacity=0,a.renderer.$maxLines=8,a.renderer.$keepTextAreaAtCursor=!1,a.setHighlightActiveLine(!1),a.session.highlight(""),a.session.$searchHighlight.clazz="ace_highlight-marker",a.on("mousedown",function(u){var o=u.getDocumentPosition();a.selection.moveToPosition(o),b.start.row=b.end.row=o.row,u.stop()});var w,S=new p(-1,0,-1,1/0),b=new p(-1,0,-1,1/0);b.id=a.session.addMarker(b,"ace_active-line","fullLine"),a.setSelectOnHover=function(u){u?S.id&&(a.session.removeMarker(S.id),S.id=null):S.id=a.session.addMarker(S,"ace_line-hover","fullLine")},a.setSelectOnHover(!1),a.on("mousemove",function(u){if(!w){w=u;return}if(!(w.x==u.x&&w.y==u.y)){w=u,w.scrollTop=a.renderer.scrollTop;var o=w.getDocumentPosition().row;S.start.row!=o&&(S.id||a.setRow(o),l(o))}}),a.renderer.on("beforeRender",function(){if(w&&S.start.row!=-1){w.$pos=null;var u=w.getDocumentPosition().row;S.id||a.setRow(u),l(u,!0)}}),a.renderer.on("afterRender",function(){var u=a.getRow(),o=a.renderer.$textLayer,f=o.element.childNodes[u-o.config.firstRow];f!==o.selectedNode&&o.selectedNode&&t.removeCssClass(o.selectedNode,"ace_selected"),o.selectedNode=f,f&&t.addCssClass(f,"ace_selected")});var c=function(){l(-1)},l=function(u,o){u!==S.start.row&&(S.start.row=S.end.row=u,o||a.session._emit("changeBackMarker"),a._emit("changeHoverMarker"))};a.getHoveredRow=function(){return S.start.row},h.addListener(a.container,"mouseout",c),a.on("hide",c),a.on("changeSelection",c),a.session.doc.getLength=function(){return a.data.length},a.session.doc.getLine=function(u){var o=a.data[u];return typeof o=="string"?o:o&&o.value||""};var g=a.session.bgTokenizer;return g.$tokenizeRow=function(u){var o=a.data[u],f=[];if(!o)return f;typeof o=="string"&&(o={value:o});var s=o.caption||o.value||o.name;function v(F,O){F&&f.push({type:(o.className||"")+(O||""),value:F})}for(var $=s.toLowerCase(),C=(a.filterText||"").toLowerCase(),x=0,L=0,R=0;R<=C.length;R++)if(R!=L&&(o.matchMask&1<<R||R==C.length)){var T=C.slice(L,R);L=R;var _=$.indexOf(T,x);if(_==-1)continue;v(s.slice(x,_),""),x=_+T.length,v(s.slice(_,x),"completion-highlight")}return v(s.slice(x,s.length),""),o.meta&&f.push({type:"completion-meta",value:o.meta}),o.message&&f.push({type:"completion-message",value:o.message}),f},g.$updateOnChange=m,g.start=m,a.session.$computeWidth=function(){return this.screenWidth=0},a.isOpen=!1,a.isTopdown=!1,a.autoSelect=!0,a.filterText="",a.data=[],a.setData=function(u,o){a.filterText=o||"",a.setValue(d.stringRepeat(`
+`,u.length),-1),a.data=u||[],a.setRow(0)},a.getData=function(u){return a.data[u]},a.getRow=function(){return b.start.row},a.setRow=function(u){u=Math.max(this.autoSelect?0:-1,Math.min(this.data.length,u)),b.start.row!=u&&(a.selection.clearSelection(),b.start.row=b.end.row=u||0,a.session._emit("changeBackMarker"),a.moveCursorTo(u||0,0),a.isOpen&&a._signal("select"))},a.on("changeSelection",function(){a.isOpen&&a.setRow(a.selection.lead.row),a.renderer.scrollCursorIntoView()}),a.hide=function(){this.container.style.display="none",this._signal("hide"),a.isOpen=!1},a.show=function(u,o,f){var s=this.container,v=window.innerHeight,$=window.innerWidth,C=this.renderer,x=C.$maxLines*o*1.4,L=u.top+this.$borderSize,R=L>v/2&&!f;R&&L+o+x>v?(C.$maxPixelHeight=L-2*this.$borderSize,s.style.top="",s.style.bottom=v-L+"px",a.isTopdown=!1):(L+=o,C.$maxPixelHeight=v-L-.2*o,s.style.top=L+"px",s.style.bottom="",a.isTopdown=!0),s.style.display="";var T=u.left;T+s.offsetWidth>$&&(T=$-s.offsetWidth),s.style.left=T+"px",this._signal("show"),w=null,a.isOpen=!0},a.goTo=function(u){var o=this.getRow(),f=this.session.getLength()-1;switch(u){case"up":o=o<=0?f:o-1;break;case"down":o=o>=f?-1:o+1;break;case"start":o=0;break;case"end":o=f;break}this.setRow(o)},a.getTextLeftOffset=function(){return this.$borderSize+this.renderer.$padding+this.$imageSize},a.$imageSize=0,a.$borderSize=1,a};t.importCssString(".ace_editor.ace_autocomplete .ace_marker-layer .ace_active-line {    background-color: #CAD6FA;    z-index: 1;}.ace_dark.ace_editor.ace_autocomplete .ace_marker-layer .ace_active-line {    background-color: #3a674e;}.ace_editor.ace_autocomplete .ace_line-hover {    border: 1px solid #abbffe;    margin-top: -1px;    background: rgba(233,233,253,0.4);    position: absolute;    z-index: 2;}.ace_dark.ace_editor.ace_autocomplete .ace_line-hover {    border: 1px solid rgba(109, 150, 13, 0.8);    background: rgba(58, 103, 78, 0.62);}.ace_completion-meta {    opacity: 0.5;    margin: 0.9em;}.ace_completion-message {    color: blue;}.ace_editor.ace_autocomplete .ace_completion-highlight{    color: #2d69c7;}.ace_dark.ace_editor.ace_autocomplete .ace_completion-highlight{    color: #93ca12;}.ace_editor.ace_autocomplete {    width: 300px;    z-index: 200000;    border: 1px lightgray solid;    position: fixed;    box-shadow: 2px 3px 5px rgba(0,0,0,.2);    line-height: 1.4;    background: #fefefe;    color: #111;}.ace_dark.ace_editor.ace_autocomplete {    border: 1px #484747 solid;    box-shadow: 2px 3px 5px rgba(0, 0, 0, 0.51);    line-height: 1.4;    background: #25282c;    color: #c1c1c1;}","autocompletion.css"),y.AcePopup=i,y.$singleLineEditor=e}),define("ace/autocomplete/util",["require","exports","module"],function(k,y,B){"use strict";y.parForEach=function(A,p,h){var d=0,t=A.length;t===0&&h();for(var e=0;e<t;e++)p(A[e],function(i,r){d++,d===t&&h(i,r)})};var E=/[a-zA-Z_0-9\$\-\u00A2-\u2000\u2070-\uFFFF]/;y.retrievePrecedingIdentifier=function(A,p,h){h=h||E;for(var d=[],t=p-1;t>=0&&h.test(A[t]);t--)d.push(A[t]);return d.reverse().join("")},y.retrieveFollowingIdentifier=function(A,p,h){h=h||E;for(var d=[],t=p;t<A.length&&h.test(A[t]);t++)d.push(A[t]);return d},y.getCompletionPrefix=function(A){var p=A.getCursorPosition(),h=A.session.getLine(p.row),d;return A.completers.forEach(function(t){t.identifierRegexps&&t.identifierRegexps.forEach(function(e){!d&&e&&(d=this.retrievePrecedingIdentifier(h,p.column,e))}.bind(this))}.bind(this)),d||this.retrievePrecedingIdentifier(h,p.column)}}),define("ace/autocomplete",["require","exports","module","ace/keyboard/hash_handler","ace/autocomplete/popup","ace/autocomplete/util","ace/lib/lang","ace/lib/dom","ace/snippets","ace/config"],function(k,y,B){"use strict";var E=k("./keyboard/hash_handler").HashHandler,A=k("./autocomplete/popup").AcePopup,p=k("./autocomplete/util"),h=k("./lib/lang"),d=k("./lib/dom"),t=k("./snippets").snippetManager,e=k("./config"),i=function(){this.autoInsert=!1,this.autoSelect=!0,this.exactMatch=!1,this.gatherCompletionsId=0,this.keyboardHandler=new E,this.keyboardHandler.bindKeys(this.commands),this.blurListener=this.blurListener.bind(this),this.changeListener=this.changeListener.bind(this),this.mousedownListener=this.mousedownListener.bind(this),this.mousewheelListener=this.mousewheelListener.bind(this),this.changeTimer=h.delayedCall(function(){this.updateCompletions(!0)}.bind(this)),this.tooltipTimer=h.delayedCall(this.updateDocTooltip.bind(this),50)};(function(){this.$init=function(){return this.popup=new A(document.body||document.documentElement),this.popup.on("click",function(n){this.insertMatch(),n.stop()}.bind(this)),this.popup.focus=this.editor.focus.bind(this.editor),this.popup.on("show",this.tooltipTimer.bind(null,null)),this.popup.on("select",this.tooltipTimer.bind(null,null)),this.popup.on("changeHoverMarker",this.tooltipTimer.bind(null,null)),this.popup},this.getPopup=function(){return this.popup||this.$init()},this.openPopup=function(n,a,m){this.popup||this.$init(),this.popup.autoSelect=this.autoSelect,this.popup.setData(this.completions.filtered,this.completions.filterText),n.keyBinding.addKeyboardHandler(this.keyboardHandler);var w=n.renderer;if(this.popup.setRow(this.autoSelect?0:-1),m)m&&!a&&this.detach();else{this.popup.setTheme(n.getTheme()),this.popup.setFontSize(n.getFontSize());var S=w.layerConfig.lineHeight,b=w.$cursorLayer.getPixelPosition(this.base,!0);b.left-=this.popup.getTextLeftOffset();var c=n.container.getBoundingClientRect();b.top+=c.top-w.layerConfig.offset,b.left+=c.left-n.renderer.scrollLeft,b.left+=w.gutterWidth,this.popup.show(b,S)}this.changeTimer.cancel()},this.detach=function(){this.editor.keyBinding.removeKeyboardHandler(this.keyboardHandler),this.editor.off("changeSelection",this.changeListener),this.editor.off("blur",this.blurListener),this.editor.off("mousedown",this.mousedownListener),this.editor.off("mousewheel",this.mousewheelListener),this.changeTimer.cancel(),this.hideDocTooltip(),this.gatherCompletionsId+=1,this.popup&&this.popup.isOpen&&this.popup.hide(),this.base&&this.base.detach(),this.activated=!1,this.completions=this.base=null},this.changeListener=function(n){var a=this.editor.selection.lead;(a.row!=this.base.row||a.column<this.base.column)&&this.detach(),this.activated?this.changeTimer.schedule():this.detach()},this.blurListener=function(n){var a=document.activeElement,m=this.editor.textInput.getElement(),w=n.relatedTarget&&this.tooltipNode&&this.tooltipNode.contains(n.relatedTarget),S=this.popup&&this.popup.container;a!=m&&a.parentNode!=S&&!w&&a!=this.tooltipNode&&n.relatedTarget!=m&&this.detach()},this.mousedownListener=function(n){this.detach()},this.mousewheelListener=function(n){this.detach()},this.goTo=function(n){this.popup.goTo(n)},this.insertMatch=function(n,a){if(n||(n=this.popup.getData(this.popup.getRow())),!n)return!1;var m=this.completions;if(this.editor.startOperation({command:{name:"insertMatch"}}),n.completer&&n.completer.insertMatch)n.completer.insertMatch(this.editor,n);else{if(m.filterText)for(var w=this.editor.selection.getAllRanges(),S=0,b;b=w[S];S++)b.start.column-=m.filterText.length,this.editor.session.remove(b);n.snippet?t.insertSnippet(this.editor,n.snippet):this.editor.execCommand("insertstring",n.value||n)}this.completions==m&&this.detach(),this.editor.endOperation()},this.commands={Up:function(n){n.completer.goTo("up")},Down:function(n){n.completer.goTo("down")},"Ctrl-Up|Ctrl-Home":function(n){n.completer.goTo("start")},"Ctrl-Down|Ctrl-End":function(n){n.completer.goTo("end")},Esc:function(n){n.completer.detach()},Return:function(n){return n.completer.insertMatch()},"Shift-Return":function(n){n.completer.insertMatch(null,{deleteSuffix:!0})},Tab:function(n){var a=n.completer.insertMatch();if(!a&&!n.tabstopManager)n.completer.goTo("down");else return a},PageUp:function(n){n.completer.popup.gotoPageUp()},PageDown:function(n){n.completer.popup.gotoPageDown()}},this.gatherCompletions=function(n,a){var m=n.getSession(),w=n.getCursorPosition(),S=p.getCompletionPrefix(n);this.base=m.doc.createAnchor(w.row,w.column-S.length),this.base.$insertRight=!0;var b=[],c=n.completers.length;return n.completers.forEach(function(l,g){l.getCompletions(n,m,w,S,function(u,o){!u&&o&&(b=b.concat(o)),a(null,{prefix:p.getCompletionPrefix(n),matches:b,finished:--c===0})})}),!0},this.showPopup=function(n,a){this.editor&&this.detach(),this.activated=!0,this.editor=n,n.completer!=this&&(n.completer&&n.completer.detach(),n.completer=this),n.on("changeSelection",this.changeListener),n.on("blur",this.blurListener),n.on("mousedown",this.mousedownListener),n.on("mousewheel",this.mousewheelListener),this.updateCompletions(!1,a)},this.updateCompletions=function(n,a){if(n&&this.base&&this.completions){var m=this.editor.getCursorPosition(),w=this.editor.session.getTextRange({start:this.base,end:m});if(w==this.completions.filterText)return;if(this.completions.setFilter(w),!this.completions.filtered.length)return this.detach();if(this.completions.filtered.length==1&&this.completions.filtered[0].value==w&&!this.completions.filtered[0].snippet)return this.detach();this.openPopup(this.editor,w,n);return}if(a&&a.matches){var m=this.editor.getSelectionRange().start;return this.base=this.editor.session.doc.createAnchor(m.row,m.column),this.base.$insertRight=!0,this.completions=new r(a.matches),this.openPopup(this.editor,"",n)}var S=this.gatherCompletionsId;this.gatherCompletions(this.editor,function(b,c){var l=function(){if(c.finished)return this.detach()}.bind(this),g=c.prefix,u=c&&c.matches;if(!u||!u.length)return l();if(!(g.indexOf(c.prefix)!==0||S!=this.gatherCompletionsId)){this.completions=new r(u),this.exactMatch&&(this.completions.exactMatch=!0),this.completions.setFilter(g);var o=this.completions.filtered;if(!o.length||o.length==1&&o[0].value==g&&!o[0].snippet)return l();if(this.autoInsert&&o.length==1&&c.finished)return this.insertMatch(o[0]);this.openPopup(this.editor,g,n)}}.bind(this))},this.cancelContextMenu=function(){this.editor.$mouseHandler.cancelContextMenu()},this.updateDocTooltip=function(){var n=this.popup,a=n.data,m=a&&(a[n.getHoveredRow()]||a[n.getRow()]),w=null;if(!m||!this.editor||!this.popup.isOpen)return this.hideDocTooltip();if(this.editor.completers.some(function(S){return S.getDocTooltip&&(w=S.getDocTooltip(m)),w}),!w&&typeof m!="string"&&(w=m),typeof w=="string"&&(w={docText:w}),!w||!(w.docHTML||w.docText))return this.hideDocTooltip();this.showDocTooltip(w)},this.showDocTooltip=function(n){this.tooltipNode||(this.tooltipNode=d.createElement("div"),this.tooltipNode.className="ace_tooltip ace_doc-tooltip",this.tooltipNode.style.margin=0,this.tooltipNode.style.pointerEvents="auto",this.tooltipNode.tabIndex=-1,this.tooltipNode.onblur=this.blurListener.bind(this),this.tooltipNode.onclick=this.onTooltipClick.bind(this));var a=this.tooltipNode;n.docHTML?a.innerHTML=n.docHTML:n.docText&&(a.textContent=n.docText),a.parentNode||document.body.appendChild(a);var m=this.popup,w=m.container.getBoundingClientRect();a.style.top=m.container.style.top,a.style.bottom=m.container.style.bottom,a.style.display="block",window.innerWidth-w.right<320?w.left<320?m.isTopdown?(a.style.top=w.bottom+"px",a.style.left=w.left+"px",a.style.right="",a.style.bottom=""):(a.style.top=m.container.offsetTop-a.offsetHeight+"px",a.style.left=w.left+"px",a.style.right="",a.style.bottom=""):(a.style.right=window.innerWidth-w.left+"px",a.style.left=""):(a.style.left=w.right+1+"px",a.style.right="")},this.hideDocTooltip=function(){if(this.tooltipTimer.cancel(),!!this.tooltipNode){var n=this.tooltipNode;!this.editor.isFocused()&&document.activeElement==n&&this.editor.focus(),this.tooltipNode=null,n.parentNode&&n.parentNode.removeChild(n)}},this.onTooltipClick=function(n){for(var a=n.target;a&&a!=this.tooltipNode;){if(a.nodeName=="A"&&a.href){a.rel="noreferrer",a.target="_blank";break}a=a.parentNode}},this.destroy=function(){if(this.detach(),this.popup){this.popup.destroy();var n=this.popup.container;n&&n.parentNode&&n.parentNode.removeChild(n)}this.editor&&this.editor.completer==this&&this.editor.completer==null,this.popup=null}}).call(i.prototype),i.for=function(n){return n.completer||(e.get("sharedPopups")?(i.$shared||(i.$sharedInstance=new i),n.completer=i.$sharedInstance):(n.completer=new i,n.once("destroy",function(a,m){m.completer.destroy()}))),n.completer},i.startCommand={name:"startAutocomplete",exec:function(n,a){var m=i.for(n);m.autoInsert=!1,m.autoSelect=!0,m.showPopup(n,a),m.cancelContextMenu()},bindKey:"Ctrl-Space|Ctrl-Shift-Space|Alt-Space"};var r=function(n,a){this.all=n,this.filtered=n,this.filterText=a||"",this.exactMatch=!1};(function(){this.setFilter=function(n){if(n.length>this.filterText&&n.lastIndexOf(this.filterText,0)===0)var a=this.filtered;else var a=this.all;this.filterText=n,a=this.filterCompletions(a,this.filterText),a=a.sort(function(w,S){return S.exactMatch-w.exactMatch||S.$score-w.$score||(w.caption||w.value).localeCompare(S.caption||S.value)});var m=null;a=a.filter(function(w){var S=w.snippet||w.caption||w.value;return S===m?!1:(m=S,!0)}),this.filtered=a},this.filterCompletions=function(n,a){var m=[],w=a.toUpperCase(),S=a.toLowerCase();e:for(var b=0,c;c=n[b];b++){var l=c.caption||c.value||c.snippet;if(l){var g=-1,u=0,o=0,f,s;if(this.exactMatch){if(a!==l.substr(0,a.length))continue e}else{var v=l.toLowerCase().indexOf(S);if(v>-1)o=v;else for(var $=0;$<a.length;$++){var C=l.indexOf(S[$],g+1),x=l.indexOf(w[$],g+1);if(f=C>=0&&(x<0||C<x)?C:x,f<0)continue e;s=f-g-1,s>0&&(g===-1&&(o+=10),o+=s,u=u|1<<$),g=f}}c.matchMask=u,c.exactMatch=o?0:1,c.$score=(c.score||0)-o,m.push(c)}}return m}}).call(r.prototype),y.Autocomplete=i,y.FilteredList=r}),define("ace/autocomplete/text_completer",["require","exports","module","ace/range"],function(k,y,B){var E=k("../range").Range,A=/[^a-zA-Z_0-9\$\-\u00C0-\u1FFF\u2C00-\uD7FF\w]+/;function p(d,t){var e=d.getTextRange(E.fromPoints({row:0,column:0},t));return e.split(A).length-1}function h(d,t){var e=p(d,t),i=d.getValue().split(A),r=Object.create(null),n=i[e];return i.forEach(function(a,m){if(!(!a||a===n)){var w=Math.abs(e-m),S=i.length-w;r[a]?r[a]=Math.max(S,r[a]):r[a]=S}}),r}y.getCompletions=function(d,t,e,i,r){var n=h(t,e),a=Object.keys(n);r(null,a.map(function(m){return{caption:m,value:m,score:n[m],meta:"local"}}))}}),define("ace/ext/language_tools",["require","exports","module","ace/snippets","ace/autocomplete","ace/config","ace/lib/lang","ace/autocomplete/util","ace/autocomplete/text_completer","ace/editor","ace/config"],function(k,y,B){"use strict";var E=k("../snippets").snippetManager,A=k("../autocomplete").Autocomplete,p=k("../config"),h=k("../lib/lang"),d=k("../autocomplete/util"),t=k("../autocomplete/text_completer"),e={getCompletions:function(c,l,g,u,o){if(l.$mode.completer)return l.$mode.completer.getCompletions(c,l,g,u,o);var f=c.session.getState(g.row),s=l.$mode.getCompletions(f,l,g,u);o(null,s)}},i={getCompletions:function(c,l,g,u,o){var f=[],s=l.getTokenAt(g.row,g.column);s&&s.type.match(/(tag-name|tag-open|tag-whitespace|attribute-name|attribute-value)\.xml$/)?f.push("html-tag"):f=E.getActiveScopes(c);var v=E.snippetMap,$=[];f.forEach(function(C){for(var x=v[C]||[],L=x.length;L--;){var R=x[L],T=R.name||R.tabTrigger;T&&$.push({caption:T,snippet:R.content,meta:R.tabTrigger&&!R.name?R.tabTrigger+"\u21E5 ":"snippet",type:"snippet"})}},this),o(null,$)},getDocTooltip:function(c){c.type=="snippet"&&!c.docHTML&&(c.docHTML=["<b>",h.escapeHTML(c.caption),"</b>","<hr></hr>",h.escapeHTML(c.snippet)].join(""))}},r=[i,t,e];y.setCompleters=function(c){r.length=0,c&&r.push.apply(r,c)},y.addCompleter=function(c){r.push(c)},y.textCompleter=t,y.keyWordCompleter=e,y.snippetCompleter=i;var n={name:"expandSnippet",exec:function(c){return E.expandWithTab(c)},bindKey:"Tab"},a=function(c,l){m(l.session.$mode)},m=function(c){typeof c=="string"&&(c=p.$modes[c]),c&&(E.files||(E.files={}),w(c.$id,c.snippetFileId),c.modes&&c.modes.forEach(m))},w=function(c,l){!l||!c||E.files[c]||(E.files[c]={},p.loadModule(l,function(g){g&&(E.files[c]=g,!g.snippets&&g.snippetText&&(g.snippets=E.parseSnippetFile(g.snippetText)),E.register(g.snippets||[],g.scope),g.includeScopes&&(E.snippetMap[g.scope].includeScopes=g.includeScopes,g.includeScopes.forEach(function(u){m("ace/mode/"+u)})))}))},S=function(c){var l=c.editor,g=l.completer&&l.completer.activated;if(c.command.name==="backspace")g&&!d.getCompletionPrefix(l)&&l.completer.detach();else if(c.command.name==="insertstring"){var u=d.getCompletionPrefix(l);if(u&&!g){var o=A.for(l);o.autoInsert=!1,o.showPopup(l)}}},b=k("../editor").Editor;k("../config").defineOptions(b.prototype,"editor",{enableBasicAutocompletion:{set:function(c){c?(this.completers||(this.completers=Array.isArray(c)?c:r),this.commands.addCommand(A.startCommand)):this.commands.removeCommand(A.startCommand)},value:!1},enableLiveAutocompletion:{set:function(c){c?(this.completers||(this.completers=Array.isArray(c)?c:r),this.commands.on("afterExec",S)):this.commands.removeListener("afterExec",S)},value:!1},enableSnippets:{set:function(c){c?(this.commands.addCommand(n),this.on("changeMode",a),a(null,this)):(this.commands.removeCommand(n),this.off("changeMode",a))},value:!1}})}),function(){window.require(["ace/ext/language_tools"],function(k){typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=k)})}(),define("ace/mode/mongo_highlight_rules",["require","exports","module","ace/lib/oop","ace/mode/text_highlight_rules"],function(k,y,B){"use strict";var E=k("../lib/oop"),A=k("./text_highlight_rules").TextHighlightRules,p=function(){var h=function(d){switch(d){case"ObjectId":case"ISODate":case"Timestamp":case"NumberInt":case"NumberLong":case"NumberDecimal":case"BinData":case"true":case"false":case"null":case"undefined":return"constant.language";case"db":case"find":case"aggregate":case"update":case"explain":return"storage.function";default:return"identifier"}};this.$rules={start:[{token:"comment",regex:"//.*"},{token:"key",regex:'["](?:(?:\\\\.)|(?:[^"\\\\]))*?["]\\s*(?=:)'},{token:"string",regex:'["](?:(?:\\\\.)|(?:[^"\\\\]))*?["]'},{token:"constant.numeric",regex:"0[xX][0-9a-fA-F]+\\b"},{token:"constant.numeric",regex:"[+-]?\\d+(?:(?:\\.\\d*)?(?:[eE][+-]?\\d+)?)?\\b"},{token:"paren.lparen",regex:"[[({]"},{token:"paren.rparen",regex:"[\\])}]"},{token:h,regex:"[a-zA-Z_$][a-zA-Z0-9_$]*\\b"}]}};E.inherits(p,A),y.MongoHighlightRules=p}),define("ace/mode/matching_brace_outdent",["require","exports","module","ace/range"],function(k,y,B){"use strict";var E=k("../range").Range,A=function(){this.checkOutdent=function(p,h){return/^\s+$/.test(p)?/^\s*\}/.test(h):!1},this.autoOutdent=function(p,h){var d=p.getLine(h),t=d.match(/^(\s*\})/);if(!t)return 0;var e=t[1].length,i=p.findMatchingBracket({row:h,column:e});if(!i||i.row==h)return 0;var r=this.$getIndent(p.getLine(i.row));p.replace(new E(h,0,h,e-1),r)},this.$getIndent=function(p){return p.match(/^\s*/)[0]}};y.MatchingBraceOutdent=A}),define("ace/mode/folding/cstyle",["require","exports","module","ace/lib/oop","ace/mode/folding/fold_mode"],function(k,y,B){"use strict";var E=k("../../lib/oop"),A=k("./fold_mode").FoldMode,p=function(){this.foldingStartMarker=/([\{\[\(])[^\}\]\)]*$/,this.foldingStopMarker=/^[^\[\{\(]*([\}\]\)])/,this._getFoldWidgetBase=this.getFoldWidget,this.getFoldWidget=function(h,d,t){return this._getFoldWidgetBase(h,d,t)},this.getFoldWidgetRange=function(h,d,t,e){var i=h.getLine(t),r=i.match(this.foldingStartMarker);if(r.length>=2)return this.openingBracketBlock(h,r[1],t,r.index);if(d!=="markbegin"&&(r=i.match(this.foldingStopMarker),r.length>=2))return this.closingBracketBlock(h,r[1],t,r.index+r[0].length)}};E.inherits(p,A),y.FoldMode=p}),define("ace/mode/mongo",["require","exports","module","ace/lib/oop","ace/mode/text","ace/mode/mongo_highlight_rules","ace/mode/matching_brace_outdent","ace/mode/behaviour/cstyle","ace/mode/folding/cstyle"],function(k,y,B){"use strict";var E=k("../lib/oop"),A=k("./text").Mode,p=k("./mongo_highlight_rules").MongoHighlightRules,h=k("./matching_brace_outdent").MatchingBraceOutdent,d=k("./behaviour/cstyle").CstyleBehaviour,t=k("./folding/cstyle").FoldMode,e=function(){this.HighlightRules=p,this.$outdent=new h,this.$behaviour=new d,this.foldingRules=new t,this.getNextLineIndent=function(i,r,n){var a=this.$getIndent(r);if(i=="start"){var m=r.match(/^.*[\{\(\[]\s*$/);m&&(a+=n)}return a},this.checkOutdent=function(i,r,n){return this.$outdent.checkOutdent(r,n)},this.autoOutdent=function(i,r,n){this.$outdent.autoOutdent(r,n)},this.$id="ace/mode/mongo"};E.inherits(e,A),y.Mode=e}),function(){window.require(["ace/mode/mongo"],function(k){typeof module=="object"&&typeof exports=="object"&&module&&(module.exports=k)})}();var Completer=function(k){const y=[{caption:"find()",value:"find()",meta:"method"},{caption:"aggregate()",value:"aggregate()",meta:"method"},{caption:"update()",value:"update()",meta:"method"},{caption:"explain()",value:"explain()",meta:"method"}],B=[{caption:"true",value:"true",meta:"bson keyword"},{caption:"false",value:"false",meta:"bson keyword"},{caption:"null",value:"null",meta:"bson keyword"},{caption:"$numberDecimal",value:"$numberDecimal: ",meta:"bson keyword"},{caption:"$numberDouble",value:"$numberDouble: ",meta:"bson keyword"},{caption:"$numberLong",value:"$numberLong: ",meta:"bson keyword"},{caption:"$numberInt",value:"$numberLong: ",meta:"bson keyword"},{caption:"$oid",value:"$oid: ",meta:"bson keyword"},{caption:"$regularExpression",value:`$regularExpression: {
  "pattern": "pattern",
  "options": "options"
 }`,meta:"bson keyword"},{caption:"$timestamp",value:'$timestamp: {"t": 0, "i": 1}',meta:"bson keyword"},{caption:"$date",value:"$date: ",meta:"bson keyword"}].map(h),E=[{caption:"$eq",value:'$eq: "value"',meta:"comparison operator"},{caption:"$gt",value:'$gt: "value"',meta:"comparison operator"},{caption:"$gte",value:'$gte: "value"',meta:"comparison operator"},{caption:"$in",value:'$in: ["value1", "value2"]',meta:"comparison operator"},{caption:"$let",value:`$let: {
@@ -251,20 +251,21 @@
  "n": "expression",
  "sortBy": { "field": 1 },
  "output": "expression"
-}`,meta:"aggregation accumulator (v5.2+)"},{caption:"$linearFill",value:'$linearFill: "expression"',meta:"aggregation (v5.3+)"},{caption:"$locf",value:'$locf: "expression"',meta:"aggregation (v5.2+)"},{caption:"$tsIncrement",value:'$tsIncrement: "expression"',meta:"aggregation (v5.1+)"},{caption:"$tsSecond",value:'$tsSecond: "expression"',meta:"aggregation (v5.1+)"}].map(h).concat(B),p=[{caption:"$currentDate",value:'$currentDate: "expression"',meta:"update operator"},{caption:"$inc",value:'$inc: { "field": 1 }',meta:"update operator"},{caption:"$min",value:'$min: "expression"',meta:"update operator"},{caption:"$max",value:'$max: "expression"',meta:"update operator"},{caption:"$mul",value:'$mul: { "field": 2 }',meta:"update operator"},{caption:"$rename",value:'$rename: { "field": "newName" }',meta:"update operator"},{caption:"$set",value:'$set: { "field": "value" }',meta:"update operator"},{caption:"$setOnInsert",value:'$setOnInsert: { "field": "value" }',meta:"update operator"},{caption:"$unset",value:'$unset: { "field": "" }',meta:"update operator"},{caption:"$addToSet",value:'$addToSet: "expression"',meta:"update operator"},{caption:"$pop",value:'$pop: "expression"',meta:"update operator"},{caption:"$pull",value:'$pull: "expression"',meta:"update operator"},{caption:"$push",value:'$push: "expression"',meta:"update operator"},{caption:"$pullAll",value:'$pullAll: { "field": ["value1", "value2"] }',meta:"update operator"},{caption:"$each",value:'$each: ["value1", "value2"]',meta:"update operator"},{caption:"$position",value:"$position: 0",meta:"update operator"},{caption:"$slice",value:"$slice: 2",meta:"update operator"},{caption:"$sort",value:'$sort: "expression"',meta:"update operator"},{caption:"$bit",value:'$bit: { "field": { "and|or|xor": 4} }',meta:"update operator"}].map(h).concat(B);function h(r){return r.completer={insertMatch:u},r}function u(r,n){let o=r.getCursorPosition(),m=r.getSession().getTokenAt(o.row,o.column);r.removeWordLeft();let w="";!m.value.startsWith('"')&&!["true","false","null"].includes(n.value)&&(w='"'),m.value.endsWith('"')&&r.removeWordRight(),r.insert(w+n.value.replace(":",'":'))}function t(r){return{caption:r,value:r,meta:"collection name"}}return{configCompleter:{getCompletions:function(r,n,o,m,w){w(null,B)}},queryCompleter:{getCompletions:function(r,n,o,m,w){let S=n.getTokens(o.row);if(S.length===3&&S[0].value==="db"&&S[1].value==="."){w(null,L.parser.getCollections().map(t));return}let y=n.getTokenAt(o.row,o.column);if(S.length>3&&S[0].value==="db"&&S[y.index-1].value==="."){w(null,b);return}switch(L.parser.getQueryType()){case"find":w(null,E);break;case"aggregate":w(null,A);break;case"update":w(null,p);break;default:w(null,[])}}}}},CustomSelect=function(L){const b="is-selected",B="is-open",E=document.getElementById(L.selectId);let A=E.selectedIndex,p=[];for(var h=0;h<E.options.length;h++)p.push(E.options[h].textContent);const u=document.createElement("div");u.className="custom-select",u.id="custom-"+E.id;const t=document.createElement("button");t.className="custom-select-title",t.style.width=E.offsetWidth+"px";const e=document.createElement("ul");e.className="custom-select-list",e.style.width=E.offsetWidth+"px",i(),u.appendChild(t),u.appendChild(e),u.addEventListener("click",r),E.parentNode.insertBefore(u,E),E.parentNode.removeChild(E),document.addEventListener("click",l=>{u.contains(l.target)||o()});function i(){t.textContent="";for(var l=0;l<p.length;l++){var g=document.createElement("li");g.innerText=p[l],g.setAttribute("data-index",l),l===A&&(g.classList.add(b),t.textContent=g.innerText),e.appendChild(g)}}function r(l){l.preventDefault(),l.target.tagName==="LI"&&(w(l.target.getAttribute("data-index")),typeof L.onChange=="function"&&L.onChange()),n()}function n(){e.classList.toggle(B)}function o(){e.classList.remove(B)}function m(){return A}function w(l){let g=e.querySelectorAll("li");for(let d=0;d<g.length;d++)g[d].classList.remove(b),d==l&&(A=d,g[d].classList.add(b),t.textContent=g[d].innerText)}function S(){return t.textContent}function y(l){t.textContent=l;let g=e.querySelectorAll("li");for(let d=0;d<g.length;d++)g[d].classList.remove(b),g[d].innerText==l&&(A=d,g[d].classList.add(b))}function c(l){for(;e.firstChild;)e.firstChild.remove();p=l,p.length===0?A=-1:A=p.length-1,i()}return{toggle:n,getSelectedIndex:m,getValue:S,setValue:y,setOptions:c}},Parser=function(){let L,b,B=!1,E=!0,A,p=!1,h,u,t,e,i=[],r=-1,n=[],o;function m(F,H,U){return B=!0,E=!0,r=-1,y(F,H,U),e}function w(F,H,U){return B=!1,E=!0,r=-1,y(F,H,U),e}function S(F,H,U,Z){return B=!1,E=!1,r=Z,y(F,H,U),e}function y(F,H,U){t=F,e="",L=0,b=" ",A=0,h=!1,u=!1,p=!1,n=[],o="unknown";try{switch(H){case"config":$(U);break;case"query":J();break;default:a(),z()}if(a(),b){if(b===";")return e=e.slice(0,-1),a(),null;Y(`Unexpected remaining char after end of ${H}`)}}catch(Z){for(;b;)c();return Z}return null}function c(F){if(F&&F!==b&&Y(`Expected '${F}' instead of '${b}'`),l(),u){e+=b;return}if(b>" ")switch(p&&b!=="]"&&b!=="}"&&(p=!1,A++,B&&(e+=d())),b){case"{":case"[":p=!0,e+=b;break;case",":e+=b,B&&(h?e+=" ":e+=d());break;case":":e+=b,B&&(e+=" ");break;case"}":case"]":p?p=!1:(A--,B&&(e+=d())),e+=b;break;default:e+=b}}function l(){b=t.charAt(L),L+=1}function g(){let F=e.length-2;for(;[" ",`
-`].includes(e.charAt(F));)F--;if(e.charAt(F)===","){const H=e.charAt(e.length-1);e=e.slice(0,-(e.length-F)),B&&(e+=d()),e+=H}}function d(){return A<0?`
+}`,meta:"aggregation accumulator (v5.2+)"},{caption:"$linearFill",value:'$linearFill: "expression"',meta:"aggregation (v5.3+)"},{caption:"$locf",value:'$locf: "expression"',meta:"aggregation (v5.2+)"},{caption:"$tsIncrement",value:'$tsIncrement: "expression"',meta:"aggregation (v5.1+)"},{caption:"$tsSecond",value:'$tsSecond: "expression"',meta:"aggregation (v5.1+)"}].map(h).concat(B),p=[{caption:"$currentDate",value:'$currentDate: "expression"',meta:"update operator"},{caption:"$inc",value:'$inc: { "field": 1 }',meta:"update operator"},{caption:"$min",value:'$min: "expression"',meta:"update operator"},{caption:"$max",value:'$max: "expression"',meta:"update operator"},{caption:"$mul",value:'$mul: { "field": 2 }',meta:"update operator"},{caption:"$rename",value:'$rename: { "field": "newName" }',meta:"update operator"},{caption:"$set",value:'$set: { "field": "value" }',meta:"update operator"},{caption:"$setOnInsert",value:'$setOnInsert: { "field": "value" }',meta:"update operator"},{caption:"$unset",value:'$unset: { "field": "" }',meta:"update operator"},{caption:"$addToSet",value:'$addToSet: "expression"',meta:"update operator"},{caption:"$pop",value:'$pop: "expression"',meta:"update operator"},{caption:"$pull",value:'$pull: "expression"',meta:"update operator"},{caption:"$push",value:'$push: "expression"',meta:"update operator"},{caption:"$pullAll",value:'$pullAll: { "field": ["value1", "value2"] }',meta:"update operator"},{caption:"$each",value:'$each: ["value1", "value2"]',meta:"update operator"},{caption:"$position",value:"$position: 0",meta:"update operator"},{caption:"$slice",value:"$slice: 2",meta:"update operator"},{caption:"$sort",value:'$sort: "expression"',meta:"update operator"},{caption:"$bit",value:'$bit: { "field": { "and|or|xor": 4} }',meta:"update operator"}].map(h).concat(B);function h(r){return r.completer={insertMatch:d},r}function d(r,n){let a=r.getCursorPosition(),m=r.getSession().getTokenAt(a.row,a.column);r.removeWordLeft();let w="";!m.value.startsWith('"')&&!["true","false","null"].includes(n.value)&&(w='"'),m.value.endsWith('"')&&r.removeWordRight(),r.insert(w+n.value.replace(":",'":'))}function t(r){return{caption:r,value:r,meta:"collection name"}}return{configCompleter:{getCompletions:function(r,n,a,m,w){w(null,B)}},queryCompleter:{getCompletions:function(r,n,a,m,w){let S=n.getTokens(a.row);if(S.length===3&&S[0].value==="db"&&S[1].value==="."){w(null,k.parser.getCollections().map(t));return}let b=n.getTokenAt(a.row,a.column);if(S.length>3&&S[0].value==="db"&&S[b.index-1].value==="."){w(null,y);return}switch(k.parser.getQueryType()){case"find":w(null,E);break;case"aggregate":w(null,A);break;case"update":w(null,p);break;default:w(null,[])}}}}},CustomSelect=function(k){const y="is-selected",B="is-open",E=document.getElementById(k.selectId);let A=E.selectedIndex,p=[];for(var h=0;h<E.options.length;h++)p.push(E.options[h].textContent);const d=document.createElement("div");d.className="custom-select",d.id="custom-"+E.id;const t=document.createElement("button");t.className="custom-select-title",t.style.width=E.offsetWidth+"px";const e=document.createElement("ul");e.className="custom-select-list",e.style.width=E.offsetWidth+"px",i(),d.appendChild(t),d.appendChild(e),d.addEventListener("click",r),E.parentNode.insertBefore(d,E),E.parentNode.removeChild(E),document.addEventListener("click",l=>{d.contains(l.target)||a()});function i(){t.textContent="";for(var l=0;l<p.length;l++){var g=document.createElement("li");g.innerText=p[l],g.setAttribute("data-index",l),l===A&&(g.classList.add(y),t.textContent=g.innerText),e.appendChild(g)}}function r(l){l.preventDefault(),l.target.tagName==="LI"&&(w(l.target.getAttribute("data-index")),typeof k.onChange=="function"&&k.onChange()),n()}function n(){e.classList.toggle(B)}function a(){e.classList.remove(B)}function m(){return A}function w(l){let g=e.querySelectorAll("li");for(let u=0;u<g.length;u++)g[u].classList.remove(y),u==l&&(A=u,g[u].classList.add(y),t.textContent=g[u].innerText)}function S(){return t.textContent}function b(l){t.textContent=l;let g=e.querySelectorAll("li");for(let u=0;u<g.length;u++)g[u].classList.remove(y),g[u].innerText==l&&(A=u,g[u].classList.add(y))}function c(l){for(;e.firstChild;)e.firstChild.remove();p=l,p.length===0?A=-1:A=p.length-1,i()}return{toggle:n,getSelectedIndex:m,getValue:S,setValue:b,setOptions:c}},Parser=function(){let k,y,B=!1,E=!0,A,p=!1,h,d,t,e,i=[],r=-1,n=[],a;function m(H,K,ee){return B=!0,E=!0,r=-1,b(H,K,ee),e}function w(H,K,ee){return B=!1,E=!0,r=-1,b(H,K,ee),e}function S(H,K,ee,oe){return B=!1,E=!1,r=oe,b(H,K,ee),e}function b(H,K,ee){t=H,e="",k=0,y=" ",A=0,h=!1,d=!1,p=!1,n=[],a="unknown";try{switch(K){case"config":$(ee);break;case"query":X();break;default:o(),z()}if(o(),y){if(y===";")return e=e.slice(0,-1),o(),null;J(`Unexpected remaining char after end of ${K}`)}}catch(oe){for(;y;)c();return oe}return null}function c(H){if(H&&H!==y&&J(`Expected '${H}' instead of '${y}'`),l(),d){e+=y;return}if(y>" ")switch(p&&y!=="]"&&y!=="}"&&(p=!1,A++,B&&(e+=u())),y){case"{":case"[":p=!0,e+=y;break;case",":e+=y,B&&(h?e+=" ":e+=u());break;case":":e+=y,B&&(e+=" ");break;case"}":case"]":p?p=!1:(A--,B&&(e+=u())),e+=y;break;default:e+=y}}function l(){y=t.charAt(k),k+=1}function g(){let H=e.length-2;for(;[" ",`
+`].includes(e.charAt(H));)H--;if(e.charAt(H)===","){const K=e.charAt(e.length-1);e=e.slice(0,-(e.length-H)),B&&(e+=u()),e+=K}}function u(){return A<0?`
 `:`
-`+"  ".repeat(A)}function a(){for(;b&&b<=" ";)c();if(b==="/"){switch(c(),b!=="/"&&b!=="*"&&Y('Javascript regex are not supported. Use "$regex" instead'),b){case"/":f();break;case"*":s();break}a()}}function f(){e=e.slice(0,-2);let F=t.indexOf(`
-`,L);F===-1&&(F=t.length);const H=t.substring(L,F).trimRight();E&&(B?e+="//"+H+d():e.slice(-2)==="*/"?(e=e.slice(0,-2),e+="*"+H+"*/"):e+="/**"+H+"*/"),b=t.charAt(F+1),L=F+2,b>" "&&(e+=b)}function s(){e=e.slice(0,-2);const F=t.indexOf("*/",L);F===-1&&Y("Unfinished multiligne comment"),l(),b==="*"&&l();let H=t.substring(L-1,F);E&&H!==""&&(B?(H=H.replace(/\*/gm,d()+"//"),e+="//"+H+d()):e+="/**"+H+"*/"),b=t.charAt(F+2),L=F+3,b>" "&&(e+=b)}function v(){if(b==='"'||b==="'")return k();const F=L-1;for(;b&&(b>="0"&&b<="9"||b>="a"&&b<="z"||b>="A"&&b<="Z"||b==="$"||b==="_");)c();return t.substring(F,L-1)}function $(F){if(i=[],a(),F==="mgodatagen"&&b!=="["&&Y("mgodatagen config has to be an array"),b==="["){if(F==="bson")return i.push("collection"),N();for(c(),a();b;){if(G(!0),a(),b==="]")return c();if(b===","){c(),a();continue}Y("Invalid configuration")}}if(c("d"),c("b"),a(),c("="),a(),b==="{"){for(c();b;)if(x(),a(),b==="}"||(b!==","&&Y("Invalid configuration"),c(),a(),b==="}"))return g(),c()}Y(`Invalid configuration:
+`+"  ".repeat(A)}function o(){for(;y&&y<=" ";)c();if(y==="/"){switch(c(),y!=="/"&&y!=="*"&&J('Javascript regex are not supported. Use "$regex" instead'),y){case"/":f();break;case"*":s();break}o()}}function f(){e=e.slice(0,-2);let H=t.indexOf(`
+`,k);H===-1&&(H=t.length);const K=t.substring(k,H).trimRight();E&&(B?e+="//"+K+u():e.slice(-2)==="*/"?(e=e.slice(0,-2),e+="*"+K+"*/"):e+="/**"+K+"*/"),y=t.charAt(H+1),k=H+2,y>" "&&(e+=y)}function s(){e=e.slice(0,-2);const H=t.indexOf("*/",k);H===-1&&J("Unfinished multiligne comment"),l(),y==="*"&&l();let K=t.substring(k-1,H);E&&K!==""&&(B?(K=K.replace(/\*/gm,u()+"//"),e+="//"+K+u()):e+="/**"+K+"*/"),y=t.charAt(H+2),k=H+3,y>" "&&(e+=y)}function v(){if(y==='"'||y==="'")return L();const H=k-1;for(;y&&(y>="0"&&y<="9"||y>="a"&&y<="z"||y>="A"&&y<="Z"||y==="$"||y==="_");)c();return t.substring(H,k-1)}function $(H){if(i=[],o(),H==="mgodatagen"&&y!=="["&&J("mgodatagen config has to be an array"),y==="["){if(H==="bson")return i.push("collection"),N();for(c(),o();y;){if(V(!0),o(),y==="]")return c();if(y===","){c(),o();continue}J("Invalid configuration")}}if(c("d"),c("b"),o(),c("="),o(),y==="{"){for(c();y;)if(C(),o(),y==="}"||(y!==","&&J("Invalid configuration"),c(),o(),y==="}"))return g(),c()}J(`Invalid configuration:
 
 must be an array of documents like '[ {_id: 1}, {_id: 2} ]'
 
 or
 
-must match 'db = { collection: [ {_id: 1}, {_id: 2} ] }'`)}function x(){a();const F=v();a(),c(":"),a(),N(),i.push(F)}function C(){let F="";for(b==="-"&&(F+=b,c());b>="0"&&b<="9";)F+=b,c();if(b===".")for(F+=b,c();b>="0"&&b<="9";)F+=b,c();if(b==="e"||b==="E")for(F+=b,c(),(b==="-"||b==="+")&&(F+=b,c());b>="0"&&b<="9";)F+=b,c();isNaN(+F)&&Y("Invalid number")}function k(){b!=='"'&&b!=="'"&&Y("Expected a string"),e=e.slice(0,-1);let F="",H=b;l();let U=b;for(;b&&!(b===H&&U!=="\\");)F+=b,U=b,(b===`
-`||b==="\r")&&Y("Invalid string: missing terminating quote"),l();return b||(e+='"'+F,Y("Invalid string: missing terminating quote")),e+='"'+F+'"',c(),F}function R(){const F=L-1;switch(b){case"t":return c(),c("r"),c("u"),c("e");case"f":return c(),c("a"),c("l"),c("s"),c("e");case"n":switch(c(),b){case"u":return c(),c("l"),c("l");case"e":return _()}break;case"u":return c(),c("n"),c("d"),c("e"),c("f"),c("i"),c("n"),c("e"),c("d");case"O":return O();case"I":return I();case"T":return D();case"B":return T();case"N":switch(c(),c("u"),c("m"),c("b"),c("e"),c("r"),b){case"D":return P();case"L":return W();case"I":return M()}Y("Expecting NumberInt, NumberLong or NumberDecimal")}const H=t.indexOf(`
-`,F);Y(`Unknown type: '${t.substring(F,H)}'`)}function _(){switch(u=!0,c("e"),c("w"),c(" "),c("D"),c("a"),c("t"),c("e"),u=!1,c("("),a(),b){case")":return c();case'"':case"'":k();break;default:C()}a(),c(")")}function O(){c("O"),c("b"),c("j"),c("e"),c("c"),c("t"),c("I"),c("d"),c("("),a(),k().length!==24&&Y("Invalid ObjectId: hash has to be 24 char long"),a(),c(")")}function I(){c("I"),c("S"),c("O"),c("D"),c("a"),c("t"),c("e"),c("("),a(),k(),a(),c(")")}function D(){c("T"),c("i"),c("m"),c("e"),c("s"),c("t"),c("a"),c("m"),c("p"),c("("),h=!0,a(),(b===")"||b===",")&&Y("Invalid timestamp: missing second since unix epoch (number)"),C(),a(),c(","),a(),b===")"&&Y("Invalid timestamp: Missing incremental ordinal (number)"),C(),a(),h=!1,c(")")}function T(){c("B"),c("i"),c("n"),c("D"),c("a"),c("t"),c("a"),c("("),h=!0,a(),(b===")"||b===",")&&Y("Missing binary type (number)"),C(),a(),c(","),a(),k(),a(),h=!1,c(")")}function P(){c("D"),c("e"),c("c"),c("i"),c("m"),c("a"),c("l"),c("("),a(),b==='"'||b==="'"?k():C(),a(),c(")")}function M(){c("I"),c("n"),c("t"),c("("),a(),b===")"&&Y("NumberInt can't be empty"),C(),a(),c(")")}function W(){switch(c("L"),c("o"),c("n"),c("g"),c("("),a(),b){case'"':case"'":k();break;default:b>="0"&&b<="9"?C():Y("NumberLong() can't be empty")}a(),c(")")}function N(){if(b!=="["&&Y("Expected an array"),c(),a(),b==="]")return c();for(;b;)if(z(),a(),b==="]"||(b!==","&&Y("Invalid array: missing closing bracket"),c(),a(),b==="]"))return g(),c();Y("Invalid array: missing closing bracket")}function G(F){b!=="{"&&Y("Expected an object"),c(),a();let H=[];if(b==="}")return c();for(;b;){let U=v();a(),c(":"),H.includes(U)&&Y("Duplicate key '"+U+"'"),H.push(U);let Z=z();if(F&&U==="collection"&&i.push(Z),a(),b==="}"||(b!==","&&Y("Invalid object: missing closing bracket"),c(),a(),b==="}"))return g(),c()}Y("Invalid object: missing closing bracket")}function z(){switch(a(),b){case"{":return G();case"[":return N();case'"':case"'":return k();case"-":return C();default:b>="0"&&b<="9"?C():R()}}function J(){if(a(),c("d"),c("b"),c("."),v(),X(),b===".")return X()}function X(){switch(c("."),b){case"f":return j();case"a":return K();case"u":return Q();case"e":return V();default:Y("Unsupported method: only find(), aggregate(), update() and explain() are supported")}}function V(){if(c("e"),c("x"),c("p"),c("l"),c("a"),c("i"),c("n"),c("("),a(),b===")")return c();const F=k();["executionStats","queryPlanner","allPlansExecution"].includes(F)||Y(`Invalid explain mode: '${F}', expected one of ["executionStats", "queryPlanner", "allPlansExecution"]`),a(),c(")")}function j(){o="find",c("f"),c("i"),c("n"),c("d"),c("("),a(),q(2),a(),c(")")}function K(){switch(o="aggregate",c("a"),c("g"),c("g"),c("r"),c("e"),c("g"),c("a"),c("t"),c("e"),c("("),a(),b){case"[":ee();break;case"{":q(-1);break}a(),c(")")}function q(F){let H=0;for(;b&&b==="{";)H++,F!==-1&&H>F&&Y(`too many object, expected up to ${F}`),G(),a(),b===","&&(c(),a())}function ee(){if(b!=="["&&Y("Expected an array"),c(),a(),b==="]")return c();let F=0,H=e.length;for(;b;)if(te(),F++,F===r&&(H=e.length-1),a(),b==="]"||(b!==","&&Y("Invalid array: missing closing bracket"),c(),a(),b==="]"))return r>0&&F>r&&(e=e.slice(0,H),e+="]"),g(),c();Y("Invalid array: missing closing bracket")}function te(){b!=="{"&&Y("Expected an object"),c(),a();let F=[],H=!1;if(b==="}")return c();for(;b;){let U=v();if(H||(n.push(U),H=!0),a(),c(":"),F.includes(U)&&Y(`Duplicate key '${U}'`),F.push(U),z(),a(),b==="}"||(b!==","&&Y("Invalid object: missing closing bracket"),c(),a(),b==="}"))return g(),c()}Y("Invalid object: missing closing bracket")}function Q(){if(o="update",c("u"),c("p"),c("d"),c("a"),c("t"),c("e"),c("("),a(),G(),a(),c(","),a(),b==="["?N():G(),a(),b===","){if(c(),b===")")return c();a(),G(),a()}b===","&&(c(),a()),c(")")}function Y(F){throw{message:F,at:L}}function le(){return n}function he(){return o}function ce(){return i}return{indent:m,compact:w,compactAndRemoveComment:S,parse:y,getAggregationStages:le,getQueryType:he,getCollections:ce}},Playground=function(){let L=!0,b=!0,B=!0,E=!1,A=!1;const p=document.getElementById("configPanel"),h=document.getElementById("queryPanel"),u=document.getElementById("resultPanel"),t=document.getElementById("docPanel"),e=document.getElementById("link"),i=document.getElementById("share"),r={mode:"ace/mode/mongo",fontSize:"16px",enableBasicAutocompletion:!0,enableLiveAutocompletion:!0,enableSnippets:!0,useWorker:!1,useSoftTabs:!0,tabSize:2,showPrintMargin:!1},n=ace.edit(document.getElementById("config"),r),o=ace.edit(document.getElementById("query"),r),m=ace.edit(document.getElementById("result"),{mode:r.mode,fontSize:r.fontSize,readOnly:!0,showLineNumbers:!1,showGutter:!1,useWorker:!1,highlightActiveLine:!1,wrap:!0,showPrintMargin:!1}),w=new CustomSelect({selectId:"aggregation_stages",onChange:R}),S=new CustomSelect({selectId:"mode",onChange:a.bind(null,n,"config")}),y=new CustomSelect({selectId:"template",onChange:()=>{v(y.getSelectedIndex())}});document.getElementById("labelTemplate").style.visibility="visible";const c=document.getElementById("custom-aggregation_stages"),l=document.getElementById("aggregation_stages_label");m.renderer.$cursorLayer.element.style.display="none";const g=new Parser,d=new Completer({parser:g});n.completers=[d.configCompleter],o.completers=[d.queryCompleter],n.getSession().on("change",a.bind(null,n,"config")),o.getSession().on("change",a.bind(null,o,"query")),n.setValue(g.indent(n.getValue(),"config",S.getValue()),-1),o.setValue(g.indent(o.getValue(),"query",S.getValue()),-1),document.querySelector("div.content").style.visibility="visible",L=!1,b=!1,B=!1,document.addEventListener("keydown",M=>{(M.ctrlKey||M.metaKey)&&M.key==="Enter"&&(M.preventDefault(),R()),(M.ctrlKey||M.metaKey)&&M.key==="s"&&(M.preventDefault(),D())}),document.addEventListener("mousedown",M=>{M.target.id==="configResizeHandler"&&(E=!0),M.target.id==="queryResizeHandler"&&(A=!0)}),document.addEventListener("mousemove",M=>{let W;if(E)W=p;else if(A)W=h;else return!1;let N=M.clientX-W.offsetLeft,G=Math.max(60,N+2);W.style.width=`${G}px`,W.style.flexGrow="0"}),document.addEventListener("mouseup",()=>{E=!1,A=!1}),document.getElementById("run").addEventListener("click",R),document.getElementById("format").addEventListener("click",D),document.getElementById("share").addEventListener("click",_),document.getElementById("showDoc").addEventListener("click",$),document.querySelectorAll("[data-tooltip]").forEach(M=>{const W=document.createElement("div");W.className="tooltip",M.parentNode.insertBefore(W,M);const N=document.createElement("span");N.innerHTML=M.getAttribute("data-tooltip"),N.className="tooltiptext",N.classList.add("tooltip-hover"),M.id=="link"&&(N.id="link_tooltip",N.classList.remove("tooltip-hover")),W.appendChild(N),W.appendChild(M)});function a(M,W){let N=[];const G=g.parse(M.getValue(),W,S.getValue());if(G!=null){const z=M.getSession().getDocument().indexToPosition(G.at-1);N.push({row:z.row,column:z.column,text:G.message,type:"error"})}M.getSession().setAnnotations(N),W==="query"&&(g.getQueryType()==="aggregate"&&g.getAggregationStages().length>0?(w.setOptions(g.getAggregationStages()),c.style.visibility="visible",l.style.visibility="visible"):(c.style.visibility="hidden",l.style.visibility="hidden")),(!L||!b||!B)&&(W==="query"?b=!0:L=!0,B=!0,f("/",!1),document.getElementById("link_tooltip").classList.remove("tooltip-fadein-fadeout"))}function f(M,W){window.history.replaceState({},"MongoDB playground",M),e.style.visibility=W?"visible":"hidden",e.innerHTML=M,i.disabled=W}const s=[{config:'[{"key":1},{"key":2}]',query:"db.collection.find()",mode:"bson"},{config:'db={"orders":[{"_id":1,"item":"almonds","price":12,"quantity":2},{"_id":2,"item":"pecans","price":20,"quantity":1},{"_id":3}],"inventory":[{"_id":1,"sku":"almonds","description":"product 1","instock":120},{"_id":2,"sku":"bread","description":"product 2","instock":80},{"_id":3,"sku":"cashews","description":"product 3","instock":60},{"_id":4,"sku":"pecans","description":"product 4","instock":70},{"_id":5,"sku":null,"description":"Incomplete"}]}',query:'db.orders.aggregate([{"$lookup":{"from":"inventory","localField":"item","foreignField":"sku","as":"inventory_docs"}}])',mode:"bson"},{config:'[{"collection":"collection","count":10,"content":{"key":{"type":"int","min":0,"max":10}}}]',query:"db.collection.find()",mode:"mgodatagen"},{config:'[{"key":1},{"key":2}]',query:'db.collection.update({"key":2},{"$set":{"updated":true}},{"multi":false,"upsert":false})',mode:"bson"},{config:'[{"collection":"collection","count":5,"content":{"description":{"type":"enum","values":["Coffee and cakes","Gourmet hamburgers","Just coffee","Discount clothing","Indonesian goods"]}},"indexes":[{"name":"description_text_idx","key":{"description":"text"}}]}]',query:'db.collection.find({"$text":{"$search":"coffee"}})',mode:"mgodatagen"},{config:'[{"_id":1,"item":"ABC","price":80,"sizes":["S","M","L"]},{"_id":2,"item":"EFG","price":120,"sizes":[]},{"_id":3,"item":"IJK","price":160,"sizes":"M"},{"_id":4,"item":"LMN","price":10},{"_id":5,"item":"XYZ","price":5.75,"sizes":null}]',query:'db.collection.aggregate([{"$unwind":{"path":"$sizes","preserveNullAndEmptyArrays":true}},{"$group":{"_id":"$sizes","averagePrice":{"$avg":"$price"}}},{"$sort":{"averagePrice":-1}}]).explain("executionStats")',mode:"bson"}];function v(M){S.setValue(s[M].mode),n.setValue(g.indent(s[M].config,"config",S.getValue()),1),o.setValue(g.indent(s[M].query,"query",S.getValue()),1),m.setValue("",1)}function $(){t.style.display==="inline"?C():x()}function x(){t.hasChildNodes()||k(),t.style.display="inline",h.style.display="none",u.style.display="none"}function C(){t.style.display="none",h.style.display="inline",u.style.display="inline"}async function k(){const M=await fetch("/static/docs-c310647d0539a44970e85f228788385b.html",{method:"GET"});if(!M.ok)return T(`Failed to fetch doc: ${M.status} ${await M.text()}`);t.innerHTML=await M.text()}async function R(){if(I())return;D(),P("running query...",!1);const M=await fetch("/run",{method:"POST",body:O(!1)});if(!M.ok)return T(`Failed to run playground: ${M.status} ${await M.text()}`);L=!1,b=!1;const W=await M.text();if(W.startsWith("[")||W.startsWith("{"))return P(W,!0);if(W==="no document found")return P(W,!1);T(W)}async function _(){D();const M=await fetch("/save",{method:"POST",body:O(!0)});if(!M.ok)return T(`Failed to save playground: ${M.status} ${await M.text()}`);B=!1;const W=await M.text();if(!W.startsWith("http"))return T(W);f(W,!0),navigator.clipboard.writeText(W),document.getElementById("link_tooltip").classList.add("tooltip-fadein-fadeout")}function O(M){let W=S.getValue(),N=M?g.compact:g.compactAndRemoveComment;const G=new FormData;return G.append("mode",W),G.append("config",N(n.getValue(),"config",W)),G.append("query",N(o.getValue(),"query",W,w.getSelectedIndex()+1)),G}function I(){let M=n.getSession().getAnnotations();return M.length>0?(T(`Invalid configuration:
+must match 'db = { collection: [ {_id: 1}, {_id: 2} ] }'`)}function C(){o();const H=v();o(),c(":"),o(),N(),i.push(H)}function x(){let H="";for(y==="-"&&(H+=y,c());y>="0"&&y<="9";)H+=y,c();if(y===".")for(H+=y,c();y>="0"&&y<="9";)H+=y,c();if(y==="e"||y==="E")for(H+=y,c(),(y==="-"||y==="+")&&(H+=y,c());y>="0"&&y<="9";)H+=y,c();isNaN(+H)&&J("Invalid number")}function L(){y!=='"'&&y!=="'"&&J("Expected a string"),e=e.slice(0,-1);let H="",K=y;l();let ee=y;for(;y&&!(y===K&&ee!=="\\");)H+=y,ee=y,(y===`
+`||y==="\r")&&J("Invalid string: missing terminating quote"),l();return y||(e+='"'+H,J("Invalid string: missing terminating quote")),e+='"'+H+'"',c(),H}function R(){const H=k-1;switch(y){case"t":return c(),c("r"),c("u"),c("e");case"f":return c(),c("a"),c("l"),c("s"),c("e");case"n":switch(c(),y){case"u":return c(),c("l"),c("l");case"e":return T()}break;case"u":return c(),c("n"),c("d"),c("e"),c("f"),c("i"),c("n"),c("e"),c("d");case"O":return _();case"I":return F();case"T":return O();case"B":return M();case"N":switch(c(),c("u"),c("m"),c("b"),c("e"),c("r"),y){case"D":return P();case"L":return D();case"I":return I()}J("Expecting NumberInt, NumberLong or NumberDecimal")}const K=t.indexOf(`
+`,H);J(`Unknown type: '${t.substring(H,K)}'`)}function T(){switch(d=!0,c("e"),c("w"),c(" "),c("D"),c("a"),c("t"),c("e"),d=!1,c("("),o(),y){case")":return c();case'"':case"'":L();break;default:x()}o(),c(")")}function _(){c("O"),c("b"),c("j"),c("e"),c("c"),c("t"),c("I"),c("d"),c("("),o(),L().length!==24&&J("Invalid ObjectId: hash has to be 24 char long"),o(),c(")")}function F(){c("I"),c("S"),c("O"),c("D"),c("a"),c("t"),c("e"),c("("),o(),L(),o(),c(")")}function O(){c("T"),c("i"),c("m"),c("e"),c("s"),c("t"),c("a"),c("m"),c("p"),c("("),h=!0,o(),(y===")"||y===",")&&J("Invalid timestamp: missing second since unix epoch (number)"),x(),o(),c(","),o(),y===")"&&J("Invalid timestamp: Missing incremental ordinal (number)"),x(),o(),h=!1,c(")")}function M(){c("B"),c("i"),c("n"),c("D"),c("a"),c("t"),c("a"),c("("),h=!0,o(),(y===")"||y===",")&&J("Missing binary type (number)"),x(),o(),c(","),o(),L(),o(),h=!1,c(")")}function P(){c("D"),c("e"),c("c"),c("i"),c("m"),c("a"),c("l"),c("("),o(),y==='"'||y==="'"?L():x(),o(),c(")")}function I(){c("I"),c("n"),c("t"),c("("),o(),y===")"&&J("NumberInt can't be empty"),x(),o(),c(")")}function D(){switch(c("L"),c("o"),c("n"),c("g"),c("("),o(),y){case'"':case"'":L();break;default:y>="0"&&y<="9"?x():J("NumberLong() can't be empty")}o(),c(")")}function N(){if(y!=="["&&J("Expected an array"),c(),o(),y==="]")return c();for(;y;)if(z(),o(),y==="]"||(y!==","&&J("Invalid array: missing closing bracket"),c(),o(),y==="]"))return g(),c();J("Invalid array: missing closing bracket")}function V(H){y!=="{"&&J("Expected an object"),c(),o();let K=[];if(y==="}")return c();for(;y;){let ee=v();o(),c(":"),K.includes(ee)&&J("Duplicate key '"+ee+"'"),K.push(ee);let oe=z();if(H&&ee==="collection"&&i.push(oe),o(),y==="}"||(y!==","&&J("Invalid object: missing closing bracket"),c(),o(),y==="}"))return g(),c()}J("Invalid object: missing closing bracket")}function z(){switch(o(),y){case"{":return V();case"[":return N();case'"':case"'":return L();case"-":return x();default:y>="0"&&y<="9"?x():R()}}const Q=["find","aggregate","update","explain","count","countDocuments","estimatedDocumentCount","distinct","updateOne","updateMany","replaceOne","insertOne","insertMany","deleteOne","deleteMany","bulkWrite","findOneAndUpdate","findOneAndReplace","findOneAndDelete","sort","skip","limit","collation","hint","min","max","projection"];function X(){let H=0,K=-1;for(o();y;){if(H>0){const ee=e.slice(-1);e=e.slice(0,-1),K>=0&&(e=e.slice(0,K)+e.slice(K+1)),e+=(B?`
+`:";")+ee}U(),H++,o(),K=-1,y===";"&&(K=e.length-1,c(),o())}H===0&&J("Expected a query like 'db.collection.find()'"),K>=0&&(e=e.slice(0,K)+e.slice(K+1)),H>1&&(a="script")}function U(){for(c("d"),c("b"),c("."),v(),Z(),o();y===".";)Z(),o()}function Z(){c(".");const H=v();switch(Q.includes(H)||J(`Unsupported method: '${H}'`),a==="unknown"&&H!=="explain"&&(a=H),H){case"find":return te();case"aggregate":return ne();case"update":return he();case"explain":return G();default:return ie()}}function G(){if(c("("),o(),y===")")return c();const H=L();["executionStats","queryPlanner","allPlansExecution"].includes(H)||J(`Invalid explain mode: '${H}', expected one of ["executionStats", "queryPlanner", "allPlansExecution"]`),o(),c(")")}function ie(){for(c("("),o();y&&y!==")"&&(z(),o(),y===",");)c(),o();c(")")}function te(){c("("),o(),q(2),o(),c(")")}function ne(){switch(c("("),o(),y){case"[":re(),o(),y===","&&(c(),o(),y==="{"&&V());break;case"{":q(-1);break}o(),c(")")}function q(H){let K=0;for(;y&&y==="{";)K++,H!==-1&&K>H&&J(`too many object, expected up to ${H}`),V(),o(),y===","&&(c(),o())}function re(){if(y!=="["&&J("Expected an array"),c(),o(),y==="]")return c();let H=0,K=e.length;for(;y;)if(ce(),H++,H===r&&(K=e.length-1),o(),y==="]"||(y!==","&&J("Invalid array: missing closing bracket"),c(),o(),y==="]"))return r>0&&H>r&&(e=e.slice(0,K),e+="]"),g(),c();J("Invalid array: missing closing bracket")}function ce(){y!=="{"&&J("Expected an object"),c(),o();let H=[],K=!1;if(y==="}")return c();for(;y;){let ee=v();if(K||(n.push(ee),K=!0),o(),c(":"),H.includes(ee)&&J(`Duplicate key '${ee}'`),H.push(ee),z(),o(),y==="}"||(y!==","&&J("Invalid object: missing closing bracket"),c(),o(),y==="}"))return g(),c()}J("Invalid object: missing closing bracket")}function he(){if(c("("),o(),V(),o(),c(","),o(),y==="["?N():V(),o(),y===","){if(c(),y===")")return c();o(),V(),o()}y===","&&(c(),o()),c(")")}function J(H){throw{message:H,at:k}}function W(){return n}function Y(){return a}function j(){return i}return{indent:m,compact:w,compactAndRemoveComment:S,parse:b,getAggregationStages:W,getQueryType:Y,getCollections:j}},Playground=function(){let k=!0,y=!0,B=!0,E=window.location.pathname.startsWith("/p/")?window.location.pathname.substring(3):"",A=!1,p=!1;const h=document.getElementById("configPanel"),d=document.getElementById("queryPanel"),t=document.getElementById("resultPanel"),e=document.getElementById("docPanel"),i=document.getElementById("link"),r=document.getElementById("share"),n={mode:"ace/mode/mongo",fontSize:"16px",enableBasicAutocompletion:!0,enableLiveAutocompletion:!0,enableSnippets:!0,useWorker:!1,useSoftTabs:!0,tabSize:2,showPrintMargin:!1},a=ace.edit(document.getElementById("config"),n),m=ace.edit(document.getElementById("query"),n),w=ace.edit(document.getElementById("result"),{mode:n.mode,fontSize:n.fontSize,readOnly:!0,showLineNumbers:!1,showGutter:!1,useWorker:!1,highlightActiveLine:!1,wrap:!0,showPrintMargin:!1}),S=new CustomSelect({selectId:"aggregation_stages",onChange:T}),b=new CustomSelect({selectId:"mode",onChange:f.bind(null,a,"config")}),c=new CustomSelect({selectId:"template",onChange:()=>{$(c.getSelectedIndex())}});document.getElementById("labelTemplate").style.visibility="visible";const l=document.getElementById("custom-aggregation_stages"),g=document.getElementById("aggregation_stages_label");w.renderer.$cursorLayer.element.style.display="none";const u=new Parser,o=new Completer({parser:u});a.completers=[o.configCompleter],m.completers=[o.queryCompleter],a.getSession().on("change",f.bind(null,a,"config")),m.getSession().on("change",f.bind(null,m,"query")),a.setValue(u.indent(a.getValue(),"config",b.getValue()),-1),m.setValue(u.indent(m.getValue(),"query",b.getValue()),-1),document.querySelector("div.content").style.visibility="visible",k=!1,y=!1,B=!1,document.addEventListener("keydown",D=>{(D.ctrlKey||D.metaKey)&&D.key==="Enter"&&(D.preventDefault(),T()),(D.ctrlKey||D.metaKey)&&D.key==="s"&&(D.preventDefault(),M())}),document.addEventListener("mousedown",D=>{D.target.id==="configResizeHandler"&&(A=!0),D.target.id==="queryResizeHandler"&&(p=!0)}),document.addEventListener("mousemove",D=>{let N;if(A)N=h;else if(p)N=d;else return!1;let V=D.clientX-N.offsetLeft,z=Math.max(60,V+2);N.style.width=`${z}px`,N.style.flexGrow="0"}),document.addEventListener("mouseup",()=>{A=!1,p=!1}),document.getElementById("run").addEventListener("click",T),document.getElementById("format").addEventListener("click",M),document.getElementById("share").addEventListener("click",_),document.getElementById("showDoc").addEventListener("click",C),document.querySelectorAll("[data-tooltip]").forEach(D=>{const N=document.createElement("div");N.className="tooltip",D.parentNode.insertBefore(N,D);const V=document.createElement("span");V.innerHTML=D.getAttribute("data-tooltip"),V.className="tooltiptext",V.classList.add("tooltip-hover"),D.id=="link"&&(V.id="link_tooltip",V.classList.remove("tooltip-hover")),N.appendChild(V),N.appendChild(D)});function f(D,N){let V=[];const z=u.parse(D.getValue(),N,b.getValue());if(z!=null){const Q=D.getSession().getDocument().indexToPosition(z.at-1);V.push({row:Q.row,column:Q.column,text:z.message,type:"error"})}D.getSession().setAnnotations(V),N==="query"&&(u.getQueryType()==="aggregate"&&u.getAggregationStages().length>0?(S.setOptions(u.getAggregationStages()),l.style.visibility="visible",g.style.visibility="visible"):(l.style.visibility="hidden",g.style.visibility="hidden")),(!k||!y||!B)&&(N==="query"?y=!0:k=!0,B=!0,s("/",!1),document.getElementById("link_tooltip").classList.remove("tooltip-fadein-fadeout"))}function s(D,N){window.history.replaceState({},"MongoDB playground",D),i.style.visibility=N?"visible":"hidden",i.innerHTML=D,r.disabled=N}const v=[{config:'[{"key":1},{"key":2}]',query:"db.collection.find()",mode:"bson"},{config:'db={"orders":[{"_id":1,"item":"almonds","price":12,"quantity":2},{"_id":2,"item":"pecans","price":20,"quantity":1},{"_id":3}],"inventory":[{"_id":1,"sku":"almonds","description":"product 1","instock":120},{"_id":2,"sku":"bread","description":"product 2","instock":80},{"_id":3,"sku":"cashews","description":"product 3","instock":60},{"_id":4,"sku":"pecans","description":"product 4","instock":70},{"_id":5,"sku":null,"description":"Incomplete"}]}',query:'db.orders.aggregate([{"$lookup":{"from":"inventory","localField":"item","foreignField":"sku","as":"inventory_docs"}}])',mode:"bson"},{config:'[{"collection":"collection","count":10,"content":{"key":{"type":"int","min":0,"max":10}}}]',query:"db.collection.find()",mode:"mgodatagen"},{config:'[{"key":1},{"key":2}]',query:'db.collection.update({"key":2},{"$set":{"updated":true}},{"multi":false,"upsert":false})',mode:"bson"},{config:'[{"collection":"collection","count":5,"content":{"description":{"type":"enum","values":["Coffee and cakes","Gourmet hamburgers","Just coffee","Discount clothing","Indonesian goods"]}},"indexes":[{"name":"description_text_idx","key":{"description":"text"}}]}]',query:'db.collection.find({"$text":{"$search":"coffee"}})',mode:"mgodatagen"},{config:'[{"_id":1,"item":"ABC","price":80,"sizes":["S","M","L"]},{"_id":2,"item":"EFG","price":120,"sizes":[]},{"_id":3,"item":"IJK","price":160,"sizes":"M"},{"_id":4,"item":"LMN","price":10},{"_id":5,"item":"XYZ","price":5.75,"sizes":null}]',query:'db.collection.aggregate([{"$unwind":{"path":"$sizes","preserveNullAndEmptyArrays":true}},{"$group":{"_id":"$sizes","averagePrice":{"$avg":"$price"}}},{"$sort":{"averagePrice":-1}}]).explain("executionStats")',mode:"bson"}];function $(D){b.setValue(v[D].mode),a.setValue(u.indent(v[D].config,"config",b.getValue()),1),m.setValue(u.indent(v[D].query,"query",b.getValue()),1),w.setValue("",1)}function C(){e.style.display==="inline"?L():x()}function x(){e.hasChildNodes()||R(),e.style.display="inline",d.style.display="none",t.style.display="none"}function L(){e.style.display="none",d.style.display="inline",t.style.display="inline"}async function R(){const D=await fetch("/static/docs-c310647d0539a44970e85f228788385b.html",{method:"GET"});if(!D.ok)return P(`Failed to fetch doc: ${D.status} ${await D.text()}`);e.innerHTML=await D.text()}async function T(){if(O())return;M(),I("running query...",!1);const D=await fetch("/run",{method:"POST",body:F(!1)});if(!D.ok)return P(`Failed to run playground: ${D.status} ${await D.text()}`);k=!1,y=!1;const N=await D.text();if(N==="no document found")return I(N,!1);if(u.parse(N,"result",b.getValue())===null)return I(N,!0);P(N)}async function _(){M();const D=F(!0);E&&D.append("parent",E);const N=await fetch("/save",{method:"POST",body:D});if(!N.ok)return P(`Failed to save playground: ${N.status} ${await N.text()}`);B=!1;const V=await N.text();if(!V.startsWith("http"))return P(V);s(V,!0),E=V.substring(V.lastIndexOf("/")+1),navigator.clipboard.writeText(V),document.getElementById("link_tooltip").classList.add("tooltip-fadein-fadeout")}function F(D){let N=b.getValue(),V=D?u.compact:u.compactAndRemoveComment,z=l.style.visibility==="visible"?S.getSelectedIndex()+1:-1;const Q=new FormData;return Q.append("mode",N),Q.append("config",V(a.getValue(),"config",N)),Q.append("query",V(m.getValue(),"query",N,z)),Q}function O(){let D=a.getSession().getAnnotations();return D.length>0?(P(`Invalid configuration:
 
-Line ${M[0].row+1}: ${M[0].text}`),!0):(M=o.getSession().getAnnotations(),M.length>0?(T(`Invalid query:
+Line ${D[0].row+1}: ${D[0].text}`),!0):(D=m.getSession().getAnnotations(),D.length>0?(P(`Invalid query:
 
-Line ${M[0].row+1}: ${M[0].text}`),!0):!1)}function D(){C(),!I()&&((L||b)&&m.setValue("",-1),L&&n.setValue(g.indent(n.getValue(),"config",S.getValue()),1),b&&o.setValue(g.indent(o.getValue(),"query",S.getValue()),1))}function T(M){C(),u.classList.add("text_red"),m.setOption("wrap",!0),m.setValue(M,-1)}function P(M,W){u.classList.remove("text_red"),W&&(M=g.indent(M,"result",S.getValue())),m.setOption("wrap",!1),m.setValue(M,-1)}};window.onload=()=>{new Playground};
+Line ${D[0].row+1}: ${D[0].text}`),!0):!1)}function M(){L(),!O()&&((k||y)&&w.setValue("",-1),k&&a.setValue(u.indent(a.getValue(),"config",b.getValue()),1),y&&m.setValue(u.indent(m.getValue(),"query",b.getValue()),1))}function P(D){L(),t.classList.add("text_red"),w.setOption("wrap",!0),w.setValue(D,-1)}function I(D,N){t.classList.remove("text_red"),N&&(D=u.indent(D,"result",b.getValue())),w.setOption("wrap",!1),w.setValue(D,-1)}};window.onload=()=>{new Playground};
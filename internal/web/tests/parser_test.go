@@ -350,6 +350,21 @@ func addJavascriptIndentRoundTripTests(buffer *bytes.Buffer) {
   //	                   with weird indentation
   //
   k: 1
+})`,
+		},
+		{
+			name:  "script",
+			eType: "query",
+			input: `db.collection.insertOne({ "k": 1 }) ;
+			// then
+			db.collection.find().sort({"k": -1})  ;`,
+			compact: `db.collection.insertOne({"k":1})/** then*/;db.collection.find().sort({"k":-1})`,
+			indent: `db.collection.insertOne({
+  "k": 1
+})// then
+
+db.collection.find().sort({
+  "k": -1
 })`,
 		},
 		{
@@ -975,6 +990,58 @@ db.collection.aggregate([{"$match": { "_id": ObjectId("5a934e000102030405000000"
 			input: `db.collection.update({"key": 2},{"$set": {"updated": true}},)`,
 			valid: true,
 		},
+		{
+			name:  `count methods`,
+			input: `db.collection.countDocuments({"k": 1}, {"limit": 10})`,
+			valid: true,
+		},
+		{
+			name:  `distinct`,
+			input: `db.collection.distinct("k", {"v": {"$gt": 1}})`,
+			valid: true,
+		},
+		{
+			name:  `write method`,
+			input: `db.collection.insertMany([{"k": 1}, {"k": 2}])`,
+			valid: true,
+		},
+		{
+			name:  `cursor methods`,
+			input: `db.collection.find({"k": 1}).sort({"k": -1}).skip(1).limit(2).count()`,
+			valid: true,
+		},
+		{
+			name: `cursor methods on several lines`,
+			input: `db.collection.find()
+  .sort({"k": -1})`,
+			valid: true,
+		},
+		{
+			name:  `aggregate with options`,
+			input: `db.collection.aggregate([{"$match": {"k": 1}}], {"allowDiskUse": true})`,
+			valid: true,
+		},
+		{
+			name: `script`,
+			input: `db.collection.insertOne({"k": 1});
+db.collection.find()`,
+			valid: true,
+		},
+		{
+			name:  `script without separator`,
+			input: `db.collection.insertOne({"k": 1})db.collection.find()`,
+			valid: true,
+		},
+		{
+			name:  `script with invalid statement`,
+			input: `db.collection.insertOne({"k": 1}); db.collection.findOne()`,
+			valid: false,
+		},
+		{
+			name:  `empty query`,
+			input: ``,
+			valid: false,
+		},
 	}
 
 	testFormat := `
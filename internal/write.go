@@ -0,0 +1,450 @@
+// mongoplayground: a sandbox to test and share MongoDB queries
+// Copyright (C) 2023 Adrien Petel
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/feliixx/mongoextjson"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// methods that modify the database. Those queries are always
+// run against a unique database, see storage.run()
+var writeMethods = map[string]bool{
+	updateMethod:            true,
+	updateOneMethod:         true,
+	updateManyMethod:        true,
+	replaceOneMethod:        true,
+	insertOneMethod:         true,
+	insertManyMethod:        true,
+	deleteOneMethod:         true,
+	deleteManyMethod:        true,
+	bulkWriteMethod:         true,
+	findOneAndUpdateMethod:  true,
+	findOneAndReplaceMethod: true,
+	findOneAndDeleteMethod:  true,
+}
+
+// options supported by the write methods taking a document of options,
+// other than update methods
+var writeOptions = map[string][]string{
+	replaceOneMethod:        {"upsert"},
+	findOneAndUpdateMethod:  {"upsert", "returnDocument", "returnNewDocument", "sort", "projection", "arrayFilters"},
+	findOneAndReplaceMethod: {"upsert", "returnDocument", "returnNewDocument", "sort", "projection"},
+	findOneAndDeleteMethod:  {"sort", "projection"},
+}
+
+// summary of a write query, as reported by the server
+type writeResult struct {
+	InsertedCount int64 `json:"insertedCount"`
+	MatchedCount  int64 `json:"matchedCount"`
+	ModifiedCount int64 `json:"modifiedCount"`
+	DeletedCount  int64 `json:"deletedCount"`
+	UpsertedCount int64 `json:"upsertedCount"`
+	UpsertedID    any   `json:"upsertedId,omitempty"`
+}
+
+func fromUpdateResult(res *mongo.UpdateResult) *writeResult {
+	return &writeResult{
+		MatchedCount:  res.MatchedCount,
+		ModifiedCount: res.ModifiedCount,
+		UpsertedCount: res.UpsertedCount,
+		UpsertedID:    res.UpsertedID,
+	}
+}
+
+// apply a write query on the collection and return the summary
+// of the write. By default, this summary is not shown to the user,
+// as the whole collection is returned afterwards
+func runWrite(ctx context.Context, collection *mongo.Collection, method string, stages []any) (*writeResult, error) {
+
+	var (
+		res *writeResult
+		err error
+	)
+
+	switch method {
+	case updateMethod:
+		var updateRes *mongo.UpdateResult
+		multi, opts := parseUpdateOpts(stageAt(stages, 2))
+		if multi {
+			updateRes, err = collection.UpdateMany(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+		} else {
+			updateRes, err = collection.UpdateOne(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+		}
+		if err == nil {
+			res = fromUpdateResult(updateRes)
+		}
+
+	case updateOneMethod:
+		_, opts := parseUpdateOpts(stageAt(stages, 2))
+		updateRes, updateErr := collection.UpdateOne(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+		if err = updateErr; err == nil {
+			res = fromUpdateResult(updateRes)
+		}
+
+	case updateManyMethod:
+		_, opts := parseUpdateOpts(stageAt(stages, 2))
+		updateRes, updateErr := collection.UpdateMany(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+		if err = updateErr; err == nil {
+			res = fromUpdateResult(updateRes)
+		}
+
+	case replaceOneMethod:
+		optsDoc, _ := stageAt(stages, 2).(map[string]any)
+		if err := checkWriteOpts(method, optsDoc); err != nil {
+			return nil, err
+		}
+		upsert, _ := optsDoc["upsert"].(bool)
+		updateRes, updateErr := collection.ReplaceOne(ctx, stageAt(stages, 0), stageAt(stages, 1), options.Replace().SetUpsert(upsert))
+		if err = updateErr; err == nil {
+			res = fromUpdateResult(updateRes)
+		}
+
+	case insertOneMethod:
+		seeder, seedErr := newIDSeeder(ctx, collection.Database())
+		if seedErr != nil {
+			return nil, fmt.Errorf("fail to run %s: %v", method, seedErr)
+		}
+		_, err = collection.InsertOne(ctx, seeder.seed(stageAt(stages, 0)))
+		res = &writeResult{InsertedCount: 1}
+
+	case insertManyMethod:
+		docs, ok := stageAt(stages, 0).([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s() requires an array of documents as first argument", method)
+		}
+		if len(docs) == 0 {
+			return nil, fmt.Errorf("%s() requires at least one document", method)
+		}
+		seeder, seedErr := newIDSeeder(ctx, collection.Database())
+		if seedErr != nil {
+			return nil, fmt.Errorf("fail to run %s: %v", method, seedErr)
+		}
+		for i := range docs {
+			docs[i] = seeder.seed(docs[i])
+		}
+		insertRes, insertErr := collection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(isOrdered(stageAt(stages, 1))))
+		if err = insertErr; err == nil {
+			res = &writeResult{InsertedCount: int64(len(insertRes.InsertedIDs))}
+		}
+
+	case deleteOneMethod:
+		deleteRes, deleteErr := collection.DeleteOne(ctx, stageAt(stages, 0))
+		if err = deleteErr; err == nil {
+			res = &writeResult{DeletedCount: deleteRes.DeletedCount}
+		}
+
+	case deleteManyMethod:
+		deleteRes, deleteErr := collection.DeleteMany(ctx, stageAt(stages, 0))
+		if err = deleteErr; err == nil {
+			res = &writeResult{DeletedCount: deleteRes.DeletedCount}
+		}
+
+	case bulkWriteMethod:
+		seeder, seedErr := newIDSeeder(ctx, collection.Database())
+		if seedErr != nil {
+			return nil, fmt.Errorf("fail to run %s: %v", method, seedErr)
+		}
+		models, parseErr := parseBulkWriteModels(stageAt(stages, 0), seeder)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		bulkRes, bulkErr := collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(isOrdered(stageAt(stages, 1))))
+		if err = bulkErr; err == nil {
+			res = &writeResult{
+				InsertedCount: bulkRes.InsertedCount,
+				MatchedCount:  bulkRes.MatchedCount,
+				ModifiedCount: bulkRes.ModifiedCount,
+				DeletedCount:  bulkRes.DeletedCount,
+				UpsertedCount: bulkRes.UpsertedCount,
+			}
+			if len(bulkRes.UpsertedIDs) > 0 {
+				res.UpsertedID = bulkRes.UpsertedIDs
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid method: '%s'", method)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("fail to run %s: %v", method, err)
+	}
+	return res, nil
+}
+
+// run a findOneAndUpdate(), findOneAndReplace() or findOneAndDelete() query,
+// and return the document returned by the server
+func runFindOneAnd(ctx context.Context, collection *mongo.Collection, method string, stages []any) ([]byte, error) {
+
+	var res *mongo.SingleResult
+
+	optsDoc, _ := stageAt(stages, 2).(map[string]any)
+	if method == findOneAndDeleteMethod {
+		optsDoc, _ = stageAt(stages, 1).(map[string]any)
+	}
+	if err := checkWriteOpts(method, optsDoc); err != nil {
+		return nil, err
+	}
+
+	upsert, _ := optsDoc["upsert"].(bool)
+	returnDocument := options.Before
+	if rd, _ := optsDoc["returnDocument"].(string); rd == "after" {
+		returnDocument = options.After
+	}
+	// legacy option from the mongo shell
+	if returnNew, _ := optsDoc["returnNewDocument"].(bool); returnNew {
+		returnDocument = options.After
+	}
+
+	switch method {
+	case findOneAndUpdateMethod:
+		opts := options.FindOneAndUpdate().
+			SetUpsert(upsert).
+			SetReturnDocument(returnDocument)
+		if sort, ok := optsDoc["sort"]; ok {
+			opts.SetSort(sort)
+		}
+		if projection, ok := optsDoc["projection"]; ok {
+			opts.SetProjection(projection)
+		}
+		if arrayFilters, ok := optsDoc["arrayFilters"].([]any); ok {
+			opts.SetArrayFilters(options.ArrayFilters{Filters: arrayFilters})
+		}
+		res = collection.FindOneAndUpdate(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+
+	case findOneAndReplaceMethod:
+		opts := options.FindOneAndReplace().
+			SetUpsert(upsert).
+			SetReturnDocument(returnDocument)
+		if sort, ok := optsDoc["sort"]; ok {
+			opts.SetSort(sort)
+		}
+		if projection, ok := optsDoc["projection"]; ok {
+			opts.SetProjection(projection)
+		}
+		res = collection.FindOneAndReplace(ctx, stageAt(stages, 0), stageAt(stages, 1), opts)
+
+	case findOneAndDeleteMethod:
+		opts := options.FindOneAndDelete()
+		if sort, ok := optsDoc["sort"]; ok {
+			opts.SetSort(sort)
+		}
+		if projection, ok := optsDoc["projection"]; ok {
+			opts.SetProjection(projection)
+		}
+		res = collection.FindOneAndDelete(ctx, stageAt(stages, 0), opts)
+
+	default:
+		return nil, fmt.Errorf("invalid method: '%s'", method)
+	}
+
+	var doc bson.M
+	err := res.Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return []byte(noDocFound), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to run %s: %v", method, err)
+	}
+	return mongoextjson.Marshal(doc)
+}
+
+func checkWriteOpts(method string, optsDoc map[string]any) error {
+	for key := range optsDoc {
+		if !contains(writeOptions[method], key) {
+			return fmt.Errorf("unsupported option '%s' in %s(), supported options are: %s", key, method, strings.Join(writeOptions[method], ", "))
+		}
+	}
+	return nil
+}
+
+func isFindOneAnd(method string) bool {
+	return method == findOneAndUpdateMethod ||
+		method == findOneAndReplaceMethod ||
+		method == findOneAndDeleteMethod
+}
+
+func parseUpdateOpts(opts any) (bool, *options.UpdateOptions) {
+
+	optsDoc, _ := opts.(map[string]any)
+
+	multi, _ := optsDoc["multi"].(bool)
+	upsert, _ := optsDoc["upsert"].(bool)
+	arrayFilters, _ := optsDoc["arrayFilters"].([]any)
+
+	return multi, options.Update().
+		SetUpsert(upsert).
+		SetArrayFilters(options.ArrayFilters{
+			Filters: arrayFilters,
+		})
+}
+
+// writes are ordered by default, like in the mongo shell
+func isOrdered(opts any) bool {
+
+	optsDoc, _ := opts.(map[string]any)
+
+	ordered, ok := optsDoc["ordered"].(bool)
+	return !ok || ordered
+}
+
+// parse the operations of a bulkWrite(), for example:
+//
+//	[
+//	  {insertOne: {document: {_id: 3}}},
+//	  {updateOne: {filter: {_id: 1}, update: {$set: {k: 1}}, upsert: true}},
+//	  {deleteMany: {filter: {k: 2}}}
+//	]
+func parseBulkWriteModels(operations any, seeder *idSeeder) ([]mongo.WriteModel, error) {
+
+	ops, ok := operations.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s() requires an array of operations as first argument", bulkWriteMethod)
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%s() requires at least one operation", bulkWriteMethod)
+	}
+
+	models := make([]mongo.WriteModel, 0, len(ops))
+	for i, op := range ops {
+
+		opDoc, _ := op.(map[string]any)
+		if len(opDoc) != 1 {
+			return nil, fmt.Errorf("invalid operation at index %d in %s(): expected a document with a single key like {insertOne: {...}}", i, bulkWriteMethod)
+		}
+
+		for name, value := range opDoc {
+
+			args, _ := value.(map[string]any)
+			upsert, _ := args["upsert"].(bool)
+			arrayFilters, _ := args["arrayFilters"].([]any)
+
+			switch name {
+			case insertOneMethod:
+				models = append(models, mongo.NewInsertOneModel().
+					SetDocument(seeder.seed(valueOrEmpty(args, "document"))))
+
+			case updateOneMethod:
+				model := mongo.NewUpdateOneModel().
+					SetFilter(valueOrEmpty(args, "filter")).
+					SetUpdate(valueOrEmpty(args, "update")).
+					SetUpsert(upsert)
+				if arrayFilters != nil {
+					model.SetArrayFilters(options.ArrayFilters{Filters: arrayFilters})
+				}
+				models = append(models, model)
+
+			case updateManyMethod:
+				model := mongo.NewUpdateManyModel().
+					SetFilter(valueOrEmpty(args, "filter")).
+					SetUpdate(valueOrEmpty(args, "update")).
+					SetUpsert(upsert)
+				if arrayFilters != nil {
+					model.SetArrayFilters(options.ArrayFilters{Filters: arrayFilters})
+				}
+				models = append(models, model)
+
+			case replaceOneMethod:
+				models = append(models, mongo.NewReplaceOneModel().
+					SetFilter(valueOrEmpty(args, "filter")).
+					SetReplacement(valueOrEmpty(args, "replacement")).
+					SetUpsert(upsert))
+
+			case deleteOneMethod:
+				models = append(models, mongo.NewDeleteOneModel().
+					SetFilter(valueOrEmpty(args, "filter")))
+
+			case deleteManyMethod:
+				models = append(models, mongo.NewDeleteManyModel().
+					SetFilter(valueOrEmpty(args, "filter")))
+
+			default:
+				return nil, fmt.Errorf("unsupported operation '%s' in %s(), supported operations are: insertOne, updateOne, updateMany, replaceOne, deleteOne, deleteMany", name, bulkWriteMethod)
+			}
+		}
+	}
+	return models, nil
+}
+
+func valueOrEmpty(doc map[string]any, key string) any {
+	if v, ok := doc[key]; ok {
+		return v
+	}
+	return bson.M{}
+}
+
+// idSeeder adds a seeded ObjectId to inserted documents without
+// "_id", like fillDatabase() does when the database is created.
+// This way, the output of an insert query is always the same
+type idSeeder struct {
+	next int32
+}
+
+// seeded ObjectIds of the config are generated from 0 to nbDocs-1,
+// so start right after the highest one found in the database. Counting
+// the documents instead would reuse an id once a document is deleted
+func newIDSeeder(ctx context.Context, db *mongo.Database) (*idSeeder, error) {
+
+	names, err := db.ListCollectionNames(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": bson.M{"$gte": seededObjectID(0), "$lte": seededObjectID(1<<24 - 1)}}
+	opts := options.FindOne().SetSort(bson.M{"_id": -1}).SetProjection(bson.M{"_id": 1})
+
+	var next int32
+	for _, name := range names {
+		var doc struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		err := db.Collection(name).FindOne(ctx, filter, opts).Decode(&doc)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the counter is stored in the last 3 bytes of the ObjectId
+		if n := int32(doc.ID[9])<<16 | int32(doc.ID[10])<<8 | int32(doc.ID[11]); n >= next {
+			next = n + 1
+		}
+	}
+	return &idSeeder{next: next}, nil
+}
+
+func (s *idSeeder) seed(doc any) any {
+
+	d, ok := doc.(map[string]any)
+	if !ok {
+		return doc
+	}
+	if _, hasID := d["_id"]; !hasID {
+		d["_id"] = seededObjectID(s.next)
+		s.next++
+	}
+	return d
+}
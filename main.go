@@ -17,27 +17,55 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"time"
 
 	"github.com/feliixx/boa"
+	"github.com/feliixx/mongoplayground/client"
 	"github.com/feliixx/mongoplayground/internal"
 )
 
 func main() {
 
+	// mongoplayground can also be used as a client of a
+	// running server, see client.RunCommand()
+	if len(os.Args) > 1 && client.IsCommand(os.Args[1]) {
+		err := client.RunCommand(context.Background(), os.Args[1:], os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	loadConfig()
 	setLogger()
 
-	s, err := internal.NewServer(
-		boa.GetString("mongo.uri"),
-		boa.GetBool("mongo.dropFirst"),
-		loadCloudflareInfo(),
-		loadMailInfo(),
-		loadGoogleDriveInfo(),
+	pageStore, err := internal.OpenPageStore(
+		boa.GetString("storage.type"),
+		boa.GetString("storage.path"),
 	)
+	if err != nil {
+		log.Fatalf("aborting: fail to open page store: %v\n", err)
+	}
+
+	s, err := internal.NewServer(internal.ServerOptions{
+		MongoURI:        boa.GetString("mongo.uri"),
+		MongoURIs:       loadMongoUris(),
+		DropFirst:       boa.GetBool("mongo.dropFirst"),
+		CacheMaxSize:    int64(boa.GetInt("mongo.cacheMaxBytes")),
+		PageStore:       pageStore,
+		CloudflareInfo:  loadCloudflareInfo(),
+		MailInfo:        loadMailInfo(),
+		GoogleDriveInfo: loadGoogleDriveInfo(),
+		ResultLimits:    loadResultLimits(),
+		RateLimits:      loadRateLimits(),
+		QueueLimits:     loadQueueLimits(),
+	})
 	if err != nil {
 		log.Fatalf("aborting: %v\n", err)
 	}
@@ -95,6 +123,20 @@ func setLogger() {
 	}(logger)
 }
 
+// additional MongoDB backends, keyed by version, like:
+//
+//	"versions": {"5.0": "mongodb://localhost:27018"}
+func loadMongoUris() map[string]string {
+
+	uris := map[string]string{}
+	for version, uri := range boa.GetMap("mongo.versions") {
+		if s, ok := uri.(string); ok {
+			uris[version] = s
+		}
+	}
+	return uris
+}
+
 func loadMailInfo() *internal.MailInfo {
 
 	if !boa.GetBool("mail.enabled") {
@@ -130,6 +172,31 @@ func loadGoogleDriveInfo() *internal.GoogleDriveInfo {
 	)
 }
 
+func loadResultLimits() *internal.ResultLimits {
+	return internal.NewResultLimits(
+		boa.GetInt("results.maxDocs"),
+		boa.GetInt("results.maxBytes"),
+	)
+}
+
+func loadRateLimits() *internal.RateLimits {
+	return internal.NewRateLimits(
+		boa.GetFloat64("rateLimit.requestsPerSecond"),
+		boa.GetInt("rateLimit.burst"),
+		boa.GetInt("rateLimit.maxDBCreations"),
+		boa.GetInt("rateLimit.maxClients"),
+		boa.GetBool("rateLimit.trustCloudflareIP"),
+	)
+}
+
+func loadQueueLimits() *internal.QueueLimits {
+	return internal.NewQueueLimits(
+		boa.GetInt("runQueue.workers"),
+		boa.GetInt("runQueue.depth"),
+		time.Duration(boa.GetInt("runQueue.waitTimeoutSeconds"))*time.Second,
+	)
+}
+
 func redirectTLS(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "https://"+r.Host+r.RequestURI, http.StatusMovedPermanently)
 }